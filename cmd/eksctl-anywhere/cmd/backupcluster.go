@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/tar"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type backupClusterOptions struct {
+	clusterName      string
+	kubeconfig       string
+	outputFile       string
+	etcdSnapshotNode string
+	sshUser          string
+	sshKey           string
+}
+
+var bco = &backupClusterOptions{}
+
+var backupClusterCmd = &cobra.Command{
+	Use:          "cluster",
+	Short:        "Backup a management cluster",
+	Long:         "Saves the CAPI and EKS-A objects and the provider secrets of a management cluster and its workload clusters, and optionally an etcd snapshot, into a tarball",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE:         bco.backupCluster,
+}
+
+func init() {
+	backupCmd.AddCommand(backupClusterCmd)
+	backupClusterCmd.Flags().StringVar(&bco.clusterName, "cluster-name", "", "Name of the management cluster to backup")
+	backupClusterCmd.Flags().StringVar(&bco.kubeconfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig in the current directory")
+	backupClusterCmd.Flags().StringVarP(&bco.outputFile, "output", "o", "", "Path of the backup tarball. Defaults to <cluster-name>-backup-<timestamp>.tar.gz")
+	backupClusterCmd.Flags().StringVar(&bco.etcdSnapshotNode, "etcd-snapshot-node", "", "IP of the etcd node (or control plane node for stacked etcd) to take an etcd snapshot from. No snapshot is taken if empty")
+	backupClusterCmd.Flags().StringVar(&bco.sshUser, "ssh-user", "", "User to SSH into the etcd snapshot node")
+	backupClusterCmd.Flags().StringVar(&bco.sshKey, "ssh-key", "", "Private key to SSH into the etcd snapshot node")
+	if err := backupClusterCmd.MarkFlagRequired("cluster-name"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+}
+
+func (o *backupClusterOptions) etcdSnapshotConfig() (*clusterbackup.EtcdSnapshotConfig, error) {
+	if o.etcdSnapshotNode == "" {
+		return nil, nil
+	}
+
+	if o.sshUser == "" || o.sshKey == "" {
+		return nil, fmt.Errorf("--ssh-user and --ssh-key are required to take an etcd snapshot")
+	}
+
+	return &clusterbackup.EtcdSnapshotConfig{
+		NodeIP:  o.etcdSnapshotNode,
+		SSHUser: o.sshUser,
+		SSHKey:  o.sshKey,
+	}, nil
+}
+
+func (o *backupClusterOptions) backupCluster(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	etcd, err := o.etcdSnapshotConfig()
+	if err != nil {
+		return err
+	}
+
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeconfig, o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	output := o.outputFile
+	if output == "" {
+		output = fmt.Sprintf("%s-backup-%s.tar.gz", o.clusterName, time.Now().Format("2006-01-02T15_04_05"))
+	}
+
+	dirs := []string{filepath.Dir(kubeconfigPath)}
+	if etcd != nil {
+		dirs = append(dirs, filepath.Dir(etcd.SSHKey))
+	}
+
+	deps, err := dependencies.NewFactory().WithReleaseSource(releaseSource).
+		WithExecutableMountDirs(dirs...).
+		WithUnAuthKubeClient().
+		WithClusterctl().
+		WithSSH().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	cluster := &types.Cluster{
+		Name:           o.clusterName,
+		KubeconfigFile: kubeconfigPath,
+	}
+
+	manager := clusterbackup.NewManager(deps.Clusterctl, deps.SSH, tar.NewGzipPackager())
+	if err := manager.Backup(ctx, deps.UnAuthKubeClient.KubeconfigClient(kubeconfigPath), cluster, output, etcd); err != nil {
+		return fmt.Errorf("backing up cluster %s: %v", o.clusterName, err)
+	}
+
+	logger.MarkSuccess(fmt.Sprintf("Cluster %s backed up to %s", o.clusterName, output))
+	return nil
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+)
+
+func TestBackupClusterEtcdSnapshotConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *backupClusterOptions
+		want    *clusterbackup.EtcdSnapshotConfig
+		wantErr string
+	}{
+		{
+			name: "no snapshot",
+			opts: &backupClusterOptions{clusterName: "mgmt"},
+		},
+		{
+			name: "snapshot",
+			opts: &backupClusterOptions{clusterName: "mgmt", etcdSnapshotNode: "1.2.3.4", sshUser: "ec2-user", sshKey: "/keys/id_rsa"},
+			want: &clusterbackup.EtcdSnapshotConfig{NodeIP: "1.2.3.4", SSHUser: "ec2-user", SSHKey: "/keys/id_rsa"},
+		},
+		{
+			name:    "snapshot without ssh key",
+			opts:    &backupClusterOptions{clusterName: "mgmt", etcdSnapshotNode: "1.2.3.4", sshUser: "ec2-user"},
+			wantErr: "--ssh-user and --ssh-key are required to take an etcd snapshot",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := tt.opts.etcdSnapshotConfig()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -3,20 +3,40 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"path"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/files"
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/version"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const (
+	checkImagesOutputJSON  = "json"
+	checkImagesOutputJUnit = "junit"
 )
 
 type checkImagesOptions struct {
-	fileName string
+	fileName    string
+	output      string
+	outputFile  string
+	concurrency int
+	kubeConfig  string
 }
 
 var cio = &checkImagesOptions{}
@@ -28,12 +48,16 @@ func init() {
 	if err != nil {
 		log.Fatalf("Error marking filename flag as required: %v", err)
 	}
+	checkImagesCommand.Flags().StringVarP(&cio.output, "output", "o", "", "Format of the check results (valid options: json, junit). By default results are logged")
+	checkImagesCommand.Flags().StringVar(&cio.outputFile, "output-file", "", "File to write the check results to when --output is set. Defaults to stdout")
+	checkImagesCommand.Flags().IntVar(&cio.concurrency, "concurrency", 10, "Maximum number of artifacts checked in parallel")
+	checkImagesCommand.Flags().StringVar(&cio.kubeConfig, "kubeconfig", "", "Path to the kubeconfig of an existing cluster to also check the curated packages charts and images of its active package bundle")
 }
 
 var checkImagesCommand = &cobra.Command{
 	Use:   "check-images",
 	Short: "Check images used by EKS Anywhere do exist in the target registry",
-	Long:  "This command is used to check images used by EKS-Anywhere for cluster provisioning do exist in the target registry",
+	Long:  "This command is used to check images, charts, OS images and Tinkerbell hook artifacts used by EKS-Anywhere for cluster provisioning do exist in the target registry or server. It fails if any of them is missing",
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		cmd.Flags().VisitAll(func(flag *pflag.Flag) {
 			if err := viper.BindPFlag(flag.Name, flag); err != nil {
@@ -44,32 +68,235 @@ var checkImagesCommand = &cobra.Command{
 	},
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return checkImages(cmd.Context(), cio.fileName)
+		return checkImages(cmd.Context(), cio)
 	},
 }
 
-func checkImages(context context.Context, clusterSpecPath string) error {
-	images, err := getImages(clusterSpecPath, "")
+func (o *checkImagesOptions) validate() error {
+	switch o.output {
+	case "", checkImagesOutputJSON, checkImagesOutputJUnit:
+	default:
+		return fmt.Errorf("invalid output format %s, valid options are json and junit", o.output)
+	}
+
+	if o.outputFile != "" && o.output == "" {
+		return fmt.Errorf("--output-file requires --output")
+	}
+
+	if o.concurrency < 1 {
+		return fmt.Errorf("--concurrency must be greater than 0")
+	}
+
+	return nil
+}
+
+func checkImages(ctx context.Context, opts *checkImagesOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	clusterSpec, err := readAndValidateClusterSpec(opts.fileName, version.Get())
 	if err != nil {
 		return err
 	}
 
-	clusterSpec, err := readAndValidateClusterSpec(clusterSpecPath, version.Get())
+	mirror := registrymirror.FromCluster(clusterSpec.Cluster)
+	checkable, err := bundleArtifacts(clusterSpec, mirror)
 	if err != nil {
 		return err
 	}
+	checkable = append(checkable, tinkerbellArtifacts(clusterSpec)...)
+
+	if opts.kubeConfig != "" {
+		bundle, controller, err := activePackageBundle(ctx, opts.kubeConfig, clusterSpec)
+		if err != nil {
+			return err
+		}
+		checkable = append(checkable, curatedPackagesArtifacts(bundle, controller)...)
+	}
+
+	report := artifacts.CheckArtifacts{
+		Checkers: map[artifacts.ArtifactType]artifacts.CheckFunc{
+			artifacts.ImageArtifact:   artifacts.CheckImage,
+			artifacts.ChartArtifact:   artifacts.CheckImage,
+			artifacts.OSImageArtifact: artifacts.CheckURL,
+			artifacts.HookArtifact:    artifacts.CheckURL,
+		},
+		Concurrency: opts.concurrency,
+	}.Run(ctx, checkable)
+
+	if err = writeCheckImagesReport(report, opts); err != nil {
+		return err
+	}
 
-	checkImageExistence := artifacts.CheckImageExistence{}
+	return report.Err()
+}
+
+// bundleArtifacts returns the images and charts in the bundles for the Kubernetes versions of the cluster,
+// at the location they are pulled from when using a registry mirror.
+func bundleArtifacts(spec *cluster.Spec, mirror *registrymirror.RegistryMirror) ([]artifacts.CheckableArtifact, error) {
+	kubeVersions := spec.Cluster.KubernetesVersions()
+	kubeVersionsFilter := make([]string, 0, len(kubeVersions))
+	for _, version := range kubeVersions {
+		kubeVersionsFilter = append(kubeVersionsFilter, string(version))
+	}
+
+	images, err := bundles.ReadImages(
+		files.NewReader(files.WithEKSAUserAgent("cli", version.Get().GitVersion), files.WithReleaseSource(releaseSource)),
+		spec.Bundles,
+		kubeVersionsFilter...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	checkable := make([]artifacts.CheckableArtifact, 0, len(images))
 	for _, image := range images {
-		myImageURI := registrymirror.FromCluster(clusterSpec.Cluster).ReplaceRegistry(image.URI)
-		checkImageExistence.ImageUri = myImageURI
-		if err = checkImageExistence.Run(context); err != nil {
-			fmt.Println(err.Error())
-			logger.MarkFail(myImageURI)
-		} else {
-			logger.MarkPass(myImageURI)
+		checkable = append(checkable, artifacts.CheckableArtifact{
+			Type: artifacts.ImageArtifact,
+			URI:  mirror.ReplaceRegistry(image.URI),
+		})
+	}
+
+	for _, version := range kubeVersions {
+		for _, chart := range spec.VersionsBundle(version).Charts() {
+			if chart.URI == "" {
+				continue
+			}
+			checkable = append(checkable, artifacts.CheckableArtifact{
+				Type: artifacts.ChartArtifact,
+				URI:  mirror.ReplaceRegistry(chart.VersionedImage()),
+			})
 		}
 	}
 
-	return nil
+	return checkable, nil
+}
+
+// tinkerbellArtifacts returns the OS images referenced by the Tinkerbell machine configs
+// and the hook artifacts used to provision the machines.
+func tinkerbellArtifacts(spec *cluster.Spec) []artifacts.CheckableArtifact {
+	datacenter := spec.TinkerbellDatacenter
+	if datacenter == nil {
+		return nil
+	}
+
+	var checkable []artifacts.CheckableArtifact
+	osImage := func(uri string) {
+		if uri != "" {
+			checkable = append(checkable, artifacts.CheckableArtifact{Type: artifacts.OSImageArtifact, URI: uri})
+		}
+	}
+
+	osImage(datacenter.Spec.OSImageURL)
+	for _, machineConfig := range spec.TinkerbellMachineConfigs {
+		osImage(machineConfig.Spec.OSImageURL)
+	}
+
+	// Bottlerocket machines without an OS image use the one from the bundle
+	if datacenter.Spec.OSImageURL == "" {
+		for _, machineConfig := range spec.TinkerbellMachineConfigs {
+			if machineConfig.Spec.OSImageURL == "" && machineConfig.Spec.OSFamily == anywherev1.Bottlerocket {
+				for _, version := range spec.Cluster.KubernetesVersions() {
+					osImage(spec.VersionsBundle(version).EksD.Raw.Bottlerocket.URI)
+				}
+			}
+		}
+	}
+
+	hook := spec.RootVersionsBundle().Tinkerbell.TinkerbellStack.Hook
+	for _, archive := range []releasev1.Archive{hook.Initramfs.Amd, hook.Initramfs.Arm, hook.Vmlinuz.Amd, hook.Vmlinuz.Arm} {
+		if archive.URI == "" {
+			continue
+		}
+		uri := archive.URI
+		if datacenter.Spec.HookImagesURLPath != "" {
+			uri = strings.TrimSuffix(datacenter.Spec.HookImagesURLPath, "/") + "/" + path.Base(archive.URI)
+		}
+		checkable = append(checkable, artifacts.CheckableArtifact{Type: artifacts.HookArtifact, URI: uri})
+	}
+
+	if datacenter.Spec.HookIsoURL != "" {
+		checkable = append(checkable, artifacts.CheckableArtifact{Type: artifacts.HookArtifact, URI: datacenter.Spec.HookIsoURL})
+	}
+
+	return checkable
+}
+
+// activePackageBundle returns the active package bundle of an existing cluster and its package bundle controller.
+func activePackageBundle(ctx context.Context, kubeConfig string, spec *cluster.Spec) (*packagesv1.PackageBundle, *packagesv1.PackageBundleController, error) {
+	deps, err := NewDependenciesForPackages(ctx, WithMountPaths(kubeConfig), WithCluster(spec.Cluster))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to initialize executables: %v", err)
+	}
+
+	reader := curatedpackages.NewBundleReader(kubeConfig, spec.Cluster.Name, deps.Kubectl, curatedpackages.CreateBundleManager(deps.Logger), deps.BundleRegistry, curatedpackages.WithReleaseSource(releaseSource))
+	controller, err := reader.GetActiveController(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle, err := reader.GetLatestBundle(ctx, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bundle, controller, nil
+}
+
+// curatedPackagesArtifacts returns the charts and images of the packages in a package bundle,
+// in the registries configured in the package bundle controller.
+func curatedPackagesArtifacts(bundle *packagesv1.PackageBundle, controller *packagesv1.PackageBundleController) []artifacts.CheckableArtifact {
+	chartRegistry := controller.Spec.DefaultRegistry
+	imageRegistry := controller.Spec.DefaultImageRegistry
+	if controller.Spec.PrivateRegistry != "" {
+		chartRegistry = controller.Spec.PrivateRegistry
+		imageRegistry = controller.Spec.PrivateRegistry
+	}
+
+	var checkable []artifacts.CheckableArtifact
+	for _, p := range bundle.Spec.Packages {
+		for _, v := range p.Source.Versions {
+			checkable = append(checkable, artifacts.CheckableArtifact{
+				Type: artifacts.ChartArtifact,
+				URI:  fmt.Sprintf("%s/%s:%s", chartRegistry, p.Source.Repository, v.Name),
+			})
+			for _, image := range v.Images {
+				checkable = append(checkable, artifacts.CheckableArtifact{
+					Type: artifacts.ImageArtifact,
+					URI:  fmt.Sprintf("%s/%s@%s", imageRegistry, image.Repository, image.Digest),
+				})
+			}
+		}
+	}
+
+	return checkable
+}
+
+func writeCheckImagesReport(report *artifacts.CheckReport, opts *checkImagesOptions) error {
+	if opts.output == "" {
+		for _, result := range report.Results {
+			if result.Status == artifacts.CheckFailed {
+				logger.MarkFail(result.URI, "type", result.Type, "error", result.Error)
+			} else {
+				logger.MarkPass(result.URI, "type", result.Type)
+			}
+		}
+		return nil
+	}
+
+	var w io.Writer = os.Stdout
+	if opts.outputFile != "" {
+		f, err := os.Create(opts.outputFile)
+		if err != nil {
+			return fmt.Errorf("creating check results file: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if opts.output == checkImagesOutputJUnit {
+		return report.WriteJUnit(w)
+	}
+	return report.WriteJSON(w)
 }
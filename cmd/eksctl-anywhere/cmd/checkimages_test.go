@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func TestCheckImagesOptionsValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect((&checkImagesOptions{concurrency: 1}).validate()).To(Succeed())
+	g.Expect((&checkImagesOptions{concurrency: 1, output: "junit", outputFile: "results.xml"}).validate()).To(Succeed())
+	g.Expect((&checkImagesOptions{concurrency: 1, output: "yaml"}).validate()).To(
+		MatchError("invalid output format yaml, valid options are json and junit"),
+	)
+	g.Expect((&checkImagesOptions{concurrency: 1, outputFile: "results.json"}).validate()).To(
+		MatchError("--output-file requires --output"),
+	)
+	g.Expect((&checkImagesOptions{}).validate()).To(MatchError("--concurrency must be greater than 0"))
+}
+
+func TestTinkerbellArtifacts(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.TinkerbellDatacenter = &anywherev1.TinkerbellDatacenterConfig{
+			Spec: anywherev1.TinkerbellDatacenterConfigSpec{
+				HookImagesURLPath: "http://10.0.0.1:8080/hook/",
+			},
+		}
+		s.TinkerbellMachineConfigs = map[string]*anywherev1.TinkerbellMachineConfig{
+			"cp": {Spec: anywherev1.TinkerbellMachineConfigSpec{OSFamily: anywherev1.Ubuntu, OSImageURL: "http://10.0.0.1:8080/ubuntu.gz"}},
+			"md": {Spec: anywherev1.TinkerbellMachineConfigSpec{OSFamily: anywherev1.Bottlerocket}},
+		}
+		vb := s.RootVersionsBundle()
+		vb.EksD.Raw.Bottlerocket.URI = "https://anywhere-assets/bottlerocket.img.gz"
+		vb.Tinkerbell.TinkerbellStack.Hook.Initramfs.Amd = releasev1.Archive{URI: "https://anywhere-assets/hook/initramfs-x86_64"}
+		vb.Tinkerbell.TinkerbellStack.Hook.Vmlinuz.Amd = releasev1.Archive{URI: "https://anywhere-assets/hook/vmlinuz-x86_64"}
+	})
+
+	g.Expect(tinkerbellArtifacts(spec)).To(ConsistOf(
+		artifacts.CheckableArtifact{Type: artifacts.OSImageArtifact, URI: "http://10.0.0.1:8080/ubuntu.gz"},
+		artifacts.CheckableArtifact{Type: artifacts.OSImageArtifact, URI: "https://anywhere-assets/bottlerocket.img.gz"},
+		artifacts.CheckableArtifact{Type: artifacts.HookArtifact, URI: "http://10.0.0.1:8080/hook/initramfs-x86_64"},
+		artifacts.CheckableArtifact{Type: artifacts.HookArtifact, URI: "http://10.0.0.1:8080/hook/vmlinuz-x86_64"},
+	))
+}
+
+func TestTinkerbellArtifactsNotTinkerbell(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(tinkerbellArtifacts(test.NewClusterSpec())).To(BeEmpty())
+}
+
+func TestCuratedPackagesArtifacts(t *testing.T) {
+	g := NewWithT(t)
+	bundle := &packagesv1.PackageBundle{
+		Spec: packagesv1.PackageBundleSpec{
+			Packages: []packagesv1.BundlePackage{
+				{
+					Name: "harbor",
+					Source: packagesv1.BundlePackageSource{
+						Repository: "harbor/harbor-helm",
+						Versions: []packagesv1.SourceVersion{
+							{
+								Name: "2.10.2-1",
+								Images: []packagesv1.VersionImages{
+									{Repository: "harbor/harbor-core", Digest: "sha256:abc"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	controller := &packagesv1.PackageBundleController{
+		Spec: packagesv1.PackageBundleControllerSpec{
+			DefaultRegistry:      "public.ecr.aws/eks-anywhere",
+			DefaultImageRegistry: "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+		},
+	}
+
+	g.Expect(curatedPackagesArtifacts(bundle, controller)).To(Equal([]artifacts.CheckableArtifact{
+		{Type: artifacts.ChartArtifact, URI: "public.ecr.aws/eks-anywhere/harbor/harbor-helm:2.10.2-1"},
+		{Type: artifacts.ImageArtifact, URI: "783794618700.dkr.ecr.us-west-2.amazonaws.com/harbor/harbor-core@sha256:abc"},
+	}))
+
+	controller.Spec.PrivateRegistry = "registry.local/curated-packages"
+	g.Expect(curatedPackagesArtifacts(bundle, controller)).To(Equal([]artifacts.CheckableArtifact{
+		{Type: artifacts.ChartArtifact, URI: "registry.local/curated-packages/harbor/harbor-helm:2.10.2-1"},
+		{Type: artifacts.ImageArtifact, URI: "registry.local/curated-packages/harbor/harbor-core@sha256:abc"},
+	}))
+}
+
+func TestWriteCheckImagesReportJUnitFile(t *testing.T) {
+	g := NewWithT(t)
+	outputFile := filepath.Join(t.TempDir(), "results.xml")
+	report := &artifacts.CheckReport{Results: []artifacts.CheckResult{
+		{Type: artifacts.ImageArtifact, URI: "registry/image:v1", Status: artifacts.CheckFailed, Error: "requested image not found"},
+	}}
+
+	g.Expect(writeCheckImagesReport(report, &checkImagesOptions{output: "junit", outputFile: outputFile})).To(Succeed())
+	content, err := os.ReadFile(outputFile)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(content)).To(ContainSubstring(`<failure message="requested image not found"></failure>`))
+}
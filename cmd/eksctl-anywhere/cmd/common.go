@@ -35,7 +35,7 @@ func getImages(clusterSpecPath, bundlesOverride string) ([]v1alpha1.Image, error
 	}
 
 	return bundles.ReadImages(
-		files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion)),
+		files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion), files.WithReleaseSource(releaseSource)),
 		spec.Bundles,
 		kubeVersionsFilter...,
 	)
@@ -52,7 +52,7 @@ func getKubeconfigPath(clusterName, override string) string {
 
 func NewDependenciesForPackages(ctx context.Context, opts ...PackageOpt) (*dependencies.Dependencies, error) {
 	config := New(opts...)
-	f := dependencies.NewFactory().
+	f := dependencies.NewFactory().WithReleaseSource(releaseSource).
 		WithExecutableMountDirs(config.mountPaths...).
 		WithCustomBundles(config.bundlesOverride).
 		WithExecutableBuilder().
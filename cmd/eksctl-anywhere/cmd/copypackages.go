@@ -11,17 +11,19 @@ import (
 
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v2"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	helmRegistry "helm.sh/helm/v3/pkg/registry"
 	"oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
+	"sigs.k8s.io/yaml"
 
 	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	packagessignature "github.com/aws/eks-anywhere-packages/pkg/signature"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/registry"
+	"github.com/aws/eks-anywhere/pkg/signature"
 )
 
 // copyPackagesCmd is the context for the copy packages command.
@@ -56,6 +58,7 @@ func init() {
 	copyPackagesCmd.Flags().BoolVar(&cpc.dstPlainHTTP, "dst-plain-http", false, "Whether or not to use plain http for destination registry")
 	copyPackagesCmd.Flags().BoolVar(&cpc.dstInsecure, "dst-insecure", false, "Skip TLS verification against the destination registry")
 	copyPackagesCmd.Flags().BoolVar(&cpc.dryRun, "dry-run", false, "Dry run will show what artifacts would be copied, but not actually copy them")
+	applyImageVerificationFlags(copyPackagesCmd.Flags(), &cpc.verification)
 
 	// making oras client to use dockerconfig
 	if err := cs.Init(); err != nil {
@@ -79,9 +82,15 @@ type copyPackagesConfig struct {
 	dstPlainHTTP     bool
 	dstInsecure      bool
 	dryRun           bool
+	verification     imageVerificationOptions
+	// copiedArtifacts are the charts and images copied to the destination registry, verified when verification is enabled.
+	copiedArtifacts []signature.ImageReference
 }
 
 func runCopyPackages(_ *cobra.Command, args []string) error {
+	if err := cpc.verification.validate(); err != nil {
+		return err
+	}
 	cpc.destRegistry = args[0]
 	if cpc.srcChartRegistry == "" {
 		cpc.srcChartRegistry = cpc.srcImageRegistry
@@ -91,10 +100,30 @@ func runCopyPackages(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("cannot fetch package bundle: %w", err)
 	}
+
+	var report *signature.VerificationReport
+	var verifierOpts []signature.ImageVerifierOpt
+	if cpc.verification.verify {
+		if verifierOpts, err = cpc.verification.verifierOpts(); err != nil {
+			return err
+		}
+		valid, _, _, err := packagessignature.ValidateSignature(bundle, packagessignature.EksaDomain)
+		if report, err = cpc.verification.bundleSignatureReport(bundle.Name, valid, err); err != nil {
+			return err
+		}
+	}
+
 	if err := copyArtifacts(context.Background(), bundle); err != nil {
 		return err
 	}
 
+	// verify images before copying the package bundle so an unverified bundle is never published
+	if cpc.verification.verify && !cpc.dryRun {
+		if err := verifyCopiedImages(ctx, report, verifierOpts...); err != nil {
+			return err
+		}
+	}
+
 	// copy package bundle yaml after charts and images
 	tag := getPackageBundleTag(cpc.kubeVersion)
 	_, err = orasCopy(ctx, curatedpackages.ImageRepositoryName, cpc.srcChartRegistry, tag, cpc.destRegistry, tag)
@@ -136,30 +165,41 @@ func getPackageBundle(ctx context.Context, registry, kubeVersion string) (*packa
 		return nil, err
 	}
 	tag := getPackageBundleTag(kubeVersion)
-	_, data, err := oras.FetchBytes(ctx, repo, tag, oras.DefaultFetchBytesOptions)
+	data, err := fetchArtifactLayer(ctx, repo, tag, "")
 	if err != nil {
 		return nil, err
 	}
 
-	var mani ocispec.Manifest
-	if err := json.Unmarshal(data, &mani); err != nil {
-		return nil, fmt.Errorf("unmarshal manifest: %v", err)
-	}
-	if len(mani.Layers) < 1 {
-		return nil, fmt.Errorf("missing layer")
-	}
-
-	_, data, err = oras.FetchBytes(ctx, repo.Blobs(), string(mani.Layers[0].Digest), oras.DefaultFetchBytesOptions)
+	bundle := packagesv1.PackageBundle{}
+	err = yaml.Unmarshal(data, &bundle)
 	if err != nil {
 		return nil, err
 	}
+	return &bundle, nil
+}
 
-	bundle := packagesv1.PackageBundle{}
-	err = yaml.Unmarshal(data, &bundle)
+// fetchArtifactLayer returns the content of the first layer of the OCI artifact ref with the given
+// media type, or of the first layer if mediaType is empty.
+func fetchArtifactLayer(ctx context.Context, repo *remote.Repository, ref, mediaType string) ([]byte, error) {
+	_, data, err := oras.FetchBytes(ctx, repo, ref, oras.DefaultFetchBytesOptions)
 	if err != nil {
 		return nil, err
 	}
-	return &bundle, nil
+
+	var mani ocispec.Manifest
+	if err := json.Unmarshal(data, &mani); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %v", err)
+	}
+
+	for _, layer := range mani.Layers {
+		if mediaType != "" && layer.MediaType != mediaType {
+			continue
+		}
+		_, data, err = oras.FetchBytes(ctx, repo.Blobs(), string(layer.Digest), oras.DefaultFetchBytesOptions)
+		return data, err
+	}
+
+	return nil, fmt.Errorf("missing layer")
 }
 
 func copyArtifacts(ctx context.Context, bundle *packagesv1.PackageBundle) error {
@@ -180,6 +220,11 @@ func copyArtifacts(ctx context.Context, bundle *packagesv1.PackageBundle) error
 			if err != nil {
 				return fmt.Errorf("cannot copy chart to repo: %w", err)
 			}
+			cpc.copiedArtifacts = append(cpc.copiedArtifacts, signature.ImageReference{
+				Image:     url + ":" + chartTag,
+				Reference: cpc.destRegistry + "/" + p.Source.Repository + ":" + chartTag,
+				Digest:    v.Digest,
+			})
 			if err := copyImages(ctx, v.Images, tags); err != nil {
 				return fmt.Errorf("cannot process images: %w", err)
 			}
@@ -199,10 +244,38 @@ func copyImages(ctx context.Context, images []packagesv1.VersionImages, tags map
 		if err != nil {
 			return fmt.Errorf("cannot copy image to repo: %w", err)
 		}
+
+		dstImage := cpc.destRegistry + "/" + i.Repository + ":" + dstRef
+		if dstRef == i.Digest {
+			dstImage = cpc.destRegistry + "/" + i.Repository + "@" + i.Digest
+		}
+		cpc.copiedArtifacts = append(cpc.copiedArtifacts, signature.ImageReference{
+			Image:     cpc.srcImageRegistry + "/" + i.Repository + "@" + i.Digest,
+			Reference: dstImage,
+			Digest:    i.Digest,
+		})
 	}
 	return nil
 }
 
+// verifyCopiedImages checks the charts and images in the destination registry match the digests
+// recorded in the package bundle and verifies their signatures.
+func verifyCopiedImages(ctx context.Context, report *signature.VerificationReport, opts ...signature.ImageVerifierOpt) error {
+	repositories := signature.NewRemoteRepositories(
+		func(ctx context.Context, registry string) (auth.Credential, error) {
+			return cs.Credential(registry)
+		},
+		nil,
+		cpc.dstInsecure,
+		signature.WithPlainHTTP(cpc.dstPlainHTTP),
+	)
+
+	logger.Info("Verifying image digests and signatures")
+	report.Images = signature.NewImageVerifier(repositories, opts...).Verify(ctx, cpc.copiedArtifacts).Images
+
+	return cpc.verification.complete(report)
+}
+
 func getChartValues(chartURL string) (map[string]interface{}, error) {
 	helmClient, err := helmRegistry.NewClient()
 	if err != nil {
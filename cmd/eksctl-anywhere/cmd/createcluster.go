@@ -3,7 +3,6 @@ package cmd
 import (
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -29,6 +28,7 @@ import (
 type createClusterOptions struct {
 	clusterOptions
 	timeoutOptions
+	eventsOptions
 	forceClean            bool
 	skipIpCheck           bool
 	hardwareCSVPath       string
@@ -36,6 +36,7 @@ type createClusterOptions struct {
 	installPackages       string
 	skipValidations       []string
 	providerOptions       *dependencies.ProviderOptions
+	resume                bool
 }
 
 var cc = &createClusterOptions{
@@ -54,20 +55,26 @@ var createClusterCmd = &cobra.Command{
 	Long:         "This command is used to create workload clusters",
 	PreRunE:      bindFlagsToViper,
 	SilenceUsage: true,
-	RunE:         cc.createCluster,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cc.eventsOptions.run("create cluster", cc.fileName, true, func() error {
+			return cc.createCluster(cmd, args)
+		})
+	},
 }
 
 func init() {
 	createCmd.AddCommand(createClusterCmd)
 	applyClusterOptionFlags(createClusterCmd.Flags(), &cc.clusterOptions)
 	applyTimeoutFlags(createClusterCmd.Flags(), &cc.timeoutOptions)
+	applyEventsFlags(createClusterCmd.Flags(), &cc.eventsOptions)
 	applyTinkerbellHardwareFlag(createClusterCmd.Flags(), &cc.hardwareCSVPath)
 	aflag.String(aflag.TinkerbellBootstrapIP, &cc.tinkerbellBootstrapIP, createClusterCmd.Flags())
 	createClusterCmd.Flags().BoolVar(&cc.forceClean, "force-cleanup", false, "Force deletion of previously created bootstrap cluster")
 	hideForceCleanup(createClusterCmd.Flags())
 	createClusterCmd.Flags().BoolVar(&cc.skipIpCheck, "skip-ip-check", false, "Skip check for whether cluster control plane ip is in use")
 	createClusterCmd.Flags().StringVar(&cc.installPackages, "install-packages", "", "Location of curated packages configuration files to install to the cluster")
-	createClusterCmd.Flags().StringArrayVar(&cc.skipValidations, "skip-validations", []string{}, fmt.Sprintf("Bypass create validations by name. Valid arguments you can pass are --skip-validations=%s", strings.Join(createvalidations.SkippableValidations[:], ",")))
+	createClusterCmd.Flags().StringArrayVar(&cc.skipValidations, "skip-validations", []string{}, skipValidationsUsage(createvalidations.SkippableValidations))
+	createClusterCmd.Flags().BoolVar(&cc.resume, "resume", false, "Resume a previous failed create from its last completed step")
 	tinkerbellFlags(createClusterCmd.Flags(), cc.providerOptions.Tinkerbell.BMCOptions.RPC)
 
 	aflag.MarkRequired(createClusterCmd.Flags(), aflag.ClusterConfig.Name)
@@ -136,8 +143,9 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 
 	validations.CheckDockerAllocatedMemory(ctx, docker)
 
+	// When resuming, the kubeconfig might have been written by the failed run.
 	kubeconfigPath := kubeconfig.FromClusterName(clusterConfig.Name)
-	if validations.FileExistsAndIsNotEmpty(kubeconfigPath) {
+	if !cc.resume && validations.FileExistsAndIsNotEmpty(kubeconfigPath) {
 		return fmt.Errorf(
 			"old cluster config file exists under %s, please use a different clusterName to proceed",
 			clusterConfig.Name,
@@ -171,17 +179,17 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 
 	var skippedValidations map[string]bool
 	if len(cc.skipValidations) != 0 {
-		skippedValidations, err = validations.ValidateSkippableValidation(cc.skipValidations, createvalidations.SkippableValidations)
+		skippedValidations, err = validations.ParseSkippedValidations(cc.skipValidations, createvalidations.SkippableValidations)
 		if err != nil {
 			return err
 		}
 	}
 
-	factory := dependencies.ForSpec(clusterSpec).WithExecutableMountDirs(dirs...).
+	factory := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).WithExecutableMountDirs(dirs...).
 		WithBootstrapper().
 		WithCliConfig(cliConfig).
 		WithClusterManager(clusterSpec.Cluster, clusterManagerTimeoutOpts).
-		WithProvider(cc.fileName, clusterSpec.Cluster, cc.skipIpCheck, cc.hardwareCSVPath, cc.forceClean, cc.tinkerbellBootstrapIP, skippedValidations, cc.providerOptions).
+		WithProvider(cc.fileName, clusterSpec.Cluster, cc.skipIpCheck || cc.resume, cc.hardwareCSVPath, cc.forceClean, cc.tinkerbellBootstrapIP, skippedValidations, cc.providerOptions).
 		WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
 		WithWriter().
 		WithEksdInstaller().
@@ -222,6 +230,7 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 		Provider:           deps.Provider,
 		CliConfig:          cliConfig,
 		SkippedValidations: skippedValidations,
+		ReportFile:         validations.ReportFilePath(cc.fileName),
 		KubeClient:         deps.UnAuthKubeClient.KubeconfigClient(mgmt.KubeconfigFile),
 		ManifestReader:     deps.ManifestReader,
 		BundlesOverride:    cc.bundlesOverride,
@@ -241,6 +250,7 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 			CreateBootstrapClusterOptions: deps.Provider,
 			Cluster:                       clustermanager.NewCreateClusterShim(clusterSpec, deps.Provider),
 			FS:                            deps.Writer,
+			Resume:                        cc.resume,
 		}
 		wflw.WithHookRegistrar(awsiamauth.NewHookRegistrar(deps.AwsIamAuth, clusterSpec))
 
@@ -263,6 +273,9 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 			deps.UnAuthKubectlClient,
 			deps.AwsIamAuth,
 		)
+		if cc.resume {
+			createWorkloadCluster.WithResume()
+		}
 		err = createWorkloadCluster.Run(ctx, clusterSpec, createValidations)
 
 	} else if clusterSpec.Cluster.IsSelfManaged() {
@@ -282,6 +295,9 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 			deps.AwsIamAuth,
 		)
 
+		if cc.resume {
+			createMgmtCluster.WithResume()
+		}
 		err = createMgmtCluster.Run(ctx, clusterSpec, createValidations)
 	}
 
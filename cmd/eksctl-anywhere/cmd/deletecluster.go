@@ -19,11 +19,13 @@ import (
 
 type deleteClusterOptions struct {
 	clusterOptions
+	eventsOptions
 	wConfig               string
 	forceCleanup          bool
 	hardwareFileName      string
 	tinkerbellBootstrapIP string
 	providerOptions       *dependencies.ProviderOptions
+	resume                bool
 }
 
 var dc = &deleteClusterOptions{
@@ -46,10 +48,12 @@ var deleteClusterCmd = &cobra.Command{
 		if err := dc.validate(cmd.Context(), args); err != nil {
 			return err
 		}
-		if err := dc.deleteCluster(cmd.Context()); err != nil {
-			return fmt.Errorf("failed to delete cluster: %v", err)
-		}
-		return nil
+		return dc.eventsOptions.run("delete cluster", dc.fileName, false, func() error {
+			if err := dc.deleteCluster(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to delete cluster: %v", err)
+			}
+			return nil
+		})
 	},
 }
 
@@ -61,6 +65,8 @@ func init() {
 	hideForceCleanup(deleteClusterCmd.Flags())
 	deleteClusterCmd.Flags().StringVar(&dc.managementKubeconfig, "kubeconfig", "", "kubeconfig file pointing to a management cluster")
 	deleteClusterCmd.Flags().StringVar(&dc.bundlesOverride, "bundles-override", "", "Override default Bundles manifest (not recommended)")
+	deleteClusterCmd.Flags().BoolVar(&dc.resume, "resume", false, "Resume a previous failed delete from its last completed step")
+	applyEventsFlags(deleteClusterCmd.Flags(), &dc.eventsOptions)
 	tinkerbellFlags(deleteClusterCmd.Flags(), dc.providerOptions.Tinkerbell.BMCOptions.RPC)
 }
 
@@ -114,7 +120,7 @@ func (dc *deleteClusterOptions) deleteCluster(ctx context.Context) error {
 		return err
 	}
 
-	deps, err := dependencies.ForSpec(clusterSpec).WithExecutableMountDirs(dirs...).
+	deps, err := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).WithExecutableMountDirs(dirs...).
 		WithBootstrapper().
 		WithCliConfig(cliConfig).
 		WithClusterManager(clusterSpec.Cluster, nil).
@@ -153,9 +159,15 @@ func (dc *deleteClusterOptions) deleteCluster(ctx context.Context) error {
 
 	if clusterSpec.Cluster.IsManaged() {
 		deleteWorkload := workload.NewDelete(deps.Provider, deps.Writer, deps.ClusterManager, deps.ClusterDeleter, deps.GitOpsFlux)
+		if dc.resume {
+			deleteWorkload.WithResume()
+		}
 		err = deleteWorkload.Run(ctx, cluster, clusterSpec)
 	} else {
 		deleteManagement := management.NewDelete(deps.Bootstrapper, deps.Provider, deps.Writer, deps.ClusterManager, deps.GitOpsFlux, deps.ClusterDeleter, deps.EksdInstaller, deps.EksaInstaller, deps.UnAuthKubeClient, deps.ClusterMover)
+		if dc.resume {
+			deleteManagement.WithResume()
+		}
 		err = deleteManagement.Run(ctx, cluster, clusterSpec)
 	}
 	cleanup(deps, &err)
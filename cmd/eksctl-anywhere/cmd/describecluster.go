@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterinfo"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+type describeClusterOptions struct {
+	kubeconfig string
+	namespace  string
+	output     string
+}
+
+var dco = &describeClusterOptions{}
+
+var describeClusterCmd = &cobra.Command{
+	Use:          "cluster <cluster-name>",
+	Aliases:      []string{"clusters"},
+	Short:        "Describe an EKS Anywhere cluster",
+	Long:         "Shows the spec, configs, CAPI machines, status and recent events of an EKS Anywhere cluster",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return dco.describeCluster(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	describeCmd.AddCommand(describeClusterCmd)
+	describeClusterCmd.Flags().StringVar(&dco.kubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	describeClusterCmd.Flags().StringVarP(&dco.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	describeClusterCmd.Flags().StringVarP(&dco.output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
+}
+
+func (o *describeClusterOptions) describeCluster(ctx context.Context, clusterName string) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeconfig, "")
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("creating kubernetes client: %v", err)
+	}
+
+	description, err := clusterinfo.Describe(ctx, client, clusterName, o.namespace)
+	if err != nil {
+		return err
+	}
+
+	out, err := serializeClusterDescription(description, time.Now(), o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(out)
+	return nil
+}
+
+func serializeClusterDescription(d *clusterinfo.Description, now time.Time, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		return clusterDescriptionToText(d, now)
+	case outputJson, outputYaml:
+		return marshalOutput(d, outputFormat)
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+}
+
+func clusterDescriptionToText(d *clusterinfo.Description, now time.Time) (string, error) {
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+
+	s := d.Summary
+	fmt.Fprintf(w, "Name:\t%s\n", s.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", s.Namespace)
+	fmt.Fprintf(w, "Provider:\t%s\n", s.Provider)
+	fmt.Fprintf(w, "Kubernetes Version:\t%s\n", s.KubernetesVersion)
+	fmt.Fprintf(w, "EKS-A Version:\t%s\n", valueOrNone(s.EksaVersion))
+	fmt.Fprintf(w, "Management Cluster:\t%s\n", s.ManagementCluster)
+	fmt.Fprintf(w, "Ready:\t%s\n", s.Ready)
+	if d.Cluster.Status.FailureMessage != nil {
+		fmt.Fprintf(w, "Failure Message:\t%s\n", *d.Cluster.Status.FailureMessage)
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	buffer.WriteString("\nConditions:\n")
+	if len(d.Cluster.Status.Conditions) == 0 {
+		buffer.WriteString("  <none>\n")
+	} else {
+		w = tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+		fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tMESSAGE")
+		for _, c := range d.Cluster.Status.Conditions {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", c.Type, c.Status, c.Reason, c.Message)
+		}
+		if err := w.Flush(); err != nil {
+			return "", fmt.Errorf("failed flushing table writer: %v", err)
+		}
+	}
+
+	buffer.WriteString("\nSpec:\n")
+	if err := writeIndentedYaml(&buffer, d.Cluster.Spec); err != nil {
+		return "", err
+	}
+
+	for _, obj := range d.Configs {
+		fmt.Fprintf(&buffer, "\n%s %s:\n", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName())
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return "", fmt.Errorf("failed converting %s %s: %v", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		if err := writeIndentedYaml(&buffer, content["spec"]); err != nil {
+			return "", err
+		}
+	}
+
+	buffer.WriteString("\nMachines:\n")
+	if len(d.Machines) == 0 {
+		buffer.WriteString("  <none>\n")
+	} else {
+		w = tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+		fmt.Fprintln(w, "  NAME\tROLE\tNODE\tPHASE\tVERSION\tREADY")
+		for _, m := range d.Machines {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%t\n", m.Name, m.Role, valueOrNone(m.NodeName), m.Phase, m.Version, m.Ready)
+		}
+		if err := w.Flush(); err != nil {
+			return "", fmt.Errorf("failed flushing table writer: %v", err)
+		}
+	}
+
+	buffer.WriteString("\nEvents:\n")
+	if len(d.Events) == 0 {
+		buffer.WriteString("  <none>\n")
+	} else {
+		w = tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+		fmt.Fprintln(w, "  LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE")
+		for _, e := range d.Events {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", now.Sub(e.LastSeen).Round(time.Second), e.Type, e.Reason, e.Object, e.Message)
+		}
+		if err := w.Flush(); err != nil {
+			return "", fmt.Errorf("failed flushing table writer: %v", err)
+		}
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+func writeIndentedYaml(buffer *bytes.Buffer, obj interface{}) error {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed serializing to yaml: %v", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(string(content), "\n"), "\n") {
+		buffer.WriteString("  " + line + "\n")
+	}
+
+	return nil
+}
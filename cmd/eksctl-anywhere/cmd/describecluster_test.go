@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterinfo"
+)
+
+func clusterDescription(now time.Time) *clusterinfo.Description {
+	cluster := &anywherev1.Cluster{
+		TypeMeta:   metav1.TypeMeta{Kind: anywherev1.ClusterKind, APIVersion: anywherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube131,
+			DatacenterRef:     anywherev1.Ref{Kind: anywherev1.DockerDatacenterKind, Name: "mgmt"},
+		},
+		Status: anywherev1.ClusterStatus{
+			FailureMessage: ptr.To("control plane is unhealthy"),
+			Conditions: clusterv1.Conditions{
+				{Type: anywherev1.ReadyCondition, Status: corev1.ConditionFalse, Reason: "ControlPlaneComponentsUnhealthy", Message: "2 of 3 ready"},
+			},
+		},
+	}
+	datacenter := &anywherev1.DockerDatacenterConfig{
+		TypeMeta:   metav1.TypeMeta{Kind: anywherev1.DockerDatacenterKind, APIVersion: anywherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "default"},
+	}
+
+	return &clusterinfo.Description{
+		Summary: clusterinfo.Summarize(cluster),
+		Cluster: cluster,
+		Configs: []kubernetes.Object{datacenter},
+		Machines: []clusterinfo.Machine{
+			{Name: "mgmt-cp-1", Role: "control-plane", NodeName: "mgmt-cp-1", Phase: "Running", Version: "v1.31.1", Ready: true},
+		},
+		Events: []clusterinfo.Event{
+			{LastSeen: now.Add(-90 * time.Second), Type: "Warning", Reason: "Unhealthy", Object: "KubeadmControlPlane/mgmt", Message: "etcd member unhealthy"},
+		},
+	}
+}
+
+func TestSerializeClusterDescriptionText(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := serializeClusterDescription(clusterDescription(now), now, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(ContainSubstring("Name:                 mgmt\n"))
+	g.Expect(got).To(ContainSubstring("Provider:             docker\n"))
+	g.Expect(got).To(ContainSubstring("Failure Message:      control plane is unhealthy\n"))
+	g.Expect(got).To(ContainSubstring("  Ready   False     ControlPlaneComponentsUnhealthy   2 of 3 ready\n"))
+	g.Expect(got).To(ContainSubstring("Spec:\n  clusterNetwork:"))
+	g.Expect(got).To(ContainSubstring("DockerDatacenterConfig mgmt:\n  {}\n"))
+	g.Expect(got).To(ContainSubstring("  mgmt-cp-1   control-plane   mgmt-cp-1   Running   v1.31.1   true\n"))
+	g.Expect(got).To(HaveSuffix("  1m30s       Warning   Unhealthy   KubeadmControlPlane/mgmt   etcd member unhealthy"))
+}
+
+func TestSerializeClusterDescriptionEmptyText(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := clusterDescription(now)
+	d.Cluster.Status = anywherev1.ClusterStatus{}
+	d.Machines = nil
+	d.Events = nil
+
+	got, err := serializeClusterDescription(d, now, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).NotTo(ContainSubstring("Failure Message"))
+	g.Expect(got).To(ContainSubstring("Conditions:\n  <none>\n"))
+	g.Expect(got).To(ContainSubstring("Machines:\n  <none>\n"))
+	g.Expect(got).To(HaveSuffix("Events:\n  <none>"))
+}
+
+func TestSerializeClusterDescriptionJSON(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := serializeClusterDescription(clusterDescription(now), now, outputJson)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	parsed := map[string]interface{}{}
+	g.Expect(json.Unmarshal([]byte(got), &parsed)).To(Succeed())
+	g.Expect(parsed).To(HaveKeyWithValue("cluster", HaveKeyWithValue("kind", anywherev1.ClusterKind)))
+	g.Expect(parsed).To(HaveKeyWithValue("configs", ContainElement(HaveKeyWithValue("kind", anywherev1.DockerDatacenterKind))))
+	g.Expect(parsed).To(HaveKeyWithValue("machines", HaveLen(1)))
+	g.Expect(parsed).To(HaveKeyWithValue("events", HaveLen(1)))
+}
+
+func TestSerializeClusterDescriptionYaml(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := serializeClusterDescription(clusterDescription(now), now, outputYaml)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(ContainSubstring("failureMessage: control plane is unhealthy"))
+	g.Expect(got).To(ContainSubstring("kind: DockerDatacenterConfig"))
+}
+
+func TestSerializeClusterDescriptionInvalidOutput(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+
+	_, err := serializeClusterDescription(clusterDescription(now), now, "xml")
+	g.Expect(err).To(MatchError("invalid output format [xml]"))
+}
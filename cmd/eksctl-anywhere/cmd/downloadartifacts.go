@@ -5,22 +5,31 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
 	"sigs.k8s.io/yaml"
 
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
 	"github.com/aws/eks-anywhere/pkg/files"
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
 	"github.com/aws/eks-anywhere/pkg/manifests/releases"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
+	"github.com/aws/eks-anywhere/pkg/signature"
 	"github.com/aws/eks-anywhere/pkg/version"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
@@ -31,6 +40,9 @@ type downloadArtifactsOptions struct {
 	bundlesOverride string
 	dryRun          bool
 	retainDir       bool
+	includeOSImages bool
+	skipPackages    bool
+	verification    imageVerificationOptions
 }
 
 var downloadArtifactsopts = &downloadArtifactsOptions{}
@@ -42,12 +54,15 @@ func init() {
 	downloadArtifactsCmd.Flags().StringVarP(&downloadArtifactsopts.downloadDir, "download-dir", "d", "eks-anywhere-downloads", "Directory to download the artifacts to")
 	downloadArtifactsCmd.Flags().BoolVarP(&downloadArtifactsopts.dryRun, "dry-run", "", false, "Print the manifest URIs without downloading them")
 	downloadArtifactsCmd.Flags().BoolVarP(&downloadArtifactsopts.retainDir, "retain-dir", "r", false, "Do not delete the download folder after creating a tarball")
+	downloadArtifactsCmd.Flags().BoolVar(&downloadArtifactsopts.includeOSImages, "include-os-images", false, "Include the OS images (OVAs, raw images and AMIs) referenced by the bundle in the release source")
+	downloadArtifactsCmd.Flags().BoolVar(&downloadArtifactsopts.skipPackages, "skip-packages", false, "Don't add the curated packages bundles to the release source, instead of failing when they can't be fetched")
+	applyImageVerificationFlags(downloadArtifactsCmd.Flags(), &downloadArtifactsopts.verification)
 }
 
 var downloadArtifactsCmd = &cobra.Command{
 	Use:          "artifacts",
 	Short:        "Download EKS Anywhere artifacts/manifests to a tarball on disk",
-	Long:         "This command is used to download the S3 artifacts from an EKS Anywhere bundle manifest and package them into a tarball",
+	Long:         "This command is used to download the S3 artifacts from an EKS Anywhere bundle manifest and package them into a tarball. The downloaded directory is a release source that can be passed to --release-source to run the CLI without internet access",
 	PreRunE:      preRunDownloadArtifactsCmd,
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -59,7 +74,11 @@ var downloadArtifactsCmd = &cobra.Command{
 }
 
 func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions) error {
-	factory := dependencies.NewFactory()
+	if err := opts.verification.validate(); err != nil {
+		return err
+	}
+
+	factory := dependencies.NewFactory().WithReleaseSource(releaseSource)
 	deps, err := factory.
 		WithFileReader().
 		WithManifestReader().
@@ -83,16 +102,44 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 		}
 	}
 
-	// download the eks-a-release.yaml
+	if opts.verification.verify {
+		if err = verifyBundleImagesAtOrigin(context, opts.verification, b, deps.ManifestReader); err != nil {
+			return err
+		}
+	}
+
+	releaseManifestURL := releases.ManifestURL()
+	source := releasesource.NewBuilder(opts.downloadDir, releaseManifestURL)
+
+	// download the eks-a-release.yaml and the original bundles manifest so the release source can resolve them
 	if !opts.dryRun {
-		releaseManifestURL := releases.ManifestURL()
-		if err := downloadArtifact(filepath.Join(opts.downloadDir, filepath.Base(releaseManifestURL)), releaseManifestURL, reader); err != nil {
+		if err := downloadArtifact(source, filepath.Base(releaseManifestURL), releaseManifestURL, reader); err != nil {
 			return fmt.Errorf("downloading release manifest: %v", err)
 		}
+
+		if opts.bundlesOverride == "" {
+			release, err := deps.ManifestReader.ReadReleaseForVersion(version.Get().GitVersion)
+			if err != nil {
+				return err
+			}
+			if err := downloadArtifact(source, filepath.Join("bundles", filepath.Base(release.BundleManifestUrl)), release.BundleManifestUrl, reader); err != nil {
+				return fmt.Errorf("downloading bundles manifest: %v", err)
+			}
+		}
 	}
 
 	versionBundles := b.Spec.VersionsBundles
 	for i, bundle := range versionBundles {
+		if err = downloadPackageBundle(context, source, bundle, opts); err != nil {
+			return err
+		}
+
+		if opts.includeOSImages {
+			if err = downloadOSImages(context, source, bundle, opts.dryRun); err != nil {
+				return err
+			}
+		}
+
 		for component, manifestList := range bundle.Manifests() {
 			for _, manifest := range manifestList {
 				if *manifest == "" {
@@ -104,11 +151,11 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 					continue
 				}
 
-				filePath := filepath.Join(opts.downloadDir, bundle.KubeVersion, component, filepath.Base(*manifest))
-				if err = downloadArtifact(filePath, *manifest, reader); err != nil {
+				path := filepath.Join(bundle.KubeVersion, component, filepath.Base(*manifest))
+				if err = downloadArtifact(source, path, *manifest, reader); err != nil {
 					return fmt.Errorf("downloading artifact for component %s: %v", component, err)
 				}
-				*manifest = filePath
+				*manifest = filepath.Join(opts.downloadDir, path)
 			}
 		}
 		b.Spec.VersionsBundles[i] = bundle
@@ -124,6 +171,10 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 	}
 
 	if !opts.dryRun {
+		if err = source.Write(); err != nil {
+			return fmt.Errorf("writing release source index: %v", err)
+		}
+
 		if err = createTarball(opts.downloadDir); err != nil {
 			return err
 		}
@@ -138,6 +189,36 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 	return nil
 }
 
+// verifyBundleImagesAtOrigin validates the bundle signature and checks the images in their origin
+// registries match the digests recorded in the bundle before any artifact is downloaded.
+func verifyBundleImagesAtOrigin(ctx context.Context, o imageVerificationOptions, b *releasev1.Bundles, reader *manifests.Reader) error {
+	report, err := o.verifyBundle(b)
+	if err != nil {
+		return err
+	}
+
+	images, err := reader.ReadImagesFromBundles(ctx, b)
+	if err != nil {
+		return fmt.Errorf("reading images from bundle: %v", err)
+	}
+
+	repositories := signature.NewRemoteRepositories(
+		func(ctx context.Context, registry string) (auth.Credential, error) {
+			return cs.Credential(registry)
+		},
+		nil,
+		false,
+	)
+	verifier, err := o.newBundleImagesVerifier(repositories, report, func(image string) string {
+		return image
+	})
+	if err != nil {
+		return err
+	}
+
+	return verifier.Verify(ctx, images)
+}
+
 func preRunDownloadArtifactsCmd(cmd *cobra.Command, args []string) error {
 	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
 		if err := viper.BindPFlag(flag.Name, flag); err != nil {
@@ -147,24 +228,115 @@ func preRunDownloadArtifactsCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func downloadArtifact(filePath, artifactUri string, reader *files.Reader) error {
+func downloadArtifact(source *releasesource.Builder, path, artifactUri string, reader *files.Reader) error {
 	logger.V(3).Info(fmt.Sprintf("Downloading artifact: %s", artifactUri))
 
-	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+	contents, err := reader.ReadFile(artifactUri)
+	if err != nil {
 		return err
 	}
 
-	logger.V(3).Info(fmt.Sprintf("Creating local artifact file: %s", filePath))
+	logger.V(3).Info(fmt.Sprintf("Creating local artifact file: %s", path))
+	if err = source.AddFile(artifactUri, path, contents); err != nil {
+		return err
+	}
 
-	contents, err := reader.ReadFile(artifactUri)
+	logger.V(3).Info(fmt.Sprintf("Successfully downloaded artifact %s to %s", artifactUri, path))
+
+	return nil
+}
+
+// downloadPackageBundle stores the latest curated packages bundle of a versions bundle in the release source,
+// indexed by its OCI reference. Helm charts are not part of the release source, they are pulled from the
+// registry mirror.
+func downloadPackageBundle(ctx context.Context, source *releasesource.Builder, bundle releasev1.VersionsBundle, opts *downloadArtifactsOptions) error {
+	if opts.skipPackages {
+		return nil
+	}
+
+	packageBundleRef, err := curatedpackages.GetPackageBundleRef(bundle)
 	if err != nil {
 		return err
 	}
-	if err = os.WriteFile(filePath, contents, 0o644); err != nil {
+
+	if opts.dryRun {
+		logger.Info(fmt.Sprintf("Found package bundle: %s\n", packageBundleRef))
+		return nil
+	}
+
+	if err = downloadOCIArtifact(ctx, source, filepath.Join(bundle.KubeVersion, "packages", "bundle.yaml"), packageBundleRef, ""); err != nil {
+		return fmt.Errorf("downloading package bundle for kubernetes %s, use --skip-packages to create the release source without curated packages: %v", bundle.KubeVersion, err)
+	}
+
+	return nil
+}
+
+func downloadOCIArtifact(ctx context.Context, source *releasesource.Builder, path, ref, mediaType string) error {
+	logger.V(3).Info(fmt.Sprintf("Downloading artifact: %s", ref))
+
+	repo, err := remote.NewRepository(ref)
+	if err != nil {
 		return err
 	}
 
-	logger.V(3).Info(fmt.Sprintf("Successfully downloaded artifact %s to %s", artifactUri, filePath))
+	contents, err := fetchArtifactLayer(ctx, repo, repo.Reference.Reference, mediaType)
+	if err != nil {
+		return err
+	}
+
+	return source.AddFile(ref, path, contents)
+}
+
+// downloadOSImages stores the OS images of a versions bundle in the release source, validating their sha512 checksum.
+func downloadOSImages(ctx context.Context, source *releasesource.Builder, bundle releasev1.VersionsBundle, dryRun bool) error {
+	images := map[string]releasev1.Archive{
+		"ova": bundle.EksD.Ova.Bottlerocket,
+		"raw": bundle.EksD.Raw.Bottlerocket,
+		"ami": bundle.EksD.Ami.Bottlerocket,
+	}
+
+	for format, image := range images {
+		if image.URI == "" || source.Contains(image.URI) {
+			continue
+		}
+		if dryRun {
+			logger.Info(fmt.Sprintf("Found OS image: %s\n", image.URI))
+			continue
+		}
+
+		path := filepath.Join(bundle.KubeVersion, "os-images", format, filepath.Base(image.URI))
+		if err := downloadOSImage(ctx, source, path, image); err != nil {
+			return fmt.Errorf("downloading %s OS image: %v", format, err)
+		}
+	}
+
+	return nil
+}
+
+func downloadOSImage(ctx context.Context, source *releasesource.Builder, path string, image releasev1.Archive) error {
+	logger.V(3).Info(fmt.Sprintf("Downloading artifact: %s", image.URI))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, image.URI, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	h := sha512.New()
+	if err = source.AddFromReader(image.URI, path, io.TeeReader(resp.Body, h)); err != nil {
+		return err
+	}
+
+	if image.SHA512 != "" && hex.EncodeToString(h.Sum(nil)) != image.SHA512 {
+		return fmt.Errorf("%s doesn't match sha512 %s", image.URI, image.SHA512)
+	}
 
 	return nil
 }
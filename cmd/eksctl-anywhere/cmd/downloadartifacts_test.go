@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/releasesource"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func TestDownloadOSImages(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ova"))
+	}))
+	t.Cleanup(server.Close)
+
+	sum := sha512.Sum512([]byte("ova"))
+	bundle := releasev1.VersionsBundle{KubeVersion: "1.29"}
+	bundle.EksD.Ova.Bottlerocket = releasev1.Archive{URI: server.URL + "/bottlerocket.ova", SHA512: hex.EncodeToString(sum[:])}
+
+	dir := t.TempDir()
+	builder := releasesource.NewBuilder(dir, "https://example.com/eks-a-release.yaml")
+	g.Expect(downloadOSImages(context.Background(), builder, bundle, false)).To(Succeed())
+	g.Expect(builder.Write()).To(Succeed())
+
+	source, err := releasesource.Load(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(source.ReadFile(server.URL + "/bottlerocket.ova")).To(BeEquivalentTo("ova"))
+}
+
+func TestDownloadOSImagesChecksumMismatch(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("tampered"))
+	}))
+	t.Cleanup(server.Close)
+
+	sum := sha512.Sum512([]byte("ova"))
+	bundle := releasev1.VersionsBundle{KubeVersion: "1.29"}
+	bundle.EksD.Raw.Bottlerocket = releasev1.Archive{URI: server.URL + "/bottlerocket.img.gz", SHA512: hex.EncodeToString(sum[:])}
+
+	builder := releasesource.NewBuilder(t.TempDir(), "https://example.com/eks-a-release.yaml")
+	g.Expect(downloadOSImages(context.Background(), builder, bundle, false)).To(
+		MatchError(ContainSubstring("downloading raw OS image: " + server.URL + "/bottlerocket.img.gz doesn't match sha512")),
+	)
+}
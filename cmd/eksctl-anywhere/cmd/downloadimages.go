@@ -57,7 +57,7 @@ type downloadImagesCommand struct {
 }
 
 func (c downloadImagesCommand) Run(ctx context.Context) error {
-	factory := dependencies.NewFactory()
+	factory := dependencies.NewFactory().WithReleaseSource(releaseSource)
 	helmOpts := []helm.Opt{}
 	if c.insecure {
 		helmOpts = append(helmOpts, helm.WithInsecure())
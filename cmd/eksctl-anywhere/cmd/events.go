@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/events"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+type eventsOptions struct {
+	outputEvents string
+	eventsFile   string
+}
+
+func applyEventsFlags(flagSet *pflag.FlagSet, e *eventsOptions) {
+	flagSet.StringVar(&e.outputEvents, "output-events", "", fmt.Sprintf("Emit machine-readable progress events in the given format. Supported formats: %s", events.JSONFormat))
+	flagSet.StringVar(&e.eventsFile, "events-file", "", "File to write the progress events to instead of stdout. When unset, logs are written to stderr")
+}
+
+// eventsToStdout returns true if the command flags configure progress events to be written to stdout.
+func eventsToStdout(flagSet *pflag.FlagSet) bool {
+	outputEvents, err := flagSet.GetString("output-events")
+	if err != nil || outputEvents == "" {
+		return false
+	}
+
+	eventsFile, err := flagSet.GetString("events-file")
+	return err == nil && eventsFile == ""
+}
+
+// commandEvents emits the final event of a lifecycle command. A nil *commandEvents is valid
+// and does nothing, which is what start returns when events are disabled.
+type commandEvents struct {
+	command string
+	start   time.Time
+	file    *os.File
+}
+
+// run runs fn for command, emitting its result once it returns. configFile is read to
+// report the cluster name and, if withKubeconfig is set, the path to its kubeconfig.
+func (e eventsOptions) run(command, configFile string, withKubeconfig bool, fn func() error) error {
+	c, err := e.start(command)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return fn()
+	}
+
+	err = fn()
+
+	var clusterName, kubeconfigPath string
+	if config, configErr := v1alpha1.GetClusterConfig(configFile); configErr == nil {
+		clusterName = config.Name
+	}
+	if withKubeconfig && clusterName != "" {
+		kubeconfigPath = kubeconfig.FromClusterName(clusterName)
+	}
+	c.finish(clusterName, kubeconfigPath, err)
+
+	return err
+}
+
+// start configures the events output for command from the flags.
+func (e eventsOptions) start(command string) (*commandEvents, error) {
+	if e.outputEvents == "" {
+		if e.eventsFile != "" {
+			return nil, errors.New("--events-file requires --output-events")
+		}
+		return nil, nil
+	}
+
+	c := &commandEvents{command: command, start: time.Now()}
+	var w io.Writer = os.Stdout
+	if e.eventsFile != "" {
+		f, err := os.Create(e.eventsFile)
+		if err != nil {
+			return nil, fmt.Errorf("creating events file: %v", err)
+		}
+		c.file = f
+		w = f
+	}
+
+	if err := events.Init(e.outputEvents, w); err != nil {
+		c.close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// finish emits the command result and stops emitting events. kubeconfig is only reported
+// if the command succeeded.
+func (c *commandEvents) finish(cluster, kubeconfig string, err error) {
+	if c == nil {
+		return
+	}
+
+	event := events.Event{
+		Type:            events.CommandFinished,
+		Command:         c.command,
+		Cluster:         cluster,
+		Kubeconfig:      kubeconfig,
+		DurationSeconds: time.Since(c.start).Seconds(),
+	}
+	if err != nil {
+		event.Type = events.CommandFailed
+		event.Kubeconfig = ""
+		event = event.WithError(err)
+	}
+
+	events.Emit(event)
+	events.Reset()
+	c.close()
+}
+
+func (c *commandEvents) close() {
+	if c.file != nil {
+		c.file.Close()
+	}
+}
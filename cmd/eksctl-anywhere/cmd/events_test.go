@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"github.com/aws/eks-anywhere/pkg/events"
+)
+
+const clusterConfig = `apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: Cluster
+metadata:
+  name: test-cluster
+spec:
+  kubernetesVersion: "1.29"
+`
+
+func TestEventsOptionsRunEmitsCommandResult(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantType       events.Type
+		wantKubeconfig string
+	}{
+		{
+			name:           "success",
+			wantType:       events.CommandFinished,
+			wantKubeconfig: "test-cluster/test-cluster-eks-a-cluster.kubeconfig",
+		},
+		{
+			name:     "failure",
+			err:      errors.New("creating cluster"),
+			wantType: events.CommandFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			dir := t.TempDir()
+			eventsFile := filepath.Join(dir, "events.json")
+			configFile := filepath.Join(dir, "cluster.yaml")
+			g.Expect(os.WriteFile(configFile, []byte(clusterConfig), 0o644)).To(Succeed())
+			e := eventsOptions{outputEvents: events.JSONFormat, eventsFile: eventsFile}
+
+			err := e.run("create cluster", configFile, true, func() error {
+				events.Emit(events.Event{Type: events.TaskStarted, Task: "setup-validate"})
+				return tt.err
+			})
+			if tt.err == nil {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.err))
+			}
+			g.Expect(events.Enabled()).To(BeFalse())
+
+			content, err := os.ReadFile(eventsFile)
+			g.Expect(err).NotTo(HaveOccurred())
+			decoder := json.NewDecoder(bytes.NewReader(content))
+			task, result := events.Event{}, events.Event{}
+			g.Expect(decoder.Decode(&task)).To(Succeed())
+			g.Expect(decoder.Decode(&result)).To(Succeed())
+
+			g.Expect(task.Type).To(Equal(events.TaskStarted))
+			g.Expect(result.Type).To(Equal(tt.wantType))
+			g.Expect(result.Command).To(Equal("create cluster"))
+			g.Expect(result.Cluster).To(Equal("test-cluster"))
+			g.Expect(result.Kubeconfig).To(Equal(tt.wantKubeconfig))
+		})
+	}
+}
+
+func TestEventsOptionsRunDisabled(t *testing.T) {
+	g := NewWithT(t)
+	called := false
+
+	g.Expect(eventsOptions{}.run("delete cluster", "", false, func() error {
+		called = true
+		g.Expect(events.Enabled()).To(BeFalse())
+		return nil
+	})).To(Succeed())
+	g.Expect(called).To(BeTrue())
+}
+
+func TestEventsOptionsRunFileWithoutFormat(t *testing.T) {
+	g := NewWithT(t)
+
+	err := eventsOptions{eventsFile: "events.json"}.run("delete cluster", "", false, func() error {
+		t.Fatal("command shouldn't run")
+		return nil
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("--events-file requires --output-events")))
+}
+
+func TestEventsToStdout(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want bool
+	}{
+		{
+			name: "no events",
+			want: false,
+		},
+		{
+			name: "events to stdout",
+			args: []string{"--output-events", "json"},
+			want: true,
+		},
+		{
+			name: "events to file",
+			args: []string{"--output-events", "json", "--events-file", "events.json"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			applyEventsFlags(flagSet, &eventsOptions{})
+			g.Expect(flagSet.Parse(tt.args)).To(Succeed())
+
+			g.Expect(eventsToStdout(flagSet)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestEventsToStdoutWithoutEventsFlags(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(eventsToStdout(pflag.NewFlagSet("test", pflag.ContinueOnError))).To(BeFalse())
+}
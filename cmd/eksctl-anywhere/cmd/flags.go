@@ -90,3 +90,8 @@ func hideForceCleanup(flags *pflag.FlagSet) {
 		log.Fatalf("Failed hiding flag: %v", err)
 	}
 }
+
+func skipValidationsUsage(skippableValidations []string) string {
+	return fmt.Sprintf("Bypass validations by ID, as listed in the validation report written next to the cluster config. "+
+		"The %s validations don't run at all when skipped, other skipped validations run but their failures are ignored", strings.Join(skippableValidations, ","))
+}
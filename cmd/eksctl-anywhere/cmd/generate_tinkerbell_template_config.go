@@ -98,7 +98,7 @@ func NewGenerateTinkerbellTemplateConfig() *cobra.Command {
 			tinkerbellIP := cs.TinkerbellDatacenter.Spec.TinkerbellIP
 
 			cfg := v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(cs.Cluster, osImageURL,
-				opts.BootstrapTinkerbellIP, tinkerbellIP, osFamily)
+				opts.BootstrapTinkerbellIP, tinkerbellIP, osFamily, controlPlaneMachineConfig.Spec.Network)
 
 			return yaml.NewK8sEncoder(os.Stdout).Encode(cfg)
 		},
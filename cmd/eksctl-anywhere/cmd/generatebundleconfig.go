@@ -75,7 +75,7 @@ func (gsbo *generateSupportBundleOptions) generateBundleConfig(ctx context.Conte
 		return nil, fmt.Errorf("unable to get cluster config from file: %v", err)
 	}
 
-	deps, err := dependencies.ForSpec(clusterSpec).
+	deps, err := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).
 		WithProvider(clusterConfigPath, clusterSpec.Cluster, cc.skipIpCheck, gsbo.hardwareFileName, false, gsbo.tinkerbellBootstrapIP, map[string]bool{}, nil).
 		WithDiagnosticBundleFactory().
 		Build(ctx)
@@ -88,7 +88,7 @@ func (gsbo *generateSupportBundleOptions) generateBundleConfig(ctx context.Conte
 }
 
 func (gsbo *generateSupportBundleOptions) generateDefaultBundleConfig(ctx context.Context) (diagnostics.DiagnosticBundle, error) {
-	f := dependencies.NewFactory().WithFileReader()
+	f := dependencies.NewFactory().WithReleaseSource(releaseSource).WithFileReader()
 	deps, err := f.Build(ctx)
 	if err != nil {
 		return nil, err
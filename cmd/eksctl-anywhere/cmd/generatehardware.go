@@ -3,6 +3,7 @@ package cmd
 import (
 	"bufio"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,9 +11,18 @@ import (
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
 )
 
+const (
+	bmcEndpointsFlagName = "bmc-endpoints"
+	hardwareFormatYAML   = "yaml"
+	hardwareFormatCSV    = "csv"
+)
+
 type hardwareOptions struct {
 	csvPath         string
 	outputPath      string
+	outputFormat    string
+	discovery       hardware.DiscoveryOptions
+	labels          map[string]string
 	providerOptions *dependencies.ProviderOptions
 }
 
@@ -27,9 +37,11 @@ var hOpts = &hardwareOptions{
 }
 
 var generateHardwareCmd = &cobra.Command{
-	Use:     "hardware",
-	Short:   "Generate hardware files",
-	Long:    `Generate Kubernetes hardware YAML manifests for each Hardware entry in the source.`,
+	Use:   "hardware",
+	Short: "Generate hardware files",
+	Long: `Generate Kubernetes hardware YAML manifests for each Hardware entry in the source.
+The source is either a hardware CSV file or a list of BMC endpoints that are queried with Redfish
+to discover the MAC address, disk and serial number of each machine.`,
 	RunE:    hOpts.generateHardware,
 	PreRunE: bindFlagsToViper,
 }
@@ -46,17 +58,40 @@ func init() {
 		"",
 		TinkerbellHardwareCSVFlagDescription,
 	)
+	fset.StringVar(&hOpts.outputFormat, "output-format", hardwareFormatYAML, "Format of the generated hardware when discovering it from BMCs: yaml or csv.")
 
-	if err := generateHardwareCmd.MarkFlagRequired(TinkerbellHardwareCSVFlagName); err != nil {
-		panic(err)
-	}
+	fset.StringSliceVar(&hOpts.discovery.Endpoints, bmcEndpointsFlagName, nil, "BMC IPs, CIDRs or URLs to discover the hardware from with Redfish.")
+	fset.StringVar(&hOpts.discovery.Username, "bmc-username", "", "Username of the BMCs. Defaults to the EKSA_BMC_USERNAME env var. The password is read from the EKSA_BMC_PASSWORD env var.")
+	fset.BoolVar(&hOpts.discovery.InsecureSkipVerify, "bmc-insecure-skip-verify", false, "Skip the verification of the BMC TLS certificates.")
+	fset.DurationVar(&hOpts.discovery.Timeout, "bmc-timeout", 10*time.Second, "Timeout for each request to a BMC.")
+	fset.StringSliceVar(&hOpts.discovery.IPAddresses, "ip-addresses", nil, "IPs or start-end IP ranges assigned to the discovered machines in BMC address order.")
+	fset.StringVar(&hOpts.discovery.Netmask, "netmask", "", "Netmask of the discovered machines.")
+	fset.StringVar(&hOpts.discovery.Gateway, "gateway", "", "Gateway of the discovered machines.")
+	fset.StringSliceVar((*[]string)(&hOpts.discovery.Nameservers), "nameservers", nil, "Nameservers of the discovered machines.")
+	fset.StringToStringVar(&hOpts.labels, "labels", nil, "Labels applied to the discovered machines, for example type=cp.")
+	fset.StringVar(&hOpts.discovery.Disk, "disk", "", "Disk of the discovered machines. Overrides the disk derived from the BMC drive inventory.")
+
+	generateHardwareCmd.MarkFlagsOneRequired(TinkerbellHardwareCSVFlagName, bmcEndpointsFlagName)
+	generateHardwareCmd.MarkFlagsMutuallyExclusive(TinkerbellHardwareCSVFlagName, bmcEndpointsFlagName)
 	tinkerbellFlags(fset, hOpts.providerOptions.Tinkerbell.BMCOptions.RPC)
 }
 
 func (hOpts *hardwareOptions) generateHardware(cmd *cobra.Command, args []string) error {
-	hardwareYaml, err := hardware.BuildHardwareYAML(hOpts.csvPath, hOpts.providerOptions.Tinkerbell.BMCOptions)
-	if err != nil {
-		return fmt.Errorf("building hardware yaml from csv: %v", err)
+	var hardwareData []byte
+	var err error
+	if len(hOpts.discovery.Endpoints) > 0 {
+		hardwareData, err = hOpts.discoverHardware(cmd)
+		if err != nil {
+			return err
+		}
+	} else {
+		if hOpts.outputFormat != hardwareFormatYAML {
+			return fmt.Errorf("output format %s is only supported when discovering hardware from BMCs", hOpts.outputFormat)
+		}
+		hardwareData, err = hardware.BuildHardwareYAML(hOpts.csvPath, hOpts.providerOptions.Tinkerbell.BMCOptions)
+		if err != nil {
+			return fmt.Errorf("building hardware yaml from csv: %v", err)
+		}
 	}
 
 	fh, err := hardware.CreateOrStdout(hOpts.outputPath)
@@ -65,10 +100,36 @@ func (hOpts *hardwareOptions) generateHardware(cmd *cobra.Command, args []string
 	}
 	bufferedWriter := bufio.NewWriter(fh)
 	defer bufferedWriter.Flush()
-	_, err = bufferedWriter.Write(hardwareYaml)
+	_, err = bufferedWriter.Write(hardwareData)
 	if err != nil {
 		return fmt.Errorf("writing hardware yaml to output: %v", err)
 	}
 
 	return nil
 }
+
+func (hOpts *hardwareOptions) discoverHardware(cmd *cobra.Command) ([]byte, error) {
+	opts := hOpts.discovery
+	if err := opts.SetCredentialsFromEnv(); err != nil {
+		return nil, err
+	}
+	opts.Labels = hardware.Labels(hOpts.labels)
+	opts.BMCOptions = hOpts.providerOptions.Tinkerbell.BMCOptions
+
+	switch hOpts.outputFormat {
+	case hardwareFormatYAML:
+		hardwareYaml, err := hardware.DiscoverHardwareYAML(cmd.Context(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("discovering hardware from bmcs: %v", err)
+		}
+		return hardwareYaml, nil
+	case hardwareFormatCSV:
+		hardwareCSV, err := hardware.DiscoverHardwareCSV(cmd.Context(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("discovering hardware from bmcs: %v", err)
+		}
+		return hardwareCSV, nil
+	default:
+		return nil, fmt.Errorf("output format %s is not supported, it must be %s or %s", hOpts.outputFormat, hardwareFormatYAML, hardwareFormatCSV)
+	}
+}
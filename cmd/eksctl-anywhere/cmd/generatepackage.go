@@ -91,7 +91,7 @@ func generatePackages(ctx context.Context, args []string) error {
 	}
 	bm := curatedpackages.CreateBundleManager(deps.Logger)
 
-	b := curatedpackages.NewBundleReader(kubeConfig, gpOptions.clusterName, deps.Kubectl, bm, deps.BundleRegistry)
+	b := curatedpackages.NewBundleReader(kubeConfig, gpOptions.clusterName, deps.Kubectl, bm, deps.BundleRegistry, curatedpackages.WithReleaseSource(releaseSource))
 
 	bundle, err := b.GetLatestBundle(ctx, gpOptions.kubeVersion)
 	if err != nil {
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+type getCertificatesOptions struct {
+	clusterName   string
+	kubeconfig    string
+	output        string
+	warningInDays int
+}
+
+var gco = &getCertificatesOptions{}
+
+var getCertificatesCmd = &cobra.Command{
+	Use:          "certificates",
+	Aliases:      []string{"certificate", "certs"},
+	Short:        "Get certificate expiry of the control plane and etcd machines",
+	Long:         "Lists the certificate expiry date of every control plane and external etcd machine of a cluster and of its control plane and etcd certificate secrets",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return gco.getCertificates(cmd.Context())
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getCertificatesCmd)
+	getCertificatesCmd.Flags().StringVar(&gco.clusterName, "cluster-name", "", "Name of the cluster to get certificates for")
+	getCertificatesCmd.Flags().StringVar(&gco.kubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	getCertificatesCmd.Flags().StringVarP(&gco.output, outputFlagName, "o", outputDefault, "Output format: text|json")
+	getCertificatesCmd.Flags().IntVar(&gco.warningInDays, "warning-threshold", int(certificates.DefaultExpiryWarningThreshold.Hours()/24), "Number of days before expiry certificates are reported as expiring soon")
+	if err := getCertificatesCmd.MarkFlagRequired("cluster-name"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+}
+
+func (o *getCertificatesOptions) getCertificates(ctx context.Context) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeconfig, o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("creating kubernetes client: %v", err)
+	}
+
+	cluster := &anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: o.clusterName}}
+	machineExpirations, err := certificates.MachineExpirations(ctx, client, cluster)
+	if err != nil {
+		return err
+	}
+
+	secretExpirations, err := certificates.SecretExpirations(ctx, client, cluster)
+	if err != nil {
+		return err
+	}
+
+	threshold := time.Duration(o.warningInDays) * 24 * time.Hour
+	out, err := serializeCertificateExpirations(machineExpirations, secretExpirations, time.Now(), threshold, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(out)
+	return nil
+}
+
+type certificateExpiryReport struct {
+	Machine   string                   `json:"machine,omitempty"`
+	Secret    string                   `json:"secret,omitempty"`
+	Role      string                   `json:"role"`
+	ExpiresAt time.Time                `json:"expiresAt"`
+	Days      int                      `json:"daysUntilExpiry"`
+	State     certificates.ExpiryState `json:"state"`
+}
+
+func serializeCertificateExpirations(machineExpirations []anywherev1.MachineCertificateExpiry, secretExpirations []anywherev1.SecretCertificateExpiry, now time.Time, threshold time.Duration, outputFormat string) (string, error) {
+	reports := make([]certificateExpiryReport, 0, len(machineExpirations)+len(secretExpirations))
+	for _, e := range machineExpirations {
+		reports = append(reports, certificateExpiryReport{
+			Machine:   e.Machine,
+			Role:      e.Role,
+			ExpiresAt: e.ExpiresAt.UTC(),
+			Days:      certificates.DaysUntil(e.ExpiresAt.Time, now),
+			State:     certificates.State(e.ExpiresAt.Time, now, threshold),
+		})
+	}
+	for _, e := range secretExpirations {
+		reports = append(reports, certificateExpiryReport{
+			Secret:    e.Secret,
+			Role:      e.Role,
+			ExpiresAt: e.ExpiresAt.UTC(),
+			Days:      certificates.DaysUntil(e.ExpiresAt.Time, now),
+			State:     certificates.State(e.ExpiresAt.Time, now, threshold),
+		})
+	}
+
+	switch outputFormat {
+	case outputText:
+		return certificateExpirationsToText(reports)
+	case outputJson:
+		out, err := json.Marshal(reports)
+		if err != nil {
+			return "", fmt.Errorf("failed serializing the certificate expirations to json: %v", err)
+		}
+		return string(out), nil
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+}
+
+func certificateExpirationsToText(reports []certificateExpiryReport) (string, error) {
+	if len(reports) == 0 {
+		return "No control plane or etcd certificates found", nil
+	}
+
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tKIND\tROLE\tEXPIRES\tDAYS\tSTATE")
+	for _, r := range reports {
+		name, kind := r.Machine, "Machine"
+		if r.Secret != "" {
+			name, kind = r.Secret, "Secret"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", name, kind, r.Role, r.ExpiresAt.Format(time.RFC3339), r.Days, r.State)
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	return buffer.String(), nil
+}
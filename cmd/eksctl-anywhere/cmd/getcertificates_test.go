@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+)
+
+func TestSerializeCertificateExpirations(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expirations := []anywherev1.MachineCertificateExpiry{
+		{
+			Machine:   "etcd-1",
+			Role:      "etcd",
+			ExpiresAt: metav1.NewTime(now.Add(10 * 24 * time.Hour)),
+		},
+		{
+			Machine:   "cp-1",
+			Role:      "control-plane",
+			ExpiresAt: metav1.NewTime(now.Add(100 * 24 * time.Hour)),
+		},
+	}
+
+	secretExpirations := []anywherev1.SecretCertificateExpiry{
+		{
+			Secret:    "my-cluster-ca",
+			Role:      "control-plane",
+			ExpiresAt: metav1.NewTime(now.Add(-24 * time.Hour)),
+		},
+	}
+
+	tests := []struct {
+		name              string
+		expirations       []anywherev1.MachineCertificateExpiry
+		secretExpirations []anywherev1.SecretCertificateExpiry
+		output            string
+		want              string
+		wantErr           string
+	}{
+		{
+			name:              "text",
+			expirations:       expirations,
+			secretExpirations: secretExpirations,
+			output:            outputText,
+			want: "NAME            KIND      ROLE            EXPIRES                DAYS      STATE\n" +
+				"etcd-1          Machine   etcd            2024-01-11T00:00:00Z   10        ExpiringSoon\n" +
+				"cp-1            Machine   control-plane   2024-04-10T00:00:00Z   100       Valid\n" +
+				"my-cluster-ca   Secret    control-plane   2023-12-31T00:00:00Z   -1        Expired\n",
+		},
+		{
+			name:        "text no certificates",
+			expirations: nil,
+			output:      outputText,
+			want:        "No control plane or etcd certificates found",
+		},
+		{
+			name:              "json",
+			expirations:       expirations[:1],
+			secretExpirations: secretExpirations,
+			output:            outputJson,
+			want: `[{"machine":"etcd-1","role":"etcd","expiresAt":"2024-01-11T00:00:00Z","daysUntilExpiry":10,"state":"ExpiringSoon"},` +
+				`{"secret":"my-cluster-ca","role":"control-plane","expiresAt":"2023-12-31T00:00:00Z","daysUntilExpiry":-1,"state":"Expired"}]`,
+		},
+		{
+			name:        "json no certificates",
+			expirations: nil,
+			output:      outputJson,
+			want:        "[]",
+		},
+		{
+			name:    "invalid output",
+			output:  "yaml",
+			wantErr: "invalid output format [yaml]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := serializeCertificateExpirations(tt.expirations, tt.secretExpirations, now, certificates.DefaultExpiryWarningThreshold, tt.output)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterinfo"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+const outputYaml = "yaml"
+
+type getClustersOptions struct {
+	kubeconfig string
+	output     string
+}
+
+var gclo = &getClustersOptions{}
+
+var getClustersCmd = &cobra.Command{
+	Use:          "clusters",
+	Aliases:      []string{"cluster"},
+	Short:        "Get the EKS Anywhere clusters of a management cluster",
+	Long:         "Lists every EKS Anywhere cluster in a management cluster with its provider, versions, node counts and Ready condition",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return gclo.getClusters(cmd.Context())
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getClustersCmd)
+	getClustersCmd.Flags().StringVar(&gclo.kubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	getClustersCmd.Flags().StringVarP(&gclo.output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
+}
+
+func (o *getClustersOptions) getClusters(ctx context.Context) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeconfig, "")
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("creating kubernetes client: %v", err)
+	}
+
+	summaries, err := clusterinfo.ListClusters(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	out, err := serializeClusterSummaries(summaries, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(out)
+	return nil
+}
+
+func serializeClusterSummaries(summaries []clusterinfo.Summary, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		return clusterSummariesToText(summaries)
+	case outputJson, outputYaml:
+		return marshalOutput(summaries, outputFormat)
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+}
+
+func clusterSummariesToText(summaries []clusterinfo.Summary) (string, error) {
+	if len(summaries) == 0 {
+		return "No clusters found", nil
+	}
+
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tPROVIDER\tKUBERNETES\tEKSA\tMANAGEMENT\tCONTROL PLANE\tWORKERS\tREADY")
+	for _, s := range summaries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\n",
+			s.Namespace, s.Name, s.Provider, s.KubernetesVersion, valueOrNone(s.EksaVersion), s.ManagementCluster,
+			s.ControlPlaneNodes, s.WorkerNodes, s.Ready)
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	return buffer.String(), nil
+}
+
+// marshalOutput serializes obj to json or yaml.
+func marshalOutput(obj interface{}, outputFormat string) (string, error) {
+	var out []byte
+	var err error
+	if outputFormat == outputYaml {
+		out, err = yaml.Marshal(obj)
+	} else {
+		out, err = json.Marshal(obj)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed serializing to %s: %v", outputFormat, err)
+	}
+
+	return string(out), nil
+}
+
+func valueOrNone(v string) string {
+	if v == "" {
+		return "<none>"
+	}
+	return v
+}
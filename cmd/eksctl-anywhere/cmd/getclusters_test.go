@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/pkg/clusterinfo"
+)
+
+func TestSerializeClusterSummaries(t *testing.T) {
+	summaries := []clusterinfo.Summary{
+		{
+			Name:              "mgmt",
+			Namespace:         "default",
+			Provider:          "vsphere",
+			KubernetesVersion: "1.31",
+			EksaVersion:       "v0.22.0",
+			ManagementCluster: "mgmt",
+			ControlPlaneNodes: 3,
+			WorkerNodes:       5,
+			Ready:             corev1.ConditionTrue,
+		},
+		{
+			Name:              "w01",
+			Namespace:         "workloads",
+			Provider:          "docker",
+			KubernetesVersion: "1.30",
+			ManagementCluster: "mgmt",
+			ControlPlaneNodes: 1,
+			WorkerNodes:       1,
+			Ready:             corev1.ConditionUnknown,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		summaries []clusterinfo.Summary
+		output    string
+		want      string
+		wantErr   string
+	}{
+		{
+			name:      "text",
+			summaries: summaries,
+			output:    outputText,
+			want: "NAMESPACE   NAME      PROVIDER   KUBERNETES   EKSA      MANAGEMENT   CONTROL PLANE   WORKERS   READY\n" +
+				"default     mgmt      vsphere    1.31         v0.22.0   mgmt         3               5         True\n" +
+				"workloads   w01       docker     1.30         <none>    mgmt         1               1         Unknown\n",
+		},
+		{
+			name:      "text no clusters",
+			summaries: nil,
+			output:    outputText,
+			want:      "No clusters found",
+		},
+		{
+			name:      "json",
+			summaries: summaries[:1],
+			output:    outputJson,
+			want:      `[{"name":"mgmt","namespace":"default","provider":"vsphere","kubernetesVersion":"1.31","eksaVersion":"v0.22.0","managementCluster":"mgmt","controlPlaneNodes":3,"workerNodes":5,"ready":"True"}]`,
+		},
+		{
+			name:      "yaml",
+			summaries: summaries[:1],
+			output:    outputYaml,
+			want: `- controlPlaneNodes: 3
+  eksaVersion: v0.22.0
+  kubernetesVersion: "1.31"
+  managementCluster: mgmt
+  name: mgmt
+  namespace: default
+  provider: vsphere
+  ready: "True"
+  workerNodes: 5
+`,
+		},
+		{
+			name:      "invalid output",
+			summaries: summaries,
+			output:    "xml",
+			wantErr:   "invalid output format [xml]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := serializeClusterSummaries(tt.summaries, tt.output)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
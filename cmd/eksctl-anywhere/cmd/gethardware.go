@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+// tinkerbellLabelPrefix is the prefix of the labels CAPT sets on the hardware it claims.
+const tinkerbellLabelPrefix = "v1alpha1.tinkerbell.org/"
+
+type getHardwareOptions struct {
+	kubeconfig string
+	output     string
+}
+
+var ghwo = &getHardwareOptions{}
+
+var getHardwareCmd = &cobra.Command{
+	Use:          "hardware",
+	Short:        "Get the Tinkerbell hardware of a management cluster",
+	Long:         "Lists every Tinkerbell Hardware in a management cluster with its allocation, BMC state and last workflow, and the free hardware for each TinkerbellMachineConfig selector",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return ghwo.getHardware(cmd.Context())
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getHardwareCmd)
+	getHardwareCmd.Flags().StringVar(&ghwo.kubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	getHardwareCmd.Flags().StringVarP(&ghwo.output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
+}
+
+func (o *getHardwareOptions) getHardware(ctx context.Context) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeconfig, "")
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("creating kubernetes client: %v", err)
+	}
+
+	machineConfigs := &anywherev1.TinkerbellMachineConfigList{}
+	if err := client.List(ctx, machineConfigs); err != nil {
+		return fmt.Errorf("listing tinkerbell machine configs: %v", err)
+	}
+
+	inventory, err := hardware.BuildInventory(ctx, hardware.NewKubeReader(client), machineConfigs.Items)
+	if err != nil {
+		return err
+	}
+
+	out, err := serializeHardwareInventory(inventory, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(out)
+	return nil
+}
+
+func serializeHardwareInventory(inventory *hardware.Inventory, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		return hardwareInventoryToText(inventory)
+	case outputJson, outputYaml:
+		return marshalOutput(inventory, outputFormat)
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+}
+
+func hardwareInventoryToText(inventory *hardware.Inventory) (string, error) {
+	if len(inventory.Hardware) == 0 {
+		return "No hardware found", nil
+	}
+
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tCLUSTER\tMACHINE\tPOWER\tBMC CONTACTABLE\tLAST WORKFLOW\tLABELS")
+	for _, hw := range inventory.Hardware {
+		status := "Allocated"
+		if hw.Free() {
+			status = "Free"
+		}
+		lastWorkflow := ""
+		if hw.LastWorkflow != "" {
+			lastWorkflow = fmt.Sprintf("%s (%s)", hw.LastWorkflow, hw.LastWorkflowState)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			hw.Name, status, valueOrNone(hw.Cluster), valueOrNone(hw.Machine), valueOrNone(string(hw.PowerState)),
+			valueOrNone(string(hw.BMCContactable)), valueOrNone(lastWorkflow), valueOrNone(userLabels(hw.Labels)))
+	}
+
+	if len(inventory.Capacity) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "NAMESPACE\tMACHINE CONFIG\tSELECTOR\tTOTAL\tALLOCATED\tFREE\tUNREACHABLE")
+		for _, c := range inventory.Capacity {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\n",
+				c.Namespace, c.MachineConfig, valueOrNone(c.Selector), c.Total, c.Allocated, c.Free, c.Unreachable)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	return buffer.String(), nil
+}
+
+// userLabels returns the labels of a hardware without the ones set by CAPT, sorted by key.
+func userLabels(l map[string]string) string {
+	filtered := labels.Set{}
+	for k, v := range l {
+		if !strings.HasPrefix(k, tinkerbellLabelPrefix) {
+			filtered[k] = v
+		}
+	}
+	return filtered.String()
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+func TestSerializeHardwareInventory(t *testing.T) {
+	inventory := &hardware.Inventory{
+		Hardware: []hardware.HardwareStatus{
+			{
+				Name:              "hw-1",
+				Labels:            map[string]string{"type": "cp", hardware.OwnerNameLabel: "mgmt-cp-xyz"},
+				Owner:             "mgmt-cp-xyz",
+				Cluster:           "mgmt",
+				Machine:           "mgmt-cp-abcde",
+				BMC:               "bmc-hw-1",
+				PowerState:        rufiov1alpha1.On,
+				BMCContactable:    rufiov1alpha1.ConditionTrue,
+				LastWorkflow:      "mgmt-cp-xyz",
+				LastWorkflowState: "STATE_SUCCESS",
+			},
+			{
+				Name:   "hw-2",
+				Labels: map[string]string{"type": "cp"},
+			},
+		},
+		Capacity: []hardware.SelectorCapacity{
+			{MachineConfig: "mgmt-cp", Namespace: "default", Selector: `{"type":"cp"}`, Total: 2, Allocated: 1, Free: 1},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		inventory *hardware.Inventory
+		output    string
+		want      string
+		wantErr   string
+	}{
+		{
+			name:      "text",
+			inventory: inventory,
+			output:    outputText,
+			want: "NAME      STATUS      CLUSTER   MACHINE         POWER     BMC CONTACTABLE   LAST WORKFLOW                 LABELS\n" +
+				"hw-1      Allocated   mgmt      mgmt-cp-abcde   on        True              mgmt-cp-xyz (STATE_SUCCESS)   type=cp\n" +
+				"hw-2      Free        <none>    <none>          <none>    <none>            <none>                        type=cp\n" +
+				"\n" +
+				"NAMESPACE   MACHINE CONFIG   SELECTOR        TOTAL     ALLOCATED   FREE      UNREACHABLE\n" +
+				"default     mgmt-cp          {\"type\":\"cp\"}   2         1           1         0\n",
+		},
+		{
+			name:      "text no hardware",
+			inventory: &hardware.Inventory{},
+			output:    outputText,
+			want:      "No hardware found",
+		},
+		{
+			name:      "json",
+			inventory: &hardware.Inventory{Hardware: inventory.Hardware[1:]},
+			output:    outputJson,
+			want:      `{"hardware":[{"name":"hw-2","labels":{"type":"cp"}}]}`,
+		},
+		{
+			name:      "invalid output",
+			inventory: inventory,
+			output:    "table",
+			wantErr:   "invalid output format [table]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := serializeHardwareInventory(tt.inventory, tt.output)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
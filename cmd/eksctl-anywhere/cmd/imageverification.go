@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/signature"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// imageVerificationOptions configures the verification of bundle signatures and image digests
+// performed by the commands that move artifacts for air-gapped environments.
+type imageVerificationOptions struct {
+	verify             bool
+	reportFile         string
+	cosignKeyFile      string
+	notationTrustRoots string
+}
+
+func applyImageVerificationFlags(flagSet *pflag.FlagSet, o *imageVerificationOptions) {
+	flagSet.BoolVar(&o.verify, "verify", false, "Verify the bundle signature and that image digests match the ones recorded in the bundle, failing on any mismatch or on image signatures that can't be verified with --cosign-key or --notation-trust-roots")
+	flagSet.StringVar(&o.reportFile, "verification-report", "", "File to write the per-image verification report to in JSON format")
+	flagSet.StringVar(&o.cosignKeyFile, "cosign-key", "", "PEM encoded public key used to verify cosign image signatures")
+	flagSet.StringVar(&o.notationTrustRoots, "notation-trust-roots", "", "PEM encoded root certificates used to verify Notation image signatures")
+}
+
+func (o imageVerificationOptions) validate() error {
+	if !o.verify && (o.reportFile != "" || o.cosignKeyFile != "" || o.notationTrustRoots != "") {
+		return errors.New("--verification-report, --cosign-key and --notation-trust-roots require --verify")
+	}
+	return nil
+}
+
+func (o imageVerificationOptions) verifierOpts() ([]signature.ImageVerifierOpt, error) {
+	var opts []signature.ImageVerifierOpt
+	if o.cosignKeyFile != "" {
+		content, err := os.ReadFile(o.cosignKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading cosign key: %v", err)
+		}
+		key, err := signature.ParseCosignPublicKey(content)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, signature.WithCosignPublicKey(key))
+	}
+
+	if o.notationTrustRoots != "" {
+		content, err := os.ReadFile(o.notationTrustRoots)
+		if err != nil {
+			return nil, fmt.Errorf("reading notation trust roots: %v", err)
+		}
+		roots, err := signature.ParseCertPool(content)
+		if err != nil {
+			return nil, fmt.Errorf("parsing notation trust roots: %v", err)
+		}
+		opts = append(opts, signature.WithNotationTrustRoots(roots))
+	}
+
+	return opts, nil
+}
+
+// complete writes the verification report if requested and returns an error if verification failed.
+func (o imageVerificationOptions) complete(report *signature.VerificationReport) error {
+	if o.reportFile != "" {
+		if err := report.Write(o.reportFile); err != nil {
+			return err
+		}
+		logger.Info("Verification report written", "file", o.reportFile)
+	}
+
+	if err := report.Err(); err != nil {
+		return fmt.Errorf("verification failed: %v", err)
+	}
+
+	logger.Info("Verification succeeded", "images", len(report.Images))
+	return nil
+}
+
+// verifyBundle validates the Bundles signature, failing before any artifact is moved
+// if the bundle can't be trusted.
+func (o imageVerificationOptions) verifyBundle(bundle *releasev1.Bundles) (*signature.VerificationReport, error) {
+	valid, err := signature.ValidateSignature(bundle, constants.KMSPublicKey)
+	return o.bundleSignatureReport(bundle.Name, valid, err)
+}
+
+// bundleSignatureReport starts a verification report with the result of a bundle signature
+// validation, writing the report and returning an error if the signature isn't valid.
+func (o imageVerificationOptions) bundleSignatureReport(bundleName string, valid bool, err error) (*signature.VerificationReport, error) {
+	if err != nil {
+		logger.V(3).Info("Validating bundle signature", "bundle", bundleName, "error", err)
+	}
+
+	report := &signature.VerificationReport{
+		Bundle:                  bundleName,
+		BundleSignatureVerified: err == nil && valid,
+	}
+	if !report.BundleSignatureVerified {
+		return report, o.complete(report)
+	}
+
+	return report, nil
+}
+
+// bundleImagesVerifier verifies bundle images at the location they were copied to.
+type bundleImagesVerifier struct {
+	options  imageVerificationOptions
+	verifier *signature.ImageVerifier
+	report   *signature.VerificationReport
+	// destination returns the reference an image from the bundle is stored at.
+	destination func(image string) string
+}
+
+func (o imageVerificationOptions) newBundleImagesVerifier(repositories signature.Repositories, report *signature.VerificationReport, destination func(image string) string) (*bundleImagesVerifier, error) {
+	opts, err := o.verifierOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundleImagesVerifier{
+		options:     o,
+		verifier:    signature.NewImageVerifier(repositories, opts...),
+		report:      report,
+		destination: destination,
+	}, nil
+}
+
+// Verify verifies the images, writes the verification report and fails if any image doesn't pass verification.
+func (v *bundleImagesVerifier) Verify(ctx context.Context, images []releasev1.Image) error {
+	logger.Info("Verifying image digests and signatures")
+	refs := make([]signature.ImageReference, 0, len(images))
+	for _, i := range images {
+		refs = append(refs, signature.ImageReference{
+			Image:     i.VersionedImage(),
+			Reference: v.destination(i.VersionedImage()),
+			Digest:    i.ImageDigest,
+		})
+	}
+	v.report.Images = v.verifier.Verify(ctx, refs).Images
+
+	return v.options.complete(v.report)
+}
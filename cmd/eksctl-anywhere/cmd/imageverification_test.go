@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/signature"
+)
+
+func TestImageVerificationOptionsValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(imageVerificationOptions{}.validate()).To(Succeed())
+	g.Expect(imageVerificationOptions{verify: true, cosignKeyFile: "key.pub"}.validate()).To(Succeed())
+	g.Expect(imageVerificationOptions{cosignKeyFile: "key.pub"}.validate()).To(
+		MatchError("--verification-report, --cosign-key and --notation-trust-roots require --verify"),
+	)
+}
+
+func TestImageVerificationOptionsVerifierOptsInvalidKey(t *testing.T) {
+	g := NewWithT(t)
+	keyFile := filepath.Join(t.TempDir(), "key.pub")
+	g.Expect(os.WriteFile(keyFile, []byte("not a key"), 0o644)).To(Succeed())
+
+	_, err := imageVerificationOptions{verify: true, cosignKeyFile: keyFile}.verifierOpts()
+	g.Expect(err).To(MatchError("cosign public key is not PEM encoded"))
+}
+
+func TestImageVerificationOptionsBundleSignatureReportInvalid(t *testing.T) {
+	g := NewWithT(t)
+	reportFile := filepath.Join(t.TempDir(), "report.json")
+	o := imageVerificationOptions{verify: true, reportFile: reportFile}
+
+	report, err := o.bundleSignatureReport("bundles-1", false, errors.New("missing signature annotation"))
+	g.Expect(err).To(MatchError("verification failed: bundle bundles-1 signature is not valid"))
+	g.Expect(report.BundleSignatureVerified).To(BeFalse())
+	g.Expect(reportFile).To(BeAnExistingFile())
+}
+
+func TestImageVerificationOptionsBundleSignatureReportValid(t *testing.T) {
+	g := NewWithT(t)
+	o := imageVerificationOptions{verify: true}
+
+	report, err := o.bundleSignatureReport("bundles-1", true, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(report).To(Equal(&signature.VerificationReport{Bundle: "bundles-1", BundleSignatureVerified: true}))
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"log"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"oras.land/oras-go/v2/registry/remote/auth"
 
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
 	"github.com/aws/eks-anywhere/pkg/config"
@@ -17,7 +19,9 @@ import (
 	"github.com/aws/eks-anywhere/pkg/helm"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/signature"
 	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 // imagesCmd represents the images command.
@@ -51,6 +55,7 @@ func init() {
 	importImagesCmd.Flags().BoolVar(&importImagesCommand.includePackages, "include-packages", false, "Flag to indicate inclusion of curated packages in imported images")
 	importImagesCmd.Flag("include-packages").Deprecated = "use copy packages command"
 	importImagesCmd.Flags().BoolVar(&importImagesCommand.insecure, "insecure", false, "Flag to indicate skipping TLS verification while pushing helm charts and bundles")
+	applyImageVerificationFlags(importImagesCmd.Flags(), &importImagesCommand.verification)
 }
 
 var importImagesCommand = ImportImagesCommand{}
@@ -61,15 +66,20 @@ type ImportImagesCommand struct {
 	BundlesFile      string
 	includePackages  bool
 	insecure         bool
+	verification     imageVerificationOptions
 }
 
 func (c ImportImagesCommand) Call(ctx context.Context) error {
+	if err := c.verification.validate(); err != nil {
+		return err
+	}
+
 	username, password, err := config.ReadCredentials()
 	if err != nil {
 		return err
 	}
 
-	factory := dependencies.NewFactory()
+	factory := dependencies.NewFactory().WithReleaseSource(releaseSource)
 	deps, err := factory.
 		WithManifestReader().
 		Build(ctx)
@@ -82,6 +92,14 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		return err
 	}
 
+	var imageVerifier artifacts.ImageVerifier
+	if c.verification.verify {
+		imageVerifier, err = c.imageVerifier(bundle, username, password)
+		if err != nil {
+			return err
+		}
+	}
+
 	artifactsFolder := "tmp-eks-a-artifacts"
 	dockerClient := executables.BuildDockerExecutable()
 	toolsImageFile := filepath.Join(artifactsFolder, eksaToolsImageTarFile)
@@ -147,7 +165,28 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		),
 		TmpArtifactsFolder: artifactsFolder,
 		FileImporter:       oras.NewFileRegistryImporter(c.RegistryEndpoint, username, password, artifactsFolder),
+		ImageVerifier:      imageVerifier,
 	}
 
 	return importArtifacts.Run(context.WithValue(ctx, types.InsecureRegistry, c.insecure))
 }
+
+// imageVerifier validates the bundle signature and returns a verifier that checks the images
+// pushed to the registry against the digests recorded in the bundle.
+func (c ImportImagesCommand) imageVerifier(bundle *releasev1.Bundles, username, password string) (artifacts.ImageVerifier, error) {
+	report, err := c.verification.verifyBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	registryHost := strings.SplitN(c.RegistryEndpoint, "/", 2)[0]
+	repositories := signature.NewRemoteRepositories(
+		auth.StaticCredential(registryHost, auth.Credential{Username: username, Password: password}),
+		nil,
+		c.insecure,
+	)
+
+	return c.verification.newBundleImagesVerifier(repositories, report, func(image string) string {
+		return docker.DestinationImage(c.RegistryEndpoint, image)
+	})
+}
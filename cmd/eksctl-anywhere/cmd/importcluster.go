@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterimport"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+type importClusterOptions struct {
+	kubeconfig string
+	namespace  string
+	dryRun     bool
+}
+
+var imco = &importClusterOptions{}
+
+var importClusterCmd = &cobra.Command{
+	Use:   "cluster <cluster-name>",
+	Short: "Import an existing CAPI cluster",
+	Long: "Generates the EKS Anywhere cluster config for an existing Cluster API cluster running in the eksa-system " +
+		"namespace of a management cluster and hands its ownership to EKS Anywhere without rolling its machines. " +
+		"The existing kubeadm configuration is kept until the cluster spec or its Kubernetes version is changed, " +
+		"then the configuration generated from the spec is rolled out. Only vSphere clusters are supported",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return imco.importCluster(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	importCmd.AddCommand(importClusterCmd)
+	importClusterCmd.Flags().StringVar(&imco.kubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	importClusterCmd.Flags().StringVarP(&imco.namespace, "namespace", "n", "default", "Namespace to create the EKS Anywhere cluster objects in")
+	importClusterCmd.Flags().BoolVar(&imco.dryRun, "dry-run", false, "Print the generated cluster config without taking ownership of the cluster")
+}
+
+func (o *importClusterOptions) importCluster(ctx context.Context, clusterName string) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeconfig, "")
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("creating kubernetes client: %v", err)
+	}
+
+	importer := clusterimport.NewImporter(clientutil.NewKubeClient(client), vsphere.NewImporter())
+
+	objs, err := importer.ReadCAPIObjects(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+
+	spec, err := importer.Generate(ctx, objs, o.namespace)
+	if err != nil {
+		return err
+	}
+
+	if o.dryRun {
+		out, err := marshalImportedConfig(spec.Config)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	if err = importer.Adopt(ctx, objs, spec.Config); err != nil {
+		return err
+	}
+
+	logger.MarkSuccess(fmt.Sprintf("Cluster %s imported into namespace %s", clusterName, o.namespace))
+	return nil
+}
+
+// marshallableObject is implemented by the EKS-A config objects that can be marshalled
+// without their status and server populated fields.
+type marshallableObject interface {
+	Marshallable() anywherev1.Marshallable
+}
+
+func marshalImportedConfig(config *cluster.Config) ([]byte, error) {
+	objs := []interface{}{config.Cluster.ConvertConfigToConfigGenerateStruct()}
+	for _, obj := range config.ChildObjects() {
+		if m, ok := obj.(marshallableObject); ok {
+			objs = append(objs, m.Marshallable())
+		} else {
+			objs = append(objs, obj)
+		}
+	}
+
+	resources := make([][]byte, 0, len(objs))
+	for _, obj := range objs {
+		resource, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling imported cluster config: %v", err)
+		}
+		resources = append(resources, resource)
+	}
+
+	return templater.AppendYamlResources(resources...), nil
+}
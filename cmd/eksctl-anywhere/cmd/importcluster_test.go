@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+func TestMarshalImportedConfig(t *testing.T) {
+	g := NewWithT(t)
+	config := &cluster.Config{
+		Cluster: &anywherev1.Cluster{
+			TypeMeta:   metav1.TypeMeta{Kind: anywherev1.ClusterKind, APIVersion: anywherev1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "default"},
+			Spec: anywherev1.ClusterSpec{
+				KubernetesVersion: anywherev1.Kube129,
+				DatacenterRef:     anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "legacy"},
+			},
+		},
+		VSphereDatacenter: &anywherev1.VSphereDatacenterConfig{
+			TypeMeta:   metav1.TypeMeta{Kind: anywherev1.VSphereDatacenterKind, APIVersion: anywherev1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "default", ResourceVersion: "1"},
+			Spec:       anywherev1.VSphereDatacenterConfigSpec{Server: "vcenter.local"},
+		},
+	}
+
+	got, err := marshalImportedConfig(config)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(got)).To(ContainSubstring("kind: Cluster\n"))
+	g.Expect(string(got)).To(ContainSubstring("kubernetesVersion: \"1.29\"\n"))
+	g.Expect(string(got)).To(ContainSubstring("kind: VSphereDatacenterConfig\n"))
+	g.Expect(string(got)).To(ContainSubstring("server: vcenter.local\n"))
+	g.Expect(string(got)).NotTo(ContainSubstring("resourceVersion"))
+	g.Expect(string(got)).NotTo(ContainSubstring("status:"))
+}
@@ -87,7 +87,7 @@ func installPackages(ctx context.Context, args []string) error {
 
 	bm := curatedpackages.CreateBundleManager(deps.Logger)
 
-	b := curatedpackages.NewBundleReader(kubeConfig, ipo.clusterName, deps.Kubectl, bm, deps.BundleRegistry)
+	b := curatedpackages.NewBundleReader(kubeConfig, ipo.clusterName, deps.Kubectl, bm, deps.BundleRegistry, curatedpackages.WithReleaseSource(releaseSource))
 
 	bundle, err := b.GetLatestBundle(ctx, ipo.kubeVersion)
 	if err != nil {
@@ -0,0 +1,269 @@
+package artifacts
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ArtifactType identifies how the existence of an artifact is checked.
+type ArtifactType string
+
+const (
+	// ImageArtifact is a container image in an OCI registry.
+	ImageArtifact ArtifactType = "image"
+	// ChartArtifact is a Helm chart in an OCI registry.
+	ChartArtifact ArtifactType = "chart"
+	// OSImageArtifact is a node OS image served over http.
+	OSImageArtifact ArtifactType = "os-image"
+	// HookArtifact is a Tinkerbell hook OS artifact served over http.
+	HookArtifact ArtifactType = "hook"
+)
+
+// defaultCheckConcurrency is the number of artifacts checked in parallel when CheckArtifacts.Concurrency is not set.
+const defaultCheckConcurrency = 10
+
+// CheckableArtifact is an artifact CheckArtifacts verifies exists.
+type CheckableArtifact struct {
+	Type ArtifactType
+	URI  string
+}
+
+// CheckFunc checks the artifact at uri exists.
+type CheckFunc func(ctx context.Context, uri string) error
+
+// CheckArtifacts checks artifacts exist concurrently, with the checker configured for their type.
+type CheckArtifacts struct {
+	Checkers    map[ArtifactType]CheckFunc
+	Concurrency int
+}
+
+// Run checks all artifacts and returns the result for each of them, in the same order.
+// Duplicated artifacts are only checked once.
+func (c CheckArtifacts) Run(ctx context.Context, artifacts []CheckableArtifact) *CheckReport {
+	concurrency := c.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultCheckConcurrency
+	}
+
+	unique := make([]CheckableArtifact, 0, len(artifacts))
+	seen := make(map[CheckableArtifact]struct{}, len(artifacts))
+	for _, a := range artifacts {
+		if _, ok := seen[a]; ok {
+			continue
+		}
+		seen[a] = struct{}{}
+		unique = append(unique, a)
+	}
+
+	results := make([]CheckResult, len(unique))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, a := range unique {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, a CheckableArtifact) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = c.check(ctx, a)
+		}(i, a)
+	}
+	wg.Wait()
+
+	return &CheckReport{Results: results}
+}
+
+func (c CheckArtifacts) check(ctx context.Context, a CheckableArtifact) CheckResult {
+	result := CheckResult{
+		Type:   a.Type,
+		URI:    a.URI,
+		Status: CheckPassed,
+	}
+
+	check, ok := c.Checkers[a.Type]
+	if !ok {
+		result.Status = CheckFailed
+		result.Error = fmt.Sprintf("no checker configured for artifact type %s", a.Type)
+		return result
+	}
+
+	start := time.Now()
+	if err := check(ctx, a.URI); err != nil {
+		result.Status = CheckFailed
+		result.Error = err.Error()
+	}
+	result.Duration = time.Since(start)
+
+	return result
+}
+
+// CheckImage checks an image or chart exists in its OCI registry.
+func CheckImage(ctx context.Context, uri string) error {
+	return CheckImageExistence{ImageUri: uri}.Run(ctx)
+}
+
+// CheckURL checks a file served over http exists without downloading it.
+func CheckURL(ctx context.Context, uri string) error {
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		req, err := http.NewRequestWithContext(ctx, method, uri, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		// some servers don't allow HEAD requests, fall back to GET without reading the body
+		if resp.StatusCode == http.StatusMethodNotAllowed {
+			continue
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("requested file not found")
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("unknown response: %s", resp.Status)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown response: %s", http.StatusText(http.StatusMethodNotAllowed))
+}
+
+// CheckStatus is the result of checking an artifact.
+type CheckStatus string
+
+const (
+	// CheckPassed means the artifact exists.
+	CheckPassed CheckStatus = "passed"
+	// CheckFailed means the artifact is missing or couldn't be checked.
+	CheckFailed CheckStatus = "failed"
+)
+
+// CheckResult is the result of checking an artifact.
+type CheckResult struct {
+	Type     ArtifactType  `json:"type"`
+	URI      string        `json:"uri"`
+	Status   CheckStatus   `json:"status"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"-"`
+}
+
+// CheckReport contains the results of checking artifacts.
+type CheckReport struct {
+	Results []CheckResult
+}
+
+// Failed returns the results of the artifacts that failed the check.
+func (r *CheckReport) Failed() []CheckResult {
+	var failed []CheckResult
+	for _, result := range r.Results {
+		if result.Status == CheckFailed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns an error if any artifact failed the check.
+func (r *CheckReport) Err() error {
+	if failed := len(r.Failed()); failed > 0 {
+		return fmt.Errorf("%d of %d artifacts failed the check", failed, len(r.Results))
+	}
+	return nil
+}
+
+type jsonCheckReport struct {
+	Total   int           `json:"total"`
+	Failed  int           `json:"failed"`
+	Results []CheckResult `json:"results"`
+}
+
+// WriteJSON writes the report in JSON format.
+func (r *CheckReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonCheckReport{
+		Total:   len(r.Results),
+		Failed:  len(r.Failed()),
+		Results: r.Results,
+	})
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the report in JUnit XML format, with one test case per artifact
+// grouped by artifact type.
+func (r *CheckReport) WriteJUnit(w io.Writer) error {
+	suites := junitTestSuites{}
+	suiteIndex := map[ArtifactType]int{}
+	suiteTime := map[ArtifactType]time.Duration{}
+	for _, result := range r.Results {
+		i, ok := suiteIndex[result.Type]
+		if !ok {
+			i = len(suites.Suites)
+			suiteIndex[result.Type] = i
+			suites.Suites = append(suites.Suites, junitTestSuite{Name: string(result.Type)})
+		}
+
+		suite := &suites.Suites[i]
+		testCase := junitTestCase{
+			Name:      result.URI,
+			ClassName: string(result.Type),
+			Time:      junitTime(result.Duration),
+		}
+		if result.Status == CheckFailed {
+			testCase.Failure = &junitFailure{Message: result.Error}
+			suite.Failures++
+		}
+		suite.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+		suiteTime[result.Type] += result.Duration
+		suite.Time = junitTime(suiteTime[result.Type])
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
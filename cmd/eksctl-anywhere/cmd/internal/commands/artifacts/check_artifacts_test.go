@@ -0,0 +1,124 @@
+package artifacts_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
+)
+
+func TestCheckArtifactsRun(t *testing.T) {
+	g := NewWithT(t)
+	var running, maxRunning int32
+	check := func(_ context.Context, uri string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		if uri == "missing" {
+			return errors.New("requested image not found")
+		}
+		return nil
+	}
+
+	c := artifacts.CheckArtifacts{
+		Checkers: map[artifacts.ArtifactType]artifacts.CheckFunc{
+			artifacts.ImageArtifact: check,
+		},
+		Concurrency: 2,
+	}
+	report := c.Run(context.Background(), []artifacts.CheckableArtifact{
+		{Type: artifacts.ImageArtifact, URI: "a"},
+		{Type: artifacts.ImageArtifact, URI: "missing"},
+		{Type: artifacts.ImageArtifact, URI: "a"},
+		{Type: artifacts.ImageArtifact, URI: "b"},
+		{Type: artifacts.ImageArtifact, URI: "c"},
+		{Type: artifacts.HookArtifact, URI: "https://hook/vmlinuz-x86_64"},
+	})
+
+	g.Expect(maxRunning).To(BeNumerically("<=", 2))
+	g.Expect(report.Results).To(HaveLen(5))
+	g.Expect(report.Results[0].URI).To(Equal("a"))
+	g.Expect(report.Results[0].Status).To(Equal(artifacts.CheckPassed))
+	g.Expect(report.Results[1].Status).To(Equal(artifacts.CheckFailed))
+	g.Expect(report.Results[1].Error).To(Equal("requested image not found"))
+	g.Expect(report.Results[4].Error).To(Equal("no checker configured for artifact type hook"))
+	g.Expect(report.Failed()).To(HaveLen(2))
+	g.Expect(report.Err()).To(MatchError("2 of 5 artifacts failed the check"))
+}
+
+func TestCheckReportWriteJSON(t *testing.T) {
+	g := NewWithT(t)
+	report := &artifacts.CheckReport{Results: []artifacts.CheckResult{
+		{Type: artifacts.ImageArtifact, URI: "registry/image:v1", Status: artifacts.CheckPassed},
+		{Type: artifacts.OSImageArtifact, URI: "https://images/ubuntu.gz", Status: artifacts.CheckFailed, Error: "requested file not found"},
+	}}
+
+	out := &bytes.Buffer{}
+	g.Expect(report.WriteJSON(out)).To(Succeed())
+	g.Expect(out.String()).To(MatchJSON(`{
+		"total": 2,
+		"failed": 1,
+		"results": [
+			{"type": "image", "uri": "registry/image:v1", "status": "passed"},
+			{"type": "os-image", "uri": "https://images/ubuntu.gz", "status": "failed", "error": "requested file not found"}
+		]
+	}`))
+}
+
+func TestCheckReportWriteJUnit(t *testing.T) {
+	g := NewWithT(t)
+	report := &artifacts.CheckReport{Results: []artifacts.CheckResult{
+		{Type: artifacts.ImageArtifact, URI: "registry/image:v1", Status: artifacts.CheckPassed},
+		{Type: artifacts.ImageArtifact, URI: "registry/image:v2", Status: artifacts.CheckFailed, Error: "requested image not found"},
+		{Type: artifacts.ChartArtifact, URI: "registry/chart:v1", Status: artifacts.CheckPassed},
+	}}
+
+	out := &bytes.Buffer{}
+	g.Expect(report.WriteJUnit(out)).To(Succeed())
+	g.Expect(out.String()).To(Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="image" tests="2" failures="1" time="0.000">
+    <testcase name="registry/image:v1" classname="image" time="0.000"></testcase>
+    <testcase name="registry/image:v2" classname="image" time="0.000">
+      <failure message="requested image not found"></failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="chart" tests="1" failures="0" time="0.000">
+    <testcase name="registry/chart:v1" classname="chart" time="0.000"></testcase>
+  </testsuite>
+</testsuites>
+`))
+}
+
+func TestCheckURL(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/head-not-allowed" && r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(server.Close)
+	ctx := context.Background()
+
+	g.Expect(artifacts.CheckURL(ctx, server.URL+"/ubuntu.gz")).To(Succeed())
+	g.Expect(artifacts.CheckURL(ctx, server.URL+"/head-not-allowed")).To(Succeed())
+	g.Expect(artifacts.CheckURL(ctx, server.URL+"/missing")).To(MatchError("requested file not found"))
+	g.Expect(artifacts.CheckURL(ctx, server.URL+"/error")).To(MatchError("unknown response: 500 Internal Server Error"))
+}
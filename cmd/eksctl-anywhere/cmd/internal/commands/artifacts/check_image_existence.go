@@ -107,6 +107,13 @@ func splitImageUri(imageUri string) (string, string, string, error) {
 		return "", "", "", errors.Errorf("Invalid URI: %s", imageUri)
 	}
 	registry := imageUri[:indexOfSlash]
+	// images referenced by digest are checked with the digest as manifest reference
+	if repository, digest, found := strings.Cut(imageUri[len(registry)+1:], "@"); found {
+		if repository == "" || digest == "" {
+			return "", "", "", errors.Errorf("Invalid URI: %s", imageUri)
+		}
+		return registry, repository, digest, nil
+	}
 	imageUriSplit := strings.Split(imageUri[len(registry)+1:], ":")
 	if len(imageUriSplit) < 2 {
 		return "", "", "", errors.Errorf("Invalid URI: %s", imageUri)
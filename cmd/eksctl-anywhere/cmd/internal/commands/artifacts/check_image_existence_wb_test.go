@@ -0,0 +1,20 @@
+package artifacts
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSplitImageUriDigest(t *testing.T) {
+	g := NewWithT(t)
+
+	registry, repository, reference, err := splitImageUri("registry.local:443/curated-packages/harbor/harbor-core@sha256:abc")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(registry).To(Equal("registry.local:443"))
+	g.Expect(repository).To(Equal("curated-packages/harbor/harbor-core"))
+	g.Expect(reference).To(Equal("sha256:abc"))
+
+	_, _, _, err = splitImageUri("registry.local/harbor@")
+	g.Expect(err).To(MatchError("Invalid URI: registry.local/harbor@"))
+}
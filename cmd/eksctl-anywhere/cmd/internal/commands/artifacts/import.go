@@ -15,6 +15,8 @@ type Import struct {
 	ChartImporter      ChartImporter
 	TmpArtifactsFolder string
 	FileImporter       FileImporter
+	// ImageVerifier is optional. When set, imported images are verified after being moved to the registry.
+	ImageVerifier ImageVerifier
 }
 
 type ChartImporter interface {
@@ -25,6 +27,11 @@ type FileImporter interface {
 	Push(ctx context.Context, bundles *releasev1.Bundles)
 }
 
+// ImageVerifier verifies images after they have been imported.
+type ImageVerifier interface {
+	Verify(ctx context.Context, images []releasev1.Image) error
+}
+
 func (i Import) Run(ctx context.Context) error {
 	images, err := i.Reader.ReadImagesFromBundles(ctx, i.Bundles)
 	if err != nil {
@@ -35,6 +42,12 @@ func (i Import) Run(ctx context.Context) error {
 		return err
 	}
 
+	if i.ImageVerifier != nil {
+		if err = i.ImageVerifier.Verify(ctx, images); err != nil {
+			return err
+		}
+	}
+
 	charts := i.Reader.ReadChartsFromBundles(ctx, i.Bundles)
 
 	if err := i.ChartImporter.Import(ctx, artifactNames(charts)...); err != nil {
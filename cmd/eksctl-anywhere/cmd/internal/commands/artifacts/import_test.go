@@ -2,6 +2,7 @@ package artifacts_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 
@@ -96,3 +97,28 @@ func TestImportRun(t *testing.T) {
 
 	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
 }
+
+func TestImportRunWithImageVerifier(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	verifier := mocks.NewMockImageVerifier(gomock.NewController(t))
+	tt.command.ImageVerifier = verifier
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.mover.EXPECT().Move(tt.ctx, "image1:1", "image2:1")
+	verifier.EXPECT().Verify(tt.ctx, tt.images)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.fileImporter.EXPECT().Push(tt.ctx, tt.bundles)
+	tt.importer.EXPECT().Import(tt.ctx, "chart:v1.0.0", "package-chart:v1.0.0")
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestImportRunErrorImageVerification(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	verifier := mocks.NewMockImageVerifier(gomock.NewController(t))
+	tt.command.ImageVerifier = verifier
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.mover.EXPECT().Move(tt.ctx, "image1:1", "image2:1")
+	verifier.EXPECT().Verify(tt.ctx, tt.images).Return(errors.New("digest mismatch"))
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError("digest mismatch"))
+}
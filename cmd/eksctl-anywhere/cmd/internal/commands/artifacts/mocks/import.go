@@ -88,3 +88,40 @@ func (mr *MockFileImporterMockRecorder) Push(ctx, bundles interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockFileImporter)(nil).Push), ctx, bundles)
 }
+
+// MockImageVerifier is a mock of ImageVerifier interface.
+type MockImageVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageVerifierMockRecorder
+}
+
+// MockImageVerifierMockRecorder is the mock recorder for MockImageVerifier.
+type MockImageVerifierMockRecorder struct {
+	mock *MockImageVerifier
+}
+
+// NewMockImageVerifier creates a new mock instance.
+func NewMockImageVerifier(ctrl *gomock.Controller) *MockImageVerifier {
+	mock := &MockImageVerifier{ctrl: ctrl}
+	mock.recorder = &MockImageVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageVerifier) EXPECT() *MockImageVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockImageVerifier) Verify(ctx context.Context, images []v1alpha1.Image) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, images)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockImageVerifierMockRecorder) Verify(ctx, images interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockImageVerifier)(nil).Verify), ctx, images)
+}
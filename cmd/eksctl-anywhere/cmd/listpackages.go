@@ -91,7 +91,7 @@ func listPackages(ctx context.Context) error {
 
 	bm := curatedpackages.CreateBundleManager(deps.Logger)
 
-	b := curatedpackages.NewBundleReader(kubeConfig, lpo.clusterName, deps.Kubectl, bm, deps.BundleRegistry)
+	b := curatedpackages.NewBundleReader(kubeConfig, lpo.clusterName, deps.Kubectl, bm, deps.BundleRegistry, curatedpackages.WithReleaseSource(releaseSource))
 
 	bundle, err := b.GetLatestBundle(ctx, lpo.kubeVersion)
 	if err != nil {
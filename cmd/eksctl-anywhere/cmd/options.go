@@ -23,6 +23,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/manifests/releases"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack/decoder"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validations"
@@ -90,8 +91,9 @@ func (c clusterOptions) mountDirs() []string {
 }
 
 func readClusterSpec(clusterConfigPath string, cliVersion version.Info, opts ...cluster.FileSpecBuilderOpt) (*cluster.Spec, error) {
+	opts = append([]cluster.FileSpecBuilderOpt{cluster.WithReleasesManifest(releases.ManifestURLForSource(releaseSource))}, opts...)
 	b := cluster.NewFileSpecBuilder(
-		files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion)),
+		files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion), files.WithReleaseSource(releaseSource)),
 		cliVersion,
 		opts...,
 	)
@@ -140,8 +142,8 @@ func newClusterSpec(options clusterOptions) (*cluster.Spec, error) {
 }
 
 func getBundles(cliVersion version.Info, bundlesManifestURL string) (*releasev1.Bundles, error) {
-	reader := files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion))
-	manifestReader := manifests.NewReader(reader)
+	reader := files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion), files.WithReleaseSource(releaseSource))
+	manifestReader := manifests.NewReader(reader, manifests.WithReleasesManifest(releases.ManifestURLForSource(releaseSource)))
 	if bundlesManifestURL == "" {
 		return manifestReader.ReadBundlesForVersion(cliVersion.GitVersion)
 	}
@@ -150,8 +152,8 @@ func getBundles(cliVersion version.Info, bundlesManifestURL string) (*releasev1.
 }
 
 func getEksaRelease(cliVersion version.Info) (*releasev1.EksARelease, error) {
-	reader := files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion))
-	manifestReader := manifests.NewReader(reader)
+	reader := files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion), files.WithReleaseSource(releaseSource))
+	manifestReader := manifests.NewReader(reader, manifests.WithReleasesManifest(releases.ManifestURLForSource(releaseSource)))
 	release, err := manifestReader.ReadReleaseForVersion(cliVersion.GitVersion)
 	if err != nil {
 		return nil, err
@@ -161,7 +163,7 @@ func getEksaRelease(cliVersion version.Info) (*releasev1.EksARelease, error) {
 }
 
 func getConfig(clusterConfigPath string, cliVersion version.Info) (*cluster.Config, error) {
-	reader := files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion))
+	reader := files.NewReader(files.WithEKSAUserAgent("cli", cliVersion.GitVersion), files.WithReleaseSource(releaseSource))
 	yaml, err := reader.ReadFile(clusterConfigPath)
 	if err != nil {
 		return nil, errors.Wrapf(err, "reading cluster config file")
@@ -3,16 +3,20 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/aws/eks-anywhere/pkg/certificates"
 	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 )
 
 type renewCertificatesOptions struct {
-	configFile string
-	component  string
+	configFile           string
+	component            string
+	managementKubeconfig string
 }
 
 var rc = &renewCertificatesOptions{}
@@ -30,6 +34,7 @@ func init() {
 	renewCmd.AddCommand(renewCertificatesCmd)
 	renewCertificatesCmd.Flags().StringVarP(&rc.configFile, "config", "f", "", "Config file containing node and SSH information")
 	renewCertificatesCmd.Flags().StringVarP(&rc.component, "component", "c", "", fmt.Sprintf("Component to renew certificates for (%s or %s). If not specified, renews both.", constants.EtcdComponent, constants.ControlPlaneComponent))
+	renewCertificatesCmd.Flags().StringVar(&rc.managementKubeconfig, "kubeconfig", "", "kubeconfig file pointing to the management cluster. Defaults to the cluster kubeconfig in the current directory")
 	if err := renewCertificatesCmd.MarkFlagRequired("config"); err != nil {
 		log.Fatalf("marking config as required: %s", err)
 	}
@@ -42,14 +47,56 @@ func validateComponent(component string) error {
 	return nil
 }
 
-func (rc *renewCertificatesOptions) renewCertificates(_ *cobra.Command, _ []string) error {
-	if err := validateComponent(rc.component); err != nil {
+func (rc *renewCertificatesOptions) renewCertificates(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := rc.loadConfig()
+	if err != nil {
 		return err
 	}
 
-	_, err := certificates.ParseConfig(rc.configFile)
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(rc.managementKubeconfig, cfg.ClusterName)
 	if err != nil {
-		return fmt.Errorf("parsing config file: %v", err)
+		return err
 	}
-	return nil
+
+	deps, err := dependencies.NewFactory().WithReleaseSource(releaseSource).
+		WithExecutableMountDirs(sshKeyDirs(cfg)...).
+		WithUnAuthKubeClient().
+		WithSSH().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	renewer := certificates.NewRenewer(deps.SSH, deps.UnAuthKubeClient.KubeconfigClient(kubeconfigPath))
+	return renewer.RenewCertificates(ctx, cfg, rc.component)
+}
+
+func (rc *renewCertificatesOptions) loadConfig() (*certificates.RenewalConfig, error) {
+	if err := validateComponent(rc.component); err != nil {
+		return nil, err
+	}
+
+	cfg, err := certificates.ParseConfig(rc.configFile)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config file: %v", err)
+	}
+
+	if rc.component == constants.EtcdComponent && len(cfg.Etcd.Nodes) == 0 {
+		return nil, fmt.Errorf("component %s requires etcd nodes in the config file", constants.EtcdComponent)
+	}
+
+	return cfg, nil
+}
+
+// sshKeyDirs returns the directories holding the SSH keys so they can be mounted
+// in the tools container.
+func sshKeyDirs(cfg *certificates.RenewalConfig) []string {
+	dirs := []string{filepath.Dir(cfg.ControlPlane.SSHKey)}
+	if len(cfg.Etcd.Nodes) > 0 && filepath.Dir(cfg.Etcd.SSHKey) != dirs[0] {
+		dirs = append(dirs, filepath.Dir(cfg.Etcd.SSHKey))
+	}
+	return dirs
 }
@@ -164,8 +164,8 @@ func TestConfigFileValidation(t *testing.T) {
 	}
 }
 
-// TestRenewCertificates tests the renewCertificates method.
-func TestRenewCertificates(t *testing.T) {
+// TestLoadConfig tests the loadConfig method.
+func TestLoadConfig(t *testing.T) {
 	// Setup SSH key file once for all tests
 	cleanup := setupSSHKeyFile(t)
 	defer cleanup()
@@ -203,6 +203,13 @@ func TestRenewCertificates(t *testing.T) {
 			errorMsg:    "invalid component",
 			configYaml:  validConfigYamlNoEtcd,
 		},
+		{
+			name:        "etcd component without etcd nodes",
+			component:   constants.EtcdComponent,
+			expectError: true,
+			errorMsg:    "requires etcd nodes",
+			configYaml:  validConfigYamlNoEtcd,
+		},
 	}
 
 	// Run tests
@@ -218,13 +225,28 @@ func TestRenewCertificates(t *testing.T) {
 				component:  tt.component,
 			}
 
-			cmd := &cobra.Command{}
-
-			// Run the renewCertificates method
-			err := rc.renewCertificates(cmd, []string{})
+			// Run the loadConfig method
+			_, err := rc.loadConfig()
 
 			// Check for expected errors
 			checkTestError(t, err, tt.expectError, tt.errorMsg)
 		})
 	}
 }
+
+// TestRenewCertificatesMissingKubeconfig tests the renewCertificates method fails before connecting to nodes without a kubeconfig.
+func TestRenewCertificatesMissingKubeconfig(t *testing.T) {
+	cleanup := setupSSHKeyFile(t)
+	defer cleanup()
+
+	configFile, fileCleanup := createConfigFileFromYAML(t, validConfigYaml)
+	defer fileCleanup()
+
+	rc := &renewCertificatesOptions{
+		configFile:           configFile,
+		managementKubeconfig: "non-existent.kubeconfig",
+	}
+
+	err := rc.renewCertificates(&cobra.Command{}, []string{})
+	checkTestError(t, err, true, "validating kubeconfig")
+}
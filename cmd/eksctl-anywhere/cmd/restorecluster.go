@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/tar"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+type restoreClusterOptions struct {
+	clusterName string
+	kubeconfig  string
+	backupFile  string
+}
+
+var rco = &restoreClusterOptions{}
+
+var restoreClusterCmd = &cobra.Command{
+	Use:          "cluster",
+	Short:        "Restore a management cluster from a backup",
+	Long:         "Recreates the CAPI and EKS-A objects and the provider secrets saved by 'eksctl anywhere backup cluster' in a new management cluster with the same EKS-A version installed",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE:         rco.restoreCluster,
+}
+
+func init() {
+	restoreCmd.AddCommand(restoreClusterCmd)
+	restoreClusterCmd.Flags().StringVar(&rco.clusterName, "cluster-name", "", "Name of the management cluster to restore the backup into")
+	restoreClusterCmd.Flags().StringVar(&rco.kubeconfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig in the current directory")
+	restoreClusterCmd.Flags().StringVarP(&rco.backupFile, "filename", "f", "", "Backup tarball created by 'eksctl anywhere backup cluster'")
+	for _, flag := range []string{"cluster-name", "filename"} {
+		if err := restoreClusterCmd.MarkFlagRequired(flag); err != nil {
+			log.Fatalf("Error marking flag as required: %v", err)
+		}
+	}
+}
+
+func (o *restoreClusterOptions) restoreCluster(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	if !validations.FileExists(o.backupFile) {
+		return fmt.Errorf("backup file %s does not exist", o.backupFile)
+	}
+
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeconfig, o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().WithReleaseSource(releaseSource).
+		WithExecutableMountDirs(filepath.Dir(kubeconfigPath)).
+		WithUnAuthKubeClient().
+		WithClusterctl().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	cluster := &types.Cluster{
+		Name:           o.clusterName,
+		KubeconfigFile: kubeconfigPath,
+	}
+
+	manager := clusterbackup.NewManager(deps.Clusterctl, nil, tar.NewGzipPackager())
+	result, err := manager.Restore(ctx, deps.UnAuthKubeClient.KubeconfigClient(kubeconfigPath), cluster, o.backupFile)
+	if err != nil {
+		return fmt.Errorf("restoring backup %s: %v", o.backupFile, err)
+	}
+
+	if result.EtcdSnapshot != "" {
+		logger.Info("The backup contains an etcd snapshot, it needs to be restored manually with etcdutl", "snapshot", result.EtcdSnapshot)
+	}
+
+	logger.MarkSuccess(fmt.Sprintf("Backup of cluster %s taken at %s restored into cluster %s",
+		result.Metadata.ClusterName, result.Metadata.CreatedAt.Format(time.RFC3339), o.clusterName))
+	return nil
+}
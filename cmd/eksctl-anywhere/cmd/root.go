@@ -10,10 +10,17 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"oras.land/oras-go/v2/registry/remote/auth"
 
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 )
 
+// releaseSource is the release source configured with --release-source, nil if there is none.
+// It's passed to the readers and dependencies of the commands so they read the release manifest,
+// bundles and artifacts from it instead of the internet.
+var releaseSource *releasesource.Source
+
 var rootCmd = &cobra.Command{
 	Use:              "anywhere",
 	Short:            "Amazon EKS Anywhere",
@@ -32,18 +39,49 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	rootCmd.PersistentFlags().IntP("verbosity", "v", 0, "Set the log level verbosity")
+	rootCmd.PersistentFlags().String("release-source", "", "Directory or OCI reference of a release source created by 'download artifacts' to read the release manifest, bundles and artifacts from instead of the internet")
 	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
 		log.Fatalf("failed to bind flags for root: %v", err)
 	}
 }
 
 func rootPersistentPreRun(cmd *cobra.Command, args []string) {
-	if err := initLogger(); err != nil {
+	if err := initLogger(cmd); err != nil {
 		log.Fatal(err)
 	}
+
+	if err := initReleaseSource(cmd.Context()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// initReleaseSource opens the release source configured with --release-source, if any.
+func initReleaseSource(ctx context.Context) error {
+	location := viper.GetString("release-source")
+	if location == "" {
+		return nil
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+
+	source, err := releasesource.Open(ctx, location, filepath.Join(cacheDir, "eks-anywhere", "release-source"),
+		func(ctx context.Context, registry string) (auth.Credential, error) {
+			return cs.Credential(registry)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("opening release source: %v", err)
+	}
+
+	releaseSource = source
+	logger.V(1).Info("Using release source", "dir", source.Dir(), "releaseManifest", source.ReleaseManifestURL())
+	return nil
 }
 
-func initLogger() error {
+func initLogger(cmd *cobra.Command) error {
 	logsFolder := filepath.Join(".", "eksa-cli-logs")
 	err := os.MkdirAll(logsFolder, 0o750)
 	if err != nil {
@@ -51,10 +89,16 @@ func initLogger() error {
 	}
 
 	outputFilePath := filepath.Join(".", "eksa-cli-logs", fmt.Sprintf("%s.log", time.Now().Format("2006-01-02T15_04_05")))
-	if err = logger.Init(logger.Options{
+	opts := logger.Options{
 		Level:          viper.GetInt("verbosity"),
 		OutputFilePath: outputFilePath,
-	}); err != nil {
+	}
+	// Keep stdout for the progress events so they can be parsed without filtering out the logs.
+	if eventsToStdout(cmd.Flags()) {
+		opts.Console = os.Stderr
+	}
+
+	if err = logger.Init(opts); err != nil {
 		return fmt.Errorf("root cmd: %v", err)
 	}
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -24,12 +23,14 @@ import (
 type upgradeClusterOptions struct {
 	clusterOptions
 	timeoutOptions
+	eventsOptions
 	wConfig               string
 	forceClean            bool
 	hardwareCSVPath       string
 	tinkerbellBootstrapIP string
 	skipValidations       []string
 	providerOptions       *dependencies.ProviderOptions
+	resume                bool
 }
 
 var uc = &upgradeClusterOptions{
@@ -55,10 +56,12 @@ var upgradeClusterCmd = &cobra.Command{
 			return errors.New("please remove the --force-cleanup flag")
 		}
 
-		if err := uc.upgradeCluster(cmd, args); err != nil {
-			return fmt.Errorf("failed to upgrade cluster: %v", err)
-		}
-		return nil
+		return uc.eventsOptions.run("upgrade cluster", uc.fileName, true, func() error {
+			if err := uc.upgradeCluster(cmd, args); err != nil {
+				return fmt.Errorf("failed to upgrade cluster: %v", err)
+			}
+			return nil
+		})
 	},
 }
 
@@ -66,11 +69,13 @@ func init() {
 	upgradeCmd.AddCommand(upgradeClusterCmd)
 	applyClusterOptionFlags(upgradeClusterCmd.Flags(), &uc.clusterOptions)
 	applyTimeoutFlags(upgradeClusterCmd.Flags(), &uc.timeoutOptions)
+	applyEventsFlags(upgradeClusterCmd.Flags(), &uc.eventsOptions)
 	applyTinkerbellHardwareFlag(upgradeClusterCmd.Flags(), &uc.hardwareCSVPath)
 	upgradeClusterCmd.Flags().StringVarP(&uc.wConfig, "w-config", "w", "", "Kubeconfig file to use when upgrading a workload cluster")
 	upgradeClusterCmd.Flags().BoolVar(&uc.forceClean, "force-cleanup", false, "Force deletion of previously created bootstrap cluster")
 	hideForceCleanup(upgradeClusterCmd.Flags())
-	upgradeClusterCmd.Flags().StringArrayVar(&uc.skipValidations, "skip-validations", []string{}, fmt.Sprintf("Bypass upgrade validations by name. Valid arguments you can pass are --skip-validations=%s", strings.Join(upgradevalidations.SkippableValidations[:], ",")))
+	upgradeClusterCmd.Flags().StringArrayVar(&uc.skipValidations, "skip-validations", []string{}, skipValidationsUsage(upgradevalidations.SkippableValidations))
+	upgradeClusterCmd.Flags().BoolVar(&uc.resume, "resume", false, "Resume a previous failed upgrade from its last completed step")
 	aflag.MarkRequired(createClusterCmd.Flags(), aflag.ClusterConfig.Name)
 	tinkerbellFlags(upgradeClusterCmd.Flags(), uc.providerOptions.Tinkerbell.BMCOptions.RPC)
 }
@@ -140,13 +145,13 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 
 	var skippedValidations map[string]bool
 	if len(uc.skipValidations) != 0 {
-		skippedValidations, err = validations.ValidateSkippableValidation(uc.skipValidations, upgradevalidations.SkippableValidations)
+		skippedValidations, err = validations.ParseSkippedValidations(uc.skipValidations, upgradevalidations.SkippableValidations)
 		if err != nil {
 			return err
 		}
 	}
 
-	factory := dependencies.ForSpec(clusterSpec).WithExecutableMountDirs(dirs...).
+	factory := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).WithExecutableMountDirs(dirs...).
 		WithBootstrapper().
 		WithCliConfig(cliConfig).
 		WithClusterManager(clusterSpec.Cluster, clusterManagerTimeoutOpts).
@@ -198,6 +203,7 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 		Provider:           deps.Provider,
 		CliConfig:          cliConfig,
 		SkippedValidations: skippedValidations,
+		ReportFile:         validations.ReportFilePath(uc.fileName),
 		KubeClient:         deps.UnAuthKubeClient.KubeconfigClient(managementCluster.KubeconfigFile),
 		ManifestReader:     deps.ManifestReader,
 		BundlesOverride:    uc.bundlesOverride,
@@ -220,6 +226,9 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 			deps.AwsIamAuth,
 		)
 
+		if uc.resume {
+			upgrade.WithResume()
+		}
 		err = upgrade.Run(ctx, clusterSpec, managementCluster, upgradeValidations)
 
 	} else {
@@ -234,6 +243,9 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 			deps.PackageManager,
 			deps.AwsIamAuth,
 		)
+		if uc.resume {
+			upgradeWorkloadCluster.WithResume()
+		}
 		err = upgradeWorkloadCluster.Run(ctx, workloadCluster, clusterSpec, upgradeValidations)
 	}
 
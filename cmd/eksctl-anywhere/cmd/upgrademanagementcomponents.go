@@ -50,7 +50,7 @@ var upgradeManagementComponentsCmd = &cobra.Command{
 			return err
 		}
 
-		factory := dependencies.ForSpec(clusterSpec).WithExecutableMountDirs(dirs...).
+		factory := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).WithExecutableMountDirs(dirs...).
 			WithBootstrapper().
 			WithCliConfig(cliConfig).
 			WithClusterManager(clusterSpec.Cluster, nil).
@@ -54,7 +54,7 @@ func (uc *upgradeClusterOptions) upgradePlanManagementComponents(ctx context.Con
 		return err
 	}
 
-	deps, err := dependencies.ForSpec(newClusterSpec).
+	deps, err := dependencies.ForSpec(newClusterSpec).WithReleaseSource(releaseSource).
 		WithClusterManager(newClusterSpec.Cluster, nil).
 		WithProvider(uc.fileName, newClusterSpec.Cluster, false, uc.hardwareCSVPath, uc.forceClean, uc.tinkerbellBootstrapIP, map[string]bool{}, uc.providerOptions).
 		WithGitOpsFlux(newClusterSpec.Cluster, newClusterSpec.FluxConfig, nil).
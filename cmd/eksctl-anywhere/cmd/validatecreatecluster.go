@@ -79,7 +79,7 @@ func (valOpt *validateOptions) validateCreateCluster(cmd *cobra.Command, _ []str
 	if err != nil {
 		return err
 	}
-	deps, err := dependencies.ForSpec(clusterSpec).
+	deps, err := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).
 		WithExecutableMountDirs(dirs...).
 		WithWriterFolder(tmpPath).
 		WithDocker().
@@ -107,6 +107,7 @@ func (valOpt *validateOptions) validateCreateCluster(cmd *cobra.Command, _ []str
 		Provider:          deps.Provider,
 		CliConfig:         cliConfig,
 		ManifestReader:    deps.ManifestReader,
+		ReportFile:        validations.ReportFilePath(valOpt.fileName),
 	}
 	createValidations := createvalidations.New(validationOpts)
 
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var validateDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Validate delete resources",
+	Long:  "Use eksctl anywhere validate delete to validate the delete action on resources, such as cluster",
+}
+
+func init() {
+	validateCmd.AddCommand(validateDeleteCmd)
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+	"github.com/aws/eks-anywhere/pkg/validations/deletecluster"
+)
+
+// validateDeleteClusterOptions reuses the delete options so the cluster config is located
+// the same way as in a delete.
+type validateDeleteClusterOptions struct {
+	deleteClusterOptions
+}
+
+var vdc = &validateDeleteClusterOptions{
+	deleteClusterOptions: deleteClusterOptions{
+		providerOptions: &dependencies.ProviderOptions{
+			Tinkerbell: &dependencies.TinkerbellOptions{
+				BMCOptions: &hardware.BMCOptions{
+					RPC: &hardware.RPCOpts{},
+				},
+			},
+		},
+	},
+}
+
+var validateDeleteClusterCmd = &cobra.Command{
+	Use:          "cluster (<cluster-name>|-f <config-file>)",
+	Short:        "Validate delete cluster",
+	Long:         "Use eksctl anywhere validate delete cluster to check a cluster can be deleted and list the provider resources the delete would remove, without deleting anything",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := vdc.validate(cmd.Context(), args); err != nil {
+			return err
+		}
+		return vdc.validateDeleteCluster(cmd)
+	},
+}
+
+func init() {
+	validateDeleteCmd.AddCommand(validateDeleteClusterCmd)
+	validateDeleteClusterCmd.Flags().StringVarP(&vdc.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration, required if <cluster-name> is not provided")
+	validateDeleteClusterCmd.Flags().StringVarP(&vdc.wConfig, "w-config", "w", "", "Kubeconfig file to use when validating the delete of a workload cluster")
+	validateDeleteClusterCmd.Flags().StringVar(&vdc.managementKubeconfig, "kubeconfig", "", "kubeconfig file pointing to a management cluster")
+	validateDeleteClusterCmd.Flags().StringVar(&vdc.bundlesOverride, "bundles-override", "", "Override default Bundles manifest (not recommended)")
+	tinkerbellFlags(validateDeleteClusterCmd.Flags(), vdc.providerOptions.Tinkerbell.BMCOptions.RPC)
+}
+
+func (vdc *validateDeleteClusterOptions) validateDeleteCluster(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	clusterSpec, err := newClusterSpec(vdc.clusterOptions)
+	if err != nil {
+		return fmt.Errorf("unable to get cluster config from file: %v", err)
+	}
+
+	if err := validations.ValidateAuthenticationForRegistryMirror(clusterSpec); err != nil {
+		return err
+	}
+
+	cliConfig := buildCliConfig(clusterSpec)
+	dirs, err := vdc.directoriesToMount(clusterSpec, cliConfig)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).WithExecutableMountDirs(dirs...).
+		WithCliConfig(cliConfig).
+		WithProvider(vdc.fileName, clusterSpec.Cluster, true, vdc.hardwareFileName, false, vdc.tinkerbellBootstrapIP, map[string]bool{}, vdc.providerOptions).
+		WithWriter().
+		WithKubectl().
+		WithDeleteClusterDefaulter(buildDeleteCliConfig()).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	clusterSpec, err = deps.DeleteClusterDefaulter.Run(ctx, clusterSpec)
+	if err != nil {
+		return err
+	}
+
+	managementCluster := getManagementCluster(clusterSpec)
+	workloadCluster := &types.Cluster{
+		Name:           clusterSpec.Cluster.Name,
+		KubeconfigFile: managementCluster.KubeconfigFile,
+	}
+
+	commandVal := deletecluster.NewValidations(clusterSpec, workloadCluster, managementCluster, deps.Provider, deps.Kubectl)
+	err = commandVal.Validate(ctx)
+
+	logger.Info(fmt.Sprintf("Deleting cluster %s removes these %s resources from the management cluster", clusterSpec.Cluster.Name, deps.Provider.Name()))
+	for _, r := range deletecluster.ProviderResources(deps.Provider, clusterSpec) {
+		logger.Info(r.String())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	logger.MarkSuccess(fmt.Sprintf("Cluster %s can be deleted", clusterSpec.Cluster.Name))
+	cleanup(deps, &err)
+	return err
+}
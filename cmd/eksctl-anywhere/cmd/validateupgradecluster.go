@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+	"github.com/aws/eks-anywhere/pkg/validations/upgradecluster"
+	"github.com/aws/eks-anywhere/pkg/validations/upgradevalidations"
+)
+
+// validateUpgradeClusterOptions reuses the upgrade options so the cluster spec is read and
+// defaulted exactly as in an upgrade. Only the flags that affect validations are exposed.
+type validateUpgradeClusterOptions struct {
+	upgradeClusterOptions
+}
+
+var vuc = &validateUpgradeClusterOptions{
+	upgradeClusterOptions: upgradeClusterOptions{
+		providerOptions: &dependencies.ProviderOptions{
+			Tinkerbell: &dependencies.TinkerbellOptions{
+				BMCOptions: &hardware.BMCOptions{
+					RPC: &hardware.RPCOpts{},
+				},
+			},
+		},
+	},
+}
+
+var validateUpgradeClusterCmd = &cobra.Command{
+	Use:          "cluster -f <cluster-config-file> [flags]",
+	Short:        "Validate upgrade cluster",
+	Long:         "Use eksctl anywhere validate upgrade cluster to run all the upgrade preflight validations against a live cluster without upgrading it",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.MaximumNArgs(1),
+	RunE:         vuc.validateUpgradeCluster,
+}
+
+func init() {
+	validateUpgradeCmd.AddCommand(validateUpgradeClusterCmd)
+	applyClusterOptionFlags(validateUpgradeClusterCmd.Flags(), &vuc.clusterOptions)
+	applyTinkerbellHardwareFlag(validateUpgradeClusterCmd.Flags(), &vuc.hardwareCSVPath)
+	validateUpgradeClusterCmd.Flags().StringVarP(&vuc.wConfig, "w-config", "w", "", "Kubeconfig file to use when validating the upgrade of a workload cluster")
+	validateUpgradeClusterCmd.Flags().StringVar(&vuc.tinkerbellBootstrapIP, "tinkerbell-bootstrap-ip", "", "Override the local tinkerbell IP in the bootstrap cluster")
+	validateUpgradeClusterCmd.Flags().StringArrayVar(&vuc.skipValidations, "skip-validations", []string{}, skipValidationsUsage(upgradevalidations.SkippableValidations))
+	tinkerbellFlags(validateUpgradeClusterCmd.Flags(), vuc.providerOptions.Tinkerbell.BMCOptions.RPC)
+}
+
+func (vuc *validateUpgradeClusterOptions) validateUpgradeCluster(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	clusterConfig, err := vuc.commonValidations(ctx)
+	if err != nil {
+		return fmt.Errorf("common validations failed due to: %v", err)
+	}
+
+	if clusterConfig.Spec.DatacenterRef.Kind == v1alpha1.TinkerbellDatacenterKind {
+		if err := checkTinkerbellFlags(cmd.Flags(), vuc.hardwareCSVPath, Upgrade); err != nil {
+			return err
+		}
+	}
+
+	if err := validations.ValidateClusterNameFromCommandAndConfig(args, clusterConfig.Name); err != nil {
+		return err
+	}
+
+	clusterSpec, err := newClusterSpec(vuc.clusterOptions)
+	if err != nil {
+		return err
+	}
+
+	cliConfig := buildCliConfig(clusterSpec)
+	dirs, err := vuc.directoriesToMount(clusterSpec, cliConfig)
+	if err != nil {
+		return err
+	}
+
+	upgradeCLIConfig, err := buildUpgradeCliConfig(&vuc.upgradeClusterOptions)
+	if err != nil {
+		return err
+	}
+
+	var skippedValidations map[string]bool
+	if len(vuc.skipValidations) != 0 {
+		skippedValidations, err = validations.ParseSkippedValidations(vuc.skipValidations, upgradevalidations.SkippableValidations)
+		if err != nil {
+			return err
+		}
+	}
+
+	deps, err := dependencies.ForSpec(clusterSpec).WithReleaseSource(releaseSource).WithExecutableMountDirs(dirs...).
+		WithCliConfig(cliConfig).
+		WithClusterManager(clusterSpec.Cluster, nil).
+		WithProvider(vuc.fileName, clusterSpec.Cluster, true, vuc.hardwareCSVPath, false, vuc.tinkerbellBootstrapIP, skippedValidations, vuc.providerOptions).
+		WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
+		WithWriter().
+		WithKubectl().
+		WithValidatorClients().
+		WithUpgradeClusterDefaulter(upgradeCLIConfig).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	clusterSpec, err = deps.UpgradeClusterDefaulter.Run(ctx, clusterSpec)
+	if err != nil {
+		return err
+	}
+
+	workloadCluster := &types.Cluster{
+		Name:           clusterSpec.Cluster.Name,
+		KubeconfigFile: getKubeconfigPath(clusterSpec.Cluster.Name, vuc.wConfig),
+	}
+
+	managementCluster := workloadCluster
+	if clusterSpec.ManagementCluster != nil {
+		managementCluster = clusterSpec.ManagementCluster
+	}
+
+	validationOpts := &validations.Opts{
+		Kubectl:            deps.UnAuthKubectlClient,
+		Spec:               clusterSpec,
+		WorkloadCluster:    workloadCluster,
+		ManagementCluster:  managementCluster,
+		Provider:           deps.Provider,
+		CliConfig:          cliConfig,
+		SkippedValidations: skippedValidations,
+		ReportFile:         validations.ReportFilePath(vuc.fileName),
+		KubeClient:         deps.UnAuthKubeClient.KubeconfigClient(managementCluster.KubeconfigFile),
+		ManifestReader:     deps.ManifestReader,
+		BundlesOverride:    vuc.bundlesOverride,
+	}
+
+	commandVal := upgradecluster.NewValidations(
+		clusterSpec,
+		managementCluster,
+		deps.Provider,
+		deps.GitOpsFlux,
+		deps.ClusterManager,
+		upgradevalidations.New(validationOpts),
+	)
+	if err = commandVal.Validate(ctx); err != nil {
+		return err
+	}
+
+	logger.MarkSuccess(fmt.Sprintf("Cluster %s can be upgraded", clusterSpec.Cluster.Name))
+	cleanup(deps, &err)
+	return err
+}
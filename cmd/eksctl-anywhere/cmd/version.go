@@ -31,7 +31,7 @@ func init() {
 }
 
 func (vo *versionOptions) printVersion() error {
-	versionInfo, bundlesErr := version.GetFullVersionInfo()
+	versionInfo, bundlesErr := version.GetFullVersionInfo(releaseSource)
 	switch vo.output {
 	case "":
 		fmt.Printf("Version: %s\n", versionInfo.GitVersion)
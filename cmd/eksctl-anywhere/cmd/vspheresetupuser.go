@@ -55,7 +55,7 @@ func (setupUserOptions *vSphereSetupUserOptions) setupUser(cmd *cobra.Command, _
 	if err != nil {
 		return err
 	}
-	deps, err := dependencies.NewFactory().WithGovc().Build(ctx)
+	deps, err := dependencies.NewFactory().WithReleaseSource(releaseSource).WithGovc().Build(ctx)
 	if err != nil {
 		return err
 	}
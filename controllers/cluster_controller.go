@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
 	c "github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
@@ -39,6 +40,8 @@ import (
 
 const (
 	defaultRequeueTime = time.Minute
+	// etcdBackupStatusRefreshTime is how often the status of the scheduled etcd snapshots is refreshed.
+	etcdBackupStatusRefreshTime = 5 * time.Minute
 	// ClusterFinalizerName is the finalizer added to clusters to handle deletion.
 	ClusterFinalizerName = "clusters.anywhere.eks.amazonaws.com/finalizer"
 	releaseV022          = "v0.22.0"
@@ -53,6 +56,10 @@ type ClusterReconciler struct {
 	packagesClient             PackagesClient
 	machineHealthCheck         MachineHealthCheckReconciler
 	vSpherefailureDomainMover  FailureDomainApplier
+	etcdBackup                 EtcdBackupReconciler
+	loadBalancer               LoadBalancerReconciler
+	// certificateExpiryWarningThreshold is how long before their expiry certificates are reported as expiring soon.
+	certificateExpiryWarningThreshold time.Duration
 }
 
 // PackagesClient handles curated packages operations from within the cluster
@@ -79,6 +86,16 @@ type MachineHealthCheckReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) error
 }
 
+// EtcdBackupReconciler manages the scheduled etcd snapshots of an eks-a cluster.
+type EtcdBackupReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// LoadBalancerReconciler manages the in-cluster load balancer of an eks-a cluster.
+type LoadBalancerReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
 // ClusterValidator runs cluster level preflight validations before it goes to provider reconciler.
 type ClusterValidator interface {
 	ValidateManagementClusterName(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) error
@@ -87,6 +104,28 @@ type ClusterValidator interface {
 // ClusterReconcilerOption allows to configure the ClusterReconciler.
 type ClusterReconcilerOption func(*ClusterReconciler)
 
+// WithEtcdBackupReconciler configures the reconciler that manages the cluster's etcd snapshots.
+func WithEtcdBackupReconciler(etcdBackup EtcdBackupReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.etcdBackup = etcdBackup
+	}
+}
+
+// WithLoadBalancerReconciler configures the reconciler that manages the cluster's in-cluster load balancer.
+func WithLoadBalancerReconciler(loadBalancer LoadBalancerReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.loadBalancer = loadBalancer
+	}
+}
+
+// WithCertificateExpiryWarningThreshold configures how long before their expiry certificates are reported
+// as expiring soon in the CertificatesValid condition.
+func WithCertificateExpiryWarningThreshold(threshold time.Duration) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.certificateExpiryWarningThreshold = threshold
+	}
+}
+
 // SpecBuilder builds a cluster specification from an EKS Anywhere Cluster object.
 type SpecBuilder interface {
 	BuildSpec(ctx context.Context, eksaCluster *anywherev1.Cluster) (*c.Spec, error)
@@ -167,13 +206,14 @@ func NewFailureDomainMoverWithDependencies(
 // NewClusterReconciler constructs a new ClusterReconciler.
 func NewClusterReconciler(client client.Client, registry ProviderClusterReconcilerRegistry, awsIamAuth AWSIamConfigReconciler, clusterValidator ClusterValidator, pkgs PackagesClient, machineHealthCheck MachineHealthCheckReconciler, failuredomainmover FailureDomainApplier, opts ...ClusterReconcilerOption) *ClusterReconciler {
 	c := &ClusterReconciler{
-		client:                     client,
-		providerReconcilerRegistry: registry,
-		awsIamAuth:                 awsIamAuth,
-		clusterValidator:           clusterValidator,
-		packagesClient:             pkgs,
-		machineHealthCheck:         machineHealthCheck,
-		vSpherefailureDomainMover:  failuredomainmover,
+		client:                            client,
+		providerReconcilerRegistry:        registry,
+		awsIamAuth:                        awsIamAuth,
+		clusterValidator:                  clusterValidator,
+		packagesClient:                    pkgs,
+		machineHealthCheck:                machineHealthCheck,
+		vSpherefailureDomainMover:         failuredomainmover,
+		certificateExpiryWarningThreshold: certificates.DefaultExpiryWarningThreshold,
 	}
 
 	for _, opt := range opts {
@@ -274,6 +314,7 @@ func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager, log logr.Logger)
 // +kubebuilder:rbac:groups=tinkerbell.org,resources=hardware,verbs=list;watch
 // +kubebuilder:rbac:groups=bmc.tinkerbell.org,resources=machines,verbs=list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awssnowclusters;awssnowmachinetemplates;awssnowippools;vsphereclusters;vspheremachinetemplates;dockerclusters;dockermachinetemplates;tinkerbellclusters;tinkerbellmachinetemplates;cloudstackclusters;cloudstackmachinetemplates;nutanixclusters;nutanixmachinetemplates;vspherefailuredomains;vspheredeploymentzones,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=inclusterippools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=packages.eks.amazonaws.com,resources=packages,verbs=create;delete;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=packages.eks.amazonaws.com,namespace=eksa-system,resources=packagebundlecontrollers,verbs=delete
 // +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=eksareleases,verbs=get;list;watch
@@ -330,6 +371,13 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		if reterr == nil && !result.Requeue && result.RequeueAfter <= 0 && conditions.IsFalse(cluster, anywherev1.ReadyCondition) {
 			result = ctrl.Result{RequeueAfter: 10 * time.Second}
 		}
+
+		// Snapshots taken by the etcd backup CronJob don't trigger reconciliation requests either,
+		// so requeue periodically to keep the etcd backup status up to date.
+		if reterr == nil && !result.Requeue && result.RequeueAfter <= 0 && r.etcdBackup != nil && cluster.Spec.EtcdBackup != nil &&
+			cluster.DeletionTimestamp.IsZero() && !cluster.IsReconcilePaused() {
+			result = ctrl.Result{RequeueAfter: etcdBackupStatusRefreshTime}
+		}
 	}()
 
 	if !cluster.DeletionTimestamp.IsZero() {
@@ -487,6 +535,17 @@ func (r *ClusterReconciler) preClusterProviderReconcile(ctx context.Context, log
 		}
 	}
 
+	for _, secretRef := range cluster.RegistryMirrorCredentialsSecretRefs() {
+		username, password, err := config.ReadEndpointCredentialsFromSecret(ctx, r.client, secretRef)
+		if err != nil {
+			return controller.Result{}, err
+		}
+
+		if err := config.SetEndpointCredentialsEnv(secretRef, username, password); err != nil {
+			return controller.Result{}, err
+		}
+	}
+
 	return controller.Result{}, nil
 }
 
@@ -503,6 +562,22 @@ func (r *ClusterReconciler) postClusterProviderReconcile(ctx context.Context, lo
 		return controller.Result{}, err
 	}
 
+	if r.etcdBackup != nil {
+		if result, err := r.etcdBackup.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
+	if r.loadBalancer != nil {
+		if result, err := r.loadBalancer.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
 	return controller.Result{}, nil
 }
 
@@ -513,6 +588,11 @@ func (r *ClusterReconciler) packagesReconcile(ctx context.Context, log logr.Logg
 		if err := r.packagesClient.Reconcile(ctx, log, r.client, cluster); err != nil {
 			return controller.Result{}, err
 		}
+		// Package installations progress asynchronously, requeue to keep their status up to date.
+		if !curatedpackages.PackagesInstalled(cluster) {
+			log.Info("Waiting for packages to be installed")
+			return controller.ResultWithRequeue(defaultRequeueTime), nil
+		}
 	}
 
 	return controller.Result{}, nil
@@ -538,6 +618,10 @@ func (r *ClusterReconciler) updateStatus(ctx context.Context, log logr.Logger, c
 
 	clusters.UpdateClusterStatusForCNI(ctx, cluster)
 
+	if err := clusters.UpdateClusterStatusForCertificates(ctx, r.client, cluster, r.certificateExpiryWarningThreshold); err != nil {
+		return errors.Wrap(err, "updating status for certificates")
+	}
+
 	summarizedConditionTypes := []anywherev1.ConditionType{
 		anywherev1.ControlPlaneInitializedCondition,
 		anywherev1.ControlPlaneReadyCondition,
@@ -684,6 +768,7 @@ func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *anywh
 			anywherev1.ControlPlaneReadyCondition,
 			anywherev1.WorkersReadyCondition,
 			anywherev1.DefaultCNIConfiguredCondition,
+			anywherev1.CertificatesValidCondition,
 		}},
 	}, patchOpts...)
 
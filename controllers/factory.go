@@ -19,9 +19,11 @@ import (
 	"github.com/aws/eks-anywhere/pkg/crypto"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
+	etcdbackupreconciler "github.com/aws/eks-anywhere/pkg/etcdbackup/reconciler"
 	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/executables/cmk"
 	"github.com/aws/eks-anywhere/pkg/helm"
+	loadbalancerreconciler "github.com/aws/eks-anywhere/pkg/loadbalancer/reconciler"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 	ciliumreconciler "github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
 	cnireconciler "github.com/aws/eks-anywhere/pkg/networking/reconciler"
@@ -55,6 +57,8 @@ type Factory struct {
 	ipValidator                  *clusters.IPValidator
 	awsIamConfigReconciler       *awsiamconfigreconciler.Reconciler
 	machineHealthCheckReconciler *mhcreconciler.Reconciler
+	etcdBackupReconciler         *etcdbackupreconciler.Reconciler
+	loadBalancerReconciler       *loadbalancerreconciler.Reconciler
 	logger                       logr.Logger
 	deps                         *dependencies.Dependencies
 	packageControllerClient      *curatedpackages.PackageControllerClient
@@ -120,13 +124,20 @@ func (f *Factory) WithClusterReconciler(capiProviders []clusterctlv1.Provider, o
 		WithProviderClusterReconcilerRegistry(capiProviders).
 		withAWSIamConfigReconciler().
 		withPackageControllerClient().
-		withMachineHealthCheckReconciler()
+		withMachineHealthCheckReconciler().
+		withEtcdBackupReconciler().
+		withLoadBalancerReconciler()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.reconcilers.ClusterReconciler != nil {
 			return nil
 		}
 
+		opts = append([]ClusterReconcilerOption{
+			WithEtcdBackupReconciler(f.etcdBackupReconciler),
+			WithLoadBalancerReconciler(f.loadBalancerReconciler),
+		}, opts...)
+
 		f.reconcilers.ClusterReconciler = NewClusterReconciler(
 			f.manager.GetClient(),
 			f.registry,
@@ -594,6 +605,44 @@ func (f *Factory) withMachineHealthCheckReconciler() *Factory {
 	return f
 }
 
+func (f *Factory) withEtcdBackupReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.etcdBackupReconciler != nil {
+			return nil
+		}
+
+		f.etcdBackupReconciler = etcdbackupreconciler.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withLoadBalancerReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.loadBalancerReconciler != nil {
+			return nil
+		}
+
+		f.loadBalancerReconciler = loadbalancerreconciler.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
 // WithKubeadmControlPlaneReconciler builds the KubeadmControlPlane reconciler.
 func (f *Factory) WithKubeadmControlPlaneReconciler() *Factory {
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
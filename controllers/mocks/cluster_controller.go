@@ -232,6 +232,82 @@ func (mr *MockMachineHealthCheckReconcilerMockRecorder) Reconcile(ctx, logger, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockMachineHealthCheckReconciler)(nil).Reconcile), ctx, logger, cluster)
 }
 
+// MockEtcdBackupReconciler is a mock of EtcdBackupReconciler interface.
+type MockEtcdBackupReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEtcdBackupReconcilerMockRecorder
+}
+
+// MockEtcdBackupReconcilerMockRecorder is the mock recorder for MockEtcdBackupReconciler.
+type MockEtcdBackupReconcilerMockRecorder struct {
+	mock *MockEtcdBackupReconciler
+}
+
+// NewMockEtcdBackupReconciler creates a new mock instance.
+func NewMockEtcdBackupReconciler(ctrl *gomock.Controller) *MockEtcdBackupReconciler {
+	mock := &MockEtcdBackupReconciler{ctrl: ctrl}
+	mock.recorder = &MockEtcdBackupReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEtcdBackupReconciler) EXPECT() *MockEtcdBackupReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockEtcdBackupReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockEtcdBackupReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockEtcdBackupReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockLoadBalancerReconciler is a mock of LoadBalancerReconciler interface.
+type MockLoadBalancerReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancerReconcilerMockRecorder
+}
+
+// MockLoadBalancerReconcilerMockRecorder is the mock recorder for MockLoadBalancerReconciler.
+type MockLoadBalancerReconcilerMockRecorder struct {
+	mock *MockLoadBalancerReconciler
+}
+
+// NewMockLoadBalancerReconciler creates a new mock instance.
+func NewMockLoadBalancerReconciler(ctrl *gomock.Controller) *MockLoadBalancerReconciler {
+	mock := &MockLoadBalancerReconciler{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancerReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancerReconciler) EXPECT() *MockLoadBalancerReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockLoadBalancerReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockLoadBalancerReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockLoadBalancerReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
 // MockClusterValidator is a mock of ClusterValidator interface.
 type MockClusterValidator struct {
 	ctrl     *gomock.Controller
@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"os"
+	"time"
 
 	eksdv1alpha1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
@@ -31,10 +32,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	"github.com/aws/eks-anywhere/controllers"
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	tinkerbellv1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/capt/v1beta1"
 	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
+	"github.com/aws/eks-anywhere/pkg/certificates"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
 	"github.com/aws/eks-anywhere/pkg/features"
@@ -65,6 +68,7 @@ func init() {
 	utilruntime.Must(tinkv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(rufiov1alpha1.AddToScheme(scheme))
 	utilruntime.Must(nutanixv1.AddToScheme(scheme))
+	utilruntime.Must(packagesv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -74,6 +78,8 @@ type config struct {
 	probeAddr            string
 	gates                []string
 	logging              *logsv1.LoggingConfiguration
+	// certificateExpiryWarningThreshold is how long before their expiry certificates are reported as expiring soon.
+	certificateExpiryWarningThreshold time.Duration
 }
 
 func newConfig() *config {
@@ -95,6 +101,8 @@ func initFlags(fs *pflag.FlagSet, config *config) {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	fs.StringSliceVar(&config.gates, "feature-gates", []string{}, "A set of key=value pairs that describe feature gates for alpha/experimental features. ")
+	fs.DurationVar(&config.certificateExpiryWarningThreshold, "certificate-expiry-warning-threshold", certificates.DefaultExpiryWarningThreshold,
+		"How long before their expiry the control plane and etcd certificates are reported as expiring soon on the Cluster status.")
 }
 
 func main() {
@@ -138,7 +146,7 @@ func main() {
 	// Setup the context that's going to be used in controllers and for the manager.
 	ctx := ctrl.SetupSignalHandler()
 
-	closer := setupReconcilers(ctx, setupLog, mgr)
+	closer := setupReconcilers(ctx, setupLog, mgr, config)
 	defer func() {
 		setupLog.Info("Closing reconciler dependencies")
 		if err := closer.Close(ctx); err != nil {
@@ -166,7 +174,7 @@ type closable interface {
 	Close(ctx context.Context) error
 }
 
-func setupReconcilers(ctx context.Context, setupLog logr.Logger, mgr ctrl.Manager) closable {
+func setupReconcilers(ctx context.Context, setupLog logr.Logger, mgr ctrl.Manager, config *config) closable {
 	setupLog.Info("Reading CAPI providers")
 	providers, err := clusterapi.GetProviders(ctx, mgr.GetAPIReader())
 	if err != nil {
@@ -177,6 +185,7 @@ func setupReconcilers(ctx context.Context, setupLog logr.Logger, mgr ctrl.Manage
 	factory := controllers.NewFactory(ctrl.Log, mgr).
 		WithClusterReconciler(
 			providers,
+			controllers.WithCertificateExpiryWarningThreshold(config.certificateExpiryWarningThreshold),
 		).
 		WithVSphereDatacenterReconciler().
 		WithSnowMachineConfigReconciler().
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -35,6 +37,8 @@ const (
 	ClusterKind              = "Cluster"
 	RegistryMirrorCAKey      = "EKSA_REGISTRY_MIRROR_CA"
 	podSubnetNodeMaskMaxDiff = 16
+	// maxASN is the largest 4-byte BGP autonomous system number.
+	maxASN = 4294967295
 )
 
 var re = regexp.MustCompile(constants.DefaultCuratedPackagesRegistryRegex)
@@ -190,12 +194,15 @@ var clusterConfigValidations = []func(*Cluster) error{
 	validateCPUpgradeRolloutStrategy,
 	validateControlPlaneLabels,
 	validatePackageControllerConfiguration,
+	validatePackageInstall,
 	validateEksaVersion,
 	validateControlPlaneCertSANs,
 	validateControlPlaneAPIServerExtraArgs,
 	validateControlPlaneAPIServerOIDCExtraArgs,
 	validateControlPlaneKubeletConfiguration,
 	validateWorkerNodeKubeletConfiguration,
+	validateEtcdBackup,
+	validateLoadBalancer,
 }
 
 // GetClusterConfig parses a Cluster object from a multiobject yaml file in disk
@@ -365,6 +372,22 @@ func (c *Cluster) RegistryAuth() bool {
 	return c.Spec.RegistryMirrorConfiguration.Authenticate
 }
 
+// RegistryMirrorCredentialsSecretRefs returns the names of the secrets holding the credentials
+// of the additional registry mirror endpoints.
+func (c *Cluster) RegistryMirrorCredentialsSecretRefs() []string {
+	if c.Spec.RegistryMirrorConfiguration == nil {
+		return nil
+	}
+
+	var refs []string
+	for _, endpoint := range c.Spec.RegistryMirrorConfiguration.Endpoints {
+		if endpoint.CredentialsSecretRef != "" {
+			refs = append(refs, endpoint.CredentialsSecretRef)
+		}
+	}
+	return refs
+}
+
 func (c *Cluster) ProxyConfiguration() map[string]string {
 	if c.Spec.ProxyConfiguration == nil {
 		return nil
@@ -737,49 +760,55 @@ func validateNetworking(clusterConfig *Cluster) error {
 	if len(clusterNetwork.Services.CidrBlocks) <= 0 {
 		return errors.New("services CIDR block not specified or empty")
 	}
-	if len(clusterNetwork.Pods.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Pods are not yet supported")
-	}
-	if len(clusterNetwork.Services.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Services are not yet supported")
-	}
-	_, podCIDRIPNet, err := net.ParseCIDR(clusterNetwork.Pods.CidrBlocks[0])
-	if err != nil {
+	podCIDRs, podFamilies, err := parseCIDRBlocks("pods", clusterNetwork.Pods.CidrBlocks)
+	if errors.Is(err, errInvalidCIDR) {
 		return fmt.Errorf("invalid CIDR block format for Pods: %s. Please specify a valid CIDR block for pod subnet", clusterNetwork.Pods)
+	} else if err != nil {
+		return err
 	}
-	_, serviceCIDRIPNet, err := net.ParseCIDR(clusterNetwork.Services.CidrBlocks[0])
-	if err != nil {
+	serviceCIDRs, serviceFamilies, err := parseCIDRBlocks("services", clusterNetwork.Services.CidrBlocks)
+	if errors.Is(err, errInvalidCIDR) {
 		return fmt.Errorf("invalid CIDR block for Services: %s. Please specify a valid CIDR block for service subnet", clusterNetwork.Services)
+	} else if err != nil {
+		return err
+	}
+	if !slices.Equal(podFamilies, serviceFamilies) {
+		return fmt.Errorf("pods CIDR blocks IP families %v don't match services CIDR blocks IP families %v", podFamilies, serviceFamilies)
+	}
+
+	if err := validateIPv6ProviderSupport(clusterConfig, podFamilies); err != nil {
+		return err
 	}
 
 	if clusterConfig.Spec.DatacenterRef.Kind == SnowDatacenterKind {
-		controlPlaneEndpoint := net.ParseIP(clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host)
-		if controlPlaneEndpoint == nil {
+		controlPlaneEndpoint, err := netip.ParseAddr(clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host)
+		if err != nil {
 			return fmt.Errorf("control plane endpoint %s is invalid", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host)
 		}
-		if podCIDRIPNet.Contains(controlPlaneEndpoint) {
-			return fmt.Errorf("control plane endpoint %s conflicts with pods CIDR block %s", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host, clusterNetwork.Pods.CidrBlocks[0])
-		}
-		if serviceCIDRIPNet.Contains(controlPlaneEndpoint) {
-			return fmt.Errorf("control plane endpoint %s conflicts with services CIDR block %s", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host, clusterNetwork.Services.CidrBlocks[0])
+		for i := range podCIDRs {
+			if podCIDRs[i].Contains(controlPlaneEndpoint) {
+				return fmt.Errorf("control plane endpoint %s conflicts with pods CIDR block %s", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host, clusterNetwork.Pods.CidrBlocks[i])
+			}
+			if serviceCIDRs[i].Contains(controlPlaneEndpoint) {
+				return fmt.Errorf("control plane endpoint %s conflicts with services CIDR block %s", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host, clusterNetwork.Services.CidrBlocks[i])
+			}
 		}
 	}
 
-	podMaskSize, _ := podCIDRIPNet.Mask.Size()
-	nodeCidrMaskSize := constants.DefaultNodeCidrMaskSize
+	if err := validateControlPlaneEndpointIPFamily(clusterConfig, podFamilies); err != nil {
+		return err
+	}
 
-	if clusterNetwork.Nodes != nil && clusterNetwork.Nodes.CIDRMaskSize != nil {
-		nodeCidrMaskSize = *clusterNetwork.Nodes.CIDRMaskSize
+	if err := validateNodeCIDRMaskSize(&clusterNetwork, podCIDRs, podFamilies); err != nil {
+		return err
+	}
+
+	if err := validateCiliumNativeRoutingCIDRs(clusterNetwork.CNIConfig, podFamilies); err != nil {
+		return err
 	}
-	// the pod subnet mask needs to allow one or multiple node-masks
-	// i.e. if it has a /24 the node mask must be between 24 and 32 for ipv4
-	// the below validations are run by kubeadm and we are bubbling those up here for better customer experience
-	if podMaskSize >= nodeCidrMaskSize {
-		return fmt.Errorf("the size of pod subnet with mask %d is smaller than or equal to the size of node subnet with mask %d", podMaskSize, nodeCidrMaskSize)
-	} else if (nodeCidrMaskSize - podMaskSize) > podSubnetNodeMaskMaxDiff {
-		// PodSubnetNodeMaskMaxDiff is limited to 16 due to an issue with uncompressed IP bitmap in core
-		// The node subnet mask size must be no more than the pod subnet mask size + 16
-		return fmt.Errorf("pod subnet mask (%d) and node-mask (%d) difference is greater than %d", podMaskSize, nodeCidrMaskSize, podSubnetNodeMaskMaxDiff)
+
+	if err := validateCiliumKubeProxyReplacement(clusterConfig); err != nil {
+		return err
 	}
 
 	return validateCNIPlugin(clusterNetwork)
@@ -838,13 +867,21 @@ func validateCiliumConfig(cilium *CiliumConfig) error {
 	}
 
 	if !cilium.IsManaged() {
-		if cilium.PolicyEnforcementMode != "" {
+		if cilium.PolicyEnforcementMode != "" || cilium.Hubble != nil || cilium.BGPControlPlane != nil || cilium.KubeProxyReplacement {
 			return errors.New("when using skipUpgrades for cilium all other fields must be empty")
 		}
 	}
 
-	if cilium.RoutingMode == "direct" && cilium.IPv4NativeRoutingCIDR == "" {
-		return errors.New("direct routing mode requires IPv4NativeRoutingCIDR to be set")
+	if cilium.RoutingMode == "direct" && cilium.IPv4NativeRoutingCIDR == "" && cilium.IPv6NativeRoutingCIDR == "" {
+		return errors.New("direct routing mode requires IPv4NativeRoutingCIDR or IPv6NativeRoutingCIDR to be set")
+	}
+
+	if cilium.Hubble != nil && cilium.Hubble.UI && !cilium.Hubble.Relay {
+		return errors.New("cilium hubble ui requires the hubble relay to be enabled")
+	}
+
+	if err := validateCiliumBGPControlPlane(cilium.BGPControlPlane); err != nil {
+		return err
 	}
 
 	if cilium.PolicyEnforcementMode == "" {
@@ -858,6 +895,58 @@ func validateCiliumConfig(cilium *CiliumConfig) error {
 	return nil
 }
 
+func validateCiliumBGPControlPlane(bgp *CiliumBGPControlPlaneConfig) error {
+	if bgp == nil {
+		return nil
+	}
+
+	if !validASN(bgp.LocalASN) {
+		return fmt.Errorf("cilium bgpControlPlane localASN %d is invalid, it must be between 1 and %d", bgp.LocalASN, maxASN)
+	}
+
+	if len(bgp.Peers) == 0 {
+		return errors.New("cilium bgpControlPlane requires at least one peer")
+	}
+
+	peers := make(map[netip.Addr]struct{}, len(bgp.Peers))
+	for _, peer := range bgp.Peers {
+		addr, err := netip.ParseAddr(peer.PeerAddress)
+		if err != nil {
+			return fmt.Errorf("cilium bgpControlPlane peerAddress %s is not a valid IP", peer.PeerAddress)
+		}
+		if _, ok := peers[addr]; ok {
+			return fmt.Errorf("cilium bgpControlPlane peerAddress %s is duplicated", peer.PeerAddress)
+		}
+		peers[addr] = struct{}{}
+
+		if !validASN(peer.PeerASN) {
+			return fmt.Errorf("cilium bgpControlPlane peerASN %d for peer %s is invalid, it must be between 1 and %d", peer.PeerASN, peer.PeerAddress, maxASN)
+		}
+	}
+
+	return nil
+}
+
+// validateCiliumKubeProxyReplacement checks the cluster has a control plane endpoint, Cilium
+// needs it to reach the API server without kube-proxy.
+func validateCiliumKubeProxyReplacement(clusterConfig *Cluster) error {
+	cniConfig := clusterConfig.Spec.ClusterNetwork.CNIConfig
+	if cniConfig == nil || cniConfig.Cilium == nil || !cniConfig.Cilium.KubeProxyReplacement {
+		return nil
+	}
+
+	endpoint := clusterConfig.Spec.ControlPlaneConfiguration.Endpoint
+	if endpoint == nil || endpoint.Host == "" {
+		return errors.New("cilium kubeProxyReplacement requires a control plane endpoint host")
+	}
+
+	return nil
+}
+
+func validASN(asn int64) bool {
+	return asn > 0 && asn <= maxASN
+}
+
 func validateProxyConfig(clusterConfig *Cluster) error {
 	if clusterConfig.Spec.ProxyConfiguration == nil {
 		return nil
@@ -929,6 +1018,47 @@ func validateMirrorConfig(clusterConfig *Cluster) error {
 		}
 	}
 
+	return validateMirrorEndpoints(clusterConfig.Spec.RegistryMirrorConfiguration)
+}
+
+// validateMirrorEndpoints checks the additional registry mirror endpoints are unique and only mirror
+// registries already mirrored by the primary endpoint, since they are used as its fallbacks.
+func validateMirrorEndpoints(config *RegistryMirrorConfiguration) error {
+	mirrored := map[string]bool{}
+	for _, ociNamespace := range config.OCINamespaces {
+		mirrored[ociNamespace.Registry] = true
+	}
+
+	hosts := map[string]bool{net.JoinHostPort(config.Endpoint, config.Port): true}
+	for i, endpoint := range config.Endpoints {
+		if endpoint.Endpoint == "" {
+			return fmt.Errorf("no value set for RegistryMirrorConfiguration.Endpoints[%d].Endpoint", i)
+		}
+
+		if !networkutils.IsPortValid(endpoint.Port) {
+			return fmt.Errorf("registry mirror endpoint %s port %s is invalid, please provide a valid port", endpoint.Endpoint, endpoint.Port)
+		}
+
+		host := net.JoinHostPort(endpoint.Endpoint, endpoint.Port)
+		if hosts[host] {
+			return fmt.Errorf("registry mirror endpoint %s is duplicated", host)
+		}
+		hosts[host] = true
+
+		if len(endpoint.OCINamespaces) > 0 && len(config.OCINamespaces) == 0 {
+			return fmt.Errorf("registry mirror endpoint %s can't set ociNamespaces when the primary endpoint doesn't", host)
+		}
+
+		for _, ociNamespace := range endpoint.OCINamespaces {
+			if ociNamespace.Registry == "" {
+				return fmt.Errorf("registry can't be set to empty in OCINamespaces of registry mirror endpoint %s", host)
+			}
+			if !mirrored[ociNamespace.Registry] {
+				return fmt.Errorf("registry %s of registry mirror endpoint %s is not mirrored by the primary endpoint", ociNamespace.Registry, host)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -976,6 +1106,204 @@ func validatePodIAMConfig(clusterConfig *Cluster) error {
 	return nil
 }
 
+func validateEtcdBackup(clusterConfig *Cluster) error {
+	backup := clusterConfig.Spec.EtcdBackup
+	if backup == nil {
+		return nil
+	}
+
+	if len(strings.Fields(backup.Schedule)) != 5 {
+		return fmt.Errorf("etcdBackup: schedule %q must be a cron expression with five fields", backup.Schedule)
+	}
+
+	if backup.Retention < 0 {
+		return errors.New("etcdBackup: retention cannot be a negative value")
+	}
+
+	destination := backup.Destination
+	configured := 0
+	if destination.PersistentVolumeClaim != nil {
+		configured++
+		if destination.PersistentVolumeClaim.ClaimName == "" {
+			return errors.New("etcdBackup: persistentVolumeClaim claimName can't be empty")
+		}
+	}
+	if destination.HostPath != nil {
+		configured++
+		if !strings.HasPrefix(destination.HostPath.Path, "/") {
+			return fmt.Errorf("etcdBackup: hostPath path %q must be an absolute path", destination.HostPath.Path)
+		}
+	}
+	if destination.S3 != nil {
+		configured++
+		if err := validateEtcdBackupS3Destination(destination.S3); err != nil {
+			return err
+		}
+	}
+	if configured != 1 {
+		return errors.New("etcdBackup: exactly one destination must be configured")
+	}
+
+	return nil
+}
+
+func validateEtcdBackupS3Destination(s3 *EtcdBackupS3Destination) error {
+	u, err := url.ParseRequestURI(s3.Endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("etcdBackup: s3 endpoint %q must be a valid http or https URL", s3.Endpoint)
+	}
+	if s3.Bucket == "" {
+		return errors.New("etcdBackup: s3 bucket can't be empty")
+	}
+	if s3.CredentialsSecretRef == "" {
+		return errors.New("etcdBackup: s3 credentialsSecretRef can't be empty")
+	}
+	return nil
+}
+
+func validateLoadBalancer(clusterConfig *Cluster) error {
+	lb := clusterConfig.Spec.LoadBalancer
+	if lb == nil {
+		return nil
+	}
+
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case VSphereDatacenterKind, TinkerbellDatacenterKind, NutanixDatacenterKind:
+	default:
+		return fmt.Errorf("loadBalancer: not supported for %s", clusterConfig.Spec.DatacenterRef.Kind)
+	}
+
+	switch lb.ModeOrDefault() {
+	case LoadBalancerModeL2:
+		if lb.BGP != nil {
+			return errors.New("loadBalancer: bgp can only be configured in BGP mode")
+		}
+	case LoadBalancerModeBGP:
+		if err := validateLoadBalancerBGP(lb.BGP); err != nil {
+			return err
+		}
+		if cilium := clusterConfig.Spec.ClusterNetwork.CNIConfig; cilium != nil && cilium.Cilium != nil &&
+			cilium.Cilium.BGPControlPlane != nil && cilium.Cilium.BGPControlPlane.AdvertiseServices {
+			return errors.New("loadBalancer: BGP mode can't be used with cilium bgpControlPlane advertiseServices")
+		}
+	default:
+		return fmt.Errorf("loadBalancer: mode %s is not supported, it must be %s or %s", lb.Mode, LoadBalancerModeL2, LoadBalancerModeBGP)
+	}
+
+	if len(lb.AddressPools) == 0 {
+		return errors.New("loadBalancer: at least one address pool must be configured")
+	}
+
+	reserved, err := loadBalancerReservedRanges(clusterConfig)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{}, len(lb.AddressPools))
+	var poolRanges []IPRange
+	for i := range lb.AddressPools {
+		pool := &lb.AddressPools[i]
+		if pool.Name == "" {
+			return fmt.Errorf("loadBalancer: addressPools[%d].name can't be empty", i)
+		}
+		if _, ok := names[pool.Name]; ok {
+			return fmt.Errorf("loadBalancer: addressPool name %s is duplicated", pool.Name)
+		}
+		names[pool.Name] = struct{}{}
+
+		if len(pool.Addresses) == 0 {
+			return fmt.Errorf("loadBalancer: addressPool %s addresses can't be empty", pool.Name)
+		}
+		ranges, err := pool.Ranges()
+		if err != nil {
+			return fmt.Errorf("loadBalancer: addressPool %s is invalid: %v", pool.Name, err)
+		}
+
+		for _, r := range ranges {
+			for _, used := range poolRanges {
+				if r.Overlaps(used) {
+					return fmt.Errorf("loadBalancer: addressPool %s address %s overlaps with another pool", pool.Name, r)
+				}
+			}
+			for _, res := range reserved {
+				if r.Overlaps(res.IPRange) {
+					return fmt.Errorf("loadBalancer: addressPool %s address %s overlaps with the %s", pool.Name, r, res.name)
+				}
+			}
+			poolRanges = append(poolRanges, r)
+		}
+	}
+
+	return nil
+}
+
+// reservedRange is a range of addresses the load balancer pools can't include, name describes
+// what they are used for.
+type reservedRange struct {
+	IPRange
+	name string
+}
+
+func loadBalancerReservedRanges(clusterConfig *Cluster) ([]reservedRange, error) {
+	var reserved []reservedRange
+
+	if endpoint := clusterConfig.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil {
+		host, _, err := GetControlPlaneHostPort(endpoint.Host, ControlEndpointDefaultPort)
+		if err != nil {
+			return nil, err
+		}
+		if ip, err := netip.ParseAddr(host); err == nil {
+			reserved = append(reserved, reservedRange{IPRange{First: ip, Last: ip}, "control plane endpoint " + host})
+		}
+	}
+
+	network := clusterConfig.Spec.ClusterNetwork
+	for _, cidr := range network.Pods.CidrBlocks {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil {
+			reserved = append(reserved, reservedRange{prefixRange(prefix), "pod CIDR " + cidr})
+		}
+	}
+	for _, cidr := range network.Services.CidrBlocks {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil {
+			reserved = append(reserved, reservedRange{prefixRange(prefix), "service CIDR " + cidr})
+		}
+	}
+
+	return reserved, nil
+}
+
+func validateLoadBalancerBGP(bgp *LoadBalancerBGPConfiguration) error {
+	if bgp == nil {
+		return errors.New("loadBalancer: bgp must be configured in BGP mode")
+	}
+
+	if !validASN(bgp.LocalASN) {
+		return fmt.Errorf("loadBalancer: bgp localASN %d is invalid, it must be between 1 and %d", bgp.LocalASN, maxASN)
+	}
+
+	if len(bgp.Peers) == 0 {
+		return errors.New("loadBalancer: bgp requires at least one peer")
+	}
+
+	peers := make(map[netip.Addr]struct{}, len(bgp.Peers))
+	for _, peer := range bgp.Peers {
+		addr, err := netip.ParseAddr(peer.Address)
+		if err != nil {
+			return fmt.Errorf("loadBalancer: bgp peer address %s is not a valid IP", peer.Address)
+		}
+		if _, ok := peers[addr]; ok {
+			return fmt.Errorf("loadBalancer: bgp peer address %s is duplicated", peer.Address)
+		}
+		peers[addr] = struct{}{}
+
+		if !validASN(peer.ASN) {
+			return fmt.Errorf("loadBalancer: bgp peer ASN %d for peer %s is invalid, it must be between 1 and %d", peer.ASN, peer.Address, maxASN)
+		}
+	}
+
+	return nil
+}
+
 func validateCPUpgradeRolloutStrategy(clusterConfig *Cluster) error {
 	cpUpgradeRolloutStrategy := clusterConfig.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy
 	if cpUpgradeRolloutStrategy == nil {
@@ -1067,6 +1395,40 @@ func validatePackageControllerConfiguration(clusterConfig *Cluster) error {
 	return nil
 }
 
+func validatePackageInstall(clusterConfig *Cluster) error {
+	if clusterConfig.Spec.Packages == nil || len(clusterConfig.Spec.Packages.Install) == 0 {
+		return nil
+	}
+
+	if !clusterConfig.IsManaged() {
+		return errors.New("packages: install is only supported for workload clusters")
+	}
+
+	if clusterConfig.Spec.Packages.Disable {
+		return errors.New("packages: install can't be specified when the package controller is disabled")
+	}
+
+	names := make(map[string]struct{}, len(clusterConfig.Spec.Packages.Install))
+	for _, p := range clusterConfig.Spec.Packages.Install {
+		if p.Name == "" {
+			return errors.New("packages: install name can't be empty")
+		}
+		if _, ok := names[p.Name]; ok {
+			return fmt.Errorf("packages: install name %s is duplicated", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		if p.PackageName == "" {
+			return fmt.Errorf("packages: install %s packageName can't be empty", p.Name)
+		}
+		if p.Config != "" && p.ConfigSecretRef != "" {
+			return fmt.Errorf("packages: install %s can't specify both config and configSecretRef", p.Name)
+		}
+	}
+
+	return nil
+}
+
 func validateEksaVersion(clusterConfig *Cluster) error {
 	if clusterConfig.Spec.BundlesRef != nil && clusterConfig.Spec.EksaVersion != nil {
 		return fmt.Errorf("cannot pass both bundlesRef and eksaVersion. New clusters should use eksaVersion instead of bundlesRef")
@@ -328,3 +328,25 @@ func TestSetClusterDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestSetRegistryMirrorConfigDefaultsEndpointsPort(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+				Endpoint: "1.2.3.4",
+				Endpoints: []RegistryMirrorEndpoint{
+					{Endpoint: "site.h"},
+					{Endpoint: "central.h", Port: "8443"},
+				},
+			},
+		},
+	}
+
+	g.Expect(setRegistryMirrorConfigDefaults(cluster)).To(Succeed())
+	g.Expect(cluster.Spec.RegistryMirrorConfiguration.Port).To(Equal("443"))
+	g.Expect(cluster.Spec.RegistryMirrorConfiguration.Endpoints).To(Equal([]RegistryMirrorEndpoint{
+		{Endpoint: "site.h", Port: "443"},
+		{Endpoint: "central.h", Port: "8443"},
+	}))
+}
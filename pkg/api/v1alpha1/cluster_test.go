@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/netip"
 	"os"
 	"reflect"
 	"strings"
@@ -3049,6 +3050,145 @@ func TestValidateNetworking(t *testing.T) {
 	}
 }
 
+func TestValidateNetworkingIPFamilies(t *testing.T) {
+	tests := []struct {
+		name             string
+		kind             string
+		pods, services   []string
+		endpoint         string
+		cilium           *CiliumConfig
+		nodes            *Nodes
+		wantErr          string
+		wantIPFamilies   []IPFamily
+		wantIsDualStack  bool
+		wantIPv6MaskSize int
+	}{
+		{
+			name:             "ipv6",
+			kind:             VSphereDatacenterKind,
+			pods:             []string{"fd00:100::/56"},
+			services:         []string{"fd00:200::/108"},
+			endpoint:         "fd00::100",
+			wantIPFamilies:   []IPFamily{IPv6Family},
+			wantIPv6MaskSize: 64,
+		},
+		{
+			name:             "dual-stack",
+			kind:             TinkerbellDatacenterKind,
+			pods:             []string{"192.168.0.0/16", "fd00:100::/56"},
+			services:         []string{"10.96.0.0/12", "fd00:200::/108"},
+			endpoint:         "10.0.0.100",
+			nodes:            &Nodes{CIDRMaskSizeIPv6: ptr.Int(72)},
+			wantIPFamilies:   []IPFamily{IPv4Family, IPv6Family},
+			wantIsDualStack:  true,
+			wantIPv6MaskSize: 72,
+		},
+		{
+			name:     "two ipv4 cidrs",
+			kind:     VSphereDatacenterKind,
+			pods:     []string{"192.168.0.0/16", "10.0.0.0/16"},
+			services: []string{"10.96.0.0/12"},
+			wantErr:  "dual-stack pods CIDR blocks must be one IPv4 and one IPv6 CIDR",
+		},
+		{
+			name:     "three cidrs",
+			kind:     VSphereDatacenterKind,
+			pods:     []string{"192.168.0.0/16", "fd00:100::/56", "10.0.0.0/16"},
+			services: []string{"10.96.0.0/12"},
+			wantErr:  "at most two CIDR blocks, one IPv4 and one IPv6, can be specified for pods",
+		},
+		{
+			name:     "pods and services families mismatch",
+			kind:     VSphereDatacenterKind,
+			pods:     []string{"192.168.0.0/16", "fd00:100::/56"},
+			services: []string{"10.96.0.0/12"},
+			wantErr:  "pods CIDR blocks IP families [IPv4 IPv6] don't match services CIDR blocks IP families [IPv4]",
+		},
+		{
+			name:     "unsupported provider",
+			kind:     DockerDatacenterKind,
+			pods:     []string{"fd00:100::/56"},
+			services: []string{"fd00:200::/108"},
+			wantErr:  "IPv6 and dual-stack cluster networking are only supported for vSphere, Tinkerbell and Snow",
+		},
+		{
+			name:     "ipv4 endpoint in ipv6 cluster",
+			kind:     VSphereDatacenterKind,
+			pods:     []string{"fd00:100::/56"},
+			services: []string{"fd00:200::/108"},
+			endpoint: "10.0.0.100",
+			wantErr:  "control plane endpoint 10.0.0.100 is an IPv4 address but the cluster network is IPv6 only",
+		},
+		{
+			name:     "ipv6 pods subnet too small for node mask",
+			kind:     VSphereDatacenterKind,
+			pods:     []string{"fd00:100::/64"},
+			services: []string{"fd00:200::/108"},
+			wantErr:  "the size of pod subnet with mask 64 is smaller than or equal to the size of node subnet with mask 64",
+		},
+		{
+			name:     "direct routing without ipv6 native routing cidr",
+			kind:     VSphereDatacenterKind,
+			pods:     []string{"192.168.0.0/16", "fd00:100::/56"},
+			services: []string{"10.96.0.0/12", "fd00:200::/108"},
+			cilium:   &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv4NativeRoutingCIDR: "10.0.0.0/16"},
+			wantErr:  "direct routing mode requires IPv6NativeRoutingCIDR to be set for IPv6 cluster networks",
+		},
+		{
+			name:     "ipv6 native routing cidr with ipv4 cidr",
+			kind:     VSphereDatacenterKind,
+			pods:     []string{"fd00:100::/56"},
+			services: []string{"fd00:200::/108"},
+			cilium:   &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv6NativeRoutingCIDR: "10.0.0.0/16"},
+			wantErr:  "cilium IPv6NativeRoutingCIDR 10.0.0.0/16 is not a valid IPv6 CIDR",
+		},
+		{
+			name:           "ipv6 direct routing",
+			kind:           VSphereDatacenterKind,
+			pods:           []string{"fd00:100::/56"},
+			services:       []string{"fd00:200::/108"},
+			cilium:         &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv6NativeRoutingCIDR: "fd00::/48"},
+			wantIPFamilies: []IPFamily{IPv6Family},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cilium := tt.cilium
+			if cilium == nil {
+				cilium = &CiliumConfig{}
+			}
+			cluster := &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{Kind: tt.kind},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{Host: tt.endpoint},
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods:      Pods{CidrBlocks: tt.pods},
+						Services:  Services{CidrBlocks: tt.services},
+						CNIConfig: &CNIConfig{Cilium: cilium},
+						Nodes:     tt.nodes,
+					},
+				},
+			}
+
+			err := validateNetworking(cluster)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			network := cluster.Spec.ClusterNetwork
+			g.Expect(network.IPFamilies()).To(Equal(tt.wantIPFamilies))
+			g.Expect(network.IsDualStack()).To(Equal(tt.wantIsDualStack))
+			if tt.wantIPv6MaskSize != 0 {
+				g.Expect(network.NodeCIDRMaskSize(IPv6Family)).To(Equal(tt.wantIPv6MaskSize))
+			}
+		})
+	}
+}
+
 func TestValidateCNIConfig(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -3074,7 +3214,7 @@ func TestValidateCNIConfig(t *testing.T) {
 		},
 		{
 			name:    "directmode needs native routing CIDR",
-			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR to be set"),
+			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR or IPv6NativeRoutingCIDR to be set"),
 			clusterNetwork: &ClusterNetwork{
 				CNIConfig: &CNIConfig{
 					Cilium: &CiliumConfig{
@@ -3163,6 +3303,123 @@ func TestValidateCNIConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "CiliumSkipUpgradeWithHubble",
+			wantErr: fmt.Errorf("validating cniConfig: when using skipUpgrades for cilium all " +
+				"other fields must be empty"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						SkipUpgrade: ptr.Bool(true),
+						Hubble:      &CiliumHubbleConfig{},
+					},
+				},
+			},
+		},
+		{
+			name: "CiliumHubbleUIWithoutRelay",
+			wantErr: fmt.Errorf("validating cniConfig: cilium hubble ui requires the hubble relay " +
+				"to be enabled"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble: &CiliumHubbleConfig{UI: true},
+					},
+				},
+			},
+		},
+		{
+			name: "CiliumHubbleRelayAndUI",
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble: &CiliumHubbleConfig{Relay: true, UI: true},
+					},
+				},
+			},
+		},
+		{
+			name: "CiliumBGPControlPlane",
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						BGPControlPlane: &CiliumBGPControlPlaneConfig{
+							LocalASN: 65000,
+							Peers:    []CiliumBGPPeer{{PeerAddress: "10.0.0.1", PeerASN: 65001}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "CiliumBGPControlPlaneInvalidLocalASN",
+			wantErr: fmt.Errorf("validating cniConfig: cilium bgpControlPlane localASN 0 is invalid, it must be between 1 and 4294967295"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						BGPControlPlane: &CiliumBGPControlPlaneConfig{
+							Peers: []CiliumBGPPeer{{PeerAddress: "10.0.0.1", PeerASN: 65001}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "CiliumBGPControlPlaneNoPeers",
+			wantErr: fmt.Errorf("validating cniConfig: cilium bgpControlPlane requires at least one peer"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						BGPControlPlane: &CiliumBGPControlPlaneConfig{LocalASN: 65000},
+					},
+				},
+			},
+		},
+		{
+			name:    "CiliumBGPControlPlaneInvalidPeerAddress",
+			wantErr: fmt.Errorf("validating cniConfig: cilium bgpControlPlane peerAddress router is not a valid IP"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						BGPControlPlane: &CiliumBGPControlPlaneConfig{
+							LocalASN: 65000,
+							Peers:    []CiliumBGPPeer{{PeerAddress: "router", PeerASN: 65001}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "CiliumBGPControlPlaneDuplicatedPeer",
+			wantErr: fmt.Errorf("validating cniConfig: cilium bgpControlPlane peerAddress 10.0.0.1 is duplicated"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						BGPControlPlane: &CiliumBGPControlPlaneConfig{
+							LocalASN: 65000,
+							Peers: []CiliumBGPPeer{
+								{PeerAddress: "10.0.0.1", PeerASN: 65001},
+								{PeerAddress: "10.0.0.1", PeerASN: 65002},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "CiliumBGPControlPlaneInvalidPeerASN",
+			wantErr: fmt.Errorf("validating cniConfig: cilium bgpControlPlane peerASN 4294967296 for peer 10.0.0.1 is invalid, it must be between 1 and 4294967295"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						BGPControlPlane: &CiliumBGPControlPlaneConfig{
+							LocalASN: 65000,
+							Peers:    []CiliumBGPPeer{{PeerAddress: "10.0.0.1", PeerASN: 4294967296}},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "CiliumSkipUpgradeExplicitFalseWithOtherFields",
 			clusterNetwork: &ClusterNetwork{
@@ -3295,6 +3552,103 @@ func TestValidateMirrorConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "valid endpoints",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						OCINamespaces: []OCINamespace{
+							{Registry: "public.ecr.aws", Namespace: "eks-anywhere"},
+						},
+						Endpoints: []RegistryMirrorEndpoint{
+							{Endpoint: "site.h", Port: "443", CredentialsSecretRef: "harbor-site"},
+							{Endpoint: "central.h", Port: "443", OCINamespaces: []OCINamespace{{Registry: "public.ecr.aws", Namespace: "mirror"}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "endpoint not specified in endpoints",
+			wantErr: "no value set for RegistryMirrorConfiguration.Endpoints[0].Endpoint",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						Endpoints: []RegistryMirrorEndpoint{
+							{Port: "443"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid port in endpoints",
+			wantErr: "registry mirror endpoint central.h port 65536 is invalid",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						Endpoints: []RegistryMirrorEndpoint{
+							{Endpoint: "central.h", Port: "65536"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "duplicated endpoint",
+			wantErr: "registry mirror endpoint 1.2.3.4:443 is duplicated",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						Endpoints: []RegistryMirrorEndpoint{
+							{Endpoint: "1.2.3.4", Port: "443"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "endpoint namespaces without primary namespaces",
+			wantErr: "can't set ociNamespaces when the primary endpoint doesn't",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						Endpoints: []RegistryMirrorEndpoint{
+							{Endpoint: "central.h", Port: "443", OCINamespaces: []OCINamespace{{Registry: "public.ecr.aws", Namespace: "mirror"}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "endpoint registry not mirrored by primary",
+			wantErr: "registry docker.io of registry mirror endpoint central.h:443 is not mirrored by the primary endpoint",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						OCINamespaces: []OCINamespace{
+							{Registry: "public.ecr.aws", Namespace: "eks-anywhere"},
+						},
+						Endpoints: []RegistryMirrorEndpoint{
+							{Endpoint: "central.h", Port: "443", OCINamespaces: []OCINamespace{{Registry: "docker.io", Namespace: "mirror"}}},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4213,3 +4567,364 @@ func TestClusterCPUpgradeInPlace(t *testing.T) {
 	g.Expect(err).To(BeNil())
 	g.Expect(string(clusterSpec)).To(BeEquivalentTo(string(expected)))
 }
+
+func TestValidateEtcdBackup(t *testing.T) {
+	tests := []struct {
+		name    string
+		backup  *EtcdBackup
+		wantErr string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "valid host path",
+			backup: &EtcdBackup{
+				Schedule:    "0 */6 * * *",
+				Destination: EtcdBackupDestination{HostPath: &EtcdBackupHostPathDestination{Path: "/var/lib/etcd-backups"}},
+			},
+		},
+		{
+			name: "valid pvc",
+			backup: &EtcdBackup{
+				Schedule:    "0 0 * * *",
+				Retention:   3,
+				Destination: EtcdBackupDestination{PersistentVolumeClaim: &EtcdBackupPVCDestination{ClaimName: "etcd-backups"}},
+			},
+		},
+		{
+			name: "valid s3",
+			backup: &EtcdBackup{
+				Schedule: "0 0 * * *",
+				Destination: EtcdBackupDestination{S3: &EtcdBackupS3Destination{
+					Endpoint:             "https://s3.us-west-2.amazonaws.com",
+					Bucket:               "backups",
+					CredentialsSecretRef: "s3-credentials",
+				}},
+			},
+		},
+		{
+			name: "invalid schedule",
+			backup: &EtcdBackup{
+				Schedule:    "@daily",
+				Destination: EtcdBackupDestination{HostPath: &EtcdBackupHostPathDestination{Path: "/backups"}},
+			},
+			wantErr: `etcdBackup: schedule "@daily" must be a cron expression with five fields`,
+		},
+		{
+			name: "negative retention",
+			backup: &EtcdBackup{
+				Schedule:    "0 0 * * *",
+				Retention:   -1,
+				Destination: EtcdBackupDestination{HostPath: &EtcdBackupHostPathDestination{Path: "/backups"}},
+			},
+			wantErr: "etcdBackup: retention cannot be a negative value",
+		},
+		{
+			name: "no destination",
+			backup: &EtcdBackup{
+				Schedule: "0 0 * * *",
+			},
+			wantErr: "etcdBackup: exactly one destination must be configured",
+		},
+		{
+			name: "multiple destinations",
+			backup: &EtcdBackup{
+				Schedule: "0 0 * * *",
+				Destination: EtcdBackupDestination{
+					HostPath:              &EtcdBackupHostPathDestination{Path: "/backups"},
+					PersistentVolumeClaim: &EtcdBackupPVCDestination{ClaimName: "etcd-backups"},
+				},
+			},
+			wantErr: "etcdBackup: exactly one destination must be configured",
+		},
+		{
+			name: "relative host path",
+			backup: &EtcdBackup{
+				Schedule:    "0 0 * * *",
+				Destination: EtcdBackupDestination{HostPath: &EtcdBackupHostPathDestination{Path: "backups"}},
+			},
+			wantErr: `etcdBackup: hostPath path "backups" must be an absolute path`,
+		},
+		{
+			name: "empty claim name",
+			backup: &EtcdBackup{
+				Schedule:    "0 0 * * *",
+				Destination: EtcdBackupDestination{PersistentVolumeClaim: &EtcdBackupPVCDestination{}},
+			},
+			wantErr: "etcdBackup: persistentVolumeClaim claimName can't be empty",
+		},
+		{
+			name: "invalid s3 endpoint",
+			backup: &EtcdBackup{
+				Schedule: "0 0 * * *",
+				Destination: EtcdBackupDestination{S3: &EtcdBackupS3Destination{
+					Endpoint:             "s3.amazonaws.com",
+					Bucket:               "backups",
+					CredentialsSecretRef: "s3-credentials",
+				}},
+			},
+			wantErr: `etcdBackup: s3 endpoint "s3.amazonaws.com" must be a valid http or https URL`,
+		},
+		{
+			name: "s3 without credentials",
+			backup: &EtcdBackup{
+				Schedule: "0 0 * * *",
+				Destination: EtcdBackupDestination{S3: &EtcdBackupS3Destination{
+					Endpoint: "https://minio.local:9000",
+					Bucket:   "backups",
+				}},
+			},
+			wantErr: "etcdBackup: s3 credentialsSecretRef can't be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateEtcdBackup(&Cluster{Spec: ClusterSpec{EtcdBackup: tt.backup}})
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateCiliumKubeProxyReplacement(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			ClusterNetwork: ClusterNetwork{
+				CNIConfig: &CNIConfig{Cilium: &CiliumConfig{KubeProxyReplacement: true}},
+			},
+		},
+	}
+	g.Expect(validateCiliumKubeProxyReplacement(cluster)).To(MatchError("cilium kubeProxyReplacement requires a control plane endpoint host"))
+
+	cluster.Spec.ControlPlaneConfiguration.Endpoint = &Endpoint{Host: "1.2.3.4"}
+	g.Expect(validateCiliumKubeProxyReplacement(cluster)).To(Succeed())
+}
+
+func TestValidateLoadBalancer(t *testing.T) {
+	pools := []LoadBalancerAddressPool{
+		{Name: "default", Addresses: []string{"10.0.0.100-10.0.0.120", "10.0.1.0/28"}},
+		{Name: "team-a", Addresses: []string{"10.0.2.5"}, Namespace: "team-a"},
+	}
+	bgp := &LoadBalancerBGPConfiguration{
+		LocalASN: 64512,
+		Peers:    []LoadBalancerBGPPeer{{Address: "10.0.0.1", ASN: 64513}},
+	}
+	tests := []struct {
+		name         string
+		lb           *LoadBalancerConfiguration
+		datacenter   string
+		ciliumConfig *CiliumConfig
+		wantErr      string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "valid L2",
+			lb:   &LoadBalancerConfiguration{AddressPools: pools},
+		},
+		{
+			name: "valid BGP",
+			lb:   &LoadBalancerConfiguration{Mode: LoadBalancerModeBGP, AddressPools: pools, BGP: bgp},
+		},
+		{
+			name: "valid IPv6 pool",
+			lb:   &LoadBalancerConfiguration{AddressPools: []LoadBalancerAddressPool{{Name: "v6", Addresses: []string{"fd00:10::/120"}}}},
+		},
+		{
+			name:       "unsupported provider",
+			lb:         &LoadBalancerConfiguration{AddressPools: pools},
+			datacenter: DockerDatacenterKind,
+			wantErr:    "loadBalancer: not supported for DockerDatacenterConfig",
+		},
+		{
+			name:    "invalid mode",
+			lb:      &LoadBalancerConfiguration{Mode: "L3", AddressPools: pools},
+			wantErr: "loadBalancer: mode L3 is not supported, it must be L2 or BGP",
+		},
+		{
+			name:    "bgp in L2 mode",
+			lb:      &LoadBalancerConfiguration{AddressPools: pools, BGP: bgp},
+			wantErr: "loadBalancer: bgp can only be configured in BGP mode",
+		},
+		{
+			name:    "BGP mode without bgp",
+			lb:      &LoadBalancerConfiguration{Mode: LoadBalancerModeBGP, AddressPools: pools},
+			wantErr: "loadBalancer: bgp must be configured in BGP mode",
+		},
+		{
+			name: "BGP mode with invalid peer",
+			lb: &LoadBalancerConfiguration{Mode: LoadBalancerModeBGP, AddressPools: pools, BGP: &LoadBalancerBGPConfiguration{
+				LocalASN: 64512,
+				Peers:    []LoadBalancerBGPPeer{{Address: "router", ASN: 64513}},
+			}},
+			wantErr: "loadBalancer: bgp peer address router is not a valid IP",
+		},
+		{
+			name:         "BGP mode with cilium advertising services",
+			lb:           &LoadBalancerConfiguration{Mode: LoadBalancerModeBGP, AddressPools: pools, BGP: bgp},
+			ciliumConfig: &CiliumConfig{BGPControlPlane: &CiliumBGPControlPlaneConfig{AdvertiseServices: true}},
+			wantErr:      "loadBalancer: BGP mode can't be used with cilium bgpControlPlane advertiseServices",
+		},
+		{
+			name:    "no pools",
+			lb:      &LoadBalancerConfiguration{},
+			wantErr: "loadBalancer: at least one address pool must be configured",
+		},
+		{
+			name:    "duplicated pool name",
+			lb:      &LoadBalancerConfiguration{AddressPools: []LoadBalancerAddressPool{pools[0], {Name: "default", Addresses: []string{"10.0.3.1"}}}},
+			wantErr: "loadBalancer: addressPool name default is duplicated",
+		},
+		{
+			name:    "invalid address",
+			lb:      &LoadBalancerConfiguration{AddressPools: []LoadBalancerAddressPool{{Name: "default", Addresses: []string{"10.0.0.20-10.0.0.10"}}}},
+			wantErr: "loadBalancer: addressPool default is invalid: address range 10.0.0.20-10.0.0.10 is invalid: start should be smaller than end",
+		},
+		{
+			name:    "overlapping pools",
+			lb:      &LoadBalancerConfiguration{AddressPools: []LoadBalancerAddressPool{pools[0], {Name: "other", Addresses: []string{"10.0.1.8-10.0.1.20"}}}},
+			wantErr: "loadBalancer: addressPool other address 10.0.1.8-10.0.1.20 overlaps with another pool",
+		},
+		{
+			name:    "pool contains control plane endpoint",
+			lb:      &LoadBalancerConfiguration{AddressPools: []LoadBalancerAddressPool{{Name: "default", Addresses: []string{"10.0.0.0/24"}}}},
+			wantErr: "loadBalancer: addressPool default address 10.0.0.0-10.0.0.255 overlaps with the control plane endpoint 10.0.0.10",
+		},
+		{
+			name:    "pool overlaps pod CIDR",
+			lb:      &LoadBalancerConfiguration{AddressPools: []LoadBalancerAddressPool{{Name: "default", Addresses: []string{"192.168.10.1-192.168.10.5"}}}},
+			wantErr: "loadBalancer: addressPool default address 192.168.10.1-192.168.10.5 overlaps with the pod CIDR 192.168.0.0/16",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			datacenter := tt.datacenter
+			if datacenter == "" {
+				datacenter = VSphereDatacenterKind
+			}
+			cluster := &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{Kind: datacenter},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{Host: "10.0.0.10"},
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods:      Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+						Services:  Services{CidrBlocks: []string{"10.96.0.0/12"}},
+						CNIConfig: &CNIConfig{Cilium: tt.ciliumConfig},
+					},
+					LoadBalancer: tt.lb,
+				},
+			}
+			err := validateLoadBalancer(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestLoadBalancerAddressPoolRanges(t *testing.T) {
+	g := NewWithT(t)
+	pool := &LoadBalancerAddressPool{Addresses: []string{"10.0.0.5", "10.0.1.0/30", "10.0.2.1-10.0.2.9", "fd00::/126"}}
+
+	ranges, err := pool.Ranges()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ranges).To(HaveLen(4))
+	g.Expect(ranges[0].String()).To(Equal("10.0.0.5-10.0.0.5"))
+	g.Expect(ranges[1].String()).To(Equal("10.0.1.0-10.0.1.3"))
+	g.Expect(ranges[2].String()).To(Equal("10.0.2.1-10.0.2.9"))
+	g.Expect(ranges[3].String()).To(Equal("fd00::-fd00::3"))
+	g.Expect(ranges[1].Contains(netip.MustParseAddr("10.0.1.2"))).To(BeTrue())
+	g.Expect(ranges[0].Overlaps(ranges[3])).To(BeFalse())
+}
+
+func TestValidatePackageInstall(t *testing.T) {
+	harbor := PackageInstall{Name: "my-harbor", PackageName: "harbor", Version: "2.7.1", TargetNamespace: "harbor"}
+	tests := []struct {
+		name              string
+		managementCluster string
+		packages          *PackageConfiguration
+		wantErr           string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:              "valid workload cluster",
+			managementCluster: "mgmt",
+			packages: &PackageConfiguration{Install: []PackageInstall{
+				harbor,
+				{Name: "my-prometheus", PackageName: "prometheus", ConfigSecretRef: "prometheus-config"},
+			}},
+		},
+		{
+			name:     "self-managed cluster",
+			packages: &PackageConfiguration{Install: []PackageInstall{harbor}},
+			wantErr:  "packages: install is only supported for workload clusters",
+		},
+		{
+			name:              "package controller disabled",
+			managementCluster: "mgmt",
+			packages:          &PackageConfiguration{Disable: true, Install: []PackageInstall{harbor}},
+			wantErr:           "packages: install can't be specified when the package controller is disabled",
+		},
+		{
+			name:              "empty name",
+			managementCluster: "mgmt",
+			packages:          &PackageConfiguration{Install: []PackageInstall{{PackageName: "harbor"}}},
+			wantErr:           "packages: install name can't be empty",
+		},
+		{
+			name:              "duplicated name",
+			managementCluster: "mgmt",
+			packages:          &PackageConfiguration{Install: []PackageInstall{harbor, harbor}},
+			wantErr:           "packages: install name my-harbor is duplicated",
+		},
+		{
+			name:              "empty package name",
+			managementCluster: "mgmt",
+			packages:          &PackageConfiguration{Install: []PackageInstall{{Name: "my-harbor"}}},
+			wantErr:           "packages: install my-harbor packageName can't be empty",
+		},
+		{
+			name:              "config and config secret",
+			managementCluster: "mgmt",
+			packages: &PackageConfiguration{Install: []PackageInstall{
+				{Name: "my-harbor", PackageName: "harbor", Config: "secretKey: abc", ConfigSecretRef: "harbor-config"},
+			}},
+			wantErr: "packages: install my-harbor can't specify both config and configSecretRef",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				Spec: ClusterSpec{
+					ManagementCluster: ManagementCluster{Name: tt.managementCluster},
+					Packages:          tt.packages,
+				},
+			}
+			if tt.managementCluster == "" {
+				cluster.SetSelfManaged()
+			}
+			err := validatePackageInstall(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
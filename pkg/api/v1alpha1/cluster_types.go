@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -92,6 +93,12 @@ type ClusterSpec struct {
 	MachineHealthCheck *MachineHealthCheck `json:"machineHealthCheck,omitempty"`
 	EtcdEncryption     *[]EtcdEncryption   `json:"etcdEncryption,omitempty"`
 	LicenseToken       string              `json:"licenseToken,omitempty"`
+	// EtcdBackup configures scheduled etcd snapshots of the cluster.
+	// +optional
+	EtcdBackup *EtcdBackup `json:"etcdBackup,omitempty"`
+	// LoadBalancer configures an in-cluster load balancer for Services of type LoadBalancer.
+	// +optional
+	LoadBalancer *LoadBalancerConfiguration `json:"loadBalancer,omitempty"`
 }
 
 // EksaVersion is the semver identifying the release of eks-a used to populate the cluster components.
@@ -190,6 +197,12 @@ func (n *Cluster) Equal(o *Cluster) bool {
 	if !reflect.DeepEqual(n.Spec.EtcdEncryption, o.Spec.EtcdEncryption) {
 		return false
 	}
+	if !reflect.DeepEqual(n.Spec.EtcdBackup, o.Spec.EtcdBackup) {
+		return false
+	}
+	if !reflect.DeepEqual(n.Spec.LoadBalancer, o.Spec.LoadBalancer) {
+		return false
+	}
 	if n.Spec.LicenseToken != o.Spec.LicenseToken {
 		return false
 	}
@@ -246,6 +259,51 @@ type RegistryMirrorConfiguration struct {
 	// InsecureSkipVerify skips the registry certificate verification.
 	// Only use this solution for isolated testing or in a tightly controlled, air-gapped environment.
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// Endpoints defines additional registry mirror endpoints, tried in order after Endpoint
+	// for the registries it mirrors.
+	// +optional
+	Endpoints []RegistryMirrorEndpoint `json:"endpoints,omitempty"`
+
+	// FallbackToUpstream defines if images should be pulled from the upstream registry
+	// when none of the registry mirror endpoints can serve them.
+	// +optional
+	FallbackToUpstream bool `json:"fallbackToUpstream,omitempty"`
+}
+
+// RegistryMirrorEndpoint defines an additional registry mirror endpoint.
+type RegistryMirrorEndpoint struct {
+	// Endpoint defines the registry mirror endpoint to use for pulling images
+	Endpoint string `json:"endpoint"`
+
+	// Port defines the port exposed for registry mirror endpoint
+	Port string `json:"port,omitempty"`
+
+	// OCINamespaces defines the mapping from an upstream registry to a namespace in this endpoint.
+	// Defaults to the OCINamespaces of the primary registry mirror endpoint.
+	// +optional
+	OCINamespaces []OCINamespace `json:"ociNamespaces,omitempty"`
+
+	// CACertContent defines the contents of the registry mirror endpoint CA certificate
+	// +optional
+	CACertContent string `json:"caCertContent,omitempty"`
+
+	// CredentialsSecretRef is the name of a secret in the eksa-system namespace of the management cluster
+	// with the username and password keys used to authenticate to the endpoint.
+	// +optional
+	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
+
+	// InsecureSkipVerify skips the registry certificate verification.
+	// Only use this solution for isolated testing or in a tightly controlled, air-gapped environment.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// Equal checks if two RegistryMirrorEndpoints are equal.
+func (n RegistryMirrorEndpoint) Equal(o RegistryMirrorEndpoint) bool {
+	return n.Endpoint == o.Endpoint && n.Port == o.Port && n.CACertContent == o.CACertContent &&
+		n.CredentialsSecretRef == o.CredentialsSecretRef && n.InsecureSkipVerify == o.InsecureSkipVerify &&
+		OCINamespacesSliceEqual(n.OCINamespaces, o.OCINamespaces)
 }
 
 // OCINamespace represents an entity in a local reigstry to group related images.
@@ -265,7 +323,22 @@ func (n *RegistryMirrorConfiguration) Equal(o *RegistryMirrorConfiguration) bool
 	}
 	return n.Endpoint == o.Endpoint && n.Port == o.Port && n.CACertContent == o.CACertContent &&
 		n.InsecureSkipVerify == o.InsecureSkipVerify && n.Authenticate == o.Authenticate &&
-		OCINamespacesSliceEqual(n.OCINamespaces, o.OCINamespaces)
+		OCINamespacesSliceEqual(n.OCINamespaces, o.OCINamespaces) &&
+		n.FallbackToUpstream == o.FallbackToUpstream && registryMirrorEndpointsEqual(n.Endpoints, o.Endpoints)
+}
+
+// registryMirrorEndpointsEqual checks two lists of registry mirror endpoints are equal, order included,
+// since it defines the order in which the endpoints are tried.
+func registryMirrorEndpointsEqual(a, b []RegistryMirrorEndpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 // OCINamespacesSliceEqual is used to check equality of the OCINamespaces fields of two RegistryMirrorConfiguration.
@@ -653,6 +726,14 @@ func (n *CiliumConfig) Equal(o *CiliumConfig) bool {
 		return false
 	}
 
+	if !n.Hubble.Equal(o.Hubble) || !n.BGPControlPlane.Equal(o.BGPControlPlane) {
+		return false
+	}
+
+	if n.KubeProxyReplacement != o.KubeProxyReplacement {
+		return false
+	}
+
 	oSkipUpgradeIsFalse := o.SkipUpgrade == nil || !*o.SkipUpgrade
 	nSkipUpgradeIsFalse := n.SkipUpgrade == nil || !*n.SkipUpgrade
 
@@ -666,6 +747,31 @@ func (n *CiliumConfig) Equal(o *CiliumConfig) bool {
 	return true
 }
 
+// Equal checks if two CiliumHubbleConfigs are equal.
+func (n *CiliumHubbleConfig) Equal(o *CiliumHubbleConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return *n == *o
+}
+
+// Equal checks if two CiliumBGPControlPlaneConfigs are equal.
+func (n *CiliumBGPControlPlaneConfig) Equal(o *CiliumBGPControlPlaneConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.LocalASN == o.LocalASN &&
+		n.AdvertisePodCIDRs == o.AdvertisePodCIDRs &&
+		n.AdvertiseServices == o.AdvertiseServices &&
+		slices.Equal(n.Peers, o.Peers)
+}
+
 func (n *KindnetdConfig) Equal(o *KindnetdConfig) bool {
 	if n == o {
 		return true
@@ -795,6 +901,8 @@ type ResolvConf struct {
 type Nodes struct {
 	// CIDRMaskSize defines the mask size for node cidr in the cluster, default for ipv4 is 24. This is an optional field
 	CIDRMaskSize *int `json:"cidrMaskSize,omitempty"`
+	// CIDRMaskSizeIPv6 defines the mask size for IPv6 node cidrs in IPv6 and dual-stack clusters, default is 64. This is an optional field
+	CIDRMaskSizeIPv6 *int `json:"cidrMaskSizeIPv6,omitempty"`
 }
 
 // Equal compares two Nodes definitions and return true if the are equivalent.
@@ -806,14 +914,7 @@ func (n *Nodes) Equal(o *Nodes) bool {
 		return false
 	}
 
-	if n.CIDRMaskSize == o.CIDRMaskSize {
-		return true
-	}
-	if n.CIDRMaskSize == nil || o.CIDRMaskSize == nil {
-		return false
-	}
-
-	return *n.CIDRMaskSize == *o.CIDRMaskSize
+	return intPtrEqual(n.CIDRMaskSize, o.CIDRMaskSize) && intPtrEqual(n.CIDRMaskSizeIPv6, o.CIDRMaskSizeIPv6)
 }
 
 func (n *ResolvConf) Equal(o *ResolvConf) bool {
@@ -904,6 +1005,59 @@ type CiliumConfig struct {
 	// If this is not set autoDirectNodeRoutes will be set to true
 	// +optional
 	IPv6NativeRoutingCIDR string `json:"ipv6NativeRoutingCIDR,omitempty"`
+
+	// Hubble enables Hubble network observability on the Cilium agents. The Hubble relay and
+	// UI are optional.
+	// +optional
+	Hubble *CiliumHubbleConfig `json:"hubble,omitempty"`
+
+	// BGPControlPlane enables the Cilium BGP control plane, which peers the nodes with the
+	// given routers to advertise pod and service addresses.
+	// +optional
+	BGPControlPlane *CiliumBGPControlPlaneConfig `json:"bgpControlPlane,omitempty"`
+
+	// KubeProxyReplacement makes Cilium handle Kubernetes services in place of kube-proxy,
+	// which is removed from the cluster. It can't be turned off once enabled.
+	// +optional
+	KubeProxyReplacement bool `json:"kubeProxyReplacement,omitempty"`
+}
+
+// CiliumHubbleConfig contains the Hubble configuration for Cilium.
+type CiliumHubbleConfig struct {
+	// Relay deploys the Hubble relay, which aggregates the flows of all the nodes.
+	// +optional
+	Relay bool `json:"relay,omitempty"`
+
+	// UI deploys the Hubble UI. It requires the relay.
+	// +optional
+	UI bool `json:"ui,omitempty"`
+}
+
+// CiliumBGPControlPlaneConfig contains the BGP control plane configuration for Cilium.
+// All the nodes peer with every one of the Peers.
+type CiliumBGPControlPlaneConfig struct {
+	// LocalASN is the autonomous system number of the cluster nodes.
+	LocalASN int64 `json:"localASN"`
+
+	// Peers are the BGP routers the nodes peer with.
+	Peers []CiliumBGPPeer `json:"peers"`
+
+	// AdvertisePodCIDRs advertises the pod CIDR of each node to the peers.
+	// +optional
+	AdvertisePodCIDRs bool `json:"advertisePodCIDRs,omitempty"`
+
+	// AdvertiseServices advertises the addresses of LoadBalancer services to the peers.
+	// +optional
+	AdvertiseServices bool `json:"advertiseServices,omitempty"`
+}
+
+// CiliumBGPPeer is a BGP router the cluster nodes peer with.
+type CiliumBGPPeer struct {
+	// PeerAddress is the IP address of the router.
+	PeerAddress string `json:"peerAddress"`
+
+	// PeerASN is the autonomous system number of the router.
+	PeerASN int64 `json:"peerASN"`
 }
 
 // IsManaged returns true if SkipUpgrade is nil or false indicating EKS-A is responsible for
@@ -1030,6 +1184,61 @@ type ClusterStatus struct {
 
 	// ObservedGeneration is the latest generation observed by the controller.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// CertificateExpirations reports when the certificates of the control plane
+	// and external etcd machines expire.
+	// +optional
+	CertificateExpirations []MachineCertificateExpiry `json:"certificateExpirations,omitempty"`
+
+	// CertificateSecretExpirations reports when the certificates stored in the control plane
+	// and external etcd certificate secrets expire.
+	// +optional
+	CertificateSecretExpirations []SecretCertificateExpiry `json:"certificateSecretExpirations,omitempty"`
+
+	// EtcdBackup reports the outcome of the scheduled etcd snapshots when EtcdBackup is configured.
+	// +optional
+	EtcdBackup *EtcdBackupStatus `json:"etcdBackup,omitempty"`
+
+	// Packages reports the state of the curated packages in the packages install list.
+	// +optional
+	Packages []PackageInstallStatus `json:"packages,omitempty"`
+}
+
+// PackageInstallStatus reports the state of a curated package installed from the cluster spec.
+type PackageInstallStatus struct {
+	// Name is the name of the Package object.
+	Name string `json:"name"`
+	// PackageName is the name of the package as specified in the package bundle.
+	PackageName string `json:"packageName"`
+	// CurrentVersion is the version of the package currently installed.
+	// +optional
+	CurrentVersion string `json:"currentVersion,omitempty"`
+	// State is the state of the package installation reported by the package controller.
+	// +optional
+	State string `json:"state,omitempty"`
+	// Detail gives more information about the state of the package installation.
+	// +optional
+	Detail string `json:"detail,omitempty"`
+}
+
+// MachineCertificateExpiry reports when the certificates of a cluster machine expire.
+type MachineCertificateExpiry struct {
+	// Machine is the name of the CAPI Machine.
+	Machine string `json:"machine"`
+	// Role is the role of the machine in the cluster, either control-plane or etcd.
+	Role string `json:"role"`
+	// ExpiresAt is the time at which the machine certificates expire.
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// SecretCertificateExpiry reports when the certificate stored in a cluster certificate secret expires.
+type SecretCertificateExpiry struct {
+	// Secret is the name of the Secret storing the certificate.
+	Secret string `json:"secret"`
+	// Role is the component the certificate is used by, either control-plane or etcd.
+	Role string `json:"role"`
+	// ExpiresAt is the time at which the certificate expires.
+	ExpiresAt metav1.Time `json:"expiresAt"`
 }
 
 type EksdReleaseRef struct {
@@ -1107,6 +1316,11 @@ type PackageConfiguration struct {
 
 	// Cronjob for ecr token refresher
 	CronJob *PackageControllerCronJob `json:"cronjob,omitempty"`
+
+	// Install is the list of curated packages installed in the cluster. Packages removed
+	// from the list are uninstalled from the cluster.
+	// +optional
+	Install []PackageInstall `json:"install,omitempty"`
 }
 
 // Equal for PackageConfiguration.
@@ -1117,7 +1331,50 @@ func (n *PackageConfiguration) Equal(o *PackageConfiguration) bool {
 	if n == nil || o == nil {
 		return false
 	}
-	return n.Disable == o.Disable && n.Controller.Equal(o.Controller) && n.CronJob.Equal(o.CronJob)
+	return n.Disable == o.Disable && n.Controller.Equal(o.Controller) && n.CronJob.Equal(o.CronJob) &&
+		packageInstallsEqual(n.Install, o.Install)
+}
+
+// PackageInstall defines a curated package installed in the cluster.
+type PackageInstall struct {
+	// Name is the name of the Package object. It must be unique in the install list.
+	Name string `json:"name"`
+
+	// PackageName is the name of the package as specified in the package bundle.
+	PackageName string `json:"packageName"`
+
+	// Version is the version name or digest of the package as specified in the package bundle.
+	// If not configured, the default version of the active package bundle is used, so the package
+	// follows the bundle as the cluster is upgraded. Release channels aren't supported: package
+	// bundles only publish versions, so to track updates leave the version empty.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// TargetNamespace is the namespace where the package resources are deployed.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
+	// Config is the yaml configuration of the package.
+	// +optional
+	Config string `json:"config,omitempty"`
+
+	// ConfigSecretRef is the name of a secret in the eksa-system namespace of the management
+	// cluster with the yaml configuration of the package in the config key.
+	// It can't be used together with Config.
+	// +optional
+	ConfigSecretRef string `json:"configSecretRef,omitempty"`
+}
+
+func packageInstallsEqual(a, b []PackageInstall) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // PackageControllerConfiguration configure aspects of package controller.
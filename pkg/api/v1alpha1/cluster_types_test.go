@@ -1948,6 +1948,62 @@ func TestRegistryMirrorConfigurationEqual(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			testName: "both exist, same endpoints",
+			cluster1Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "site.h", CredentialsSecretRef: "harbor-site"},
+					{Endpoint: "central.h", CACertContent: "ca"},
+				},
+				FallbackToUpstream: true,
+			},
+			cluster2Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "site.h", CredentialsSecretRef: "harbor-site"},
+					{Endpoint: "central.h", CACertContent: "ca"},
+				},
+				FallbackToUpstream: true,
+			},
+			want: true,
+		},
+		{
+			testName: "both exist, endpoints diff order",
+			cluster1Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "site.h"},
+					{Endpoint: "central.h"},
+				},
+			},
+			cluster2Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "central.h"},
+					{Endpoint: "site.h"},
+				},
+			},
+			want: false,
+		},
+		{
+			testName: "both exist, endpoints diff credentials",
+			cluster1Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "central.h", CredentialsSecretRef: "harbor-central"},
+				},
+			},
+			cluster2Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "central.h", CredentialsSecretRef: "harbor-central-2"},
+				},
+			},
+			want: false,
+		},
+		{
+			testName: "both exist, fallbackToUpstream diff",
+			cluster1Regi: &v1alpha1.RegistryMirrorConfiguration{
+				FallbackToUpstream: true,
+			},
+			cluster2Regi: &v1alpha1.RegistryMirrorConfiguration{},
+			want:         false,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -2265,6 +2321,34 @@ func TestPackageConfiguration_Equal(t *testing.T) {
 			pco:  &v1alpha1.PackageConfiguration{Disable: false},
 			want: false,
 		},
+		{
+			name: "equal install",
+			pcn: &v1alpha1.PackageConfiguration{
+				Install: []v1alpha1.PackageInstall{{Name: "my-harbor", PackageName: "harbor", Version: "2.7.1"}},
+			},
+			pco: &v1alpha1.PackageConfiguration{
+				Install: []v1alpha1.PackageInstall{{Name: "my-harbor", PackageName: "harbor", Version: "2.7.1"}},
+			},
+			want: true,
+		},
+		{
+			name: "not equal install version",
+			pcn: &v1alpha1.PackageConfiguration{
+				Install: []v1alpha1.PackageInstall{{Name: "my-harbor", PackageName: "harbor", Version: "2.7.1"}},
+			},
+			pco: &v1alpha1.PackageConfiguration{
+				Install: []v1alpha1.PackageInstall{{Name: "my-harbor", PackageName: "harbor", Version: "2.8.0"}},
+			},
+			want: false,
+		},
+		{
+			name: "not equal install removed",
+			pcn: &v1alpha1.PackageConfiguration{
+				Install: []v1alpha1.PackageInstall{{Name: "my-harbor", PackageName: "harbor"}},
+			},
+			pco:  &v1alpha1.PackageConfiguration{},
+			want: false,
+		},
 		{
 			name: "not equal controller",
 			pcn: &v1alpha1.PackageConfiguration{
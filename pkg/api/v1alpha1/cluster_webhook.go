@@ -345,6 +345,18 @@ func validateImmutableFieldsCluster(new, old *Cluster) field.ErrorList {
 		)
 	}
 
+	// Cilium removes kube-proxy when kubeProxyReplacement is enabled, turning it off would
+	// leave the cluster without a service proxy.
+	if oCNI != nil && oCNI.Cilium != nil && oCNI.Cilium.KubeProxyReplacement && (nCNI == nil || nCNI.Cilium == nil || !nCNI.Cilium.KubeProxyReplacement) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				specPath.Child("clusterNetwork", "cniConfig", "cilium", "kubeProxyReplacement"),
+				"cannot toggle off kubeProxyReplacement once enabled",
+			),
+		)
+	}
+
 	if !new.Spec.ClusterNetwork.Nodes.Equal(old.Spec.ClusterNetwork.Nodes) {
 		allErrs = append(
 			allErrs,
@@ -2002,6 +2002,40 @@ func TestClusterValidateUpdateSkipUpgradeImmutability(t *testing.T) {
 	}
 }
 
+func TestClusterValidateUpdateKubeProxyReplacementImmutability(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Old   bool
+		New   bool
+		Error bool
+	}{
+		{Name: "Enable", Old: false, New: true},
+		{Name: "KeepEnabled", Old: true, New: true},
+		{Name: "Disable", Old: true, New: false, Error: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			cOld := baseCluster(func(c *v1alpha1.Cluster) {
+				c.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = tc.Old
+			})
+			cNew := baseCluster(func(c *v1alpha1.Cluster) {
+				c.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = tc.New
+			})
+
+			_, err := cNew.ValidateUpdate(context.TODO(), cOld, cNew)
+			if !tc.Error {
+				g.Expect(err).To(Succeed())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(
+					"spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement: Forbidden: cannot toggle off kubeProxyReplacement once enabled",
+				)))
+			}
+		})
+	}
+}
+
 func TestClusterValidateUpdateVersionSkew(t *testing.T) {
 	features.ClearCache()
 	cOld := baseCluster()
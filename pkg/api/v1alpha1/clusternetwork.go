@@ -0,0 +1,188 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// IPFamily is the IP address family of a cluster network.
+type IPFamily string
+
+const (
+	IPv4Family IPFamily = "IPv4"
+	IPv6Family IPFamily = "IPv6"
+
+	// defaultNodeCidrMaskSizeIPv6 is the kube-controller-manager default for IPv6 node CIDRs.
+	defaultNodeCidrMaskSizeIPv6 = 64
+)
+
+var errInvalidCIDR = errors.New("invalid CIDR block")
+
+// ipv6Providers are the providers that support IPv6 and dual-stack cluster networking.
+var ipv6Providers = map[string]bool{
+	VSphereDatacenterKind:    true,
+	TinkerbellDatacenterKind: true,
+	SnowDatacenterKind:       true,
+}
+
+// IPFamilyOf returns the IP family of an IP address or CIDR block.
+func IPFamilyOf(addressOrCIDR string) (IPFamily, error) {
+	addr, err := netip.ParseAddr(addressOrCIDR)
+	if err != nil {
+		prefix, perr := netip.ParsePrefix(addressOrCIDR)
+		if perr != nil {
+			return "", fmt.Errorf("%s is not a valid IP or CIDR", addressOrCIDR)
+		}
+		addr = prefix.Addr()
+	}
+
+	if addr.Unmap().Is4() {
+		return IPv4Family, nil
+	}
+	return IPv6Family, nil
+}
+
+// IPFamilies returns the IP families of the pod CIDR blocks, in order. The first one is the
+// primary family of the cluster.
+func (n *ClusterNetwork) IPFamilies() []IPFamily {
+	families := make([]IPFamily, 0, len(n.Pods.CidrBlocks))
+	for _, cidr := range n.Pods.CidrBlocks {
+		if family, err := IPFamilyOf(cidr); err == nil {
+			families = append(families, family)
+		}
+	}
+	return families
+}
+
+// HasIPFamily returns true if the cluster pods get addresses from the given IP family.
+func (n *ClusterNetwork) HasIPFamily(family IPFamily) bool {
+	for _, f := range n.IPFamilies() {
+		if f == family {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDualStack returns true if the cluster network has both IPv4 and IPv6 CIDR blocks.
+func (n *ClusterNetwork) IsDualStack() bool {
+	return n.HasIPFamily(IPv4Family) && n.HasIPFamily(IPv6Family)
+}
+
+// NodeCIDRMaskSize returns the node CIDR mask size for the given IP family.
+func (n *ClusterNetwork) NodeCIDRMaskSize(family IPFamily) int {
+	if family == IPv6Family {
+		if n.Nodes != nil && n.Nodes.CIDRMaskSizeIPv6 != nil {
+			return *n.Nodes.CIDRMaskSizeIPv6
+		}
+		return defaultNodeCidrMaskSizeIPv6
+	}
+
+	if n.Nodes != nil && n.Nodes.CIDRMaskSize != nil {
+		return *n.Nodes.CIDRMaskSize
+	}
+	return constants.DefaultNodeCidrMaskSize
+}
+
+// parseCIDRBlocks parses pods or services CIDR blocks, which can be a single CIDR or, for
+// dual-stack, one IPv4 and one IPv6 CIDR.
+func parseCIDRBlocks(name string, cidrBlocks []string) ([]netip.Prefix, []IPFamily, error) {
+	if len(cidrBlocks) > 2 {
+		return nil, nil, fmt.Errorf("at most two CIDR blocks, one IPv4 and one IPv6, can be specified for %s", name)
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(cidrBlocks))
+	families := make([]IPFamily, 0, len(cidrBlocks))
+	for _, cidr := range cidrBlocks {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w %s", errInvalidCIDR, cidr)
+		}
+		family, _ := IPFamilyOf(cidr)
+		prefixes = append(prefixes, prefix.Masked())
+		families = append(families, family)
+	}
+
+	if len(families) == 2 && families[0] == families[1] {
+		return nil, nil, fmt.Errorf("dual-stack %s CIDR blocks must be one IPv4 and one IPv6 CIDR", name)
+	}
+
+	return prefixes, families, nil
+}
+
+func validateNodeCIDRMaskSize(network *ClusterNetwork, podCIDRs []netip.Prefix, families []IPFamily) error {
+	for i, podCIDR := range podCIDRs {
+		podMaskSize := podCIDR.Bits()
+		nodeCidrMaskSize := network.NodeCIDRMaskSize(families[i])
+		// the pod subnet mask needs to allow one or multiple node-masks
+		// i.e. if it has a /24 the node mask must be between 24 and 32 for ipv4
+		// the below validations are run by kubeadm and we are bubbling those up here for better customer experience
+		if podMaskSize >= nodeCidrMaskSize {
+			return fmt.Errorf("the size of pod subnet with mask %d is smaller than or equal to the size of node subnet with mask %d", podMaskSize, nodeCidrMaskSize)
+		} else if (nodeCidrMaskSize - podMaskSize) > podSubnetNodeMaskMaxDiff {
+			// PodSubnetNodeMaskMaxDiff is limited to 16 due to an issue with uncompressed IP bitmap in core
+			// The node subnet mask size must be no more than the pod subnet mask size + 16
+			return fmt.Errorf("pod subnet mask (%d) and node-mask (%d) difference is greater than %d", podMaskSize, nodeCidrMaskSize, podSubnetNodeMaskMaxDiff)
+		}
+	}
+
+	return nil
+}
+
+// validateControlPlaneEndpointIPFamily checks that, when the control plane endpoint is an IP,
+// it belongs to one of the cluster IP families.
+func validateControlPlaneEndpointIPFamily(clusterConfig *Cluster, families []IPFamily) error {
+	if clusterConfig.Spec.ControlPlaneConfiguration.Endpoint == nil {
+		return nil
+	}
+	host := clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host
+	if _, err := netip.ParseAddr(host); err != nil {
+		return nil
+	}
+
+	family, _ := IPFamilyOf(host)
+	for _, f := range families {
+		if f == family {
+			return nil
+		}
+	}
+	return fmt.Errorf("control plane endpoint %s is an %s address but the cluster network is %s only", host, family, families[0])
+}
+
+func validateIPv6ProviderSupport(clusterConfig *Cluster, families []IPFamily) error {
+	for _, f := range families {
+		if f == IPv6Family && !ipv6Providers[clusterConfig.Spec.DatacenterRef.Kind] {
+			return errors.New("IPv6 and dual-stack cluster networking are only supported for vSphere, Tinkerbell and Snow")
+		}
+	}
+	return nil
+}
+
+// validateCiliumNativeRoutingCIDRs checks that, in direct routing mode, there is a native routing
+// CIDR of the right IP family for each of the cluster IP families.
+func validateCiliumNativeRoutingCIDRs(cniConfig *CNIConfig, families []IPFamily) error {
+	if cniConfig == nil || cniConfig.Cilium == nil || cniConfig.Cilium.RoutingMode != CiliumRoutingModeDirect {
+		return nil
+	}
+
+	cidrs := []string{cniConfig.Cilium.IPv4NativeRoutingCIDR, cniConfig.Cilium.IPv6NativeRoutingCIDR}
+	for i, family := range []IPFamily{IPv4Family, IPv6Family} {
+		cidr := cidrs[i]
+		if cidr == "" {
+			if slices.Contains(families, family) {
+				return fmt.Errorf("direct routing mode requires %sNativeRoutingCIDR to be set for %s cluster networks", family, family)
+			}
+			continue
+		}
+		if f, err := IPFamilyOf(cidr); err != nil || f != family || !strings.Contains(cidr, "/") {
+			return fmt.Errorf("cilium %sNativeRoutingCIDR %s is not a valid %s CIDR", family, cidr, family)
+		}
+	}
+
+	return nil
+}
@@ -66,6 +66,18 @@ const (
 	MachineDeploymentNotReadyReason = "MachineDeploymentNotReady"
 )
 
+const (
+	// CertificatesValidCondition reports whether the certificates of the control plane and external etcd
+	// machines are valid and not close to their expiry date.
+	CertificatesValidCondition ConditionType = "CertificatesValid"
+
+	// CertificatesExpiringSoonReason reports that the certificates of at least one machine expire within the warning threshold.
+	CertificatesExpiringSoonReason = "CertificatesExpiringSoon"
+
+	// CertificatesExpiredReason reports that the certificates of at least one machine have expired.
+	CertificatesExpiredReason = "CertificatesExpired"
+)
+
 const (
 	// DefaultCNIConfiguredCondition reports the default cni cluster has been configured successfully.
 	DefaultCNIConfiguredCondition ConditionType = "DefaultCNIConfigured"
@@ -0,0 +1,82 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// DefaultEtcdBackupRetention is the number of snapshots kept when EtcdBackup.Retention is not set.
+const DefaultEtcdBackupRetention = 7
+
+// EtcdBackup defines the configuration for scheduled etcd snapshots of the cluster.
+// Snapshots are taken from a control plane node for both stacked and external etcd.
+type EtcdBackup struct {
+	// Schedule is the cron expression, in the standard five field format, that defines when snapshots are taken.
+	Schedule string `json:"schedule"`
+	// Retention is the number of snapshots to keep in the destination. Older snapshots are deleted
+	// after each successful snapshot. If not configured, the default value is 7.
+	// +optional
+	Retention int `json:"retention,omitempty"`
+	// Destination defines where the snapshots are stored. Exactly one destination must be configured.
+	Destination EtcdBackupDestination `json:"destination"`
+}
+
+// EtcdBackupDestination defines where etcd snapshots are stored.
+type EtcdBackupDestination struct {
+	// PersistentVolumeClaim stores the snapshots in an existing PVC in the kube-system namespace of the cluster.
+	PersistentVolumeClaim *EtcdBackupPVCDestination `json:"persistentVolumeClaim,omitempty"`
+	// HostPath stores the snapshots in a directory of the control plane node that takes them.
+	HostPath *EtcdBackupHostPathDestination `json:"hostPath,omitempty"`
+	// S3 uploads the snapshots to an S3 compatible object storage.
+	S3 *EtcdBackupS3Destination `json:"s3,omitempty"`
+}
+
+// EtcdBackupPVCDestination references a PersistentVolumeClaim to store etcd snapshots.
+type EtcdBackupPVCDestination struct {
+	// ClaimName is the name of the PersistentVolumeClaim in the kube-system namespace of the cluster.
+	ClaimName string `json:"claimName"`
+}
+
+// EtcdBackupHostPathDestination defines a directory on the control plane nodes to store etcd snapshots.
+type EtcdBackupHostPathDestination struct {
+	// Path is the absolute path of the directory on the node.
+	Path string `json:"path"`
+}
+
+// EtcdBackupS3Destination defines an S3 compatible bucket to upload etcd snapshots to.
+type EtcdBackupS3Destination struct {
+	// Endpoint is the URL of the S3 compatible API, for example https://s3.us-west-2.amazonaws.com.
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket.
+	Bucket string `json:"bucket"`
+	// Region is the region used to sign the requests. If not configured, the default value is us-east-1.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// Prefix is prepended to the snapshot object keys.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// CredentialsSecretRef is the name of a secret in the eksa-system namespace of the management cluster
+	// with the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY keys.
+	CredentialsSecretRef string `json:"credentialsSecretRef"`
+}
+
+// EtcdBackupStatus reports the outcome of the scheduled etcd snapshots.
+type EtcdBackupStatus struct {
+	// LastSuccessfulSnapshotTime is the completion time of the last successful snapshot.
+	// +optional
+	LastSuccessfulSnapshotTime *metav1.Time `json:"lastSuccessfulSnapshotTime,omitempty"`
+	// LastSnapshotSizeBytes is the size of the last successful snapshot.
+	// +optional
+	LastSnapshotSizeBytes int64 `json:"lastSnapshotSizeBytes,omitempty"`
+	// LastFailedSnapshotTime is the time of the last failed snapshot.
+	// +optional
+	LastFailedSnapshotTime *metav1.Time `json:"lastFailedSnapshotTime,omitempty"`
+	// LastFailureMessage describes why the last failed snapshot failed.
+	// +optional
+	LastFailureMessage string `json:"lastFailureMessage,omitempty"`
+}
+
+// RetentionOrDefault returns the configured retention or DefaultEtcdBackupRetention if not set.
+func (b *EtcdBackup) RetentionOrDefault() int {
+	if b.Retention == 0 {
+		return DefaultEtcdBackupRetention
+	}
+	return b.Retention
+}
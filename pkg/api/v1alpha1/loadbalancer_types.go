@@ -0,0 +1,152 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// LoadBalancerMode defines how the in-cluster load balancer announces the Service addresses.
+type LoadBalancerMode string
+
+const (
+	// LoadBalancerModeL2 announces the Service addresses with ARP/NDP from one of the nodes.
+	LoadBalancerModeL2 LoadBalancerMode = "L2"
+	// LoadBalancerModeBGP advertises the Service addresses to BGP peers from all the nodes.
+	LoadBalancerModeBGP LoadBalancerMode = "BGP"
+)
+
+// LoadBalancerConfiguration defines an in-cluster load balancer that assigns addresses to Services
+// of type LoadBalancer. It's only supported for the vSphere, Tinkerbell and Nutanix providers.
+type LoadBalancerConfiguration struct {
+	// Mode defines how the Service addresses are announced, L2 or BGP. If not configured,
+	// the default value is L2.
+	// +optional
+	Mode LoadBalancerMode `json:"mode,omitempty"`
+	// AddressPools are the addresses assigned to the LoadBalancer Services.
+	AddressPools []LoadBalancerAddressPool `json:"addressPools"`
+	// BGP configures the BGP peering of the nodes. It's required for BGP mode.
+	// +optional
+	BGP *LoadBalancerBGPConfiguration `json:"bgp,omitempty"`
+}
+
+// LoadBalancerAddressPool is a set of addresses that can be assigned to LoadBalancer Services.
+type LoadBalancerAddressPool struct {
+	// Name is used as a unique identifier for each pool.
+	Name string `json:"name"`
+	// Addresses is a list of single IPs (10.0.0.10), ranges (10.0.0.10-10.0.0.20) or CIDRs (10.0.0.0/27).
+	Addresses []string `json:"addresses"`
+	// Namespace restricts the pool to the Services of a namespace. Pools without a namespace are
+	// used by the Services of all the namespaces that don't have their own pool.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// LoadBalancerBGPConfiguration defines the BGP peering used to advertise the Service addresses.
+type LoadBalancerBGPConfiguration struct {
+	// LocalASN is the autonomous system number of the cluster nodes.
+	LocalASN int64 `json:"localASN"`
+	// Peers are the BGP routers the nodes peer with.
+	Peers []LoadBalancerBGPPeer `json:"peers"`
+}
+
+// LoadBalancerBGPPeer is a BGP router the cluster nodes peer with.
+type LoadBalancerBGPPeer struct {
+	// Address is the IP address of the router.
+	Address string `json:"address"`
+	// ASN is the autonomous system number of the router.
+	ASN int64 `json:"asn"`
+}
+
+// ModeOrDefault returns the configured mode or LoadBalancerModeL2 if not set.
+func (l *LoadBalancerConfiguration) ModeOrDefault() LoadBalancerMode {
+	if l.Mode == "" {
+		return LoadBalancerModeL2
+	}
+	return l.Mode
+}
+
+// IPRange is an inclusive range of IP addresses of the same family.
+// +kubebuilder:object:generate=false
+type IPRange struct {
+	First, Last netip.Addr
+}
+
+// String returns the range in the start-end format.
+func (r IPRange) String() string {
+	return fmt.Sprintf("%s-%s", r.First, r.Last)
+}
+
+// Contains returns true if addr is part of the range.
+func (r IPRange) Contains(addr netip.Addr) bool {
+	return r.First.Compare(addr) <= 0 && addr.Compare(r.Last) <= 0
+}
+
+// Overlaps returns true if both ranges share at least one address.
+func (r IPRange) Overlaps(o IPRange) bool {
+	return r.First.Compare(o.Last) <= 0 && o.First.Compare(r.Last) <= 0
+}
+
+// Ranges returns the pool addresses as ranges, in the order they are configured.
+func (p *LoadBalancerAddressPool) Ranges() ([]IPRange, error) {
+	ranges := make([]IPRange, 0, len(p.Addresses))
+	for _, address := range p.Addresses {
+		r, err := parseIPRange(address)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// parseIPRange parses a single IP, a start-end range or a CIDR, for either IP family.
+func parseIPRange(address string) (IPRange, error) {
+	if strings.Contains(address, "/") {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return IPRange{}, fmt.Errorf("address %s is not a valid CIDR", address)
+		}
+		return prefixRange(prefix), nil
+	}
+
+	if start, end, ok := strings.Cut(address, "-"); ok {
+		first, err := netip.ParseAddr(strings.TrimSpace(start))
+		if err != nil {
+			return IPRange{}, fmt.Errorf("address range %s is invalid: %s is not a valid IP", address, start)
+		}
+		last, err := netip.ParseAddr(strings.TrimSpace(end))
+		if err != nil {
+			return IPRange{}, fmt.Errorf("address range %s is invalid: %s is not a valid IP", address, end)
+		}
+		if first.Is4() != last.Is4() {
+			return IPRange{}, fmt.Errorf("address range %s is invalid: start and end should be of the same IP family", address)
+		}
+		if last.Less(first) {
+			return IPRange{}, fmt.Errorf("address range %s is invalid: start should be smaller than end", address)
+		}
+		return IPRange{First: first, Last: last}, nil
+	}
+
+	ip, err := netip.ParseAddr(address)
+	if err != nil {
+		return IPRange{}, fmt.Errorf("address %s is not a valid IP", address)
+	}
+	return IPRange{First: ip, Last: ip}, nil
+}
+
+func prefixRange(prefix netip.Prefix) IPRange {
+	first := prefix.Masked().Addr()
+	last := first.AsSlice()
+	hostBits := first.BitLen() - prefix.Bits()
+	for i := len(last) - 1; i >= 0 && hostBits > 0; i-- {
+		bits := hostBits
+		if bits > 8 {
+			bits = 8
+		}
+		last[i] |= byte(1<<bits - 1)
+		hostBits -= bits
+	}
+	lastAddr, _ := netip.AddrFromSlice(last)
+	return IPRange{First: first, Last: lastAddr}
+}
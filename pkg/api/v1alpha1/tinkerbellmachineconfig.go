@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"golang.org/x/crypto/ssh"
@@ -11,6 +12,9 @@ import (
 
 const TinkerbellMachineConfigKind = "TinkerbellMachineConfig"
 
+// interfaceNameRegex matches linux interface names, that can't be longer than 15 characters.
+var interfaceNameRegex = regexp.MustCompile(`^[a-zA-Z][\w-]{0,14}$`)
+
 // +kubebuilder:object:generate=false
 type TinkerbellMachineConfigGenerateOpt func(config *TinkerbellMachineConfigGenerate)
 
@@ -70,17 +74,21 @@ func validateTinkerbellMachineConfig(config *TinkerbellMachineConfig) error {
 		return fmt.Errorf("TinkerbellMachineConfig: %v", err)
 	}
 
-	if len(config.Spec.HardwareSelector) == 0 {
+	if config.Spec.HardwareLabelSelector().IsEmpty() {
 		return fmt.Errorf("TinkerbellMachineConfig: missing spec.hardwareSelector: %s", config.Name)
 	}
 
-	if len(config.Spec.HardwareSelector) != 1 {
+	if len(config.Spec.HardwareSelector) > 1 {
 		return fmt.Errorf(
 			"TinkerbellMachineConfig: spec.hardwareSelector must contain only 1 key-value pair: %s",
 			config.Name,
 		)
 	}
 
+	if _, err := config.Spec.HardwareLabelSelector().Selector(); err != nil {
+		return fmt.Errorf("TinkerbellMachineConfig: invalid spec.hardwareSelectorExpressions: %s: %v", config.Name, err)
+	}
+
 	if config.Spec.OSFamily == "" {
 		return fmt.Errorf("TinkerbellMachineConfig: missing spec.osFamily: %s", config.Name)
 	}
@@ -109,6 +117,87 @@ func validateTinkerbellMachineConfig(config *TinkerbellMachineConfig) error {
 		return fmt.Errorf("HostOSConfiguration is invalid for TinkerbellMachineConfig %s: %v", config.Name, err)
 	}
 
+	if err := validateTinkerbellNetworkConfig(config.Spec.Network, config.Spec.OSFamily); err != nil {
+		return fmt.Errorf("TinkerbellMachineConfig: invalid spec.network: %s: %v", config.Name, err)
+	}
+
+	return nil
+}
+
+func validateTinkerbellNetworkConfig(network *TinkerbellNetworkConfig, osFamily OSFamily) error {
+	if network == nil {
+		return nil
+	}
+
+	names := make(map[string]struct{}, len(network.Interfaces)+len(network.AdditionalInterfaces))
+	for _, name := range network.InterfaceNames() {
+		if !interfaceNameRegex.MatchString(name) {
+			return fmt.Errorf("interface name %q must match %v", name, interfaceNameRegex)
+		}
+		if _, seen := names[name]; seen {
+			return fmt.Errorf("interface %s is referenced more than once", name)
+		}
+		names[name] = struct{}{}
+	}
+
+	if len(network.Interfaces) > 1 && network.Bond == nil {
+		return fmt.Errorf("multiple interfaces require a bond")
+	}
+
+	if network.Bond != nil {
+		if len(network.Interfaces) < 2 {
+			return fmt.Errorf("bond requires at least 2 interfaces")
+		}
+
+		if err := validateBondMode(network.Bond.Mode, osFamily); err != nil {
+			return err
+		}
+	}
+
+	if err := validateVLANID(network.VLANID); err != nil {
+		return err
+	}
+
+	for _, i := range network.AdditionalInterfaces {
+		if err := validateVLANID(i.VLANID); err != nil {
+			return fmt.Errorf("interface %s: %v", i.Name, err)
+		}
+	}
+
+	if len(network.Interfaces) == 0 {
+		return fmt.Errorf("interfaces must name the interface carrying the machine IP address")
+	}
+
+	return nil
+}
+
+func validateBondMode(mode BondMode, osFamily OSFamily) error {
+	switch mode {
+	case "", BondMode8023ad, BondModeActiveBackup, BondModeBalanceALB, BondModeBalanceTLB, BondModeBalanceXOR:
+	default:
+		return fmt.Errorf(
+			"unsupported bond mode (%v); Please use one of the following: %s, %s, %s, %s, %s",
+			mode,
+			BondMode8023ad,
+			BondModeActiveBackup,
+			BondModeBalanceALB,
+			BondModeBalanceTLB,
+			BondModeBalanceXOR,
+		)
+	}
+
+	if osFamily == Bottlerocket && mode != "" && mode != BondModeActiveBackup {
+		return fmt.Errorf("bond mode must be %s for %s", BondModeActiveBackup, Bottlerocket)
+	}
+
+	return nil
+}
+
+func validateVLANID(id int) error {
+	// valid VLAN IDs are between 1 and 4094 - https://en.m.wikipedia.org/wiki/VLAN#IEEE_802.1Q
+	if id < 0 || id > 4094 {
+		return fmt.Errorf("vlanID must be between 1 and 4094")
+	}
 	return nil
 }
 
@@ -116,6 +205,13 @@ func setTinkerbellMachineConfigDefaults(machineConfig *TinkerbellMachineConfig)
 	if machineConfig.Spec.OSFamily == "" {
 		machineConfig.Spec.OSFamily = Bottlerocket
 	}
+
+	if network := machineConfig.Spec.Network; network != nil && network.Bond != nil && network.Bond.Mode == "" {
+		network.Bond.Mode = BondMode8023ad
+		if machineConfig.Spec.OSFamily == Bottlerocket {
+			network.Bond.Mode = BondModeActiveBackup
+		}
+	}
 }
 
 func normalizeSSHKeys(machineConfig *TinkerbellMachineConfig) {
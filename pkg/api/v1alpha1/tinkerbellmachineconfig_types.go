@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
@@ -11,8 +12,13 @@ import (
 // TinkerbellMachineConfigSpec defines the desired state of TinkerbellMachineConfig.
 type TinkerbellMachineConfigSpec struct {
 	HardwareSelector HardwareSelector `json:"hardwareSelector"`
-	TemplateRef      Ref              `json:"templateRef,omitempty"`
-	OSFamily         OSFamily         `json:"osFamily"`
+	// HardwareSelectorExpressions are set-based requirements on the Hardware labels, with the In, NotIn,
+	// Exists and DoesNotExist operators. Hardware must satisfy both the HardwareSelector and all the
+	// expressions to be selected.
+	// +optional
+	HardwareSelectorExpressions []metav1.LabelSelectorRequirement `json:"hardwareSelectorExpressions,omitempty"`
+	TemplateRef                 Ref                               `json:"templateRef,omitempty"`
+	OSFamily                    OSFamily                          `json:"osFamily"`
 	//+optional
 	// OSImageURL can be used to override the default OS image path to pull from a local server.
 	// OSImageURL is a URL to the OS image used during provisioning. It must include
@@ -21,6 +27,70 @@ type TinkerbellMachineConfigSpec struct {
 	OSImageURL          string               `json:"osImageURL"`
 	Users               []UserConfiguration  `json:"users,omitempty"`
 	HostOSConfiguration *HostOSConfiguration `json:"hostOSConfiguration,omitempty"`
+	// Network is the network layout written to the host during provisioning. The interfaces it
+	// references must be declared, with their MAC addresses, in the selected Hardware.
+	// +optional
+	Network *TinkerbellNetworkConfig `json:"network,omitempty"`
+}
+
+// TinkerbellNetworkConfig describes the network interfaces of the machines of a TinkerbellMachineConfig.
+type TinkerbellNetworkConfig struct {
+	// Interfaces are the names of the Hardware interfaces carrying the machine IP address. At least
+	// one interface is required and more than one requires a Bond.
+	// +optional
+	Interfaces []string `json:"interfaces,omitempty"`
+	// Bond aggregates the Interfaces in a bond0 interface.
+	// +optional
+	Bond *TinkerbellBondConfig `json:"bond,omitempty"`
+	// VLANID puts the machine IP address on a VLAN sub-interface of the bond or interface.
+	// +optional
+	VLANID int `json:"vlanID,omitempty"`
+	// AdditionalInterfaces are Hardware interfaces carrying additional networks. Their IP addresses
+	// are read from the Hardware.
+	// +optional
+	AdditionalInterfaces []TinkerbellAdditionalInterface `json:"additionalInterfaces,omitempty"`
+}
+
+// BondMode is the bonding mode of a bond interface.
+type BondMode string
+
+const (
+	// BondMode8023ad is the IEEE 802.3ad LACP dynamic link aggregation mode.
+	BondMode8023ad BondMode = "802.3ad"
+	// BondModeActiveBackup only uses one interface at a time and fails over to the other ones.
+	BondModeActiveBackup BondMode = "active-backup"
+	// BondModeBalanceALB is the adaptive load balancing mode.
+	BondModeBalanceALB BondMode = "balance-alb"
+	// BondModeBalanceTLB is the adaptive transmit load balancing mode.
+	BondModeBalanceTLB BondMode = "balance-tlb"
+	// BondModeBalanceXOR balances the traffic based on a hash of the packets.
+	BondModeBalanceXOR BondMode = "balance-xor"
+)
+
+// TinkerbellBondConfig configures the bond of the machine interfaces.
+type TinkerbellBondConfig struct {
+	// Mode is the bonding mode. Defaults to 802.3ad.
+	// +optional
+	Mode BondMode `json:"mode,omitempty"`
+}
+
+// TinkerbellAdditionalInterface is an interface carrying an additional network.
+type TinkerbellAdditionalInterface struct {
+	// Name is the name of the interface in the Hardware.
+	Name string `json:"name"`
+	// VLANID puts the interface IP address on a VLAN sub-interface.
+	// +optional
+	VLANID int `json:"vlanID,omitempty"`
+}
+
+// InterfaceNames returns the names of all the interfaces referenced by n.
+func (n *TinkerbellNetworkConfig) InterfaceNames() []string {
+	names := make([]string, 0, len(n.Interfaces)+len(n.AdditionalInterfaces))
+	names = append(names, n.Interfaces...)
+	for _, i := range n.AdditionalInterfaces {
+		names = append(names, i.Name)
+	}
+	return names
 }
 
 // HardwareSelector models a simple key-value selector used in Tinkerbell provisioning.
@@ -39,6 +109,64 @@ func (s HardwareSelector) ToString() (string, error) {
 	return string(encoded), nil
 }
 
+// HardwareLabelSelector combines the key-value HardwareSelector and the set-based
+// HardwareSelectorExpressions of a TinkerbellMachineConfig.
+// +kubebuilder:object:generate=false
+type HardwareLabelSelector struct {
+	MatchLabels      HardwareSelector
+	MatchExpressions []metav1.LabelSelectorRequirement
+}
+
+// HardwareLabelSelector returns the selector used to pick the Hardware for the machines.
+func (s *TinkerbellMachineConfigSpec) HardwareLabelSelector() HardwareLabelSelector {
+	return HardwareLabelSelector{
+		MatchLabels:      s.HardwareSelector,
+		MatchExpressions: s.HardwareSelectorExpressions,
+	}
+}
+
+// IsEmpty returns true if s has no key-value pairs nor expressions.
+func (s HardwareLabelSelector) IsEmpty() bool {
+	return s.MatchLabels.IsEmpty() && len(s.MatchExpressions) == 0
+}
+
+// LabelSelector returns s as a Kubernetes label selector.
+func (s HardwareLabelSelector) LabelSelector() *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels:      s.MatchLabels,
+		MatchExpressions: s.MatchExpressions,
+	}
+}
+
+// Selector converts s into a labels.Selector that can be matched against Hardware labels.
+func (s HardwareLabelSelector) Selector() (labels.Selector, error) {
+	return metav1.LabelSelectorAsSelector(s.LabelSelector())
+}
+
+// Matches returns true if l satisfies all the key-value pairs and expressions of s.
+// An invalid selector never matches.
+func (s HardwareLabelSelector) Matches(l map[string]string) bool {
+	selector, err := s.Selector()
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(l))
+}
+
+// ToString returns a unique representation of s. Selectors without expressions keep the
+// json representation of the HardwareSelector.
+func (s HardwareLabelSelector) ToString() (string, error) {
+	if len(s.MatchExpressions) == 0 {
+		return s.MatchLabels.ToString()
+	}
+
+	selector, err := s.Selector()
+	if err != nil {
+		return "", err
+	}
+	return selector.String(), nil
+}
+
 func (c *TinkerbellMachineConfig) PauseReconcile() {
 	c.Annotations[pausedAnnotation] = "true"
 }
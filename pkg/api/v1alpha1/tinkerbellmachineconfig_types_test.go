@@ -14,6 +14,61 @@ func TestTinkerbellMachineConfigValidateSucceed(t *testing.T) {
 	g.Expect(machineConfig.Validate()).To(Succeed())
 }
 
+func TestTinkerbellMachineConfigValidateSucceedSelectorExpressions(t *testing.T) {
+	machineConfig := CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+		mc.Spec.HardwareSelector = nil
+		mc.Spec.HardwareSelectorExpressions = []metav1.LabelSelectorRequirement{
+			{Key: "rack", Operator: metav1.LabelSelectorOpIn, Values: []string{"r1", "r2"}},
+		}
+	})
+
+	g := NewWithT(t)
+	g.Expect(machineConfig.Validate()).To(Succeed())
+}
+
+func TestTinkerbellMachineConfigValidateSucceedNetwork(t *testing.T) {
+	machineConfig := CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+		mc.Spec.Network = &TinkerbellNetworkConfig{
+			Interfaces: []string{"eno1", "eno2"},
+			Bond:       &TinkerbellBondConfig{Mode: BondMode8023ad},
+			VLANID:     100,
+			AdditionalInterfaces: []TinkerbellAdditionalInterface{
+				{Name: "ens1f0", VLANID: 300},
+			},
+		}
+	})
+
+	g := NewWithT(t)
+	g.Expect(machineConfig.Validate()).To(Succeed())
+}
+
+func TestTinkerbellMachineConfigSetDefaultsBondMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		osFamily OSFamily
+		want     BondMode
+	}{
+		{name: "ubuntu", osFamily: Ubuntu, want: BondMode8023ad},
+		{name: "bottlerocket", osFamily: Bottlerocket, want: BondModeActiveBackup},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			machineConfig := CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.OSFamily = tc.osFamily
+				mc.Spec.Network = &TinkerbellNetworkConfig{
+					Interfaces: []string{"eno1", "eno2"},
+					Bond:       &TinkerbellBondConfig{},
+				}
+			})
+
+			g := NewWithT(t)
+			machineConfig.SetDefaults()
+			g.Expect(machineConfig.Spec.Network.Bond.Mode).To(Equal(tc.want))
+		})
+	}
+}
+
 func TestTinkerbellMachineConfigValidateFail(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -41,6 +96,15 @@ func TestTinkerbellMachineConfigValidateFail(t *testing.T) {
 			}),
 			expectedErr: "TinkerbellMachineConfig: spec.hardwareSelector must contain only 1 key-value pair",
 		},
+		{
+			name: "Invalid hardware selector expression",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.HardwareSelectorExpressions = []metav1.LabelSelectorRequirement{
+					{Key: "rack", Operator: metav1.LabelSelectorOpIn},
+				}
+			}),
+			expectedErr: "TinkerbellMachineConfig: invalid spec.hardwareSelectorExpressions",
+		},
 		{
 			name: "Empty OS family",
 			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
@@ -73,6 +137,86 @@ func TestTinkerbellMachineConfigValidateFail(t *testing.T) {
 			}),
 			expectedErr: "parsing osImageOverride: parse \"test\": invalid URI for request",
 		},
+		{
+			name: "Invalid network interface name",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{Interfaces: []string{"eth/0"}}
+			}),
+			expectedErr: "TinkerbellMachineConfig: invalid spec.network: tinkerbellmachineconfig: interface name \"eth/0\" must match",
+		},
+		{
+			name: "Duplicate network interface",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{
+					Interfaces:           []string{"eno1"},
+					AdditionalInterfaces: []TinkerbellAdditionalInterface{{Name: "eno1"}},
+				}
+			}),
+			expectedErr: "interface eno1 is referenced more than once",
+		},
+		{
+			name: "Multiple network interfaces without bond",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{Interfaces: []string{"eno1", "eno2"}}
+			}),
+			expectedErr: "multiple interfaces require a bond",
+		},
+		{
+			name: "Bond with a single interface",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{
+					Interfaces: []string{"eno1"},
+					Bond:       &TinkerbellBondConfig{Mode: BondMode8023ad},
+				}
+			}),
+			expectedErr: "bond requires at least 2 interfaces",
+		},
+		{
+			name: "Invalid bond mode",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{
+					Interfaces: []string{"eno1", "eno2"},
+					Bond:       &TinkerbellBondConfig{Mode: "broadcast"},
+				}
+			}),
+			expectedErr: "unsupported bond mode (broadcast)",
+		},
+		{
+			name: "Unsupported Bottlerocket bond mode",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.OSFamily = Bottlerocket
+				mc.Spec.Network = &TinkerbellNetworkConfig{
+					Interfaces: []string{"eno1", "eno2"},
+					Bond:       &TinkerbellBondConfig{Mode: BondMode8023ad},
+				}
+			}),
+			expectedErr: "bond mode must be active-backup for bottlerocket",
+		},
+		{
+			name: "Invalid VLAN ID",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{Interfaces: []string{"eno1"}, VLANID: 4095}
+			}),
+			expectedErr: "vlanID must be between 1 and 4094",
+		},
+		{
+			name: "Invalid additional interface VLAN ID",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{
+					AdditionalInterfaces: []TinkerbellAdditionalInterface{{Name: "ens1f0", VLANID: -1}},
+				}
+			}),
+			expectedErr: "interface ens1f0: vlanID must be between 1 and 4094",
+		},
+		{
+			name: "No interface carrying the machine IP address",
+			machineConfig: CreateTinkerbellMachineConfig(func(mc *TinkerbellMachineConfig) {
+				mc.Spec.Network = &TinkerbellNetworkConfig{
+					AdditionalInterfaces: []TinkerbellAdditionalInterface{{Name: "ens1f0"}},
+				}
+			}),
+			expectedErr: "interfaces must name the interface carrying the machine IP address",
+		},
 	}
 
 	for _, tc := range tests {
@@ -83,6 +227,30 @@ func TestTinkerbellMachineConfigValidateFail(t *testing.T) {
 	}
 }
 
+func TestHardwareLabelSelector(t *testing.T) {
+	g := NewWithT(t)
+	spec := TinkerbellMachineConfigSpec{
+		HardwareSelector: HardwareSelector{"type": "worker"},
+		HardwareSelectorExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "rack", Operator: metav1.LabelSelectorOpIn, Values: []string{"r1", "r2"}},
+			{Key: "gpu", Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	}
+
+	selector := spec.HardwareLabelSelector()
+	g.Expect(selector.IsEmpty()).To(BeFalse())
+	g.Expect(selector.Matches(map[string]string{"type": "worker", "rack": "r2"})).To(BeTrue())
+	g.Expect(selector.Matches(map[string]string{"type": "worker", "rack": "r3"})).To(BeFalse())
+	g.Expect(selector.Matches(map[string]string{"type": "worker", "rack": "r1", "gpu": "true"})).To(BeFalse())
+	g.Expect(selector.ToString()).To(Equal("!gpu,rack in (r1,r2),type=worker"))
+
+	spec = TinkerbellMachineConfigSpec{HardwareSelector: HardwareSelector{"type": "cp"}}
+	g.Expect(spec.HardwareLabelSelector().ToString()).To(Equal(`{"type":"cp"}`))
+
+	spec = TinkerbellMachineConfigSpec{}
+	g.Expect(spec.HardwareLabelSelector().IsEmpty()).To(BeTrue())
+}
+
 type tinkerbellMachineConfigOpt func(mc *TinkerbellMachineConfig)
 
 func withHostOSConfiguration(config *HostOSConfiguration) tinkerbellMachineConfigOpt {
@@ -171,5 +171,13 @@ func validateImmutableFieldsTinkerbellMachineConfig(new, old *TinkerbellMachineC
 		allErrs = append(allErrs, field.Forbidden(specPath.Child("HardwareSelector"), "field is immutable"))
 	}
 
+	if !reflect.DeepEqual(new.Spec.HardwareSelectorExpressions, old.Spec.HardwareSelectorExpressions) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("HardwareSelectorExpressions"), "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(new.Spec.Network, old.Spec.Network) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("Network"), "field is immutable"))
+	}
+
 	return allErrs
 }
@@ -231,6 +231,18 @@ func TestTinkerbellMachineConfigValidateUpdateFailHardwareSelector(t *testing.T)
 	g.Expect(HaveField("HardwareSelector", err))
 }
 
+func TestTinkerbellMachineConfigValidateUpdateFailNetwork(t *testing.T) {
+	ctx := context.Background()
+	machineConfigOld := v1alpha1.CreateTinkerbellMachineConfig()
+	machineConfigNew := v1alpha1.CreateTinkerbellMachineConfig(func(mc *v1alpha1.TinkerbellMachineConfig) {
+		mc.Spec.Network = &v1alpha1.TinkerbellNetworkConfig{Interfaces: []string{"eno1"}}
+	})
+
+	g := NewWithT(t)
+	_, err := machineConfigNew.ValidateUpdate(ctx, machineConfigOld, machineConfigNew)
+	g.Expect(err).To(MatchError(ContainSubstring("spec.Network: Forbidden: field is immutable")))
+}
+
 func TestTinkerbellMachineConfigDefaultCastFail(t *testing.T) {
 	g := NewWithT(t)
 
@@ -24,10 +24,14 @@ warnings:
 	actionImage2Disk = "127.0.0.1/embedded/image2disk"
 	actionWriteFile  = "127.0.0.1/embedded/writefile"
 	actionReboot     = "127.0.0.1/embedded/reboot"
+
+	// bondInterfaceName is the name of the bond of the machine interfaces.
+	bondInterfaceName = "bond0"
 )
 
-// DefaultActions constructs a set of default actions for the given osFamily.
-func DefaultActions(clusterSpec *Cluster, osImageOverride, tinkerbellLocalIP, tinkerbellLBIP string, osFamily OSFamily) []ActionOpt {
+// DefaultActions constructs a set of default actions for the given osFamily. When network is not nil,
+// its interfaces, bond and VLANs are written to the host network config.
+func DefaultActions(clusterSpec *Cluster, osImageOverride, tinkerbellLocalIP, tinkerbellLBIP string, osFamily OSFamily, network *TinkerbellNetworkConfig) []ActionOpt {
 	// The metadata string will have two URLs:
 	// 1. one that will be used initially for bootstrap and will point to hegel running on kind.
 	// 2. one that will be used when the workload cluster is up and will point to hegel running on
@@ -71,7 +75,9 @@ func DefaultActions(clusterSpec *Cluster, osImageOverride, tinkerbellLocalIP, ti
 			withBottlerocketUserDataAction(partitionPath, strings.Join(metadataURLs, ",")),
 			// Order matters. This action needs to append to an existing user-data.toml file so
 			// must be after withBottlerocketUserDataAction().
-			withNetplanAction(partitionPath, osFamily),
+			withNetplanAction(partitionPath, osFamily, network),
+			// Order matters. This action replaces the net.toml written by withNetplanAction().
+			withBottlerocketNetworkConfigAction(partitionPath, network),
 			withRebootAction(),
 		)
 	case RedHat:
@@ -83,7 +89,7 @@ func DefaultActions(clusterSpec *Cluster, osImageOverride, tinkerbellLocalIP, ti
 		partitionPath := fmt.Sprintf(paritionPathFmt, "1")
 
 		actions = append(actions,
-			withNetplanAction(partitionPath, osFamily),
+			withNetplanAction(partitionPath, osFamily, network),
 			withDisableCloudInitNetworkCapabilities(partitionPath),
 			withTinkCloudInitAction(partitionPath, strings.Join(mu, ",")),
 			withDsCloudInitAction(partitionPath),
@@ -93,7 +99,7 @@ func DefaultActions(clusterSpec *Cluster, osImageOverride, tinkerbellLocalIP, ti
 		partitionPath := fmt.Sprintf(paritionPathFmt, "2")
 
 		actions = append(actions,
-			withNetplanAction(partitionPath, osFamily),
+			withNetplanAction(partitionPath, osFamily, network),
 			withDisableCloudInitNetworkCapabilities(partitionPath),
 			withTinkCloudInitAction(partitionPath, strings.Join(metadataURLs, ",")),
 			withDsCloudInitAction(partitionPath),
@@ -125,7 +131,7 @@ func withStreamImageAction(disk, imageURL string, additionalEnvVar map[string]st
 	}
 }
 
-func withNetplanAction(disk string, osFamily OSFamily) ActionOpt {
+func withNetplanAction(disk string, osFamily OSFamily, network *TinkerbellNetworkConfig) ActionOpt {
 	return func(a *[]tinkerbell.Action) {
 		netplanAction := tinkerbell.Action{
 			Name:    "write netplan config",
@@ -151,10 +157,45 @@ func withNetplanAction(disk string, osFamily OSFamily) ActionOpt {
 		} else {
 			netplanAction.Environment["STATIC_NETPLAN"] = "true"
 		}
+
+		if network != nil {
+			if osFamily == Bottlerocket {
+				// The action keeps writing the nameservers to the user data, its net.toml is replaced
+				// by withBottlerocketNetworkConfigAction().
+				netplanAction.Environment["IFNAME"] = network.Interfaces[0]
+			} else {
+				delete(netplanAction.Environment, "STATIC_NETPLAN")
+				netplanAction.Environment["CONTENTS"] = netplanNetworkConfig(network)
+			}
+		}
 		*a = append(*a, netplanAction)
 	}
 }
 
+func withBottlerocketNetworkConfigAction(disk string, network *TinkerbellNetworkConfig) ActionOpt {
+	return func(a *[]tinkerbell.Action) {
+		if network == nil {
+			return
+		}
+		*a = append(*a, tinkerbell.Action{
+			Name:    "write Bottlerocket network config",
+			Image:   actionWriteFile,
+			Timeout: 90,
+			Pid:     "host",
+			Environment: map[string]string{
+				"DEST_DISK": disk,
+				"FS_TYPE":   "ext4",
+				"DEST_PATH": "/net.toml",
+				"CONTENTS":  bottlerocketNetworkConfig(network),
+				"UID":       "0",
+				"GID":       "0",
+				"MODE":      "0644",
+				"DIRMODE":   "0755",
+			},
+		})
+	}
+}
+
 func withDisableCloudInitNetworkCapabilities(disk string) ActionOpt {
 	return func(a *[]tinkerbell.Action) {
 		*a = append(*a, tinkerbell.Action{
@@ -1,10 +1,14 @@
 package v1alpha1
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
+	"text/template"
 
 	"github.com/google/go-cmp/cmp"
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell"
 )
@@ -27,6 +31,7 @@ warnings:
 		osFamily        OSFamily
 		osImageOverride string
 		clusterSpec     *Cluster
+		network         *TinkerbellNetworkConfig
 		wantActions     []tinkerbell.Action
 	}{
 		{
@@ -541,7 +546,7 @@ warnings:
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
 			givenActions := []tinkerbell.Action{}
-			opts := DefaultActions(tt.clusterSpec, tt.osImageOverride, tinkerbellLocalIp, tinkerbellLBIP, tt.osFamily)
+			opts := DefaultActions(tt.clusterSpec, tt.osImageOverride, tinkerbellLocalIp, tinkerbellLBIP, tt.osFamily, tt.network)
 			for _, opt := range opts {
 				opt(&givenActions)
 			}
@@ -551,3 +556,254 @@ warnings:
 		})
 	}
 }
+
+func TestDefaultActionsNetwork(t *testing.T) {
+	tests := []struct {
+		testName     string
+		osFamily     OSFamily
+		network      *TinkerbellNetworkConfig
+		action       string
+		wantEnv      map[string]string
+		wantContents string
+	}{
+		{
+			testName: "Ubuntu bond with VLANs",
+			osFamily: Ubuntu,
+			network: &TinkerbellNetworkConfig{
+				Interfaces: []string{"eno1", "eno2"},
+				Bond:       &TinkerbellBondConfig{Mode: BondMode8023ad},
+				VLANID:     100,
+				AdditionalInterfaces: []TinkerbellAdditionalInterface{
+					{Name: "ens1f0", VLANID: 300},
+					{Name: "ens1f1"},
+				},
+			},
+			action:  "write netplan config",
+			wantEnv: map[string]string{"DEST_PATH": "/etc/netplan/config.yaml"},
+			wantContents: `network:
+  version: 2
+  renderer: networkd
+  ethernets:
+    eno1:
+      match:
+        macaddress: "00:00:00:00:00:01"
+      set-name: eno1
+      dhcp4: false
+    eno2:
+      match:
+        macaddress: "00:00:00:00:00:02"
+      set-name: eno2
+      dhcp4: false
+    ens1f0:
+      match:
+        macaddress: "00:00:00:00:00:03"
+      set-name: ens1f0
+      dhcp4: false
+    ens1f1:
+      match:
+        macaddress: "00:00:00:00:00:04"
+      set-name: ens1f1
+      dhcp4: false
+      addresses:
+      - 10.10.70.5/22
+  bonds:
+    bond0:
+      interfaces: [eno1, eno2]
+      parameters:
+        mode: 802.3ad
+        mii-monitor-interval: 100
+  vlans:
+    bond0.100:
+      id: 100
+      link: bond0
+      dhcp4: false
+      addresses:
+      - 10.10.50.5/23
+      routes:
+      - to: default
+        via: 10.10.50.1
+      nameservers:
+        addresses: [8.8.8.8, 1.1.1.1]
+    ens1f0.300:
+      id: 300
+      link: ens1f0
+      dhcp4: false
+      addresses:
+      - 10.10.60.5/24
+`,
+		},
+		{
+			testName: "RedHat single interface",
+			osFamily: RedHat,
+			network: &TinkerbellNetworkConfig{
+				Interfaces: []string{"eno1"},
+			},
+			action:  "write netplan config",
+			wantEnv: map[string]string{"DEST_PATH": "/etc/netplan/config.yaml"},
+			wantContents: `network:
+  version: 2
+  renderer: networkd
+  ethernets:
+    eno1:
+      match:
+        macaddress: "00:00:00:00:00:01"
+      set-name: eno1
+      dhcp4: false
+      addresses:
+      - 10.10.50.5/23
+      routes:
+      - to: default
+        via: 10.10.50.1
+      nameservers:
+        addresses: [8.8.8.8, 1.1.1.1]
+`,
+		},
+		{
+			testName: "Bottlerocket bond with VLANs",
+			osFamily: Bottlerocket,
+			network: &TinkerbellNetworkConfig{
+				Interfaces: []string{"eno1", "eno2"},
+				Bond:       &TinkerbellBondConfig{Mode: BondModeActiveBackup},
+				VLANID:     100,
+				AdditionalInterfaces: []TinkerbellAdditionalInterface{
+					{Name: "ens1f0", VLANID: 300},
+					{Name: "ens1f1"},
+				},
+			},
+			action:  "write Bottlerocket network config",
+			wantEnv: map[string]string{"DEST_PATH": "/net.toml"},
+			wantContents: `version = 3
+
+[bond0]
+kind = "bond"
+mode = "active-backup"
+interfaces = ["eno1", "eno2"]
+dhcp4 = false
+
+[bond0.monitoring]
+miimon-frequency-ms = 100
+miimon-updelay-ms = 200
+miimon-downdelay-ms = 200
+
+[bond0.100]
+kind = "vlan"
+device = "bond0"
+id = 100
+dhcp4 = false
+primary = true
+
+[bond0.100.static4]
+addresses = ["10.10.50.5/23"]
+
+[[bond0.100.route]]
+to = "default"
+via = "10.10.50.1"
+
+[ens1f0]
+dhcp4 = false
+
+[ens1f0.300]
+kind = "vlan"
+device = "ens1f0"
+id = 300
+dhcp4 = false
+
+[ens1f0.300.static4]
+addresses = ["10.10.60.5/24"]
+
+[ens1f1]
+dhcp4 = false
+
+[ens1f1.static4]
+addresses = ["10.10.70.5/22"]
+`,
+		},
+	}
+
+	hardware := map[string]interface{}{
+		"device_1": "00:00:00:00:00:01",
+		"Hardware": testTemplateHardware{
+			Disks: []string{"/dev/sda"},
+			Interfaces: []tinkv1alpha1.Interface{
+				{DHCP: &tinkv1alpha1.DHCP{
+					MAC:         "00:00:00:00:00:01",
+					IfaceName:   "eno1",
+					IP:          &tinkv1alpha1.IP{Address: "10.10.50.5", Netmask: "255.255.254.0", Gateway: "10.10.50.1"},
+					NameServers: []string{"8.8.8.8", "1.1.1.1"},
+				}},
+				{DHCP: &tinkv1alpha1.DHCP{MAC: "00:00:00:00:00:02", IfaceName: "eno2"}},
+				{DHCP: &tinkv1alpha1.DHCP{
+					MAC:       "00:00:00:00:00:03",
+					IfaceName: "ens1f0",
+					IP:        &tinkv1alpha1.IP{Address: "10.10.60.5", Netmask: "255.255.255.0"},
+				}},
+				{DHCP: &tinkv1alpha1.DHCP{
+					MAC:       "00:00:00:00:00:04",
+					IfaceName: "ens1f1",
+					IP:        &tinkv1alpha1.IP{Address: "10.10.70.5", Netmask: "255.255.252.0"},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			config := NewDefaultTinkerbellTemplateConfigCreate(&Cluster{}, "", "127.0.0.1", "1.2.3.4", tt.osFamily, tt.network)
+			workflow := renderTemplateConfig(t, config, hardware)
+
+			var action *tinkerbell.Action
+			for i, a := range workflow.Tasks[0].Actions {
+				if a.Name == tt.action {
+					action = &workflow.Tasks[0].Actions[i]
+				}
+			}
+			if action == nil {
+				t.Fatalf("Expected %s action", tt.action)
+			}
+
+			for k, v := range tt.wantEnv {
+				if got := action.Environment[k]; got != v {
+					t.Errorf("Environment[%s] = %q, want %q", k, got, v)
+				}
+			}
+			if diff := cmp.Diff(tt.wantContents, action.Environment["CONTENTS"]); diff != "" {
+				t.Errorf("Rendered network config mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// testTemplateHardware is the Hardware data the Tink controller renders templates with.
+type testTemplateHardware struct {
+	Disks      []string
+	Interfaces []tinkv1alpha1.Interface
+}
+
+// renderTemplateConfig renders the template of config like the Tink controller does for a workflow.
+func renderTemplateConfig(t *testing.T, config *TinkerbellTemplateConfig, data map[string]interface{}) tinkerbell.Workflow {
+	t.Helper()
+	templateString, err := config.ToTemplateString()
+	if err != nil {
+		t.Fatalf("ToTemplateString() error = %v", err)
+	}
+
+	funcs := template.FuncMap{
+		"formatPartition": func(dev string, partition int) string {
+			return fmt.Sprintf("%s%d", dev, partition)
+		},
+	}
+	tmpl, err := template.New("workflow").Funcs(funcs).Option("missingkey=error").Parse(templateString)
+	if err != nil {
+		t.Fatalf("parsing template: %v", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		t.Fatalf("rendering template: %v", err)
+	}
+
+	var workflow tinkerbell.Workflow
+	if err := yaml.Unmarshal(rendered.Bytes(), &workflow); err != nil {
+		t.Fatalf("unmarshalling rendered template: %v", err)
+	}
+	return workflow
+}
@@ -0,0 +1,243 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The network configs of a TinkerbellNetworkConfig are Go templates rendered by the Tink controller
+// with the Hardware of the machine: the MAC and IP addresses of the interfaces are read from the
+// Hardware interfaces, looked up by name, and the machine IP address, gateway and nameservers from
+// its first interface, the one network booted.
+const (
+	// primaryInterface is the template of the first Hardware interface.
+	primaryInterface = "(index .Hardware.Interfaces 0).DHCP"
+
+	// bondMonitoringFrequencyMs is the MII monitoring frequency of the bond links.
+	bondMonitoringFrequencyMs = 100
+)
+
+// netplanNetworkConfig returns the netplan config of network for Ubuntu and RedHat.
+func netplanNetworkConfig(network *TinkerbellNetworkConfig) string {
+	link, addressDevice := primaryLink(network)
+	var b strings.Builder
+
+	b.WriteString("network:\n")
+	b.WriteString("  version: 2\n")
+	b.WriteString("  renderer: networkd\n")
+	b.WriteString("  ethernets:\n")
+	for _, name := range network.Interfaces {
+		writeNetplanEthernet(&b, name)
+		if name == addressDevice {
+			writeNetplanPrimaryAddress(&b, "      ")
+		}
+	}
+	for _, i := range network.AdditionalInterfaces {
+		writeNetplanEthernet(&b, i.Name)
+		if i.VLANID == 0 {
+			writeNetplanAdditionalAddress(&b, "      ", i.Name)
+		}
+	}
+
+	if network.Bond != nil {
+		b.WriteString("  bonds:\n")
+		fmt.Fprintf(&b, "    %s:\n", bondInterfaceName)
+		fmt.Fprintf(&b, "      interfaces: [%s]\n", strings.Join(network.Interfaces, ", "))
+		b.WriteString("      parameters:\n")
+		fmt.Fprintf(&b, "        mode: %s\n", network.Bond.Mode)
+		fmt.Fprintf(&b, "        mii-monitor-interval: %d\n", bondMonitoringFrequencyMs)
+		if addressDevice == bondInterfaceName {
+			writeNetplanPrimaryAddress(&b, "      ")
+		}
+	}
+
+	if network.hasVLANs() {
+		b.WriteString("  vlans:\n")
+		if network.VLANID != 0 {
+			writeNetplanVLAN(&b, link, network.VLANID)
+			writeNetplanPrimaryAddress(&b, "      ")
+		}
+		for _, i := range network.AdditionalInterfaces {
+			if i.VLANID != 0 {
+				writeNetplanVLAN(&b, i.Name, i.VLANID)
+				writeNetplanAdditionalAddress(&b, "      ", i.Name)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func writeNetplanEthernet(b *strings.Builder, name string) {
+	fmt.Fprintf(b, "    %s:\n", name)
+	b.WriteString("      match:\n")
+	fmt.Fprintf(b, "        macaddress: \"%s\"\n", hardwareInterfaceValue(name, "{{ .MAC }}"))
+	fmt.Fprintf(b, "      set-name: %s\n", name)
+	b.WriteString("      dhcp4: false\n")
+}
+
+func writeNetplanVLAN(b *strings.Builder, link string, id int) {
+	fmt.Fprintf(b, "    %s:\n", vlanInterfaceName(link, id))
+	fmt.Fprintf(b, "      id: %d\n", id)
+	fmt.Fprintf(b, "      link: %s\n", link)
+	b.WriteString("      dhcp4: false\n")
+}
+
+func writeNetplanPrimaryAddress(b *strings.Builder, indent string) {
+	fmt.Fprintf(b, "%saddresses:\n", indent)
+	fmt.Fprintf(b, "%s- %s\n", indent, primaryInterfaceValue(cidrTemplate))
+	fmt.Fprintf(b, "%sroutes:\n", indent)
+	fmt.Fprintf(b, "%s- to: default\n", indent)
+	fmt.Fprintf(b, "%s  via: %s\n", indent, primaryInterfaceValue("{{ .IP.Gateway }}"))
+	fmt.Fprintf(b, "%snameservers:\n", indent)
+	fmt.Fprintf(b, "%s  addresses: [%s]\n", indent, primaryInterfaceValue(nameserversTemplate))
+}
+
+func writeNetplanAdditionalAddress(b *strings.Builder, indent, name string) {
+	fmt.Fprintf(b, "%saddresses:\n", indent)
+	fmt.Fprintf(b, "%s- %s\n", indent, hardwareInterfaceValue(name, cidrTemplate))
+}
+
+// bottlerocketNetworkConfig returns the net.toml of network for Bottlerocket.
+func bottlerocketNetworkConfig(network *TinkerbellNetworkConfig) string {
+	link, addressDevice := primaryLink(network)
+	var b strings.Builder
+
+	b.WriteString("version = 3\n")
+
+	if network.Bond != nil {
+		fmt.Fprintf(&b, "\n[%s]\n", bondInterfaceName)
+		b.WriteString("kind = \"bond\"\n")
+		fmt.Fprintf(&b, "mode = \"%s\"\n", network.Bond.Mode)
+		fmt.Fprintf(&b, "interfaces = [%s]\n", quoteAndJoin(network.Interfaces))
+		writeBottlerocketInterfaceSettings(&b, bondInterfaceName, addressDevice == bondInterfaceName)
+		fmt.Fprintf(&b, "\n[%s.monitoring]\n", bondInterfaceName)
+		fmt.Fprintf(&b, "miimon-frequency-ms = %d\n", bondMonitoringFrequencyMs)
+		fmt.Fprintf(&b, "miimon-updelay-ms = %d\n", 2*bondMonitoringFrequencyMs)
+		fmt.Fprintf(&b, "miimon-downdelay-ms = %d\n", 2*bondMonitoringFrequencyMs)
+	} else {
+		fmt.Fprintf(&b, "\n[%s]\n", link)
+		writeBottlerocketInterfaceSettings(&b, link, addressDevice == link)
+	}
+
+	if network.VLANID != 0 {
+		name := vlanInterfaceName(link, network.VLANID)
+		fmt.Fprintf(&b, "\n[%s]\n", name)
+		b.WriteString("kind = \"vlan\"\n")
+		fmt.Fprintf(&b, "device = \"%s\"\n", link)
+		fmt.Fprintf(&b, "id = %d\n", network.VLANID)
+		writeBottlerocketInterfaceSettings(&b, name, true)
+	}
+
+	for _, i := range network.AdditionalInterfaces {
+		name := i.Name
+		fmt.Fprintf(&b, "\n[%s]\n", name)
+		if i.VLANID != 0 {
+			b.WriteString("dhcp4 = false\n")
+			name = vlanInterfaceName(i.Name, i.VLANID)
+			fmt.Fprintf(&b, "\n[%s]\n", name)
+			b.WriteString("kind = \"vlan\"\n")
+			fmt.Fprintf(&b, "device = \"%s\"\n", i.Name)
+			fmt.Fprintf(&b, "id = %d\n", i.VLANID)
+		}
+		b.WriteString("dhcp4 = false\n")
+		fmt.Fprintf(&b, "\n[%s.static4]\n", name)
+		fmt.Fprintf(&b, "addresses = [\"%s\"]\n", hardwareInterfaceValue(i.Name, cidrTemplate))
+	}
+
+	return b.String()
+}
+
+// writeBottlerocketInterfaceSettings writes the settings of the interface name. The primary interface
+// carries the machine IP address and the default route.
+func writeBottlerocketInterfaceSettings(b *strings.Builder, name string, primary bool) {
+	b.WriteString("dhcp4 = false\n")
+	if !primary {
+		return
+	}
+	b.WriteString("primary = true\n")
+	fmt.Fprintf(b, "\n[%s.static4]\n", name)
+	fmt.Fprintf(b, "addresses = [\"%s\"]\n", primaryInterfaceValue(cidrTemplate))
+	fmt.Fprintf(b, "\n[[%s.route]]\n", name)
+	b.WriteString("to = \"default\"\n")
+	fmt.Fprintf(b, "via = \"%s\"\n", primaryInterfaceValue("{{ .IP.Gateway }}"))
+}
+
+// primaryLink returns the link carrying the machine IP address, a bond or a single interface, and
+// the device the address is set on, the link or its VLAN.
+func primaryLink(network *TinkerbellNetworkConfig) (link, addressDevice string) {
+	link = network.Interfaces[0]
+	if network.Bond != nil {
+		link = bondInterfaceName
+	}
+
+	if network.VLANID != 0 {
+		return link, vlanInterfaceName(link, network.VLANID)
+	}
+	return link, link
+}
+
+func vlanInterfaceName(link string, id int) string {
+	return fmt.Sprintf("%s.%d", link, id)
+}
+
+func (n *TinkerbellNetworkConfig) hasVLANs() bool {
+	if n.VLANID != 0 {
+		return true
+	}
+	for _, i := range n.AdditionalInterfaces {
+		if i.VLANID != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAndJoin(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// primaryInterfaceValue returns a template rendering value with the DHCP settings of the first
+// Hardware interface.
+func primaryInterfaceValue(value string) string {
+	return fmt.Sprintf("{{ with %s }}%s{{ end }}", primaryInterface, value)
+}
+
+// hardwareInterfaceValue returns a template rendering value with the DHCP settings of the Hardware
+// interface named name.
+func hardwareInterfaceValue(name, value string) string {
+	return fmt.Sprintf(`{{ range .Hardware.Interfaces }}{{ with .DHCP }}{{ if eq .IfaceName "%s" }}%s{{ end }}{{ end }}{{ end }}`, name, value)
+}
+
+const nameserversTemplate = `{{ range $i, $ns := .NameServers }}{{ if $i }}, {{ end }}{{ $ns }}{{ end }}`
+
+// cidrTemplate renders the address of DHCP settings in CIDR notation. The Hardware only has the
+// netmask and templates rendered by the Tink controller can only use the builtin functions, so
+// the prefix length is looked up.
+var cidrTemplate = "{{ .IP.Address }}/" + prefixLengthTemplate(".IP.Netmask")
+
+func prefixLengthTemplate(netmask string) string {
+	var b strings.Builder
+	for ones := 32; ones >= 0; ones-- {
+		if ones == 32 {
+			b.WriteString("{{ if ")
+		} else {
+			b.WriteString("{{ else if ")
+		}
+		fmt.Fprintf(&b, "eq %s \"%s\" }}%d", netmask, netmaskString(ones), ones)
+	}
+	b.WriteString("{{ end }}")
+	return b.String()
+}
+
+func netmaskString(ones int) string {
+	mask := uint32(0)
+	if ones > 0 {
+		mask = ^uint32(0) << (32 - ones)
+	}
+	return fmt.Sprintf("%d.%d.%d.%d", mask>>24, (mask>>16)&0xff, (mask>>8)&0xff, mask&0xff)
+}
@@ -22,6 +22,32 @@ type VSphereDatacenterConfigSpec struct {
 	Thumbprint     string          `json:"thumbprint"`
 	Insecure       bool            `json:"insecure"`
 	FailureDomains []FailureDomain `json:"failureDomains,omitempty"`
+	// IPPools are static IP address pools that VSphereMachineConfigs can reference to
+	// assign addresses to their VMs instead of relying on DHCP.
+	IPPools []VSphereIPPool `json:"ipPools,omitempty"`
+}
+
+// VSphereIPPool defines a set of static IP addresses assigned to node VMs by the CAPI in-cluster IPAM provider.
+type VSphereIPPool struct {
+	// +kubebuilder:validation:Required
+	// Name is used as a unique identifier for each pool and referenced by VSphereMachineConfig ipPool.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// Addresses is a list of single IPs (10.0.0.10), ranges (10.0.0.10-10.0.0.20) or CIDRs (10.0.0.0/27)
+	// that can be assigned to nodes.
+	Addresses []string `json:"addresses"`
+
+	// +kubebuilder:validation:Required
+	// Prefix is the network prefix length of the subnet the addresses belong to.
+	Prefix int `json:"prefix"`
+
+	// +kubebuilder:validation:Required
+	// Gateway is the default gateway configured on the nodes. It's never assigned to a node.
+	Gateway string `json:"gateway"`
+
+	// Nameservers is a list of DNS servers configured on the nodes.
+	Nameservers []string `json:"nameservers,omitempty"`
 }
 
 // FailureDomain defines the list of failure domains to spread the VMs across.
@@ -177,6 +203,20 @@ func (v *VSphereDatacenterConfig) Validate() error {
 		}
 	}
 
+	if err := validateVSphereIPPools(v.Spec.IPPools); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IPPool returns the ip pool with the given name or nil if it doesn't exist.
+func (v *VSphereDatacenterConfig) IPPool(name string) *VSphereIPPool {
+	for i := range v.Spec.IPPools {
+		if v.Spec.IPPools[i].Name == name {
+			return &v.Spec.IPPools[i]
+		}
+	}
 	return nil
 }
 
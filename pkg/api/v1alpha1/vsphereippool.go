@@ -0,0 +1,232 @@
+package v1alpha1
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+)
+
+// ipv4Range is an inclusive range of IPv4 addresses.
+type ipv4Range struct {
+	first, last uint32
+}
+
+func (r ipv4Range) contains(ip uint32) bool {
+	return ip >= r.first && ip <= r.last
+}
+
+func validateVSphereIPPools(pools []VSphereIPPool) error {
+	names := make(map[string]struct{}, len(pools))
+	for i := range pools {
+		pool := &pools[i]
+		if len(pool.Name) == 0 {
+			return fmt.Errorf("VSphereDatacenterConfig ipPools[%d].name can not be empty", i)
+		}
+		if _, ok := names[pool.Name]; ok {
+			return fmt.Errorf("VSphereDatacenterConfig ipPool name %s is duplicated", pool.Name)
+		}
+		names[pool.Name] = struct{}{}
+
+		if err := pool.validate(); err != nil {
+			return fmt.Errorf("VSphereDatacenterConfig ipPool %s is invalid: %v", pool.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *VSphereIPPool) validate() error {
+	if len(p.Addresses) == 0 {
+		return errors.New("addresses can not be empty")
+	}
+
+	if p.Prefix < 1 || p.Prefix > 32 {
+		return fmt.Errorf("prefix %d should be between 1 and 32", p.Prefix)
+	}
+
+	gateway, err := parseIPv4(p.Gateway)
+	if err != nil {
+		return fmt.Errorf("gateway is invalid: %v", err)
+	}
+	subnet := netip.PrefixFrom(gateway, p.Prefix).Masked()
+
+	for _, address := range p.Addresses {
+		r, err := parseIPPoolAddress(address)
+		if err != nil {
+			return err
+		}
+		if !subnet.Contains(uint32ToAddr(r.first)) || !subnet.Contains(uint32ToAddr(r.last)) {
+			return fmt.Errorf("address %s is not within the %s subnet", address, subnet)
+		}
+	}
+
+	for _, nameserver := range p.Nameservers {
+		if _, err := netip.ParseAddr(nameserver); err != nil {
+			return fmt.Errorf("nameserver %s is invalid", nameserver)
+		}
+	}
+
+	return nil
+}
+
+// Size returns the number of addresses in the pool that can be assigned to nodes. Like the
+// in-cluster IPAM provider, it excludes the gateway and the subnet network and broadcast addresses.
+func (p *VSphereIPPool) Size() (int, error) {
+	ranges, err := p.ranges()
+	if err != nil {
+		return 0, err
+	}
+
+	size := 0
+	for _, r := range ranges {
+		size += int(r.last-r.first) + 1
+	}
+
+	for _, reserved := range p.reservedAddresses() {
+		for _, r := range ranges {
+			if r.contains(reserved) {
+				size--
+				break
+			}
+		}
+	}
+
+	return size, nil
+}
+
+// Contains returns true if ip is one of the addresses in the pool.
+func (p *VSphereIPPool) Contains(ip string) bool {
+	addr, err := parseIPv4(ip)
+	if err != nil {
+		return false
+	}
+	ranges, err := p.ranges()
+	if err != nil {
+		return false
+	}
+
+	for _, r := range ranges {
+		if r.contains(addrToUint32(addr)) {
+			return true
+		}
+	}
+	return false
+}
+
+// IPRanges returns the pool addresses as sorted, non overlapping ranges.
+func (p *VSphereIPPool) IPRanges() ([]IPRange, error) {
+	ranges, err := p.ranges()
+	if err != nil {
+		return nil, err
+	}
+
+	ipRanges := make([]IPRange, 0, len(ranges))
+	for _, r := range ranges {
+		ipRanges = append(ipRanges, IPRange{First: uint32ToAddr(r.first), Last: uint32ToAddr(r.last)})
+	}
+	return ipRanges, nil
+}
+
+// ranges returns the pool addresses as sorted, non overlapping ranges.
+func (p *VSphereIPPool) ranges() ([]ipv4Range, error) {
+	ranges := make([]ipv4Range, 0, len(p.Addresses))
+	for _, address := range p.Addresses {
+		r, err := parseIPPoolAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].first < ranges[j].first
+	})
+
+	merged := make([]ipv4Range, 0, len(ranges))
+	for _, r := range ranges {
+		last := len(merged) - 1
+		if last >= 0 && r.first <= merged[last].last {
+			if r.last > merged[last].last {
+				merged[last].last = r.last
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged, nil
+}
+
+func (p *VSphereIPPool) reservedAddresses() []uint32 {
+	gateway, err := parseIPv4(p.Gateway)
+	if err != nil {
+		return nil
+	}
+	reserved := []uint32{addrToUint32(gateway)}
+
+	// /31 and /32 subnets don't have network and broadcast addresses.
+	if p.Prefix > 0 && p.Prefix < 31 {
+		network := addrToUint32(netip.PrefixFrom(gateway, p.Prefix).Masked().Addr())
+		broadcast := network | (1<<(32-p.Prefix) - 1)
+		reserved = append(reserved, network, broadcast)
+	}
+
+	return reserved
+}
+
+func parseIPPoolAddress(address string) (ipv4Range, error) {
+	if strings.Contains(address, "/") {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil || !prefix.Addr().Is4() {
+			return ipv4Range{}, fmt.Errorf("address %s is not a valid IPv4 CIDR", address)
+		}
+		first := addrToUint32(prefix.Masked().Addr())
+		return ipv4Range{first: first, last: first | (1<<(32-prefix.Bits()) - 1)}, nil
+	}
+
+	if start, end, ok := strings.Cut(address, "-"); ok {
+		first, err := parseIPv4(strings.TrimSpace(start))
+		if err != nil {
+			return ipv4Range{}, fmt.Errorf("address range %s is invalid: %v", address, err)
+		}
+		last, err := parseIPv4(strings.TrimSpace(end))
+		if err != nil {
+			return ipv4Range{}, fmt.Errorf("address range %s is invalid: %v", address, err)
+		}
+		if last.Less(first) {
+			return ipv4Range{}, fmt.Errorf("address range %s is invalid: start should be smaller than end", address)
+		}
+		return ipv4Range{first: addrToUint32(first), last: addrToUint32(last)}, nil
+	}
+
+	ip, err := parseIPv4(address)
+	if err != nil {
+		return ipv4Range{}, fmt.Errorf("address %s is invalid: %v", address, err)
+	}
+	return ipv4Range{first: addrToUint32(ip), last: addrToUint32(ip)}, nil
+}
+
+func parseIPv4(ip string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("%s is not a valid IP", ip)
+	}
+	if !addr.Is4() {
+		return netip.Addr{}, fmt.Errorf("%s is not an IPv4 address, only IPv4 is supported", ip)
+	}
+	return addr, nil
+}
+
+func addrToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func uint32ToAddr(ip uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], ip)
+	return netip.AddrFrom4(b)
+}
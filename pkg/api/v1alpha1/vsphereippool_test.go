@@ -0,0 +1,155 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func validVSphereDatacenterConfigWithIPPools(pools ...v1alpha1.VSphereIPPool) *v1alpha1.VSphereDatacenterConfig {
+	return &v1alpha1.VSphereDatacenterConfig{
+		Spec: v1alpha1.VSphereDatacenterConfigSpec{
+			Datacenter: "SDDC-Datacenter",
+			Network:    "/SDDC-Datacenter/network/default",
+			Server:     "vcenter.com",
+			IPPools:    pools,
+		},
+	}
+}
+
+func TestVSphereDatacenterConfigValidateIPPools(t *testing.T) {
+	tests := []struct {
+		name    string
+		pools   []v1alpha1.VSphereIPPool
+		wantErr string
+	}{
+		{
+			name: "valid",
+			pools: []v1alpha1.VSphereIPPool{
+				{
+					Name:        "nodes",
+					Addresses:   []string{"10.0.0.10-10.0.0.20", "10.0.0.64/28", "10.0.0.100"},
+					Prefix:      24,
+					Gateway:     "10.0.0.1",
+					Nameservers: []string{"10.0.0.2"},
+				},
+			},
+		},
+		{
+			name:    "empty name",
+			pools:   []v1alpha1.VSphereIPPool{{Addresses: []string{"10.0.0.10"}, Prefix: 24, Gateway: "10.0.0.1"}},
+			wantErr: "ipPools[0].name can not be empty",
+		},
+		{
+			name: "duplicated name",
+			pools: []v1alpha1.VSphereIPPool{
+				{Name: "nodes", Addresses: []string{"10.0.0.10"}, Prefix: 24, Gateway: "10.0.0.1"},
+				{Name: "nodes", Addresses: []string{"10.0.0.11"}, Prefix: 24, Gateway: "10.0.0.1"},
+			},
+			wantErr: "ipPool name nodes is duplicated",
+		},
+		{
+			name:    "no addresses",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Prefix: 24, Gateway: "10.0.0.1"}},
+			wantErr: "addresses can not be empty",
+		},
+		{
+			name:    "invalid prefix",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Addresses: []string{"10.0.0.10"}, Prefix: 33, Gateway: "10.0.0.1"}},
+			wantErr: "prefix 33 should be between 1 and 32",
+		},
+		{
+			name:    "invalid gateway",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Addresses: []string{"10.0.0.10"}, Prefix: 24, Gateway: "gateway"}},
+			wantErr: "gateway is invalid",
+		},
+		{
+			name:    "ipv6 gateway",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Addresses: []string{"10.0.0.10"}, Prefix: 24, Gateway: "fd00::1"}},
+			wantErr: "only IPv4 is supported",
+		},
+		{
+			name:    "invalid range",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Addresses: []string{"10.0.0.20-10.0.0.10"}, Prefix: 24, Gateway: "10.0.0.1"}},
+			wantErr: "start should be smaller than end",
+		},
+		{
+			name:    "invalid cidr",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Addresses: []string{"10.0.0.0/33"}, Prefix: 24, Gateway: "10.0.0.1"}},
+			wantErr: "address 10.0.0.0/33 is not a valid IPv4 CIDR",
+		},
+		{
+			name:    "address outside subnet",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Addresses: []string{"10.0.1.10"}, Prefix: 24, Gateway: "10.0.0.1"}},
+			wantErr: "address 10.0.1.10 is not within the 10.0.0.0/24 subnet",
+		},
+		{
+			name:    "invalid nameserver",
+			pools:   []v1alpha1.VSphereIPPool{{Name: "nodes", Addresses: []string{"10.0.0.10"}, Prefix: 24, Gateway: "10.0.0.1", Nameservers: []string{"dns"}}},
+			wantErr: "nameserver dns is invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validVSphereDatacenterConfigWithIPPools(tt.pools...).Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestVSphereIPPoolSize(t *testing.T) {
+	tests := []struct {
+		name string
+		pool v1alpha1.VSphereIPPool
+		want int
+	}{
+		{
+			name: "range",
+			pool: v1alpha1.VSphereIPPool{Addresses: []string{"10.0.0.10-10.0.0.19"}, Prefix: 24, Gateway: "10.0.0.1"},
+			want: 10,
+		},
+		{
+			name: "overlapping addresses",
+			pool: v1alpha1.VSphereIPPool{Addresses: []string{"10.0.0.10-10.0.0.19", "10.0.0.15-10.0.0.24", "10.0.0.12"}, Prefix: 24, Gateway: "10.0.0.1"},
+			want: 15,
+		},
+		{
+			name: "cidr excludes gateway, network and broadcast",
+			pool: v1alpha1.VSphereIPPool{Addresses: []string{"10.0.0.0/24"}, Prefix: 24, Gateway: "10.0.0.1"},
+			want: 253,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.pool.Size()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestVSphereIPPoolContains(t *testing.T) {
+	g := NewWithT(t)
+	pool := v1alpha1.VSphereIPPool{Addresses: []string{"10.0.0.10-10.0.0.19", "10.0.1.0/28"}, Prefix: 16, Gateway: "10.0.0.1"}
+
+	g.Expect(pool.Contains("10.0.0.15")).To(BeTrue())
+	g.Expect(pool.Contains("10.0.1.3")).To(BeTrue())
+	g.Expect(pool.Contains("10.0.0.20")).To(BeFalse())
+	g.Expect(pool.Contains("not-an-ip")).To(BeFalse())
+}
+
+func TestVSphereDatacenterConfigIPPool(t *testing.T) {
+	g := NewWithT(t)
+	config := validVSphereDatacenterConfigWithIPPools(v1alpha1.VSphereIPPool{Name: "nodes"})
+
+	g.Expect(config.IPPool("nodes")).To(Equal(&config.Spec.IPPools[0]))
+	g.Expect(config.IPPool("other")).To(BeNil())
+}
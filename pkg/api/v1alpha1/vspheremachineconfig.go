@@ -2,6 +2,9 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net/netip"
+	"path"
+	"regexp"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -93,6 +96,98 @@ func validateVSphereMachineConfig(config *VSphereMachineConfig) error {
 	if err := validateHostOSConfig(config.Spec.HostOSConfiguration, config.Spec.OSFamily); err != nil {
 		return fmt.Errorf("HostOSConfiguration is invalid for VSphereMachineConfig %s: %v", config.Name, err)
 	}
+	if err := validateVSphereMachineNetworks(config.Spec.Networks); err != nil {
+		return fmt.Errorf("VSphereMachineConfig %s networks are invalid: %v", config.Name, err)
+	}
+	if err := validateVSphereAdditionalDisks(config); err != nil {
+		return fmt.Errorf("VSphereMachineConfig %s additionalDisks are invalid: %v", config.Name, err)
+	}
+	for _, device := range config.Spec.PCIDevices {
+		if device.VendorID <= 0 || device.VendorID > 0xffff || device.DeviceID <= 0 || device.DeviceID > 0xffff {
+			return fmt.Errorf("VSphereMachineConfig %s pciDevices vendorID %d and deviceID %d must be between 1 and 0xffff", config.Name, device.VendorID, device.DeviceID)
+		}
+	}
+
+	return nil
+}
+
+func validateVSphereMachineNetworks(networks []VSphereMachineNetwork) error {
+	seen := make(map[string]struct{}, len(networks))
+	for i, network := range networks {
+		if network.Network == "" {
+			return fmt.Errorf("networks[%d].network can not be empty", i)
+		}
+		if _, ok := seen[network.Network]; ok {
+			return fmt.Errorf("network %s is duplicated", network.Network)
+		}
+		seen[network.Network] = struct{}{}
+
+		for _, route := range network.Routes {
+			if _, err := netip.ParsePrefix(route.To); err != nil {
+				return fmt.Errorf("route destination %s for network %s is not a valid CIDR", route.To, network.Network)
+			}
+			if _, err := netip.ParseAddr(route.Via); err != nil {
+				return fmt.Errorf("route gateway %s for network %s is not a valid IP", route.Via, network.Network)
+			}
+			if route.Metric < 0 {
+				return fmt.Errorf("route metric for network %s can not be negative", network.Network)
+			}
+		}
+	}
+
+	return nil
+}
+
+// diskNameRegex restricts disk names to valid filesystem labels, xfs labels are at most 12 characters long.
+var diskNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,10}[a-z0-9])?$`)
+
+func validateVSphereAdditionalDisks(config *VSphereMachineConfig) error {
+	disks := config.Spec.AdditionalDisks
+	osFamily := config.Spec.OSFamily
+	names := make(map[string]struct{}, len(disks))
+	mountPaths := make(map[string]struct{}, len(disks))
+	for i, disk := range disks {
+		if !diskNameRegex.MatchString(disk.Name) {
+			return fmt.Errorf("additionalDisks[%d].name %q must be at most 12 lowercase alphanumeric characters or '-'", i, disk.Name)
+		}
+		if _, ok := names[disk.Name]; ok {
+			return fmt.Errorf("disk name %s is duplicated", disk.Name)
+		}
+		names[disk.Name] = struct{}{}
+
+		if disk.SizeGiB <= 0 {
+			return fmt.Errorf("disk %s sizeGiB must be greater than 0", disk.Name)
+		}
+
+		// CAPV clones the data disks with the VM, so they can only live where the OS disk does.
+		if disk.Datastore != "" && disk.Datastore != config.Spec.Datastore {
+			return fmt.Errorf("disk %s datastore %s must be the machine config datastore %s, the disks are cloned with the VM", disk.Name, disk.Datastore, config.Spec.Datastore)
+		}
+		if disk.StoragePolicyName != "" && disk.StoragePolicyName != config.Spec.StoragePolicyName {
+			return fmt.Errorf("disk %s storagePolicyName %s must be the machine config storagePolicyName %q, the disks are cloned with the VM", disk.Name, disk.StoragePolicyName, config.Spec.StoragePolicyName)
+		}
+
+		if disk.Filesystem != "" && disk.Filesystem != "ext4" && disk.Filesystem != "xfs" {
+			return fmt.Errorf("disk %s filesystem %s is not supported, please use ext4 or xfs", disk.Name, disk.Filesystem)
+		}
+
+		if disk.MountPath == "" {
+			if disk.Filesystem != "" {
+				return fmt.Errorf("disk %s filesystem requires a mountPath", disk.Name)
+			}
+			continue
+		}
+		if osFamily == Bottlerocket {
+			return fmt.Errorf("disk %s mountPath is not supported for %s", disk.Name, Bottlerocket)
+		}
+		if !path.IsAbs(disk.MountPath) || path.Clean(disk.MountPath) == "/" {
+			return fmt.Errorf("disk %s mountPath %s must be an absolute path other than /", disk.Name, disk.MountPath)
+		}
+		if _, ok := mountPaths[path.Clean(disk.MountPath)]; ok {
+			return fmt.Errorf("disk %s mountPath %s is duplicated", disk.Name, disk.MountPath)
+		}
+		mountPaths[path.Clean(disk.MountPath)] = struct{}{}
+	}
 
 	return nil
 }
@@ -200,6 +200,189 @@ func TestVSphereMachineConfigValidate(t *testing.T) {
 	}
 }
 
+func TestVSphereMachineConfigValidateNetworksAndDisks(t *testing.T) {
+	tests := []struct {
+		name     string
+		osFamily OSFamily
+		networks []VSphereMachineNetwork
+		disks    []VSphereAdditionalDisk
+		wantErr  string
+	}{
+		{
+			name:     "valid",
+			osFamily: Ubuntu,
+			networks: []VSphereMachineNetwork{
+				{Network: "storage", IPPool: "storage", Routes: []VSphereNetworkRoute{{To: "10.1.0.0/16", Via: "10.0.0.1", Metric: 10}}},
+				{Network: "backup"},
+			},
+			disks: []VSphereAdditionalDisk{
+				{Name: "data", SizeGiB: 100, MountPath: "/var/lib/data", Filesystem: "xfs"},
+				{Name: "raw-0", SizeGiB: 50},
+			},
+		},
+		{
+			name:     "empty network",
+			osFamily: Ubuntu,
+			networks: []VSphereMachineNetwork{{}},
+			wantErr:  "networks are invalid: networks[0].network can not be empty",
+		},
+		{
+			name:     "duplicated network",
+			osFamily: Ubuntu,
+			networks: []VSphereMachineNetwork{{Network: "storage"}, {Network: "storage"}},
+			wantErr:  "network storage is duplicated",
+		},
+		{
+			name:     "invalid route destination",
+			osFamily: Ubuntu,
+			networks: []VSphereMachineNetwork{{Network: "storage", Routes: []VSphereNetworkRoute{{To: "10.1.0.0", Via: "10.0.0.1"}}}},
+			wantErr:  "route destination 10.1.0.0 for network storage is not a valid CIDR",
+		},
+		{
+			name:     "invalid route gateway",
+			osFamily: Ubuntu,
+			networks: []VSphereMachineNetwork{{Network: "storage", Routes: []VSphereNetworkRoute{{To: "10.1.0.0/16", Via: "gateway"}}}},
+			wantErr:  "route gateway gateway for network storage is not a valid IP",
+		},
+		{
+			name:     "invalid disk name",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "Data_Disk", SizeGiB: 10}},
+			wantErr:  `additionalDisks[0].name "Data_Disk" must be at most 12 lowercase alphanumeric characters or '-'`,
+		},
+		{
+			name:     "duplicated disk name",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10}, {Name: "data", SizeGiB: 10}},
+			wantErr:  "disk name data is duplicated",
+		},
+		{
+			name:     "invalid disk size",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data"}},
+			wantErr:  "disk data sizeGiB must be greater than 0",
+		},
+		{
+			name:     "unsupported filesystem",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10, MountPath: "/data", Filesystem: "btrfs"}},
+			wantErr:  "disk data filesystem btrfs is not supported",
+		},
+		{
+			name:     "filesystem without mount path",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10, Filesystem: "ext4"}},
+			wantErr:  "disk data filesystem requires a mountPath",
+		},
+		{
+			name:     "relative mount path",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10, MountPath: "data"}},
+			wantErr:  "disk data mountPath data must be an absolute path other than /",
+		},
+		{
+			name:     "duplicated mount path",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "a", SizeGiB: 10, MountPath: "/data"}, {Name: "b", SizeGiB: 10, MountPath: "/data/"}},
+			wantErr:  "disk b mountPath /data/ is duplicated",
+		},
+		{
+			name:     "mount path on bottlerocket",
+			osFamily: Bottlerocket,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10, MountPath: "/data"}},
+			wantErr:  "disk data mountPath is not supported for bottlerocket",
+		},
+		{
+			name:     "machine config datastore",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10, Datastore: "ds-aaa"}},
+		},
+		{
+			name:     "different datastore",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10, Datastore: "ds-bbb"}},
+			wantErr:  "disk data datastore ds-bbb must be the machine config datastore ds-aaa",
+		},
+		{
+			name:     "different storage policy",
+			osFamily: Ubuntu,
+			disks:    []VSphereAdditionalDisk{{Name: "data", SizeGiB: 10, StoragePolicyName: "fast"}},
+			wantErr:  `disk data storagePolicyName fast must be the machine config storagePolicyName ""`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: VSphereMachineConfigSpec{
+					Template:        "templateA",
+					ResourcePool:    "poolA",
+					Datastore:       "ds-aaa",
+					OSFamily:        tt.osFamily,
+					Users:           []UserConfiguration{{Name: "capv", SshAuthorizedKeys: []string{"ssh_rsa"}}},
+					Networks:        tt.networks,
+					AdditionalDisks: tt.disks,
+				},
+			}
+			if tt.osFamily == Bottlerocket {
+				config.Spec.Users[0].Name = "ec2-user"
+			}
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestVSphereMachineConfigValidatePCIDevices(t *testing.T) {
+	tests := []struct {
+		name    string
+		devices []VSpherePCIDevice
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			devices: []VSpherePCIDevice{{VendorID: 0x10de, DeviceID: 0x20b5}, {VendorID: 0x10de, DeviceID: 0x20b5}},
+		},
+		{
+			name:    "missing vendor",
+			devices: []VSpherePCIDevice{{DeviceID: 0x20b5}},
+			wantErr: "VSphereMachineConfig test pciDevices vendorID 0 and deviceID 8373 must be between 1 and 0xffff",
+		},
+		{
+			name:    "device out of range",
+			devices: []VSpherePCIDevice{{VendorID: 0x10de, DeviceID: 0x10000}},
+			wantErr: "VSphereMachineConfig test pciDevices vendorID 4318 and deviceID 65536 must be between 1 and 0xffff",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: VSphereMachineConfigSpec{
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					OSFamily:     Ubuntu,
+					Users:        []UserConfiguration{{Name: "capv", SshAuthorizedKeys: []string{"ssh_rsa"}}},
+					PCIDevices:   tt.devices,
+				},
+			}
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
 func TestVSphereMachineConfigValidateUsers(t *testing.T) {
 	g := NewWithT(t)
 	tests := []struct {
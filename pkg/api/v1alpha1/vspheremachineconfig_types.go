@@ -28,6 +28,76 @@ type VSphereMachineConfigSpec struct {
 	TagIDs              []string             `json:"tags,omitempty"`
 	CloneMode           CloneMode            `json:"cloneMode,omitempty"`
 	HostOSConfiguration *HostOSConfiguration `json:"hostOSConfiguration,omitempty"`
+	// IPPool is the name of one of the VSphereDatacenterConfig ipPools to assign static
+	// IP addresses from. The VMs use DHCP when it's not set.
+	IPPool string `json:"ipPool,omitempty"`
+	// Networks are extra NICs attached to the VMs on top of the datacenter network.
+	Networks []VSphereMachineNetwork `json:"networks,omitempty"`
+	// AdditionalDisks set the size of the template data disks that follow the OS disk.
+	// vSphere only resizes the disks cloned from the template, it doesn't add new ones.
+	AdditionalDisks []VSphereAdditionalDisk `json:"additionalDisks,omitempty"`
+	// PCIDevices are host PCI devices, like GPUs, passed through to the VMs. The VM memory
+	// is fully reserved when set.
+	PCIDevices []VSpherePCIDevice `json:"pciDevices,omitempty"`
+}
+
+// VSpherePCIDevice identifies a PCI device by its vendor and device IDs, as reported by lspci.
+type VSpherePCIDevice struct {
+	// VendorID is the PCI vendor ID, for example 0x10de for NVIDIA.
+	// +kubebuilder:validation:Required
+	VendorID int32 `json:"vendorID"`
+	// DeviceID is the PCI device ID, for example 0x20b5 for a NVIDIA A100 80GB.
+	// +kubebuilder:validation:Required
+	DeviceID int32 `json:"deviceID"`
+}
+
+// VSphereMachineNetwork defines an extra NIC for the VMs.
+type VSphereMachineNetwork struct {
+	// Network is the vSphere network (portgroup) the NIC is connected to.
+	// +kubebuilder:validation:Required
+	Network string `json:"network"`
+	// IPPool is the name of one of the VSphereDatacenterConfig ipPools to assign the NIC
+	// address from. The NIC uses DHCP when it's not set.
+	IPPool string `json:"ipPool,omitempty"`
+	// Routes are static routes configured through the NIC.
+	Routes []VSphereNetworkRoute `json:"routes,omitempty"`
+}
+
+// VSphereNetworkRoute defines a static route.
+type VSphereNetworkRoute struct {
+	// To is the destination CIDR of the route.
+	// +kubebuilder:validation:Required
+	To string `json:"to"`
+	// Via is the gateway for the route.
+	// +kubebuilder:validation:Required
+	Via string `json:"via"`
+	// Metric is the route metric.
+	Metric int32 `json:"metric,omitempty"`
+}
+
+// VSphereAdditionalDisk defines a data disk for the VMs. The VM template must already have
+// a disk in the same position, vSphere resizes it to SizeGiB when cloning the VM. The disks
+// are cloned with the VM, on the machine config datastore and with its storage policy, they
+// can't be placed on a different datastore.
+type VSphereAdditionalDisk struct {
+	// Name identifies the disk and is used as the filesystem label.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// SizeGiB is the size of the disk.
+	// +kubebuilder:validation:Required
+	SizeGiB int `json:"sizeGiB"`
+	// MountPath is where the disk is mounted on the node. The disk is left unformatted
+	// when it's not set.
+	MountPath string `json:"mountPath,omitempty"`
+	// Filesystem is the filesystem the disk is formatted with, ext4 by default.
+	// +kubebuilder:validation:Enum=ext4;xfs
+	Filesystem string `json:"filesystem,omitempty"`
+	// Datastore is the datastore the disk is placed on. It must be the VSphereMachineConfig
+	// datastore, the machine config one is used when it's not set.
+	Datastore string `json:"datastore,omitempty"`
+	// StoragePolicyName is the storage policy of the disk. It must be the VSphereMachineConfig
+	// storage policy, the machine config one is used when it's not set.
+	StoragePolicyName string `json:"storagePolicyName,omitempty"`
 }
 
 // ResourcePaths returns a map of vSphere resource paths defined in the VSphereMachineConfig.
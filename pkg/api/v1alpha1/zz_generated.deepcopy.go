@@ -310,6 +310,41 @@ func (in *CNIConfig) DeepCopy() *CNIConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumBGPControlPlaneConfig) DeepCopyInto(out *CiliumBGPControlPlaneConfig) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]CiliumBGPPeer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumBGPControlPlaneConfig.
+func (in *CiliumBGPControlPlaneConfig) DeepCopy() *CiliumBGPControlPlaneConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumBGPControlPlaneConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumBGPPeer) DeepCopyInto(out *CiliumBGPPeer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumBGPPeer.
+func (in *CiliumBGPPeer) DeepCopy() *CiliumBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumBGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CiliumConfig) DeepCopyInto(out *CiliumConfig) {
 	*out = *in
@@ -318,6 +353,16 @@ func (in *CiliumConfig) DeepCopyInto(out *CiliumConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Hubble != nil {
+		in, out := &in.Hubble, &out.Hubble
+		*out = new(CiliumHubbleConfig)
+		**out = **in
+	}
+	if in.BGPControlPlane != nil {
+		in, out := &in.BGPControlPlane, &out.BGPControlPlane
+		*out = new(CiliumBGPControlPlaneConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumConfig.
@@ -330,6 +375,21 @@ func (in *CiliumConfig) DeepCopy() *CiliumConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumHubbleConfig) DeepCopyInto(out *CiliumHubbleConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumHubbleConfig.
+func (in *CiliumHubbleConfig) DeepCopy() *CiliumHubbleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumHubbleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudStackAvailabilityZone) DeepCopyInto(out *CloudStackAvailabilityZone) {
 	*out = *in
@@ -786,6 +846,16 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			}
 		}
 	}
+	if in.EtcdBackup != nil {
+		in, out := &in.EtcdBackup, &out.EtcdBackup
+		*out = new(EtcdBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -823,6 +893,30 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CertificateExpirations != nil {
+		in, out := &in.CertificateExpirations, &out.CertificateExpirations
+		*out = make([]MachineCertificateExpiry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CertificateSecretExpirations != nil {
+		in, out := &in.CertificateSecretExpirations, &out.CertificateSecretExpirations
+		*out = make([]SecretCertificateExpiry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EtcdBackup != nil {
+		in, out := &in.EtcdBackup, &out.EtcdBackup
+		*out = new(EtcdBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]PackageInstallStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -1169,6 +1263,120 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackup) DeepCopyInto(out *EtcdBackup) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackup.
+func (in *EtcdBackup) DeepCopy() *EtcdBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupDestination) DeepCopyInto(out *EtcdBackupDestination) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(EtcdBackupPVCDestination)
+		**out = **in
+	}
+	if in.HostPath != nil {
+		in, out := &in.HostPath, &out.HostPath
+		*out = new(EtcdBackupHostPathDestination)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(EtcdBackupS3Destination)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupDestination.
+func (in *EtcdBackupDestination) DeepCopy() *EtcdBackupDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupHostPathDestination) DeepCopyInto(out *EtcdBackupHostPathDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupHostPathDestination.
+func (in *EtcdBackupHostPathDestination) DeepCopy() *EtcdBackupHostPathDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupHostPathDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupPVCDestination) DeepCopyInto(out *EtcdBackupPVCDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupPVCDestination.
+func (in *EtcdBackupPVCDestination) DeepCopy() *EtcdBackupPVCDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupPVCDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupS3Destination) DeepCopyInto(out *EtcdBackupS3Destination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupS3Destination.
+func (in *EtcdBackupS3Destination) DeepCopy() *EtcdBackupS3Destination {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupS3Destination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupStatus) DeepCopyInto(out *EtcdBackupStatus) {
+	*out = *in
+	if in.LastSuccessfulSnapshotTime != nil {
+		in, out := &in.LastSuccessfulSnapshotTime, &out.LastSuccessfulSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailedSnapshotTime != nil {
+		in, out := &in.LastFailedSnapshotTime, &out.LastFailedSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupStatus.
+func (in *EtcdBackupStatus) DeepCopy() *EtcdBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdEncryption) DeepCopyInto(out *EtcdEncryption) {
 	*out = *in
@@ -1622,6 +1830,104 @@ func (in *KindnetdConfig) DeepCopy() *KindnetdConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAddressPool) DeepCopyInto(out *LoadBalancerAddressPool) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAddressPool.
+func (in *LoadBalancerAddressPool) DeepCopy() *LoadBalancerAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerBGPConfiguration) DeepCopyInto(out *LoadBalancerBGPConfiguration) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]LoadBalancerBGPPeer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerBGPConfiguration.
+func (in *LoadBalancerBGPConfiguration) DeepCopy() *LoadBalancerBGPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerBGPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerBGPPeer) DeepCopyInto(out *LoadBalancerBGPPeer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerBGPPeer.
+func (in *LoadBalancerBGPPeer) DeepCopy() *LoadBalancerBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerBGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfiguration) DeepCopyInto(out *LoadBalancerConfiguration) {
+	*out = *in
+	if in.AddressPools != nil {
+		in, out := &in.AddressPools, &out.AddressPools
+		*out = make([]LoadBalancerAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(LoadBalancerBGPConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfiguration.
+func (in *LoadBalancerConfiguration) DeepCopy() *LoadBalancerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCertificateExpiry) DeepCopyInto(out *MachineCertificateExpiry) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCertificateExpiry.
+func (in *MachineCertificateExpiry) DeepCopy() *MachineCertificateExpiry {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCertificateExpiry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentUpgrade) DeepCopyInto(out *MachineDeploymentUpgrade) {
 	*out = *in
@@ -1932,6 +2238,11 @@ func (in *Nodes) DeepCopyInto(out *Nodes) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CIDRMaskSizeIPv6 != nil {
+		in, out := &in.CIDRMaskSizeIPv6, &out.CIDRMaskSizeIPv6
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Nodes.
@@ -2437,6 +2748,11 @@ func (in *PackageConfiguration) DeepCopyInto(out *PackageConfiguration) {
 		*out = new(PackageControllerCronJob)
 		**out = **in
 	}
+	if in.Install != nil {
+		in, out := &in.Install, &out.Install
+		*out = make([]PackageInstall, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageConfiguration.
@@ -2502,6 +2818,36 @@ func (in *PackageControllerResources) DeepCopy() *PackageControllerResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageInstall) DeepCopyInto(out *PackageInstall) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageInstall.
+func (in *PackageInstall) DeepCopy() *PackageInstall {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageInstall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageInstallStatus) DeepCopyInto(out *PackageInstallStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageInstallStatus.
+func (in *PackageInstallStatus) DeepCopy() *PackageInstallStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageInstallStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodIAMConfig) DeepCopyInto(out *PodIAMConfig) {
 	*out = *in
@@ -2580,6 +2926,13 @@ func (in *RegistryMirrorConfiguration) DeepCopyInto(out *RegistryMirrorConfigura
 		*out = make([]OCINamespace, len(*in))
 		copy(*out, *in)
 	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]RegistryMirrorEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirrorConfiguration.
@@ -2592,6 +2945,26 @@ func (in *RegistryMirrorConfiguration) DeepCopy() *RegistryMirrorConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirrorEndpoint) DeepCopyInto(out *RegistryMirrorEndpoint) {
+	*out = *in
+	if in.OCINamespaces != nil {
+		in, out := &in.OCINamespaces, &out.OCINamespaces
+		*out = make([]OCINamespace, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirrorEndpoint.
+func (in *RegistryMirrorEndpoint) DeepCopy() *RegistryMirrorEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirrorEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvConf) DeepCopyInto(out *ResolvConf) {
 	*out = *in
@@ -2607,6 +2980,22 @@ func (in *ResolvConf) DeepCopy() *ResolvConf {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretCertificateExpiry) DeepCopyInto(out *SecretCertificateExpiry) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretCertificateExpiry.
+func (in *SecretCertificateExpiry) DeepCopy() *SecretCertificateExpiry {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretCertificateExpiry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Services) DeepCopyInto(out *Services) {
 	*out = *in
@@ -3000,6 +3389,36 @@ func (in SymlinkMaps) DeepCopy() SymlinkMaps {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TinkerbellAdditionalInterface) DeepCopyInto(out *TinkerbellAdditionalInterface) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TinkerbellAdditionalInterface.
+func (in *TinkerbellAdditionalInterface) DeepCopy() *TinkerbellAdditionalInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(TinkerbellAdditionalInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TinkerbellBondConfig) DeepCopyInto(out *TinkerbellBondConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TinkerbellBondConfig.
+func (in *TinkerbellBondConfig) DeepCopy() *TinkerbellBondConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TinkerbellBondConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TinkerbellDatacenterConfig) DeepCopyInto(out *TinkerbellDatacenterConfig) {
 	*out = *in
@@ -3158,6 +3577,13 @@ func (in *TinkerbellMachineConfigSpec) DeepCopyInto(out *TinkerbellMachineConfig
 			(*out)[key] = val
 		}
 	}
+	if in.HardwareSelectorExpressions != nil {
+		in, out := &in.HardwareSelectorExpressions, &out.HardwareSelectorExpressions
+		*out = make([]metav1.LabelSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.TemplateRef = in.TemplateRef
 	if in.Users != nil {
 		in, out := &in.Users, &out.Users
@@ -3171,6 +3597,11 @@ func (in *TinkerbellMachineConfigSpec) DeepCopyInto(out *TinkerbellMachineConfig
 		*out = new(HostOSConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(TinkerbellNetworkConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TinkerbellMachineConfigSpec.
@@ -3198,6 +3629,36 @@ func (in *TinkerbellMachineConfigStatus) DeepCopy() *TinkerbellMachineConfigStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TinkerbellNetworkConfig) DeepCopyInto(out *TinkerbellNetworkConfig) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bond != nil {
+		in, out := &in.Bond, &out.Bond
+		*out = new(TinkerbellBondConfig)
+		**out = **in
+	}
+	if in.AdditionalInterfaces != nil {
+		in, out := &in.AdditionalInterfaces, &out.AdditionalInterfaces
+		*out = make([]TinkerbellAdditionalInterface, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TinkerbellNetworkConfig.
+func (in *TinkerbellNetworkConfig) DeepCopy() *TinkerbellNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TinkerbellNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TinkerbellTemplateConfig) DeepCopyInto(out *TinkerbellTemplateConfig) {
 	*out = *in
@@ -3308,6 +3769,21 @@ func (in *UserConfiguration) DeepCopy() *UserConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereAdditionalDisk) DeepCopyInto(out *VSphereAdditionalDisk) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereAdditionalDisk.
+func (in *VSphereAdditionalDisk) DeepCopy() *VSphereAdditionalDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereAdditionalDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSphereDatacenterConfig) DeepCopyInto(out *VSphereDatacenterConfig) {
 	*out = *in
@@ -3375,6 +3851,13 @@ func (in *VSphereDatacenterConfigSpec) DeepCopyInto(out *VSphereDatacenterConfig
 		*out = make([]FailureDomain, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]VSphereIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDatacenterConfigSpec.
@@ -3407,6 +3890,31 @@ func (in *VSphereDatacenterConfigStatus) DeepCopy() *VSphereDatacenterConfigStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereIPPool) DeepCopyInto(out *VSphereIPPool) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereIPPool.
+func (in *VSphereIPPool) DeepCopy() *VSphereIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSphereMachineConfig) DeepCopyInto(out *VSphereMachineConfig) {
 	*out = *in
@@ -3486,6 +3994,23 @@ func (in *VSphereMachineConfigSpec) DeepCopyInto(out *VSphereMachineConfigSpec)
 		*out = new(HostOSConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]VSphereMachineNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalDisks != nil {
+		in, out := &in.AdditionalDisks, &out.AdditionalDisks
+		*out = make([]VSphereAdditionalDisk, len(*in))
+		copy(*out, *in)
+	}
+	if in.PCIDevices != nil {
+		in, out := &in.PCIDevices, &out.PCIDevices
+		*out = make([]VSpherePCIDevice, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereMachineConfigSpec.
@@ -3513,6 +4038,56 @@ func (in *VSphereMachineConfigStatus) DeepCopy() *VSphereMachineConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereMachineNetwork) DeepCopyInto(out *VSphereMachineNetwork) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]VSphereNetworkRoute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereMachineNetwork.
+func (in *VSphereMachineNetwork) DeepCopy() *VSphereMachineNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereMachineNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereNetworkRoute) DeepCopyInto(out *VSphereNetworkRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereNetworkRoute.
+func (in *VSphereNetworkRoute) DeepCopy() *VSphereNetworkRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereNetworkRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSpherePCIDevice) DeepCopyInto(out *VSpherePCIDevice) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSpherePCIDevice.
+func (in *VSpherePCIDevice) DeepCopy() *VSpherePCIDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(VSpherePCIDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerNodeGroupConfiguration) DeepCopyInto(out *WorkerNodeGroupConfiguration) {
 	*out = *in
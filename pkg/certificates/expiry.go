@@ -0,0 +1,183 @@
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/secret"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// DefaultExpiryWarningThreshold is how long before their expiry certificates are reported as expiring soon.
+const DefaultExpiryWarningThreshold = 30 * 24 * time.Hour
+
+// certificateSecrets are the purposes of the certificate secrets created by the KubeadmControlPlane
+// and the EtcdadmCluster, mapped to the role of the component using them.
+var certificateSecrets = []struct {
+	purpose secret.Purpose
+	role    string
+}{
+	{purpose: secret.ClusterCA, role: constants.ControlPlaneComponent},
+	{purpose: secret.FrontProxyCA, role: constants.ControlPlaneComponent},
+	{purpose: secret.EtcdCA, role: constants.EtcdComponent},
+	{purpose: secret.ManagedExternalEtcdCA, role: constants.EtcdComponent},
+	{purpose: secret.APIServerEtcdClient, role: constants.EtcdComponent},
+}
+
+// MachineExpirations returns the certificate expiry of the control plane and external etcd machines
+// of a cluster, sorted by expiry date.
+//
+// The expiry date is read, in order of preference, from the CAPI certificates expiry annotation or the
+// expiry date reported by the KubeadmControlPlane on the machine status. Machines that don't report
+// their expiry date are left out.
+func MachineExpirations(ctx context.Context, c client.Client, cluster *v1alpha1.Cluster) ([]v1alpha1.MachineCertificateExpiry, error) {
+	machines := &clusterv1.MachineList{}
+	if err := c.List(ctx, machines,
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+		client.InNamespace(constants.EksaSystemNamespace),
+	); err != nil {
+		return nil, fmt.Errorf("listing machines for cluster %s: %v", cluster.Name, err)
+	}
+
+	expirations := make([]v1alpha1.MachineCertificateExpiry, 0, len(machines.Items))
+	for i := range machines.Items {
+		m := &machines.Items[i]
+		role := machineRole(m)
+		if role == "" || !m.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		expiresAt, ok := machineExpiry(m)
+		if !ok {
+			continue
+		}
+
+		expirations = append(expirations, v1alpha1.MachineCertificateExpiry{
+			Machine:   m.Name,
+			Role:      role,
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	sort.SliceStable(expirations, func(i, j int) bool {
+		if expirations[i].ExpiresAt.Equal(&expirations[j].ExpiresAt) {
+			return expirations[i].Machine < expirations[j].Machine
+		}
+		return expirations[i].ExpiresAt.Before(&expirations[j].ExpiresAt)
+	})
+
+	return expirations, nil
+}
+
+func machineRole(m *clusterv1.Machine) string {
+	if _, ok := m.Labels[clusterv1.MachineControlPlaneLabel]; ok {
+		return constants.ControlPlaneComponent
+	}
+	if _, ok := m.Labels[clusterv1.MachineEtcdClusterLabelName]; ok {
+		return constants.EtcdComponent
+	}
+	return ""
+}
+
+func machineExpiry(m *clusterv1.Machine) (metav1.Time, bool) {
+	if value, ok := m.Annotations[clusterv1.MachineCertificatesExpiryDateAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return metav1.NewTime(t), true
+		}
+	}
+
+	if m.Status.CertificatesExpiryDate != nil {
+		return *m.Status.CertificatesExpiryDate.DeepCopy(), true
+	}
+
+	return metav1.Time{}, false
+}
+
+// SecretExpirations returns the expiry of the certificates stored in the control plane and external etcd
+// certificate secrets of a cluster, sorted by expiry date. The expiry date is the NotAfter of the certificate.
+func SecretExpirations(ctx context.Context, c client.Client, cluster *v1alpha1.Cluster) ([]v1alpha1.SecretCertificateExpiry, error) {
+	expirations := make([]v1alpha1.SecretCertificateExpiry, 0, len(certificateSecrets))
+	for _, s := range certificateSecrets {
+		name := secret.Name(cluster.Name, s.purpose)
+		certSecret := &corev1.Secret{}
+		err := c.Get(ctx, client.ObjectKey{Namespace: constants.EksaSystemNamespace, Name: name}, certSecret)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting certificate secret %s: %v", name, err)
+		}
+
+		notAfter, err := certificateNotAfter(certSecret.Data[secret.TLSCrtDataName])
+		if err != nil {
+			return nil, fmt.Errorf("reading certificate from secret %s: %v", name, err)
+		}
+
+		expirations = append(expirations, v1alpha1.SecretCertificateExpiry{
+			Secret:    name,
+			Role:      s.role,
+			ExpiresAt: metav1.NewTime(notAfter),
+		})
+	}
+
+	sort.SliceStable(expirations, func(i, j int) bool {
+		return expirations[i].ExpiresAt.Before(&expirations[j].ExpiresAt)
+	})
+
+	return expirations, nil
+}
+
+func certificateNotAfter(data []byte) (time.Time, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, errors.New("certificate is not PEM encoded")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
+// ExpiryState classifies a certificate expiry date against a point in time and a warning threshold.
+type ExpiryState string
+
+const (
+	// ExpiryStateValid means the certificates are valid for longer than the warning threshold.
+	ExpiryStateValid ExpiryState = "Valid"
+	// ExpiryStateExpiringSoon means the certificates expire within the warning threshold.
+	ExpiryStateExpiringSoon ExpiryState = "ExpiringSoon"
+	// ExpiryStateExpired means the certificates have already expired.
+	ExpiryStateExpired ExpiryState = "Expired"
+)
+
+// State returns the ExpiryState of an expiry date at the given time.
+func State(expiresAt time.Time, now time.Time, threshold time.Duration) ExpiryState {
+	switch {
+	case !expiresAt.After(now):
+		return ExpiryStateExpired
+	case expiresAt.Sub(now) <= threshold:
+		return ExpiryStateExpiringSoon
+	default:
+		return ExpiryStateValid
+	}
+}
+
+// DaysUntil returns the number of whole days left before expiresAt. It is negative for past dates.
+func DaysUntil(expiresAt time.Time, now time.Time) int {
+	return int(expiresAt.Sub(now).Hours() / 24)
+}
@@ -0,0 +1,191 @@
+package certificates_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func TestMachineExpirations(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	annotated := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	reported := metav1.NewTime(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	fromAnnotation := machine("cp-1", clusterv1.MachineControlPlaneLabel, created)
+	fromAnnotation.Annotations = map[string]string{
+		clusterv1.MachineCertificatesExpiryDateAnnotation: annotated.Format(time.RFC3339),
+	}
+	fromStatus := machine("etcd-1", clusterv1.MachineEtcdClusterLabelName, created)
+	fromStatus.Status.CertificatesExpiryDate = &reported
+	unreported := machine("cp-2", clusterv1.MachineControlPlaneLabel, created)
+	worker := machine("md-1", "", created)
+	otherCluster := machine("other-cp-1", clusterv1.MachineControlPlaneLabel, created)
+	otherCluster.Labels[clusterv1.ClusterNameLabel] = "other-cluster"
+
+	c := newFakeClient(g, fromAnnotation, fromStatus, unreported, worker, otherCluster)
+	cluster := &anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}}
+
+	got, err := certificates.MachineExpirations(ctx, c, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(HaveLen(2))
+
+	g.Expect(got[0].Machine).To(Equal("etcd-1"))
+	g.Expect(got[0].Role).To(Equal(constants.EtcdComponent))
+	g.Expect(got[0].ExpiresAt.Time).To(BeTemporally("==", reported.Time))
+
+	g.Expect(got[1].Machine).To(Equal("cp-1"))
+	g.Expect(got[1].Role).To(Equal(constants.ControlPlaneComponent))
+	g.Expect(got[1].ExpiresAt.Time).To(BeTemporally("==", annotated))
+}
+
+func TestMachineExpirationsNoMachines(t *testing.T) {
+	g := NewWithT(t)
+	c := newFakeClient(g)
+	cluster := &anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}}
+
+	got, err := certificates.MachineExpirations(context.Background(), c, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(BeEmpty())
+}
+
+func TestSecretExpirations(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	caExpiry := time.Date(2034, 1, 1, 0, 0, 0, 0, time.UTC)
+	clientExpiry := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	c := newFakeClient(g,
+		certificateSecret(g, "my-cluster-ca", caExpiry),
+		certificateSecret(g, "my-cluster-apiserver-etcd-client", clientExpiry),
+		certificateSecret(g, "other-cluster-ca", clientExpiry),
+	)
+	cluster := &anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}}
+
+	got, err := certificates.SecretExpirations(ctx, c, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(HaveLen(2))
+
+	g.Expect(got[0].Secret).To(Equal("my-cluster-apiserver-etcd-client"))
+	g.Expect(got[0].Role).To(Equal(constants.EtcdComponent))
+	g.Expect(got[0].ExpiresAt.Time).To(BeTemporally("==", clientExpiry))
+
+	g.Expect(got[1].Secret).To(Equal("my-cluster-ca"))
+	g.Expect(got[1].Role).To(Equal(constants.ControlPlaneComponent))
+	g.Expect(got[1].ExpiresAt.Time).To(BeTemporally("==", caExpiry))
+}
+
+func TestSecretExpirationsInvalidCertificate(t *testing.T) {
+	g := NewWithT(t)
+	invalid := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-ca", Namespace: constants.EksaSystemNamespace},
+		Data:       map[string][]byte{"tls.crt": []byte("not a certificate")},
+	}
+	c := newFakeClient(g, invalid)
+	cluster := &anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}}
+
+	_, err := certificates.SecretExpirations(context.Background(), c, cluster)
+	g.Expect(err).To(MatchError(ContainSubstring("reading certificate from secret my-cluster-ca: certificate is not PEM encoded")))
+}
+
+func TestState(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		want      certificates.ExpiryState
+	}{
+		{
+			name:      "valid",
+			expiresAt: now.Add(31 * 24 * time.Hour),
+			want:      certificates.ExpiryStateValid,
+		},
+		{
+			name:      "expiring soon",
+			expiresAt: now.Add(30 * 24 * time.Hour),
+			want:      certificates.ExpiryStateExpiringSoon,
+		},
+		{
+			name:      "expired",
+			expiresAt: now,
+			want:      certificates.ExpiryStateExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(certificates.State(tt.expiresAt, now, certificates.DefaultExpiryWarningThreshold)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestDaysUntil(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g.Expect(certificates.DaysUntil(now.Add(10*24*time.Hour+time.Hour), now)).To(Equal(10))
+	g.Expect(certificates.DaysUntil(now.Add(-2*24*time.Hour), now)).To(Equal(-2))
+}
+
+func machine(name, roleLabel string, created time.Time) *clusterv1.Machine {
+	m := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         constants.EksaSystemNamespace,
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: "my-cluster",
+			},
+		},
+	}
+	if roleLabel != "" {
+		m.Labels[roleLabel] = ""
+	}
+	return m
+}
+
+func certificateSecret(g *WithT, name string, notAfter time.Time) *corev1.Secret {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.EksaSystemNamespace},
+		Data: map[string][]byte{
+			"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		},
+	}
+}
+
+func newFakeClient(g *WithT, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
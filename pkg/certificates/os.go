@@ -0,0 +1,159 @@
+package certificates
+
+import (
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+const (
+	linuxControlPlaneCertDir        = "/etc/kubernetes/pki"
+	linuxEtcdCertDir                = "/etc/etcd/pki"
+	bottlerocketControlPlaneCertDir = "/var/lib/kubeadm/pki"
+	bottlerocketEtcdCertDir         = "/var/lib/etcd/pki"
+
+	// etcdadm requires an endpoint to run the certificates phase even though it is never contacted.
+	etcdadmDummyEndpoint  = "http://eks-a-etcd-dumb-url"
+	staticPodsRestartWait = 20
+)
+
+// OSRenewer builds the node level scripts used to renew certificates for a given OS family.
+type OSRenewer interface {
+	// ControlPlaneCertDir is the directory holding the kubeadm generated certificates.
+	ControlPlaneCertDir() string
+	// EtcdCertDir is the directory holding the etcdadm generated certificates.
+	EtcdCertDir() string
+	// EtcdClientCertFiles returns the paths of the api server etcd client certificate and key on a control plane node.
+	EtcdClientCertFiles() (cert, key string)
+	// Shell is the remote command that executes a script read from stdin with root privileges on the host.
+	Shell() string
+	// RenewControlPlaneCerts returns the script that renews the kubeadm certificates on a control plane node.
+	RenewControlPlaneCerts() string
+	// RenewEtcdCerts returns the script that renews the etcdadm certificates on an external etcd node.
+	RenewEtcdCerts() string
+	// RestartControlPlane returns the script that restarts the control plane static pods.
+	RestartControlPlane() string
+	// RestartEtcd returns the script that restarts etcd on an external etcd node.
+	RestartEtcd() string
+}
+
+// NewOSRenewer returns the OSRenewer for the given OS family.
+func NewOSRenewer(osFamily string) (OSRenewer, error) {
+	switch osFamily {
+	case string(v1alpha1.Ubuntu), string(v1alpha1.RedHat):
+		return &LinuxRenewer{}, nil
+	case string(v1alpha1.Bottlerocket):
+		return &BottlerocketRenewer{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported OS %q", osFamily)
+	}
+}
+
+// LinuxRenewer renews certificates on Ubuntu and RHEL nodes where kubeadm and etcdadm are installed on the host.
+type LinuxRenewer struct{}
+
+// ControlPlaneCertDir implements OSRenewer.
+func (l *LinuxRenewer) ControlPlaneCertDir() string {
+	return linuxControlPlaneCertDir
+}
+
+// EtcdCertDir implements OSRenewer.
+func (l *LinuxRenewer) EtcdCertDir() string {
+	return linuxEtcdCertDir
+}
+
+// EtcdClientCertFiles implements OSRenewer.
+func (l *LinuxRenewer) EtcdClientCertFiles() (cert, key string) {
+	return linuxControlPlaneCertDir + "/apiserver-etcd-client.crt", linuxControlPlaneCertDir + "/apiserver-etcd-client.key"
+}
+
+// Shell implements OSRenewer.
+func (l *LinuxRenewer) Shell() string {
+	return "sudo bash"
+}
+
+// RenewControlPlaneCerts implements OSRenewer.
+func (l *LinuxRenewer) RenewControlPlaneCerts() string {
+	return "kubeadm certs renew all"
+}
+
+// RenewEtcdCerts implements OSRenewer.
+func (l *LinuxRenewer) RenewEtcdCerts() string {
+	return fmt.Sprintf("etcdadm join phase certificates %s --init-system systemd", etcdadmDummyEndpoint)
+}
+
+// RestartControlPlane implements OSRenewer.
+func (l *LinuxRenewer) RestartControlPlane() string {
+	return fmt.Sprintf(`mkdir -p /tmp/eksa-static-pods
+mv /etc/kubernetes/manifests/*.yaml /tmp/eksa-static-pods/
+sleep %d
+mv /tmp/eksa-static-pods/*.yaml /etc/kubernetes/manifests/`, staticPodsRestartWait)
+}
+
+// RestartEtcd implements OSRenewer.
+func (l *LinuxRenewer) RestartEtcd() string {
+	return "systemctl restart etcd"
+}
+
+// BottlerocketRenewer renews certificates on Bottlerocket nodes. Scripts are run from the admin
+// container through sheltie and kubeadm/etcdadm are executed from the bootstrap container images.
+type BottlerocketRenewer struct{}
+
+// ControlPlaneCertDir implements OSRenewer.
+func (b *BottlerocketRenewer) ControlPlaneCertDir() string {
+	return bottlerocketControlPlaneCertDir
+}
+
+// EtcdCertDir implements OSRenewer.
+func (b *BottlerocketRenewer) EtcdCertDir() string {
+	return bottlerocketEtcdCertDir
+}
+
+// EtcdClientCertFiles implements OSRenewer.
+func (b *BottlerocketRenewer) EtcdClientCertFiles() (cert, key string) {
+	return bottlerocketControlPlaneCertDir + "/server-etcd-client.crt", bottlerocketControlPlaneCertDir + "/server-etcd-client.key"
+}
+
+// Shell implements OSRenewer.
+func (b *BottlerocketRenewer) Shell() string {
+	return "sudo sheltie"
+}
+
+// RenewControlPlaneCerts implements OSRenewer.
+func (b *BottlerocketRenewer) RenewControlPlaneCerts() string {
+	return bootstrapContainerRun("kubeadm-bootstrap",
+		"--mount type=bind,src=/var/lib/kubeadm,dst=/var/lib/kubeadm,options=rbind:rw --mount type=bind,src=/var/lib/kubeadm,dst=/etc/kubernetes,options=rbind:rw",
+		"/opt/bin/kubeadm certs renew all",
+	)
+}
+
+// RenewEtcdCerts implements OSRenewer.
+func (b *BottlerocketRenewer) RenewEtcdCerts() string {
+	return bootstrapContainerRun("etcdadm-bootstrap",
+		"--mount type=bind,src=/var/lib/etcd,dst=/etc/etcd,options=rbind:rw",
+		fmt.Sprintf("/opt/bin/etcdadm join phase certificates %s --init-system kubelet", etcdadmDummyEndpoint),
+	)
+}
+
+// RestartControlPlane implements OSRenewer.
+func (b *BottlerocketRenewer) RestartControlPlane() string {
+	return restartBottlerocketStaticPods()
+}
+
+// RestartEtcd implements OSRenewer.
+func (b *BottlerocketRenewer) RestartEtcd() string {
+	return restartBottlerocketStaticPods()
+}
+
+func bootstrapContainerRun(hostContainer, mounts, command string) string {
+	return fmt.Sprintf(`IMAGE_ID=$(apiclient get | apiclient exec admin jq -r '.settings["host-containers"]["%[1]s"].source')
+ctr image pull ${IMAGE_ID}
+ctr run %[2]s --rm ${IMAGE_ID} tmp-certs-renew %[3]s`, hostContainer, mounts, command)
+}
+
+func restartBottlerocketStaticPods() string {
+	return fmt.Sprintf(`PODS=$(apiclient get | apiclient exec admin jq -r '.settings.kubernetes["static-pods"] | keys[]')
+for pod in ${PODS}; do apiclient set settings.kubernetes.static-pods.${pod}.enabled=false; done
+sleep %d
+for pod in ${PODS}; do apiclient set settings.kubernetes.static-pods.${pod}.enabled=true; done`, staticPodsRestartWait)
+}
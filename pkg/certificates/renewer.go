@@ -0,0 +1,285 @@
+package certificates
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const backupTimeFormat = "20060102-150405"
+
+// SSHRunner runs commands on a remote host over SSH.
+type SSHRunner interface {
+	RunCommand(ctx context.Context, privateKeyPath, username, IP string, command ...string) (string, error)
+}
+
+// Renewer renews the control plane and external etcd certificates of a cluster node by node.
+type Renewer struct {
+	ssh        SSHRunner
+	kubeClient kubernetes.Client
+	now        func() time.Time
+}
+
+// NewRenewer builds a new Renewer. kubeClient must point to the cluster managing the target cluster.
+func NewRenewer(ssh SSHRunner, kubeClient kubernetes.Client) *Renewer {
+	return &Renewer{
+		ssh:        ssh,
+		kubeClient: kubeClient,
+		now:        time.Now,
+	}
+}
+
+// node groups the information needed to run scripts on a single machine.
+type node struct {
+	address string
+	config  *NodeConfig
+	os      OSRenewer
+}
+
+type nodeGroup []node
+
+func newNodeGroup(config *NodeConfig) (nodeGroup, error) {
+	os, err := NewOSRenewer(config.OS)
+	if err != nil {
+		return nil, err
+	}
+
+	g := make(nodeGroup, 0, len(config.Nodes))
+	for _, address := range config.Nodes {
+		g = append(g, node{address: address, config: config, os: os})
+	}
+
+	return g, nil
+}
+
+// RenewCertificates backs up and renews the certificates for the requested component. An empty
+// component renews both external etcd (when configured) and control plane certificates. If renewal
+// fails on a node, the nodes already processed in that group are restored from their backup.
+func (r *Renewer) RenewCertificates(ctx context.Context, cfg *RenewalConfig, component string) error {
+	controlPlane, err := newNodeGroup(&cfg.ControlPlane)
+	if err != nil {
+		return fmt.Errorf("control plane: %v", err)
+	}
+
+	externalEtcd := len(cfg.Etcd.Nodes) > 0
+	if component == constants.EtcdComponent && !externalEtcd {
+		return fmt.Errorf("no external etcd nodes configured for cluster %s", cfg.ClusterName)
+	}
+
+	backupSuffix := r.now().Format(backupTimeFormat)
+
+	var etcdClientCert, etcdClientKey []byte
+	if externalEtcd && component != constants.ControlPlaneComponent {
+		etcd, err := newNodeGroup(&cfg.Etcd)
+		if err != nil {
+			return fmt.Errorf("etcd: %v", err)
+		}
+
+		logger.Info("Renewing external etcd certificates", "nodes", len(etcd))
+		etcdPhase := phase{
+			certDir: OSRenewer.EtcdCertDir,
+			restart: OSRenewer.RestartEtcd,
+			renew:   r.renewEtcdNode,
+		}
+		if err := r.renewGroup(ctx, etcd, backupSuffix, etcdPhase); err != nil {
+			return fmt.Errorf("renewing etcd certificates: %v", err)
+		}
+
+		etcdClientCert, etcdClientKey, err = r.readEtcdClientCert(ctx, etcd[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	renewControlPlane := component != constants.EtcdComponent
+	logger.Info("Updating control plane nodes", "nodes", len(controlPlane))
+	controlPlanePhase := phase{
+		certDir: OSRenewer.ControlPlaneCertDir,
+		restart: OSRenewer.RestartControlPlane,
+		renew: func(ctx context.Context, n node) error {
+			return r.renewControlPlaneNode(ctx, n, renewControlPlane, etcdClientCert, etcdClientKey)
+		},
+	}
+	if err := r.renewGroup(ctx, controlPlane, backupSuffix, controlPlanePhase); err != nil {
+		return fmt.Errorf("renewing control plane certificates: %v", err)
+	}
+
+	if etcdClientCert != nil {
+		if err := r.updateEtcdClientSecret(ctx, cfg.ClusterName, etcdClientCert, etcdClientKey); err != nil {
+			return err
+		}
+	}
+
+	if renewControlPlane {
+		if err := r.refreshKubeconfigSecret(ctx, cfg.ClusterName); err != nil {
+			return err
+		}
+	}
+
+	logger.MarkSuccess("Certificates renewed", "cluster", cfg.ClusterName)
+	return nil
+}
+
+// phase describes how to renew one group of nodes and how to restore them if it fails.
+type phase struct {
+	certDir func(OSRenewer) string
+	restart func(OSRenewer) string
+	renew   func(context.Context, node) error
+}
+
+func (r *Renewer) renewGroup(ctx context.Context, group nodeGroup, backupSuffix string, p phase) error {
+	for i, n := range group {
+		logger.V(2).Info("Backing up certificates", "node", n.address, "dir", p.certDir(n.os))
+		if err := r.runScript(ctx, n, backupScript(p.certDir(n.os), backupSuffix)); err != nil {
+			r.rollback(ctx, group[:i], backupSuffix, p)
+			return fmt.Errorf("backing up certificates on node %s: %v", n.address, err)
+		}
+
+		if err := p.renew(ctx, n); err != nil {
+			r.rollback(ctx, group[:i+1], backupSuffix, p)
+			return fmt.Errorf("node %s: %v", n.address, err)
+		}
+		logger.V(0).Info("Certificates renewed", "node", n.address)
+	}
+
+	return nil
+}
+
+func (r *Renewer) rollback(ctx context.Context, group nodeGroup, backupSuffix string, p phase) {
+	for _, n := range group {
+		logger.Info("Restoring certificates from backup", "node", n.address)
+		script := restoreScript(p.certDir(n.os), backupSuffix) + "\n" + p.restart(n.os)
+		if err := r.runScript(ctx, n, script); err != nil {
+			logger.Error(err, "Restoring certificates failed, manual intervention required", "node", n.address)
+		}
+	}
+}
+
+func (r *Renewer) renewEtcdNode(ctx context.Context, n node) error {
+	if err := r.runScript(ctx, n, n.os.RenewEtcdCerts()); err != nil {
+		return fmt.Errorf("renewing etcd certificates: %v", err)
+	}
+
+	if err := r.runScript(ctx, n, n.os.RestartEtcd()); err != nil {
+		return fmt.Errorf("restarting etcd: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Renewer) renewControlPlaneNode(ctx context.Context, n node, renew bool, etcdClientCert, etcdClientKey []byte) error {
+	if renew {
+		if err := r.runScript(ctx, n, n.os.RenewControlPlaneCerts()); err != nil {
+			return fmt.Errorf("renewing control plane certificates: %v", err)
+		}
+	}
+
+	if etcdClientCert != nil {
+		certPath, keyPath := n.os.EtcdClientCertFiles()
+		script := writeFileScript(certPath, etcdClientCert) + "\n" + writeFileScript(keyPath, etcdClientKey)
+		if err := r.runScript(ctx, n, script); err != nil {
+			return fmt.Errorf("copying etcd client certificate: %v", err)
+		}
+	}
+
+	if err := r.runScript(ctx, n, n.os.RestartControlPlane()); err != nil {
+		return fmt.Errorf("restarting control plane components: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Renewer) readEtcdClientCert(ctx context.Context, n node) (cert, key []byte, err error) {
+	dir := n.os.EtcdCertDir()
+	if cert, err = r.readFile(ctx, n, dir+"/apiserver-etcd-client.crt"); err != nil {
+		return nil, nil, fmt.Errorf("reading etcd client certificate from node %s: %v", n.address, err)
+	}
+	if key, err = r.readFile(ctx, n, dir+"/apiserver-etcd-client.key"); err != nil {
+		return nil, nil, fmt.Errorf("reading etcd client key from node %s: %v", n.address, err)
+	}
+
+	return cert, key, nil
+}
+
+func (r *Renewer) readFile(ctx context.Context, n node, path string) ([]byte, error) {
+	out, err := r.runScriptWithOutput(ctx, n, fmt.Sprintf("base64 -w0 %s", path))
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(out))
+}
+
+// updateEtcdClientSecret keeps the secret CAPI uses to reach the external etcd cluster in sync with
+// the renewed client certificate.
+func (r *Renewer) updateEtcdClientSecret(ctx context.Context, clusterName string, cert, key []byte) error {
+	secret := &corev1.Secret{}
+	name := fmt.Sprintf("%s-apiserver-etcd-client", clusterName)
+	if err := r.kubeClient.Get(ctx, name, constants.EksaSystemNamespace, secret); err != nil {
+		return fmt.Errorf("getting secret %s: %v", name, err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[corev1.TLSCertKey] = cert
+	secret.Data[corev1.TLSPrivateKeyKey] = key
+
+	if err := r.kubeClient.Update(ctx, secret); err != nil {
+		return fmt.Errorf("updating secret %s: %v", name, err)
+	}
+
+	return nil
+}
+
+// refreshKubeconfigSecret deletes the CAPI generated kubeconfig secret so the control plane
+// provider regenerates it against the renewed control plane.
+func (r *Renewer) refreshKubeconfigSecret(ctx context.Context, clusterName string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-kubeconfig", clusterName),
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+
+	if err := r.kubeClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("refreshing kubeconfig secret %s: %v", secret.Name, err)
+	}
+
+	return nil
+}
+
+func (r *Renewer) runScript(ctx context.Context, n node, script string) error {
+	_, err := r.runScriptWithOutput(ctx, n, script)
+	return err
+}
+
+// runScriptWithOutput ships the script base64 encoded so it doesn't need to be escaped for the
+// remote shell and pipes it to the OS specific privileged shell.
+func (r *Renewer) runScriptWithOutput(ctx context.Context, n node, script string) (string, error) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("set -euo pipefail\n" + script))
+	command := fmt.Sprintf("echo %s | base64 -d | %s", encoded, n.os.Shell())
+	return r.ssh.RunCommand(ctx, n.config.SSHKey, n.config.SSHUser, n.address, command)
+}
+
+func backupScript(dir, suffix string) string {
+	return fmt.Sprintf("cp -r %[1]s %[1]s.bak-%[2]s", dir, suffix)
+}
+
+func restoreScript(dir, suffix string) string {
+	return fmt.Sprintf("rm -rf %[1]s\ncp -r %[1]s.bak-%[2]s %[1]s", dir, suffix)
+}
+
+func writeFileScript(path string, content []byte) string {
+	return fmt.Sprintf("echo %s | base64 -d > %s\nchmod 600 %s", base64.StdEncoding.EncodeToString(content), path, path)
+}
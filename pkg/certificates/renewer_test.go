@@ -0,0 +1,178 @@
+package certificates_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/certificates/mocks"
+	kubemocks "github.com/aws/eks-anywhere/pkg/clients/kubernetes/mocks"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+type renewerTest struct {
+	*WithT
+	ctx        context.Context
+	ssh        *mocks.MockSSHRunner
+	kubeClient *kubemocks.MockClient
+	renewer    *certificates.Renewer
+	// scripts records the decoded scripts run on each node, in order.
+	scripts map[string][]string
+}
+
+func newRenewerTest(t *testing.T) *renewerTest {
+	ctrl := gomock.NewController(t)
+	tt := &renewerTest{
+		WithT:      NewWithT(t),
+		ctx:        context.Background(),
+		ssh:        mocks.NewMockSSHRunner(ctrl),
+		kubeClient: kubemocks.NewMockClient(ctrl),
+		scripts:    map[string][]string{},
+	}
+	tt.renewer = certificates.NewRenewer(tt.ssh, tt.kubeClient)
+	return tt
+}
+
+// recordSSH records every script and calls fn to decide the output of each one.
+func (tt *renewerTest) recordSSH(fn func(node, script string) (string, error)) {
+	tt.ssh.EXPECT().RunCommand(tt.ctx, "/tmp/key", "ec2-user", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _, node string, command ...string) (string, error) {
+			script := decodeScript(tt, command[0])
+			tt.scripts[node] = append(tt.scripts[node], script)
+			return fn(node, script)
+		},
+	).AnyTimes()
+}
+
+func decodeScript(tt *renewerTest, command string) string {
+	encoded := strings.Fields(command)[1]
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	tt.Expect(err).NotTo(HaveOccurred())
+	return string(decoded)
+}
+
+func renewalConfig(os string, etcdNodes ...string) *certificates.RenewalConfig {
+	return &certificates.RenewalConfig{
+		ClusterName: "test-cluster",
+		ControlPlane: certificates.NodeConfig{
+			Nodes:   []string{"10.0.0.1", "10.0.0.2"},
+			OS:      os,
+			SSHKey:  "/tmp/key",
+			SSHUser: "ec2-user",
+		},
+		Etcd: certificates.NodeConfig{
+			Nodes:   etcdNodes,
+			OS:      os,
+			SSHKey:  "/tmp/key",
+			SSHUser: "ec2-user",
+		},
+	}
+}
+
+func noOutput(_, _ string) (string, error) {
+	return "", nil
+}
+
+func TestRenewerRenewCertificatesExternalEtcd(t *testing.T) {
+	tt := newRenewerTest(t)
+	cfg := renewalConfig("ubuntu", "10.0.0.10")
+	tt.recordSSH(func(_, script string) (string, error) {
+		if strings.Contains(script, "base64 -w0") {
+			return base64.StdEncoding.EncodeToString([]byte("etcd-client")), nil
+		}
+		return "", nil
+	})
+
+	tt.kubeClient.EXPECT().Get(tt.ctx, "test-cluster-apiserver-etcd-client", constants.EksaSystemNamespace, &corev1.Secret{}).Return(nil)
+	tt.kubeClient.EXPECT().Update(tt.ctx, gomock.Any()).DoAndReturn(func(_ context.Context, obj *corev1.Secret) error {
+		tt.Expect(obj.Data[corev1.TLSCertKey]).To(Equal([]byte("etcd-client")))
+		tt.Expect(obj.Data[corev1.TLSPrivateKeyKey]).To(Equal([]byte("etcd-client")))
+		return nil
+	})
+	tt.kubeClient.EXPECT().Delete(tt.ctx, gomock.Any()).DoAndReturn(func(_ context.Context, obj *corev1.Secret) error {
+		tt.Expect(obj.Name).To(Equal("test-cluster-kubeconfig"))
+		return nil
+	})
+
+	tt.Expect(tt.renewer.RenewCertificates(tt.ctx, cfg, "")).To(Succeed())
+
+	etcdScripts := tt.scripts["10.0.0.10"]
+	tt.Expect(etcdScripts[0]).To(ContainSubstring("cp -r /etc/etcd/pki /etc/etcd/pki.bak-"))
+	tt.Expect(etcdScripts[1]).To(ContainSubstring("etcdadm join phase certificates"))
+	tt.Expect(etcdScripts[2]).To(ContainSubstring("systemctl restart etcd"))
+
+	for _, node := range cfg.ControlPlane.Nodes {
+		scripts := tt.scripts[node]
+		tt.Expect(scripts).To(HaveLen(4))
+		tt.Expect(scripts[0]).To(ContainSubstring("cp -r /etc/kubernetes/pki /etc/kubernetes/pki.bak-"))
+		tt.Expect(scripts[1]).To(ContainSubstring("kubeadm certs renew all"))
+		tt.Expect(scripts[2]).To(ContainSubstring("/etc/kubernetes/pki/apiserver-etcd-client.crt"))
+		tt.Expect(scripts[3]).To(ContainSubstring("/etc/kubernetes/manifests"))
+	}
+}
+
+func TestRenewerRenewCertificatesControlPlaneOnly(t *testing.T) {
+	tt := newRenewerTest(t)
+	cfg := renewalConfig("ubuntu", "10.0.0.10")
+	tt.recordSSH(noOutput)
+	tt.kubeClient.EXPECT().Delete(tt.ctx, gomock.Any()).Return(nil)
+
+	tt.Expect(tt.renewer.RenewCertificates(tt.ctx, cfg, constants.ControlPlaneComponent)).To(Succeed())
+	tt.Expect(tt.scripts).NotTo(HaveKey("10.0.0.10"))
+	tt.Expect(tt.scripts["10.0.0.1"]).To(HaveLen(3))
+}
+
+func TestRenewerRenewCertificatesEtcdOnlyWithoutEtcdNodes(t *testing.T) {
+	tt := newRenewerTest(t)
+	cfg := renewalConfig("ubuntu")
+
+	tt.Expect(tt.renewer.RenewCertificates(tt.ctx, cfg, constants.EtcdComponent)).To(MatchError(ContainSubstring("no external etcd nodes")))
+}
+
+func TestRenewerRenewCertificatesBottlerocket(t *testing.T) {
+	tt := newRenewerTest(t)
+	cfg := renewalConfig("bottlerocket")
+	tt.ssh.EXPECT().RunCommand(tt.ctx, "/tmp/key", "ec2-user", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _, _ string, command ...string) (string, error) {
+			tt.Expect(command[0]).To(HaveSuffix("| sudo sheltie"))
+			return "", nil
+		},
+	).Times(6)
+	tt.kubeClient.EXPECT().Delete(tt.ctx, gomock.Any()).Return(nil)
+
+	tt.Expect(tt.renewer.RenewCertificates(tt.ctx, cfg, "")).To(Succeed())
+}
+
+func TestRenewerRenewCertificatesRollback(t *testing.T) {
+	tt := newRenewerTest(t)
+	cfg := renewalConfig("ubuntu")
+	tt.recordSSH(func(node, script string) (string, error) {
+		if node == "10.0.0.2" && strings.Contains(script, "kubeadm certs renew") {
+			return "", errors.New("renew failed")
+		}
+		return "", nil
+	})
+
+	err := tt.renewer.RenewCertificates(tt.ctx, cfg, "")
+	tt.Expect(err).To(MatchError(ContainSubstring("node 10.0.0.2")))
+	for _, node := range cfg.ControlPlane.Nodes {
+		scripts := tt.scripts[node]
+		restore := scripts[len(scripts)-1]
+		tt.Expect(restore).To(ContainSubstring("rm -rf /etc/kubernetes/pki"))
+		tt.Expect(restore).To(ContainSubstring("/etc/kubernetes/manifests"))
+	}
+}
+
+func TestRenewerRenewCertificatesUnsupportedOS(t *testing.T) {
+	tt := newRenewerTest(t)
+	cfg := renewalConfig("windows")
+
+	tt.Expect(tt.renewer.RenewCertificates(tt.ctx, cfg, "")).To(MatchError(ContainSubstring("unsupported OS")))
+}
@@ -176,6 +176,7 @@ func KubeadmControlPlane(clusterSpec *cluster.Spec, infrastructureObject APIObje
 							Append(ControlPlaneNodeLabelsExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration)),
 						Taints: clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Taints,
 					},
+					SkipPhases: InitSkipPhases(clusterSpec.Cluster),
 				},
 				JoinConfiguration: &bootstrapv1.JoinConfiguration{
 					NodeRegistration: bootstrapv1.NodeRegistrationOptions{
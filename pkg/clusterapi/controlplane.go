@@ -48,6 +48,8 @@ func (cp *ControlPlane[C, M]) Objects() []kubernetes.Object {
 // with the current state of the cluster. If they had, it generates a new name for them by increasing a monotonic number
 // at the end of the name
 // This is applied to all provider machine templates.
+// If the KubeadmControlPlane was imported from an existing cluster and neither its Kubernetes version nor the
+// generated kubeadm config spec change, the current kubeadm config spec is kept so EKS-A doesn't roll the imported machines.
 func (cp *ControlPlane[C, M]) UpdateImmutableObjectNames(
 	ctx context.Context,
 	client kubernetes.Client,
@@ -64,6 +66,17 @@ func (cp *ControlPlane[C, M]) UpdateImmutableObjectNames(
 		return errors.Wrap(err, "reading current kubeadm control plane from API")
 	}
 
+	keep, err := keepImportedConfig(currentKCP, cp.KubeadmControlPlane,
+		currentKCP.Spec.Version, cp.KubeadmControlPlane.Spec.Version,
+		cp.KubeadmControlPlane.Spec.KubeadmConfigSpec,
+	)
+	if err != nil {
+		return err
+	}
+	if keep {
+		cp.KubeadmControlPlane.Spec.KubeadmConfigSpec = *currentKCP.Spec.KubeadmConfigSpec.DeepCopy()
+	}
+
 	cp.ControlPlaneMachineTemplate.SetName(currentKCP.Spec.MachineTemplate.InfrastructureRef.Name)
 	if err = EnsureNewNameIfChanged(ctx, client, machineTemplateRetriever, machineTemplateComparator, cp.ControlPlaneMachineTemplate); err != nil {
 		return err
@@ -140,6 +140,90 @@ func TestControlPlaneUpdateImmutableObjectNamesSuccessStackedEtcdWithChanges(t *
 	g.Expect(cp.KubeadmControlPlane.Spec.MachineTemplate.InfrastructureRef.Name).To(Equal(cp.ControlPlaneMachineTemplate.Name))
 }
 
+func TestControlPlaneUpdateImmutableObjectNamesImportedKeepsKubeadmConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		desiredVersion  string
+		wantPreKubeadm  []string
+		importedVersion string
+	}{
+		{
+			name:            "same version",
+			importedVersion: "v1.31.1-eks-1-31-4",
+			desiredVersion:  "v1.31.1-eks-1-31-4",
+			wantPreKubeadm:  []string{"imported"},
+		},
+		{
+			name:            "version upgrade",
+			importedVersion: "v1.31.1-eks-1-31-4",
+			desiredVersion:  "v1.32.0-eks-1-32-1",
+			wantPreKubeadm:  []string{"generated"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			current := controlPlaneStackedEtcd()
+			current.ControlPlaneMachineTemplate.Name = "my-machine-template-1"
+			current.KubeadmControlPlane.Spec.MachineTemplate.InfrastructureRef.Name = "my-machine-template-1"
+			current.KubeadmControlPlane.Spec.Version = tt.importedVersion
+			current.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands = []string{"imported"}
+			clusterapi.MarkImported(current.KubeadmControlPlane, tt.importedVersion)
+			client := test.NewFakeKubeClient(clientutil.ObjectsToClientObjects(current.Objects())...)
+
+			cp := controlPlaneStackedEtcd()
+			cp.ControlPlaneMachineTemplate.Name = "my-machine-template-1"
+			cp.KubeadmControlPlane.Spec.Version = tt.desiredVersion
+			cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands = []string{"generated"}
+
+			g.Expect(cp.UpdateImmutableObjectNames(ctx, client, dummyRetriever, noChangesCompare)).To(Succeed())
+			g.Expect(cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands).To(Equal(tt.wantPreKubeadm))
+			g.Expect(cp.KubeadmControlPlane.Spec.MachineTemplate.InfrastructureRef.Name).To(Equal("my-machine-template-1"))
+		})
+	}
+}
+
+func TestControlPlaneUpdateImmutableObjectNamesImportedSpecChanged(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := "v1.31.1-eks-1-31-4"
+	current := controlPlaneStackedEtcd()
+	current.ControlPlaneMachineTemplate.Name = "my-machine-template-1"
+	current.KubeadmControlPlane.Spec.MachineTemplate.InfrastructureRef.Name = "my-machine-template-1"
+	current.KubeadmControlPlane.Spec.Version = version
+	current.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands = []string{"imported"}
+	clusterapi.MarkImported(current.KubeadmControlPlane, version)
+	client := test.NewFakeKubeClient(clientutil.ObjectsToClientObjects(current.Objects())...)
+
+	desired := func(preKubeadm string) *dockerControlPlane {
+		cp := controlPlaneStackedEtcd()
+		cp.ControlPlaneMachineTemplate.Name = "my-machine-template-1"
+		cp.KubeadmControlPlane.Spec.Version = version
+		cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands = []string{preKubeadm}
+		return cp
+	}
+
+	cp := desired("generated")
+	g.Expect(cp.UpdateImmutableObjectNames(ctx, client, dummyRetriever, noChangesCompare)).To(Succeed())
+	g.Expect(cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands).To(Equal([]string{"imported"}))
+	g.Expect(cp.KubeadmControlPlane.Annotations).To(HaveKeyWithValue(clusterapi.ImportedAnnotation, version))
+	g.Expect(cp.KubeadmControlPlane.Annotations).To(HaveKey(clusterapi.ImportedConfigHashAnnotation))
+
+	current.KubeadmControlPlane.Annotations = cp.KubeadmControlPlane.Annotations
+	client = test.NewFakeKubeClient(clientutil.ObjectsToClientObjects(current.Objects())...)
+
+	cp = desired("generated")
+	g.Expect(cp.UpdateImmutableObjectNames(ctx, client, dummyRetriever, noChangesCompare)).To(Succeed())
+	g.Expect(cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands).To(Equal([]string{"imported"}))
+
+	cp = desired("edited")
+	g.Expect(cp.UpdateImmutableObjectNames(ctx, client, dummyRetriever, noChangesCompare)).To(Succeed())
+	g.Expect(cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands).To(Equal([]string{"edited"}))
+	g.Expect(cp.KubeadmControlPlane.Annotations).To(HaveKeyWithValue(clusterapi.ImportedAnnotation, ""))
+	g.Expect(cp.KubeadmControlPlane.Annotations).NotTo(HaveKey(clusterapi.ImportedConfigHashAnnotation))
+}
+
 func TestControlPlaneUpdateImmutableObjectNamesNoEtcdCluster(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
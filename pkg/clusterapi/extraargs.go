@@ -71,12 +71,34 @@ func PodIAMAuthExtraArgs(podIAMConfig *v1alpha1.PodIAMConfig) ExtraArgs {
 	return args
 }
 
+// NodeCIDRMaskExtraArgs returns the kube-controller-manager node CIDR mask size flags. Dual-stack
+// clusters need a flag per IP family, single stack IPv6 clusters use the IPv6 mask size.
 func NodeCIDRMaskExtraArgs(clusterNetwork *v1alpha1.ClusterNetwork) ExtraArgs {
-	if clusterNetwork == nil || clusterNetwork.Nodes == nil || clusterNetwork.Nodes.CIDRMaskSize == nil {
+	if clusterNetwork == nil || clusterNetwork.Nodes == nil {
 		return nil
 	}
+	nodes := clusterNetwork.Nodes
+
 	args := ExtraArgs{}
-	args.AddIfNotEmpty("node-cidr-mask-size", strconv.Itoa(*clusterNetwork.Nodes.CIDRMaskSize))
+	switch {
+	case clusterNetwork.IsDualStack():
+		if nodes.CIDRMaskSize != nil {
+			args.AddIfNotEmpty("node-cidr-mask-size-ipv4", strconv.Itoa(*nodes.CIDRMaskSize))
+		}
+		if nodes.CIDRMaskSizeIPv6 != nil {
+			args.AddIfNotEmpty("node-cidr-mask-size-ipv6", strconv.Itoa(*nodes.CIDRMaskSizeIPv6))
+		}
+	case clusterNetwork.HasIPFamily(v1alpha1.IPv6Family):
+		if nodes.CIDRMaskSizeIPv6 != nil {
+			args.AddIfNotEmpty("node-cidr-mask-size", strconv.Itoa(*nodes.CIDRMaskSizeIPv6))
+		}
+	case nodes.CIDRMaskSize != nil:
+		args.AddIfNotEmpty("node-cidr-mask-size", strconv.Itoa(*nodes.CIDRMaskSize))
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
 	return args
 }
 
@@ -586,6 +586,27 @@ func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			testName: "ipv6 with nodes config",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods:  v1alpha1.Pods{CidrBlocks: []string{"fd00:100::/56"}},
+				Nodes: &v1alpha1.Nodes{CIDRMaskSize: nodeCidrMaskSize, CIDRMaskSizeIPv6: ptr.Int(66)},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-cidr-mask-size": "66",
+			},
+		},
+		{
+			testName: "dual-stack with nodes config",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods:  v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16", "fd00:100::/56"}},
+				Nodes: &v1alpha1.Nodes{CIDRMaskSize: nodeCidrMaskSize, CIDRMaskSizeIPv6: ptr.Int(66)},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-cidr-mask-size-ipv4": "28",
+				"node-cidr-mask-size-ipv6": "66",
+			},
+		},
 	}
 
 	for _, tt := range tests {
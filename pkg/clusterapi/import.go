@@ -0,0 +1,87 @@
+package clusterapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ImportedAnnotation marks CAPI objects that were adopted by EKS-A from an existing cluster
+	// instead of being created by it. Its value is the Kubernetes version the object was running
+	// when it was imported. While neither the desired version nor the cluster spec change, the
+	// controller keeps the imported kubeadm configuration so taking ownership of the cluster
+	// doesn't roll its machines. Once the imported configuration is replaced, the value is emptied.
+	ImportedAnnotation = "anywhere.eks.amazonaws.com/imported"
+
+	// ImportedConfigHashAnnotation is the hash of the kubeadm configuration EKS-A generated from
+	// the cluster spec when it first kept the imported one. A different hash means the spec was
+	// changed after the import and the generated configuration has to be rolled out.
+	ImportedConfigHashAnnotation = "anywhere.eks.amazonaws.com/imported-config-hash"
+)
+
+// IsImported returns true if the object was adopted by EKS-A from an existing cluster.
+func IsImported(obj metav1.Object) bool {
+	_, ok := obj.GetAnnotations()[ImportedAnnotation]
+	return ok
+}
+
+// MarkImported sets the ImportedAnnotation on obj with the Kubernetes version it's currently running.
+func MarkImported(obj metav1.Object, version string) {
+	setAnnotation(obj, ImportedAnnotation, version)
+}
+
+// keepImportedConfig returns true if the imported kubeadm configuration of current should be kept
+// instead of generated, the one built from the cluster spec. It sets the imported annotations in
+// desired, the object that will replace current, so the decision is persisted: once the generated
+// configuration is used, the imported one is never kept again.
+func keepImportedConfig(current, desired metav1.Object, currentVersion, desiredVersion string, generated any) (bool, error) {
+	imported, ok := current.GetAnnotations()[ImportedAnnotation]
+	if !ok {
+		return false, nil
+	}
+
+	hash, err := configHash(generated)
+	if err != nil {
+		return false, err
+	}
+
+	recordedHash, hasHash := current.GetAnnotations()[ImportedConfigHashAnnotation]
+	if importedAtVersion(current, currentVersion, desiredVersion) && (!hasHash || recordedHash == hash) {
+		MarkImported(desired, imported)
+		setAnnotation(desired, ImportedConfigHashAnnotation, hash)
+		return true, nil
+	}
+
+	// The annotation is kept with an empty value so the object is still treated as imported when naming it.
+	MarkImported(desired, "")
+	return false, nil
+}
+
+func configHash(config any) (string, error) {
+	content, err := json.Marshal(config)
+	if err != nil {
+		return "", errors.Wrap(err, "marshalling kubeadm config to compute its hash")
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func setAnnotation(obj metav1.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
+
+// importedAtVersion returns true if obj was imported while running version and
+// it's still running that same version.
+func importedAtVersion(obj metav1.Object, currentVersion, desiredVersion string) bool {
+	imported, ok := obj.GetAnnotations()[ImportedAnnotation]
+	return ok && imported != "" && imported == currentVersion && currentVersion == desiredVersion
+}
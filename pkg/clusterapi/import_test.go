@@ -0,0 +1,19 @@
+package clusterapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+)
+
+func TestMarkImported(t *testing.T) {
+	g := NewWithT(t)
+	kcp := kubeadmControlPlane()
+	g.Expect(clusterapi.IsImported(kcp)).To(BeFalse())
+
+	clusterapi.MarkImported(kcp, "v1.31.1-eks-1-31-4")
+	g.Expect(clusterapi.IsImported(kcp)).To(BeTrue())
+	g.Expect(kcp.Annotations).To(HaveKeyWithValue(clusterapi.ImportedAnnotation, "v1.31.1-eks-1-31-4"))
+}
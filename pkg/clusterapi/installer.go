@@ -47,7 +47,7 @@ func (i *Installer) EnsureEtcdProvidersInstallation(ctx context.Context, managem
 	}
 
 	if len(installProviders) > 0 {
-		return i.capiClient.InstallEtcdadmProviders(ctx, managementComponents, currSpec, managementCluster, provider, installProviders)
+		return i.capiClient.InstallProviders(ctx, managementComponents, currSpec, managementCluster, provider, installProviders)
 	}
 	return nil
 }
@@ -60,7 +60,7 @@ func TestEnsureEtcdProviderInstallStackedEtcd(t *testing.T) {
 
 	tt.kubectlClient.EXPECT().CheckProviderExists(tt.ctx, tt.cluster.KubeconfigFile, constants.EtcdAdmBootstrapProviderName, constants.EtcdAdmBootstrapProviderSystemNamespace).Return(false, nil)
 	tt.kubectlClient.EXPECT().CheckProviderExists(tt.ctx, tt.cluster.KubeconfigFile, constants.EtcdadmControllerProviderName, constants.EtcdAdmControllerSystemNamespace).Return(false, nil)
-	tt.capiClient.EXPECT().InstallEtcdadmProviders(tt.ctx, tt.managementComponents, tt.currentSpec, tt.cluster, tt.provider, []string{constants.EtcdAdmBootstrapProviderName, constants.EtcdadmControllerProviderName})
+	tt.capiClient.EXPECT().InstallProviders(tt.ctx, tt.managementComponents, tt.currentSpec, tt.cluster, tt.provider, []string{constants.EtcdAdmBootstrapProviderName, constants.EtcdadmControllerProviderName})
 
 	tt.Expect(tt.installer.EnsureEtcdProvidersInstallation(tt.ctx, tt.cluster, tt.provider, tt.managementComponents, tt.currentSpec))
 }
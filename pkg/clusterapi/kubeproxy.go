@@ -0,0 +1,19 @@
+package clusterapi
+
+import (
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// kubeProxyAddonPhase is the kubeadm init phase that installs kube-proxy.
+const kubeProxyAddonPhase = "addon/kube-proxy"
+
+// InitSkipPhases returns the kubeadm init phases to skip when creating the first control plane node.
+// kube-proxy isn't installed when Cilium replaces it, so it never runs alongside Cilium.
+func InitSkipPhases(cluster *anywherev1.Cluster) []string {
+	cniConfig := cluster.Spec.ClusterNetwork.CNIConfig
+	if cniConfig == nil || cniConfig.Cilium == nil || !cniConfig.Cilium.KubeProxyReplacement {
+		return nil
+	}
+
+	return []string{kubeProxyAddonPhase}
+}
@@ -0,0 +1,44 @@
+package clusterapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+)
+
+func TestInitSkipPhases(t *testing.T) {
+	tests := []struct {
+		name      string
+		cniConfig *v1alpha1.CNIConfig
+		want      []string
+	}{
+		{
+			name: "no cni config",
+		},
+		{
+			name:      "kindnetd",
+			cniConfig: &v1alpha1.CNIConfig{Kindnetd: &v1alpha1.KindnetdConfig{}},
+		},
+		{
+			name:      "cilium with kube-proxy",
+			cniConfig: &v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{}},
+		},
+		{
+			name:      "cilium kube-proxy replacement",
+			cniConfig: &v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{KubeProxyReplacement: true}},
+			want:      []string{"addon/kube-proxy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &v1alpha1.Cluster{}
+			cluster.Spec.ClusterNetwork.CNIConfig = tt.cniConfig
+
+			g.Expect(clusterapi.InitSkipPhases(cluster)).To(Equal(tt.want))
+		})
+	}
+}
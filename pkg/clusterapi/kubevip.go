@@ -2,6 +2,7 @@ package clusterapi
 
 import (
 	"fmt"
+	"net"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +29,15 @@ func SetKubeVipInKubeadmControlPlane(kcp *controlplanev1.KubeadmControlPlane, ad
 	return nil
 }
 
+// KubeVipCIDR returns the kube-vip vip_cidr for the control plane endpoint address, 128 for an IPv6
+// address and 32 otherwise.
+func KubeVipCIDR(address string) string {
+	if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+		return "128"
+	}
+	return "32"
+}
+
 func kubeVip(address, image string) *corev1.Pod {
 	return &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
@@ -55,7 +65,7 @@ func kubeVip(address, image string) *corev1.Pod {
 						},
 						{
 							Name:  "vip_cidr",
-							Value: "32",
+							Value: KubeVipCIDR(address),
 						},
 						{
 							Name:  "cp_enable",
@@ -38,18 +38,18 @@ func (m *MockCAPIClient) EXPECT() *MockCAPIClientMockRecorder {
 	return m.recorder
 }
 
-// InstallEtcdadmProviders mocks base method.
-func (m *MockCAPIClient) InstallEtcdadmProviders(ctx context.Context, managementComponents *cluster.ManagementComponents, clusterSpec *cluster.Spec, cluster *types.Cluster, provider providers.Provider, installProviders []string) error {
+// InstallProviders mocks base method.
+func (m *MockCAPIClient) InstallProviders(ctx context.Context, managementComponents *cluster.ManagementComponents, clusterSpec *cluster.Spec, cluster *types.Cluster, provider providers.Provider, installProviders []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallEtcdadmProviders", ctx, managementComponents, clusterSpec, cluster, provider, installProviders)
+	ret := m.ctrl.Call(m, "InstallProviders", ctx, managementComponents, clusterSpec, cluster, provider, installProviders)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// InstallEtcdadmProviders indicates an expected call of InstallEtcdadmProviders.
-func (mr *MockCAPIClientMockRecorder) InstallEtcdadmProviders(ctx, managementComponents, clusterSpec, cluster, provider, installProviders interface{}) *gomock.Call {
+// InstallProviders indicates an expected call of InstallProviders.
+func (mr *MockCAPIClientMockRecorder) InstallProviders(ctx, managementComponents, clusterSpec, cluster, provider, installProviders interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallEtcdadmProviders", reflect.TypeOf((*MockCAPIClient)(nil).InstallEtcdadmProviders), ctx, managementComponents, clusterSpec, cluster, provider, installProviders)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallProviders", reflect.TypeOf((*MockCAPIClient)(nil).InstallProviders), ctx, managementComponents, clusterSpec, cluster, provider, installProviders)
 }
 
 // Upgrade mocks base method.
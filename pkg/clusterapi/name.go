@@ -155,6 +155,13 @@ func EnsureNewNameIfChanged[M Object[M]](ctx context.Context,
 	}
 
 	if !equal(new, current) {
+		if IsImported(current) {
+			// Objects imported from an existing cluster don't necessarily follow the EKS-A naming
+			// convention, so we fall back to the default name built from the current one.
+			new.SetName(IncrementNameWithFallbackDefault(new.GetName(), DefaultObjectName(new.GetName())))
+			return nil
+		}
+
 		newName, err := IncrementName(new.GetName())
 		if err != nil {
 			return errors.Wrapf(err, "incrementing name for %s %s/%s",
@@ -304,6 +304,22 @@ func TestEnsureNewNameIfChangedErrorIncrementingName(t *testing.T) {
 	)
 }
 
+func TestEnsureNewNameIfChangedImportedObjectFallbackName(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mt := dockerMachineTemplate()
+	mt.Name = "my-machine-template"
+	client := test.NewFakeKubeClient()
+	importedRetriever := func(_ context.Context, _ kubernetes.Client, _, _ string) (*dockerv1.DockerMachineTemplate, error) {
+		current := dockerMachineTemplate()
+		clusterapi.MarkImported(current, "v1.31.1-eks-1-31-4")
+		return current, nil
+	}
+
+	g.Expect(clusterapi.EnsureNewNameIfChanged(ctx, client, importedRetriever, withChangesCompare, mt)).To(Succeed())
+	g.Expect(mt.Name).To(Equal("my-machine-template-1"))
+}
+
 func TestEnsureNewNameIfChangedObjectNeedsNewName(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
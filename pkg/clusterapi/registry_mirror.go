@@ -3,6 +3,7 @@ package clusterapi
 import (
 	_ "embed"
 	"fmt"
+	"sort"
 
 	etcdbootstrapv1 "github.com/aws/etcdadm-bootstrap-provider/api/v1beta1"
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
@@ -68,20 +69,50 @@ func setRegistryMirrorInEtcdCluster(etcd *etcdv1.EtcdadmCluster, mirrorConfig *v
 }
 
 func registryMirror(mirrorConfig *v1alpha1.RegistryMirrorConfiguration) bootstrapv1.RegistryMirrorConfiguration {
+	mirror := registrymirror.FromClusterRegistryMirrorConfiguration(mirrorConfig)
+	if !mirror.HasFallbacks() {
+		return bootstrapv1.RegistryMirrorConfiguration{
+			Endpoint: containerd.ToAPIEndpoint(mirror.CoreEKSAMirror()),
+			CACert:   mirrorConfig.CACertContent,
+		}
+	}
+
+	registries := make([]string, 0, len(mirror.NamespacedRegistryMap))
+	for registry := range mirror.NamespacedRegistryMap {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	fallbacks := containerd.ToAPIEndpointsList(mirror.FallbackMirrors())
+	mirrors := make([]bootstrapv1.Mirror, 0, len(registries))
+	for _, registry := range registries {
+		mirrors = append(mirrors, bootstrapv1.Mirror{
+			Registry:  registry,
+			Endpoints: append([]string{containerd.ToAPIEndpoint(mirror.NamespacedRegistryMap[registry])}, fallbacks[registry]...),
+		})
+	}
+
 	return bootstrapv1.RegistryMirrorConfiguration{
-		Endpoint: containerd.ToAPIEndpoint(registrymirror.FromClusterRegistryMirrorConfiguration(mirrorConfig).CoreEKSAMirror()),
-		CACert:   mirrorConfig.CACertContent,
+		CACert:  containerd.CACertBundle(mirror),
+		Mirrors: mirrors,
 	}
 }
 
 type values map[string]interface{}
 
 func registryMirrorConfigContent(registryMirror *registrymirror.RegistryMirror) (string, error) {
+	hosts, err := containerd.HostConfigs(registryMirror)
+	if err != nil {
+		return "", err
+	}
+
 	val := values{
-		"registryMirrorMap": containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap),
-		"mirrorBase":        registryMirror.BaseRegistry,
-		"registryCACert":    registryMirror.CACertContent,
-		"insecureSkip":      registryMirror.InsecureSkipVerify,
+		"registryMirrorMap":       containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap),
+		"registryMirrorFallbacks": containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors()),
+		"registryMirrorHosts":     hosts,
+		"mirrorBase":              registryMirror.BaseRegistry,
+		"registryCACert":          registryMirror.CACertContent,
+		"insecureSkip":            registryMirror.InsecureSkipVerify,
 	}
 
 	config, err := templater.Execute(containerdConfig, val)
@@ -113,6 +144,17 @@ func registryMirrorConfig(registryMirrorConfig *v1alpha1.RegistryMirrorConfigura
 		})
 	}
 
+	for _, e := range registryMirror.Endpoints {
+		if e.CACertContent == "" {
+			continue
+		}
+		files = append(files, bootstrapv1.File{
+			Path:    fmt.Sprintf("/etc/containerd/certs.d/%s/ca.crt", e.BaseRegistry),
+			Owner:   "root:root",
+			Content: e.CACertContent,
+		})
+	}
+
 	return files, nil
 }
 
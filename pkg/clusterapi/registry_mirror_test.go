@@ -126,6 +126,87 @@ var registryMirrorTests = []struct {
 			CACert:   "xyz",
 		},
 	},
+	{
+		name: "with additional endpoints and fallback to upstream",
+		registryMirrorConfig: &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint:      "1.2.3.4",
+			Port:          "443",
+			CACertContent: "xyz",
+			OCINamespaces: []v1alpha1.OCINamespace{
+				{
+					Registry:  "public.ecr.aws",
+					Namespace: "eks-anywhere",
+				},
+				{
+					Registry:  "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+					Namespace: "curated-packages",
+				},
+			},
+			Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+				{
+					Endpoint:      "5.6.7.8",
+					Port:          "8443",
+					CACertContent: "abc",
+				},
+				{
+					Endpoint: "9.10.11.12",
+					Port:     "443",
+					OCINamespaces: []v1alpha1.OCINamespace{
+						{
+							Registry:  "public.ecr.aws",
+							Namespace: "backup",
+						},
+					},
+					InsecureSkipVerify: true,
+				},
+			},
+			FallbackToUpstream: true,
+		},
+		wantFiles: []bootstrapv1.File{
+			{
+				Path:  "/etc/containerd/config_append.toml",
+				Owner: "root:root",
+				Content: `[plugins."io.containerd.grpc.v1.cri".registry.mirrors]
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."783794618700.dkr.ecr.*.amazonaws.com"]
+    endpoint = ["https://1.2.3.4:443/v2/curated-packages", "https://5.6.7.8:8443/v2/curated-packages"]
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."public.ecr.aws"]
+    endpoint = ["https://1.2.3.4:443/v2/eks-anywhere", "https://5.6.7.8:8443/v2/eks-anywhere", "https://9.10.11.12:443/v2/backup", "https://public.ecr.aws"]
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."1.2.3.4:443".tls]
+    ca_file = "/etc/containerd/certs.d/1.2.3.4:443/ca.crt"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."5.6.7.8:8443".tls]
+    ca_file = "/etc/containerd/certs.d/5.6.7.8:8443/ca.crt"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."9.10.11.12:443".tls]
+    insecure_skip_verify = true`,
+			},
+			{
+				Path:    "/etc/containerd/certs.d/1.2.3.4:443/ca.crt",
+				Owner:   "root:root",
+				Content: "xyz",
+			},
+			{
+				Path:    "/etc/containerd/certs.d/5.6.7.8:8443/ca.crt",
+				Owner:   "root:root",
+				Content: "abc",
+			},
+		},
+		wantRegistryConfig: bootstrapv1.RegistryMirrorConfiguration{
+			CACert: "xyz\nabc",
+			Mirrors: []bootstrapv1.Mirror{
+				{
+					Registry:  "783794618700.dkr.ecr.*.amazonaws.com",
+					Endpoints: []string{"1.2.3.4:443/v2/curated-packages", "5.6.7.8:8443/v2/curated-packages"},
+				},
+				{
+					Registry:  "public.ecr.aws",
+					Endpoints: []string{"1.2.3.4:443/v2/eks-anywhere", "5.6.7.8:8443/v2/eks-anywhere", "9.10.11.12:443/v2/backup", "public.ecr.aws"},
+				},
+			},
+		},
+		wantRegistryConfigEtcd: &etcdbootstrapv1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4:443/v2/eks-anywhere",
+			CACert:   "xyz",
+		},
+	},
 }
 
 func TestSetRegistryMirrorInKubeadmControlPlaneBottleRocket(t *testing.T) {
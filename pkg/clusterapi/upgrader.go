@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/types"
@@ -32,6 +33,27 @@ func (u *Upgrader) Upgrade(ctx context.Context, managementCluster *types.Cluster
 	}
 
 	capiChangeDiff := capiChangeDiff(currentManagementComponents, newManagementComponents, provider)
+	if newManagementComponents.VSphere.IPAM != nil && provider.Name() == constants.VSphereProviderName {
+		// The in-cluster IPAM provider is installed in every vSphere management cluster, but the
+		// ones created before it was added to the bundle don't have it yet.
+		ipamExists, err := u.kubectlClient.CheckProviderExists(ctx, managementCluster.KubeconfigFile, constants.InClusterIPAMProviderName, constants.CapiIPAMInClusterSystemNamespace)
+		if err != nil {
+			return nil, err
+		}
+		if !ipamExists {
+			logger.V(1).Info("Installing in-cluster IPAM provider")
+			if err := u.capiClient.InstallProviders(ctx, newManagementComponents, newSpec, managementCluster, provider, []string{constants.InClusterIPAMProviderName}); err != nil {
+				return nil, fmt.Errorf("installing in-cluster IPAM provider: %v", err)
+			}
+			// It's installed with the new version already, there is nothing to upgrade.
+			if capiChangeDiff != nil && capiChangeDiff.IPAMProvider != nil {
+				capiChangeDiff.IPAMProvider = nil
+				if len(capiChangeDiff.toChangeDiff().ComponentReports) == 0 {
+					capiChangeDiff = nil
+				}
+			}
+		}
+	}
 	if capiChangeDiff == nil {
 		logger.V(1).Info("Nothing to upgrade for CAPI")
 		return nil, nil
@@ -51,6 +73,7 @@ type CAPIChangeDiff struct {
 	ControlPlane           *types.ComponentChangeDiff
 	BootstrapProviders     []types.ComponentChangeDiff
 	InfrastructureProvider *types.ComponentChangeDiff
+	IPAMProvider           *types.ComponentChangeDiff
 }
 
 func (c *CAPIChangeDiff) toChangeDiff() *types.ChangeDiff {
@@ -58,8 +81,8 @@ func (c *CAPIChangeDiff) toChangeDiff() *types.ChangeDiff {
 		logger.V(1).Info("Nothing to upgrade for CAPI")
 		return nil
 	}
-	r := make([]*types.ComponentChangeDiff, 0, 4+len(c.BootstrapProviders))
-	r = append(r, c.CertManager, c.Core, c.ControlPlane, c.InfrastructureProvider)
+	r := make([]*types.ComponentChangeDiff, 0, 5+len(c.BootstrapProviders))
+	r = append(r, c.CertManager, c.Core, c.ControlPlane, c.InfrastructureProvider, c.IPAMProvider)
 	for _, bootstrapChangeDiff := range c.BootstrapProviders {
 		b := bootstrapChangeDiff
 		r = append(r, &b)
@@ -146,6 +169,17 @@ func capiChangeDiff(currentManagementComponents, newManagementComponents *cluste
 		componentChanged = true
 	}
 
+	currentIPAM, newIPAM := currentManagementComponents.VSphere.IPAM, newManagementComponents.VSphere.IPAM
+	if currentIPAM != nil && newIPAM != nil && currentIPAM.Version != newIPAM.Version {
+		changeDiff.IPAMProvider = &types.ComponentChangeDiff{
+			ComponentName: "in-cluster",
+			NewVersion:    newIPAM.Version,
+			OldVersion:    currentIPAM.Version,
+		}
+		logger.V(1).Info("CAPI in-cluster IPAM Provider change diff", "oldVersion", changeDiff.IPAMProvider.OldVersion, "newVersion", changeDiff.IPAMProvider.NewVersion)
+		componentChanged = true
+	}
+
 	if !componentChanged {
 		return nil
 	}
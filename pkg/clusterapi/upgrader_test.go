@@ -13,8 +13,10 @@ import (
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/clusterapi/mocks"
+	"github.com/aws/eks-anywhere/pkg/constants"
 	providerMocks "github.com/aws/eks-anywhere/pkg/providers/mocks"
 	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 type upgraderTest struct {
@@ -259,3 +261,91 @@ func TestUpgraderUpgradeCAPIClientError(t *testing.T) {
 	_, err := tt.upgrader.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.currentManagementComponents, tt.newManagementComponents, tt.newSpec)
 	tt.Expect(err).NotTo(BeNil())
 }
+
+func TestUpgraderUpgradeIPAMChanges(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.currentManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.1.0"}
+	tt.newManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.2.0"}
+
+	changeDiff := &clusterapi.CAPIChangeDiff{
+		IPAMProvider: &types.ComponentChangeDiff{
+			ComponentName: "in-cluster",
+			NewVersion:    "v0.2.0",
+			OldVersion:    "v0.1.0",
+		},
+	}
+	wantDiff := &types.ChangeDiff{
+		ComponentReports: []types.ComponentChangeDiff{*changeDiff.IPAMProvider},
+	}
+
+	tt.provider.EXPECT().ChangeDiff(tt.currentManagementComponents, tt.newManagementComponents).Return(nil)
+	tt.provider.EXPECT().Name().Return(constants.VSphereProviderName)
+	tt.kubectlClient.EXPECT().CheckProviderExists(tt.ctx, tt.cluster.KubeconfigFile, "ipam-in-cluster", "capi-ipam-in-cluster-system").Return(true, nil)
+	tt.capiClient.EXPECT().Upgrade(tt.ctx, tt.cluster, tt.provider, tt.newManagementComponents, tt.newSpec, changeDiff)
+
+	tt.Expect(tt.upgrader.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.currentManagementComponents, tt.newManagementComponents, tt.newSpec)).To(Equal(wantDiff))
+}
+
+func TestUpgraderUpgradeIPAMChangesNotInstalled(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.currentManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.1.0"}
+	tt.newManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.2.0"}
+
+	tt.provider.EXPECT().ChangeDiff(tt.currentManagementComponents, tt.newManagementComponents).Return(nil)
+	tt.provider.EXPECT().Name().Return(constants.VSphereProviderName)
+	tt.kubectlClient.EXPECT().CheckProviderExists(tt.ctx, tt.cluster.KubeconfigFile, "ipam-in-cluster", "capi-ipam-in-cluster-system").Return(false, nil)
+	tt.capiClient.EXPECT().InstallProviders(tt.ctx, tt.newManagementComponents, tt.newSpec, tt.cluster, tt.provider, []string{constants.InClusterIPAMProviderName})
+
+	tt.Expect(tt.upgrader.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.currentManagementComponents, tt.newManagementComponents, tt.newSpec)).To(BeNil())
+}
+
+func TestUpgraderUpgradeIPAMAddedToBundleInstallsProvider(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.newManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.2.0"}
+
+	tt.provider.EXPECT().ChangeDiff(tt.currentManagementComponents, tt.newManagementComponents).Return(tt.providerChangeDiff)
+	tt.provider.EXPECT().Name().Return(constants.VSphereProviderName)
+	tt.kubectlClient.EXPECT().CheckProviderExists(tt.ctx, tt.cluster.KubeconfigFile, "ipam-in-cluster", "capi-ipam-in-cluster-system").Return(false, nil)
+	tt.capiClient.EXPECT().InstallProviders(tt.ctx, tt.newManagementComponents, tt.newSpec, tt.cluster, tt.provider, []string{constants.InClusterIPAMProviderName})
+	tt.capiClient.EXPECT().Upgrade(tt.ctx, tt.cluster, tt.provider, tt.newManagementComponents, tt.newSpec, &clusterapi.CAPIChangeDiff{InfrastructureProvider: tt.providerChangeDiff})
+
+	tt.Expect(tt.upgrader.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.currentManagementComponents, tt.newManagementComponents, tt.newSpec)).To(Equal(&types.ChangeDiff{
+		ComponentReports: []types.ComponentChangeDiff{*tt.providerChangeDiff},
+	}))
+}
+
+func TestUpgraderUpgradeIPAMNotVSphere(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.newManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.2.0"}
+
+	tt.provider.EXPECT().ChangeDiff(tt.currentManagementComponents, tt.newManagementComponents).Return(nil)
+	tt.provider.EXPECT().Name().Return(constants.DockerProviderName)
+
+	tt.Expect(tt.upgrader.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.currentManagementComponents, tt.newManagementComponents, tt.newSpec)).To(BeNil())
+}
+
+func TestUpgraderUpgradeIPAMInstallError(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.newManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.2.0"}
+
+	tt.provider.EXPECT().ChangeDiff(tt.currentManagementComponents, tt.newManagementComponents).Return(nil)
+	tt.provider.EXPECT().Name().Return(constants.VSphereProviderName)
+	tt.kubectlClient.EXPECT().CheckProviderExists(tt.ctx, tt.cluster.KubeconfigFile, "ipam-in-cluster", "capi-ipam-in-cluster-system").Return(false, nil)
+	tt.capiClient.EXPECT().InstallProviders(tt.ctx, tt.newManagementComponents, tt.newSpec, tt.cluster, tt.provider, []string{constants.InClusterIPAMProviderName}).Return(errors.New("error from clusterctl"))
+
+	_, err := tt.upgrader.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.currentManagementComponents, tt.newManagementComponents, tt.newSpec)
+	tt.Expect(err).To(MatchError(ContainSubstring("error from clusterctl")))
+}
+
+func TestUpgraderUpgradeIPAMCheckProviderError(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.currentManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.1.0"}
+	tt.newManagementComponents.VSphere.IPAM = &releasev1.IPAMBundle{Version: "v0.2.0"}
+
+	tt.provider.EXPECT().ChangeDiff(tt.currentManagementComponents, tt.newManagementComponents).Return(nil)
+	tt.provider.EXPECT().Name().Return(constants.VSphereProviderName)
+	tt.kubectlClient.EXPECT().CheckProviderExists(tt.ctx, tt.cluster.KubeconfigFile, "ipam-in-cluster", "capi-ipam-in-cluster-system").Return(false, errors.New("error from kubectl"))
+
+	_, err := tt.upgrader.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.currentManagementComponents, tt.newManagementComponents, tt.newSpec)
+	tt.Expect(err).To(MatchError(ContainSubstring("error from kubectl")))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	kubeadmv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
 
@@ -68,6 +69,8 @@ func (g *WorkerGroup[M]) Objects() []kubernetes.Object {
 // at the end of the name.
 // This process is performed to the provider machine template and the kubeadmconfigtemplate.
 // The kubeadmconfigtemplate is not immutable at the API level but we treat it as such for consistency.
+// If the MachineDeployment was imported from an existing cluster and neither its Kubernetes version nor the
+// generated kubeadmconfigtemplate change, the current kubeadmconfigtemplate is kept so EKS-A doesn't roll the imported machines.
 func (g *WorkerGroup[M]) UpdateImmutableObjectNames(
 	ctx context.Context,
 	client kubernetes.Client,
@@ -91,6 +94,21 @@ func (g *WorkerGroup[M]) UpdateImmutableObjectNames(
 	g.MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name = g.ProviderMachineTemplate.GetName()
 
 	g.KubeadmConfigTemplate.SetName(currentMachineDeployment.Spec.Template.Spec.Bootstrap.ConfigRef.Name)
+	keep, err := keepImportedConfig(currentMachineDeployment, g.MachineDeployment,
+		ptr.Deref(currentMachineDeployment.Spec.Template.Spec.Version, ""),
+		ptr.Deref(g.MachineDeployment.Spec.Template.Spec.Version, ""),
+		g.KubeadmConfigTemplate.Spec,
+	)
+	if err != nil {
+		return err
+	}
+	if keep {
+		currentKubeadmConfigTemplate, err := GetKubeadmConfigTemplate(ctx, client, g.KubeadmConfigTemplate.Name, g.KubeadmConfigTemplate.Namespace)
+		if err != nil {
+			return errors.Wrap(err, "reading imported kubeadm config template from API")
+		}
+		g.KubeadmConfigTemplate.Spec = *currentKubeadmConfigTemplate.Spec.DeepCopy()
+	}
 	if err = EnsureNewNameIfChanged(ctx, client, GetKubeadmConfigTemplate, KubeadmConfigTemplateEqual, g.KubeadmConfigTemplate); err != nil {
 		return err
 	}
@@ -171,6 +171,63 @@ func TestWorkerGroupUpdateImmutableObjectNamesSuccess(t *testing.T) {
 	g.Expect(group.MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal(group.ProviderMachineTemplate.Name))
 }
 
+func TestWorkerGroupUpdateImmutableObjectNamesImportedKeepsKubeadmConfigTemplate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := "v1.31.1-eks-1-31-4"
+	group := &dockerGroup{
+		MachineDeployment:       machineDeployment(),
+		ProviderMachineTemplate: dockerMachineTemplate(),
+		KubeadmConfigTemplate:   kubeadmConfigTemplate(),
+	}
+	group.KubeadmConfigTemplate.Name = "imported-md-0"
+	group.MachineDeployment.Spec.Template.Spec.Version = &version
+	group.MachineDeployment.Spec.Template.Spec.InfrastructureRef = *objectReference(group.ProviderMachineTemplate)
+	group.MachineDeployment.Spec.Template.Spec.Bootstrap.ConfigRef = objectReference(group.KubeadmConfigTemplate)
+	clusterapi.MarkImported(group.MachineDeployment, version)
+	client := test.NewFakeKubeClient(group.MachineDeployment, group.KubeadmConfigTemplate, group.ProviderMachineTemplate)
+	group.KubeadmConfigTemplate.Spec.Template.Spec.PostKubeadmCommands = []string{"ls"}
+
+	g.Expect(
+		group.UpdateImmutableObjectNames(ctx, client, dummyRetriever, noChangesCompare),
+	).To(Succeed())
+	g.Expect(group.KubeadmConfigTemplate.Name).To(Equal("imported-md-0"))
+	g.Expect(group.KubeadmConfigTemplate.Spec.Template.Spec.PostKubeadmCommands).To(BeEmpty())
+	g.Expect(group.MachineDeployment.Spec.Template.Spec.Bootstrap.ConfigRef.Name).To(Equal("imported-md-0"))
+}
+
+func TestWorkerGroupUpdateImmutableObjectNamesImportedSpecChanged(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := "v1.31.1-eks-1-31-4"
+	current := &dockerGroup{
+		MachineDeployment:       machineDeployment(),
+		ProviderMachineTemplate: dockerMachineTemplate(),
+		KubeadmConfigTemplate:   kubeadmConfigTemplate(),
+	}
+	current.KubeadmConfigTemplate.Name = "imported-md-0"
+	current.MachineDeployment.Spec.Template.Spec.Version = &version
+	current.MachineDeployment.Spec.Template.Spec.InfrastructureRef = *objectReference(current.ProviderMachineTemplate)
+	current.MachineDeployment.Spec.Template.Spec.Bootstrap.ConfigRef = objectReference(current.KubeadmConfigTemplate)
+	clusterapi.MarkImported(current.MachineDeployment, version)
+	clusterapi.MarkImported(current.KubeadmConfigTemplate, version)
+	current.MachineDeployment.Annotations[clusterapi.ImportedConfigHashAnnotation] = "hash-of-the-spec-at-import"
+	client := test.NewFakeKubeClient(current.MachineDeployment, current.KubeadmConfigTemplate, current.ProviderMachineTemplate)
+
+	group := current.DeepCopy()
+	group.MachineDeployment.Annotations = nil
+	group.KubeadmConfigTemplate.Annotations = nil
+	group.KubeadmConfigTemplate.Spec.Template.Spec.PostKubeadmCommands = []string{"ls"}
+
+	g.Expect(
+		group.UpdateImmutableObjectNames(ctx, client, dummyRetriever, noChangesCompare),
+	).To(Succeed())
+	g.Expect(group.KubeadmConfigTemplate.Name).To(Equal("imported-md-1"))
+	g.Expect(group.KubeadmConfigTemplate.Spec.Template.Spec.PostKubeadmCommands).To(Equal([]string{"ls"}))
+	g.Expect(group.MachineDeployment.Annotations).To(HaveKeyWithValue(clusterapi.ImportedAnnotation, ""))
+	g.Expect(group.MachineDeployment.Spec.Template.Spec.Bootstrap.ConfigRef.Name).To(Equal("imported-md-1"))
+}
+
 func TestGetKubeadmConfigTemplateSuccess(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
@@ -0,0 +1,242 @@
+package clusterbackup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/version"
+)
+
+const (
+	// FormatVersion is the version of the backup archive layout. Restore refuses archives with a different version.
+	FormatVersion = "v1"
+
+	metadataFileName     = "metadata.yaml"
+	capiFolder           = "capi"
+	resourcesFolder      = "resources"
+	etcdSnapshotFileName = "etcd-snapshot.db"
+	timeFormat           = "2006-01-02T15_04_05"
+)
+
+// Metadata describes the content of a backup archive.
+type Metadata struct {
+	FormatVersion string    `json:"formatVersion"`
+	EksaVersion   string    `json:"eksaVersion"`
+	ClusterName   string    `json:"clusterName"`
+	CreatedAt     time.Time `json:"createdAt"`
+	EtcdSnapshot  string    `json:"etcdSnapshot,omitempty"`
+}
+
+// Clusterctl saves and restores the CAPI objects of a management cluster.
+type Clusterctl interface {
+	BackupManagement(ctx context.Context, cluster *types.Cluster, managementStatePath, clusterName string) error
+	RestoreManagement(ctx context.Context, cluster *types.Cluster, managementStatePath string) error
+}
+
+// Packager packages a folder into a single file and back.
+type Packager interface {
+	Package(sourceFolder, dstFile string) error
+	UnPackage(orgFile, dstFolder string) error
+}
+
+// Manager backs up the state of a management cluster into an archive and restores it.
+type Manager struct {
+	clusterctl Clusterctl
+	ssh        SSHRunner
+	packager   Packager
+	now        func() time.Time
+}
+
+// NewManager builds a Manager.
+func NewManager(clusterctl Clusterctl, ssh SSHRunner, packager Packager) *Manager {
+	return &Manager{
+		clusterctl: clusterctl,
+		ssh:        ssh,
+		packager:   packager,
+		now:        time.Now,
+	}
+}
+
+// Backup saves the CAPI objects, the EKS-A objects and the provider secrets of a management cluster and
+// all its workload clusters into a gzipped tarball at archive. If etcd is not nil, a snapshot of the etcd
+// database is taken from the configured node and added to the archive.
+func (m *Manager) Backup(ctx context.Context, client kubernetes.Client, cluster *types.Cluster, archive string, etcd *EtcdSnapshotConfig) error {
+	stateDir := fmt.Sprintf("%s-backup-%s", cluster.Name, m.now().Format(timeFormat))
+	// clusterctl writes the CAPI objects under the cluster folder, so workDir is the folder
+	// holding everything written for this backup, including the CAPI objects.
+	workDir := filepath.Join(cluster.Name, stateDir)
+	// The folder contains secrets like the cluster CA keys, so it's removed even if the backup
+	// fails part-way.
+	defer os.RemoveAll(workDir)
+
+	logger.V(3).Info("Backing up CAPI objects", "cluster", cluster.Name)
+	if err := m.clusterctl.BackupManagement(ctx, cluster, filepath.Join(stateDir, capiFolder), ""); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Backing up EKS-A objects and secrets", "cluster", cluster.Name)
+	if err := backupResources(ctx, client, filepath.Join(workDir, resourcesFolder)); err != nil {
+		return err
+	}
+
+	metadata := Metadata{
+		FormatVersion: FormatVersion,
+		EksaVersion:   version.Get().GitVersion,
+		ClusterName:   cluster.Name,
+		CreatedAt:     m.now().UTC(),
+	}
+
+	if etcd != nil {
+		logger.V(3).Info("Taking etcd snapshot", "node", etcd.NodeIP)
+		snapshot, err := takeEtcdSnapshot(ctx, m.ssh, etcd)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(workDir, etcdSnapshotFileName), snapshot, 0o600); err != nil {
+			return fmt.Errorf("writing etcd snapshot: %v", err)
+		}
+		metadata.EtcdSnapshot = etcdSnapshotFileName
+	}
+
+	if err := writeMetadata(workDir, metadata); err != nil {
+		return err
+	}
+
+	if err := m.packager.Package(workDir, archive); err != nil {
+		return fmt.Errorf("packaging backup: %v", err)
+	}
+
+	return nil
+}
+
+func backupResources(ctx context.Context, client kubernetes.Client, dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("creating backup folder for resources: %v", err)
+	}
+
+	secrets, err := providerSecrets(ctx, client)
+	if err != nil {
+		return err
+	}
+	if err := writeObjects(dir, resourcesFileName(secretGVK), secrets); err != nil {
+		return err
+	}
+
+	hasTinkerbell := false
+	for _, gvk := range eksaKinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := client.List(ctx, list); err != nil {
+			return fmt.Errorf("listing %s: %v", gvk.Kind, err)
+		}
+		if gvk.Kind == anywherev1.TinkerbellDatacenterKind && len(list.Items) > 0 {
+			hasTinkerbell = true
+		}
+		if err := writeObjects(dir, resourcesFileName(gvk), list.Items); err != nil {
+			return err
+		}
+	}
+
+	// The Hardware CRD is only installed in Tinkerbell management clusters.
+	if hasTinkerbell {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(hardwareGVK.GroupVersion().WithKind(hardwareGVK.Kind + "List"))
+		if err := client.List(ctx, list); err != nil {
+			return fmt.Errorf("listing %s: %v", hardwareGVK.Kind, err)
+		}
+		if err := writeObjects(dir, resourcesFileName(hardwareGVK), list.Items); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// providerSecrets returns the secrets in the eksa-system namespace that are not owned by another object.
+// These hold the provider credentials and user supplied configuration, while the rest are either
+// regenerated by the controllers or saved by clusterctl along with the CAPI objects that own them.
+func providerSecrets(ctx context.Context, client kubernetes.Client) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(secretGVK.GroupVersion().WithKind("SecretList"))
+	if err := client.List(ctx, list, kubernetes.ListOptions{Namespace: constants.EksaSystemNamespace}); err != nil {
+		return nil, fmt.Errorf("listing secrets: %v", err)
+	}
+
+	secrets := make([]unstructured.Unstructured, 0, len(list.Items))
+	for _, s := range list.Items {
+		if s.GetNamespace() != constants.EksaSystemNamespace || len(s.GetOwnerReferences()) > 0 {
+			continue
+		}
+		secretType, _, _ := unstructured.NestedString(s.Object, "type")
+		if corev1.SecretType(secretType) == corev1.SecretTypeServiceAccountToken {
+			continue
+		}
+		secrets = append(secrets, s)
+	}
+
+	return secrets, nil
+}
+
+func writeObjects(dir, fileName string, objs []unstructured.Unstructured) error {
+	if len(objs) == 0 {
+		return nil
+	}
+
+	for i := range objs {
+		sanitize(&objs[i])
+	}
+
+	content, err := marshalObjects(objs)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, fileName), content, 0o600); err != nil {
+		return fmt.Errorf("writing %s: %v", fileName, err)
+	}
+
+	return nil
+}
+
+func writeMetadata(dir string, metadata Metadata) error {
+	content, err := yaml.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshalling backup metadata: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, metadataFileName), content, 0o644); err != nil {
+		return fmt.Errorf("writing backup metadata: %v", err)
+	}
+
+	return nil
+}
+
+func readMetadata(dir string) (*Metadata, error) {
+	content, err := os.ReadFile(filepath.Join(dir, metadataFileName))
+	if err != nil {
+		return nil, fmt.Errorf("reading backup metadata: %v", err)
+	}
+
+	metadata := &Metadata{}
+	if err := yaml.Unmarshal(content, metadata); err != nil {
+		return nil, fmt.Errorf("parsing backup metadata: %v", err)
+	}
+
+	if metadata.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %q, expected %q", metadata.FormatVersion, FormatVersion)
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,238 @@
+package clusterbackup_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+	"github.com/aws/eks-anywhere/pkg/clusterbackup/mocks"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/tar"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type backupTest struct {
+	*WithT
+	ctx        context.Context
+	clusterctl *mocks.MockClusterctl
+	ssh        *mocks.MockSSHRunner
+	manager    *clusterbackup.Manager
+	cluster    *types.Cluster
+	archive    string
+}
+
+func newBackupTest(t *testing.T) *backupTest {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	ctrl := gomock.NewController(t)
+	tt := &backupTest{
+		WithT:      NewWithT(t),
+		ctx:        context.Background(),
+		clusterctl: mocks.NewMockClusterctl(ctrl),
+		ssh:        mocks.NewMockSSHRunner(ctrl),
+		cluster: &types.Cluster{
+			Name:           "mgmt",
+			KubeconfigFile: "mgmt.kubeconfig",
+		},
+		archive: filepath.Join(dir, "mgmt-backup.tar.gz"),
+	}
+	tt.manager = clusterbackup.NewManager(tt.clusterctl, tt.ssh, tar.NewGzipPackager())
+	return tt
+}
+
+// expectCAPIBackup simulates clusterctl writing the CAPI objects to the backup folder.
+func (tt *backupTest) expectCAPIBackup() {
+	tt.clusterctl.EXPECT().BackupManagement(tt.ctx, tt.cluster, gomock.Any(), "").DoAndReturn(
+		func(_ context.Context, cluster *types.Cluster, statePath, _ string) error {
+			dir := filepath.Join(cluster.Name, statePath)
+			tt.Expect(os.MkdirAll(dir, os.ModePerm)).To(Succeed())
+			return os.WriteFile(filepath.Join(dir, "Cluster_eksa-system_mgmt.yaml"), []byte("kind: Cluster"), 0o600)
+		},
+	)
+}
+
+func sourceObjects() []client.Object {
+	cluster := &anywherev1.Cluster{
+		TypeMeta: metav1.TypeMeta{Kind: anywherev1.ClusterKind, APIVersion: anywherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mgmt",
+			Namespace: "default",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube131,
+		},
+	}
+	datacenter := &anywherev1.VSphereDatacenterConfig{
+		TypeMeta: metav1.TypeMeta{Kind: anywherev1.VSphereDatacenterKind, APIVersion: anywherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mgmt",
+			Namespace: "default",
+		},
+		Spec: anywherev1.VSphereDatacenterConfigSpec{
+			Server: "vsphere.local",
+		},
+	}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vsphere-credentials",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Data: map[string][]byte{"password": []byte("secret")},
+	}
+	owned := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mgmt-kubeconfig",
+			Namespace: constants.EksaSystemNamespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "cluster.x-k8s.io/v1beta1", Kind: "Cluster", Name: "mgmt", UID: "uid"},
+			},
+		},
+	}
+	otherNamespace := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other",
+			Namespace: "default",
+		},
+	}
+	return []client.Object{cluster, datacenter, credentials, owned, otherNamespace}
+}
+
+func TestManagerBackupAndRestore(t *testing.T) {
+	tt := newBackupTest(t)
+	source := test.NewFakeKubeClient(sourceObjects()...)
+	etcd := &clusterbackup.EtcdSnapshotConfig{NodeIP: "1.2.3.4", SSHUser: "ec2-user", SSHKey: "key"}
+
+	tt.expectCAPIBackup()
+	tt.ssh.EXPECT().RunCommand(tt.ctx, "key", "ec2-user", "1.2.3.4", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _, _ string, command ...string) (string, error) {
+			tt.Expect(command[0]).To(HavePrefix("echo "))
+			tt.Expect(command[0]).To(HaveSuffix(" | base64 -d | sudo bash"))
+			return base64.StdEncoding.EncodeToString([]byte("snapshot")) + "\n", nil
+		},
+	)
+
+	tt.Expect(tt.manager.Backup(tt.ctx, source, tt.cluster, tt.archive, etcd)).To(Succeed())
+	tt.Expect(tt.archive).To(BeAnExistingFile())
+
+	target := test.NewFakeKubeClient()
+	tt.clusterctl.EXPECT().RestoreManagement(tt.ctx, tt.cluster, gomock.Any()).DoAndReturn(
+		func(_ context.Context, cluster *types.Cluster, statePath string) error {
+			tt.Expect(filepath.Join(cluster.Name, statePath, "Cluster_eksa-system_mgmt.yaml")).To(BeAnExistingFile())
+
+			// EKS-A clusters need to be paused while the CAPI objects are restored.
+			c := &anywherev1.Cluster{}
+			tt.Expect(target.Get(tt.ctx, "mgmt", "default", c)).To(Succeed())
+			tt.Expect(c.IsReconcilePaused()).To(BeTrue())
+			return nil
+		},
+	)
+
+	result, err := tt.manager.Restore(tt.ctx, target, tt.cluster, tt.archive)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Metadata.ClusterName).To(Equal("mgmt"))
+	tt.Expect(result.Metadata.FormatVersion).To(Equal(clusterbackup.FormatVersion))
+	tt.Expect(os.ReadFile(result.EtcdSnapshot)).To(Equal([]byte("snapshot")))
+
+	cluster := &anywherev1.Cluster{}
+	tt.Expect(target.Get(tt.ctx, "mgmt", "default", cluster)).To(Succeed())
+	tt.Expect(cluster.IsReconcilePaused()).To(BeFalse())
+	tt.Expect(cluster.Spec.KubernetesVersion).To(Equal(anywherev1.Kube131))
+
+	datacenter := &anywherev1.VSphereDatacenterConfig{}
+	tt.Expect(target.Get(tt.ctx, "mgmt", "default", datacenter)).To(Succeed())
+	tt.Expect(datacenter.Spec.Server).To(Equal("vsphere.local"))
+
+	secret := &corev1.Secret{}
+	tt.Expect(target.Get(tt.ctx, "vsphere-credentials", constants.EksaSystemNamespace, secret)).To(Succeed())
+	tt.Expect(secret.Data).To(HaveKeyWithValue("password", []byte("secret")))
+	tt.Expect(apierrors.IsNotFound(target.Get(tt.ctx, "mgmt-kubeconfig", constants.EksaSystemNamespace, &corev1.Secret{}))).To(BeTrue())
+	tt.Expect(apierrors.IsNotFound(target.Get(tt.ctx, "other", "default", &corev1.Secret{}))).To(BeTrue())
+}
+
+func TestManagerBackupWithoutEtcdSnapshot(t *testing.T) {
+	tt := newBackupTest(t)
+	source := test.NewFakeKubeClient(sourceObjects()...)
+
+	tt.expectCAPIBackup()
+	tt.Expect(tt.manager.Backup(tt.ctx, source, tt.cluster, tt.archive, nil)).To(Succeed())
+
+	target := test.NewFakeKubeClient()
+	tt.clusterctl.EXPECT().RestoreManagement(tt.ctx, tt.cluster, gomock.Any())
+	result, err := tt.manager.Restore(tt.ctx, target, tt.cluster, tt.archive)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.EtcdSnapshot).To(BeEmpty())
+}
+
+func TestManagerBackupCAPIError(t *testing.T) {
+	tt := newBackupTest(t)
+	source := test.NewFakeKubeClient(sourceObjects()...)
+
+	tt.clusterctl.EXPECT().BackupManagement(tt.ctx, tt.cluster, gomock.Any(), "").DoAndReturn(
+		func(_ context.Context, cluster *types.Cluster, statePath, _ string) error {
+			dir := filepath.Join(cluster.Name, statePath)
+			tt.Expect(os.MkdirAll(dir, os.ModePerm)).To(Succeed())
+			tt.Expect(os.WriteFile(filepath.Join(dir, "Secret_eksa-system_mgmt-ca.yaml"), []byte("kind: Secret"), 0o600)).To(Succeed())
+			return errors.New("clusterctl failed")
+		},
+	)
+	tt.Expect(tt.manager.Backup(tt.ctx, source, tt.cluster, tt.archive, nil)).To(MatchError("clusterctl failed"))
+	tt.Expect(tt.archive).NotTo(BeAnExistingFile())
+
+	entries, err := os.ReadDir(tt.cluster.Name)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(entries).To(BeEmpty(), "the partial CAPI backup should be removed")
+}
+
+func TestManagerBackupEtcdSnapshotError(t *testing.T) {
+	tt := newBackupTest(t)
+	source := test.NewFakeKubeClient(sourceObjects()...)
+	etcd := &clusterbackup.EtcdSnapshotConfig{NodeIP: "1.2.3.4", SSHUser: "ec2-user", SSHKey: "key"}
+
+	tt.expectCAPIBackup()
+	tt.ssh.EXPECT().RunCommand(tt.ctx, "key", "ec2-user", "1.2.3.4", gomock.Any()).Return("", errors.New("connection refused"))
+
+	tt.Expect(tt.manager.Backup(tt.ctx, source, tt.cluster, tt.archive, etcd)).To(
+		MatchError(ContainSubstring("taking etcd snapshot on node 1.2.3.4: connection refused")),
+	)
+	tt.Expect(tt.archive).NotTo(BeAnExistingFile())
+}
+
+func TestManagerRestoreUnsupportedFormatVersion(t *testing.T) {
+	tt := newBackupTest(t)
+	dir := t.TempDir()
+	tt.Expect(os.WriteFile(filepath.Join(dir, "metadata.yaml"), []byte("formatVersion: v0\n"), 0o600)).To(Succeed())
+	tt.Expect(tar.NewGzipPackager().Package(dir, tt.archive)).To(Succeed())
+
+	_, err := tt.manager.Restore(tt.ctx, test.NewFakeKubeClient(), tt.cluster, tt.archive)
+	tt.Expect(err).To(MatchError(ContainSubstring(`unsupported backup format version "v0"`)))
+}
+
+func TestManagerRestoreMissingArchive(t *testing.T) {
+	tt := newBackupTest(t)
+
+	_, err := tt.manager.Restore(tt.ctx, test.NewFakeKubeClient(), tt.cluster, "missing.tar.gz")
+	tt.Expect(err).To(HaveOccurred())
+	tt.Expect(strings.HasPrefix(err.Error(), "unpackaging backup")).To(BeTrue())
+}
@@ -0,0 +1,67 @@
+package clusterbackup
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// SSHRunner runs commands on a remote host over SSH.
+type SSHRunner interface {
+	RunCommand(ctx context.Context, privateKeyPath, username, IP string, command ...string) (string, error)
+}
+
+// EtcdSnapshotConfig identifies the node an etcd snapshot is taken from and how to reach it.
+// Only Ubuntu and RHEL nodes are supported.
+type EtcdSnapshotConfig struct {
+	// NodeIP is the IP of an external etcd node or, for clusters with stacked etcd, of a control plane node.
+	NodeIP string
+	// SSHUser is the user used to connect to the node.
+	SSHUser string
+	// SSHKey is the path to the private key used to connect to the node.
+	SSHKey string
+}
+
+// etcdSnapshotScript saves an etcd snapshot on the node and prints it base64 encoded.
+// External etcd nodes provisioned by etcdadm have etcdctl installed on the host, while stacked etcd
+// members run as a kubeadm static pod whose container ships with etcdctl.
+const etcdSnapshotScript = `set -euo pipefail
+snapshot=/var/lib/etcd/eksa-backup-snapshot.db
+if [ -x /opt/bin/etcdctl ]; then
+  ETCDCTL_API=3 /opt/bin/etcdctl --endpoints=https://127.0.0.1:2379 \
+    --cacert=/etc/etcd/pki/ca.crt \
+    --cert=/etc/etcd/pki/etcdctl-etcd-client.crt \
+    --key=/etc/etcd/pki/etcdctl-etcd-client.key \
+    snapshot save "$snapshot" >/dev/null
+else
+  container=$(crictl ps --name etcd -q | head -n 1)
+  crictl exec "$container" etcdctl --endpoints=https://127.0.0.1:2379 \
+    --cacert=/etc/kubernetes/pki/etcd/ca.crt \
+    --cert=/etc/kubernetes/pki/etcd/server.crt \
+    --key=/etc/kubernetes/pki/etcd/server.key \
+    snapshot save "$snapshot" >/dev/null
+fi
+base64 -w 0 "$snapshot"
+rm -f "$snapshot"
+`
+
+func takeEtcdSnapshot(ctx context.Context, ssh SSHRunner, cfg *EtcdSnapshotConfig) ([]byte, error) {
+	script := base64.StdEncoding.EncodeToString([]byte(etcdSnapshotScript))
+	out, err := ssh.RunCommand(ctx, cfg.SSHKey, cfg.SSHUser, cfg.NodeIP,
+		fmt.Sprintf("echo %s | base64 -d | sudo bash", script),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("taking etcd snapshot on node %s: %v", cfg.NodeIP, err)
+	}
+
+	snapshot, err := base64.StdEncoding.DecodeString(strings.TrimSpace(out))
+	if err != nil {
+		return nil, fmt.Errorf("decoding etcd snapshot from node %s: %v", cfg.NodeIP, err)
+	}
+	if len(snapshot) == 0 {
+		return nil, fmt.Errorf("etcd snapshot from node %s is empty", cfg.NodeIP)
+	}
+
+	return snapshot, nil
+}
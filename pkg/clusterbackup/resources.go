@@ -0,0 +1,106 @@
+package clusterbackup
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apiyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/templater"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+var secretGVK = corev1.SchemeGroupVersion.WithKind("Secret")
+
+var hardwareGVK = schema.GroupVersionKind{Group: "tinkerbell.org", Version: "v1alpha1", Kind: "Hardware"}
+
+// eksaKinds are the EKS-A kinds included in a backup, in the order they need to be restored.
+// Clusters go last since their webhook validates the objects they reference.
+var eksaKinds = []schema.GroupVersionKind{
+	releasev1.GroupVersion.WithKind(releasev1.EKSAReleaseKind),
+	releasev1.GroupVersion.WithKind("Bundles"),
+	anywherev1.GroupVersion.WithKind(anywherev1.DockerDatacenterKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.VSphereDatacenterKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.VSphereMachineConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.CloudStackDatacenterKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.CloudStackMachineConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.NutanixDatacenterKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.NutanixMachineConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.SnowDatacenterKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.SnowMachineConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.SnowIPPoolKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.TinkerbellDatacenterKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.TinkerbellMachineConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.TinkerbellTemplateConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.GitOpsConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.FluxConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.OIDCConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.AWSIamConfigKind),
+	anywherev1.GroupVersion.WithKind(anywherev1.ClusterKind),
+}
+
+// resourcesFileName returns the name of the file holding the objects of a kind inside the backup.
+func resourcesFileName(gvk schema.GroupVersionKind) string {
+	if gvk.Group == "" {
+		return fmt.Sprintf("%s.yaml", gvk.Kind)
+	}
+	return fmt.Sprintf("%s.%s.yaml", gvk.Kind, gvk.Group)
+}
+
+// sanitize removes the server populated fields of an object so it can be created in a different cluster.
+func sanitize(obj *unstructured.Unstructured) {
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetGeneration(0)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetManagedFields(nil)
+	obj.SetOwnerReferences(nil)
+	unstructured.RemoveNestedField(obj.Object, "status")
+}
+
+func marshalObjects(objs []unstructured.Unstructured) ([]byte, error) {
+	resources := make([][]byte, 0, len(objs))
+	for i := range objs {
+		b, err := yaml.Marshal(objs[i].Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling %s %s: %v", objs[i].GetKind(), objs[i].GetName(), err)
+		}
+		resources = append(resources, b)
+	}
+
+	return templater.AppendYamlResources(resources...), nil
+}
+
+func unmarshalObjects(content []byte) ([]*unstructured.Unstructured, error) {
+	reader := apiyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+	var objs []*unstructured.Unstructured
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading yaml document: %v", err)
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(doc, &obj.Object); err != nil {
+			return nil, fmt.Errorf("unmarshalling object: %v", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
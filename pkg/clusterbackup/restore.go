@@ -0,0 +1,143 @@
+package clusterbackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// RestoreResult describes the outcome of a restore.
+type RestoreResult struct {
+	// Metadata is the metadata of the restored backup.
+	Metadata *Metadata
+	// EtcdSnapshot is the path to the etcd snapshot extracted from the backup, if it contained one.
+	// Restoring etcd requires stopping the members and is left to the user.
+	EtcdSnapshot string
+}
+
+// Restore creates the objects saved in a backup archive in a management cluster. The target cluster
+// must have the same EKS-A and CAPI components installed as the cluster the backup was taken from.
+//
+// EKS-A clusters are created paused so the controller doesn't act on them before their CAPI objects
+// are restored, and unpaused once all the objects are in place. Objects that already exist are left untouched.
+func (m *Manager) Restore(ctx context.Context, client kubernetes.Client, cluster *types.Cluster, archive string) (*RestoreResult, error) {
+	stateDir := fmt.Sprintf("%s-restore-%s", cluster.Name, m.now().Format(timeFormat))
+	workDir := filepath.Join(cluster.Name, stateDir)
+
+	if err := os.MkdirAll(workDir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("creating restore folder: %v", err)
+	}
+
+	if err := m.packager.UnPackage(archive, workDir); err != nil {
+		return nil, fmt.Errorf("unpackaging backup: %v", err)
+	}
+
+	metadata, err := readMetadata(workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.V(3).Info("Restoring EKS-A objects and secrets", "backupCluster", metadata.ClusterName)
+	restored, err := restoreResources(ctx, client, filepath.Join(workDir, resourcesFolder))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.V(3).Info("Restoring CAPI objects", "backupCluster", metadata.ClusterName)
+	if err := m.clusterctl.RestoreManagement(ctx, cluster, filepath.Join(stateDir, capiFolder)); err != nil {
+		return nil, err
+	}
+
+	for _, c := range restored {
+		if err := resumeCluster(ctx, client, c); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &RestoreResult{Metadata: metadata}
+	if metadata.EtcdSnapshot != "" {
+		result.EtcdSnapshot = filepath.Join(workDir, metadata.EtcdSnapshot)
+	}
+
+	return result, nil
+}
+
+// restoreResources creates the secrets and EKS-A objects of a backup, returning the EKS-A
+// clusters it paused.
+func restoreResources(ctx context.Context, client kubernetes.Client, dir string) ([]*unstructured.Unstructured, error) {
+	kinds := append([]schema.GroupVersionKind{secretGVK, hardwareGVK}, eksaKinds...)
+
+	var paused []*unstructured.Unstructured
+	for _, gvk := range kinds {
+		objs, err := readObjects(dir, resourcesFileName(gvk))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objs {
+			if gvk.Kind == anywherev1.ClusterKind && gvk.Group == anywherev1.GroupVersion.Group {
+				annotations := obj.GetAnnotations()
+				if annotations == nil {
+					annotations = map[string]string{}
+				}
+				if _, ok := annotations[pausedAnnotation()]; !ok {
+					annotations[pausedAnnotation()] = "true"
+					paused = append(paused, obj)
+				}
+				obj.SetAnnotations(annotations)
+			}
+
+			if err := client.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+				return nil, fmt.Errorf("restoring %s %s/%s: %v", gvk.Kind, obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+	}
+
+	return paused, nil
+}
+
+func resumeCluster(ctx context.Context, client kubernetes.Client, obj *unstructured.Unstructured) error {
+	cluster := &anywherev1.Cluster{}
+	if err := client.Get(ctx, obj.GetName(), obj.GetNamespace(), cluster); err != nil {
+		return fmt.Errorf("reading restored cluster %s: %v", obj.GetName(), err)
+	}
+
+	cluster.ClearPauseAnnotation()
+	if err := client.Update(ctx, cluster); err != nil {
+		return fmt.Errorf("resuming reconciliation of restored cluster %s: %v", obj.GetName(), err)
+	}
+
+	return nil
+}
+
+func readObjects(dir, fileName string) ([]*unstructured.Unstructured, error) {
+	content, err := os.ReadFile(filepath.Join(dir, fileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %v", fileName, err)
+	}
+
+	objs, err := unmarshalObjects(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", fileName, err)
+	}
+
+	return objs, nil
+}
+
+func pausedAnnotation() string {
+	return (&anywherev1.Cluster{}).PausedAnnotation()
+}
@@ -0,0 +1,457 @@
+package clusterimport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
+	"github.com/aws/eks-anywhere/pkg/semver"
+)
+
+const nodeLabelsArg = "node-labels"
+
+// CAPIObjects holds the CAPI objects of an existing cluster being imported.
+type CAPIObjects struct {
+	Cluster                     *clusterv1.Cluster
+	InfrastructureCluster       *unstructured.Unstructured
+	KubeadmControlPlane         *controlplanev1.KubeadmControlPlane
+	ControlPlaneMachineTemplate *unstructured.Unstructured
+	Workers                     []Worker
+}
+
+// Worker holds the CAPI objects of a worker node group of an existing cluster.
+type Worker struct {
+	MachineDeployment     *clusterv1.MachineDeployment
+	KubeadmConfigTemplate *bootstrapv1.KubeadmConfigTemplate
+	MachineTemplate       *unstructured.Unstructured
+}
+
+// ProviderImporter generates and validates the provider specific EKS-A objects for an existing cluster.
+type ProviderImporter interface {
+	// InfrastructureClusterKind returns the kind of the CAPI infrastructure cluster handled by the importer.
+	InfrastructureClusterKind() string
+
+	// GenerateConfig builds the provider datacenter and machine configs from the CAPI objects,
+	// adds them to config and sets their references in config.Cluster.
+	GenerateConfig(objs *CAPIObjects, config *cluster.Config) error
+
+	// Validate checks that the provider CAPI objects EKS-A generates for spec match the existing ones,
+	// so taking ownership of the cluster doesn't roll its machines.
+	Validate(objs *CAPIObjects, spec *cluster.Spec) error
+}
+
+// Importer adopts existing CAPI clusters under the management of an EKS-A management cluster.
+type Importer struct {
+	client    kubernetes.Client
+	providers map[string]ProviderImporter
+}
+
+// NewImporter builds an Importer for the given providers.
+func NewImporter(client kubernetes.Client, providers ...ProviderImporter) *Importer {
+	i := &Importer{
+		client:    client,
+		providers: make(map[string]ProviderImporter, len(providers)),
+	}
+	for _, p := range providers {
+		i.providers[p.InfrastructureClusterKind()] = p
+	}
+
+	return i
+}
+
+// ReadCAPIObjects reads the CAPI objects of the cluster name from the eksa-system namespace of the management cluster.
+func (i *Importer) ReadCAPIObjects(ctx context.Context, name string) (*CAPIObjects, error) {
+	objs := &CAPIObjects{
+		Cluster:             &clusterv1.Cluster{},
+		KubeadmControlPlane: &controlplanev1.KubeadmControlPlane{},
+	}
+	if err := i.client.Get(ctx, name, constants.EksaSystemNamespace, objs.Cluster); err != nil {
+		return nil, errors.Wrapf(err, "reading CAPI cluster %s", name)
+	}
+
+	if objs.Cluster.Spec.InfrastructureRef == nil || objs.Cluster.Spec.ControlPlaneRef == nil {
+		return nil, fmt.Errorf("CAPI cluster %s is missing its infrastructure or control plane reference", name)
+	}
+
+	if objs.Cluster.Spec.ControlPlaneRef.Kind != "KubeadmControlPlane" {
+		return nil, fmt.Errorf("control plane kind %s is not supported, only KubeadmControlPlane can be imported", objs.Cluster.Spec.ControlPlaneRef.Kind)
+	}
+
+	var err error
+	if objs.InfrastructureCluster, err = i.getUnstructured(ctx, objs.Cluster.Spec.InfrastructureRef); err != nil {
+		return nil, err
+	}
+
+	if err = i.client.Get(ctx, objs.Cluster.Spec.ControlPlaneRef.Name, constants.EksaSystemNamespace, objs.KubeadmControlPlane); err != nil {
+		return nil, errors.Wrapf(err, "reading KubeadmControlPlane %s", objs.Cluster.Spec.ControlPlaneRef.Name)
+	}
+
+	if objs.ControlPlaneMachineTemplate, err = i.getUnstructured(ctx, &objs.KubeadmControlPlane.Spec.MachineTemplate.InfrastructureRef); err != nil {
+		return nil, err
+	}
+
+	machineDeployments := &clusterv1.MachineDeploymentList{}
+	if err = i.client.List(ctx, machineDeployments, kubernetes.ListOptions{Namespace: constants.EksaSystemNamespace}); err != nil {
+		return nil, errors.Wrap(err, "listing MachineDeployments")
+	}
+
+	for idx := range machineDeployments.Items {
+		md := &machineDeployments.Items[idx]
+		if md.Spec.ClusterName != name {
+			continue
+		}
+
+		w := Worker{
+			MachineDeployment:     md,
+			KubeadmConfigTemplate: &bootstrapv1.KubeadmConfigTemplate{},
+		}
+		if md.Spec.Template.Spec.Bootstrap.ConfigRef == nil || md.Spec.Template.Spec.Bootstrap.ConfigRef.Kind != "KubeadmConfigTemplate" {
+			return nil, fmt.Errorf("MachineDeployment %s must use a KubeadmConfigTemplate to be imported", md.Name)
+		}
+
+		if err = i.client.Get(ctx, md.Spec.Template.Spec.Bootstrap.ConfigRef.Name, constants.EksaSystemNamespace, w.KubeadmConfigTemplate); err != nil {
+			return nil, errors.Wrapf(err, "reading KubeadmConfigTemplate %s", md.Spec.Template.Spec.Bootstrap.ConfigRef.Name)
+		}
+
+		if w.MachineTemplate, err = i.getUnstructured(ctx, &md.Spec.Template.Spec.InfrastructureRef); err != nil {
+			return nil, err
+		}
+
+		objs.Workers = append(objs.Workers, w)
+	}
+
+	sort.Slice(objs.Workers, func(a, b int) bool {
+		return objs.Workers[a].MachineDeployment.Name < objs.Workers[b].MachineDeployment.Name
+	})
+
+	return objs, nil
+}
+
+// Generate builds the EKS-A cluster spec, in the given namespace, that manages the existing CAPI objects.
+// It validates that the CAPI objects EKS-A would reconcile for such spec match the existing ones.
+func (i *Importer) Generate(ctx context.Context, objs *CAPIObjects, namespace string) (*cluster.Spec, error) {
+	provider, ok := i.providers[objs.InfrastructureCluster.GetKind()]
+	if !ok {
+		return nil, fmt.Errorf("importing clusters with infrastructure %s is not supported", objs.InfrastructureCluster.GetKind())
+	}
+
+	if err := validateCAPIObjects(objs); err != nil {
+		return nil, err
+	}
+
+	existing := &anywherev1.Cluster{}
+	err := i.client.Get(ctx, objs.Cluster.Name, namespace, existing)
+	if err == nil {
+		return nil, fmt.Errorf("cluster %s is already managed by EKS-A", objs.Cluster.Name)
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "reading EKS-A cluster %s", objs.Cluster.Name)
+	}
+
+	managementCluster, err := i.managementCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eksaCluster, err := buildCluster(objs, managementCluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &cluster.Config{Cluster: eksaCluster}
+	if err = provider.GenerateConfig(objs, config); err != nil {
+		return nil, errors.Wrap(err, "generating provider config")
+	}
+
+	if err = cluster.SetConfigDefaults(config); err != nil {
+		return nil, errors.Wrap(err, "setting defaults in generated config")
+	}
+
+	if err = cluster.ValidateConfig(config); err != nil {
+		return nil, errors.Wrap(err, "validating generated config")
+	}
+
+	spec, err := cluster.BuildSpecFromConfig(ctx, i.client, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "building cluster spec")
+	}
+
+	if err = validateVersions(objs, spec); err != nil {
+		return nil, err
+	}
+
+	if err = provider.Validate(objs, spec); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// Adopt marks the CAPI objects as imported and creates the EKS-A objects in the management cluster,
+// so the cluster controller takes ownership of the cluster without rolling its machines.
+// The EKS-A Cluster is created last so it's not reconciled before its CAPI objects are marked.
+func (i *Importer) Adopt(ctx context.Context, objs *CAPIObjects, config *cluster.Config) error {
+	for _, obj := range objs.markImported(config.Cluster) {
+		if err := i.client.Update(ctx, obj); err != nil {
+			return errors.Wrapf(err, "marking %s %s as imported", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName())
+		}
+	}
+
+	for _, obj := range config.ChildObjects() {
+		if err := i.client.Create(ctx, obj); err != nil {
+			return errors.Wrapf(err, "creating %s %s", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName())
+		}
+	}
+
+	if err := i.client.Create(ctx, config.Cluster); err != nil {
+		return errors.Wrapf(err, "creating cluster %s", config.Cluster.Name)
+	}
+
+	return nil
+}
+
+// markImported sets the imported annotation and the EKS-A cluster labels in all the CAPI objects and returns them.
+func (o *CAPIObjects) markImported(eksaCluster *anywherev1.Cluster) []kubernetes.Object {
+	version := o.KubeadmControlPlane.Spec.Version
+	objs := []kubernetes.Object{o.Cluster, o.InfrastructureCluster, o.KubeadmControlPlane, o.ControlPlaneMachineTemplate}
+	for _, obj := range objs {
+		clusterapi.MarkImported(obj, version)
+	}
+
+	for _, w := range o.Workers {
+		workerVersion := ptr.Deref(w.MachineDeployment.Spec.Template.Spec.Version, version)
+		for _, obj := range []kubernetes.Object{w.MachineDeployment, w.KubeadmConfigTemplate, w.MachineTemplate} {
+			clusterapi.MarkImported(obj, workerVersion)
+			objs = append(objs, obj)
+		}
+	}
+
+	for _, obj := range objs {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[clusterapi.EKSAClusterLabelName] = eksaCluster.Name
+		labels[clusterapi.EKSAClusterLabelNamespace] = eksaCluster.Namespace
+		obj.SetLabels(labels)
+	}
+
+	return objs
+}
+
+func (i *Importer) getUnstructured(ctx context.Context, ref *corev1.ObjectReference) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	if err := i.client.Get(ctx, ref.Name, constants.EksaSystemNamespace, obj); err != nil {
+		return nil, errors.Wrapf(err, "reading %s %s", ref.Kind, ref.Name)
+	}
+
+	return obj, nil
+}
+
+func (i *Importer) managementCluster(ctx context.Context) (*anywherev1.Cluster, error) {
+	clusters := &anywherev1.ClusterList{}
+	if err := i.client.List(ctx, clusters); err != nil {
+		return nil, errors.Wrap(err, "listing EKS-A clusters")
+	}
+
+	for idx := range clusters.Items {
+		if clusters.Items[idx].IsSelfManaged() {
+			return &clusters.Items[idx], nil
+		}
+	}
+
+	return nil, errors.New("no EKS-A management cluster found, clusters can only be imported into a management cluster")
+}
+
+func validateCAPIObjects(objs *CAPIObjects) error {
+	name := objs.Cluster.Name
+	if objs.KubeadmControlPlane.Name != name {
+		return fmt.Errorf("KubeadmControlPlane %s must be named after the cluster %s to be imported", objs.KubeadmControlPlane.Name, name)
+	}
+
+	if objs.InfrastructureCluster.GetName() != name {
+		return fmt.Errorf("%s %s must be named after the cluster %s to be imported", objs.InfrastructureCluster.GetKind(), objs.InfrastructureCluster.GetName(), name)
+	}
+
+	if c := objs.KubeadmControlPlane.Spec.KubeadmConfigSpec.ClusterConfiguration; c != nil && c.Etcd.External != nil {
+		return errors.New("importing clusters with external etcd is not supported")
+	}
+
+	if objs.KubeadmControlPlane.Spec.Replicas == nil {
+		return fmt.Errorf("KubeadmControlPlane %s doesn't have replicas set", name)
+	}
+
+	if objs.Cluster.Spec.ClusterNetwork == nil || objs.Cluster.Spec.ClusterNetwork.Pods == nil || objs.Cluster.Spec.ClusterNetwork.Services == nil {
+		return fmt.Errorf("CAPI cluster %s must define its pods and services networks to be imported", name)
+	}
+
+	for _, w := range objs.Workers {
+		if !strings.HasPrefix(w.MachineDeployment.Name, name+"-") {
+			return fmt.Errorf("MachineDeployment %s must be prefixed with the cluster name %s to be imported", w.MachineDeployment.Name, name)
+		}
+		if w.MachineDeployment.Spec.Replicas == nil {
+			return fmt.Errorf("MachineDeployment %s doesn't have replicas set", w.MachineDeployment.Name)
+		}
+	}
+
+	return nil
+}
+
+func buildCluster(objs *CAPIObjects, managementCluster *anywherev1.Cluster, namespace string) (*anywherev1.Cluster, error) {
+	kcp := objs.KubeadmControlPlane
+	kubernetesVersion, err := parseKubernetesVersion(kcp.Spec.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &anywherev1.Cluster{}
+	c.SetGroupVersionKind(anywherev1.GroupVersion.WithKind(anywherev1.ClusterKind))
+	c.Name = objs.Cluster.Name
+	c.Namespace = namespace
+	// EKS-A doesn't install Cilium in imported clusters, they keep the CNI they are running.
+	c.Annotations = map[string]string{reconciler.EKSACiliumInstalledAnnotation: ""}
+	c.SetManagedBy(managementCluster.Name)
+	c.Spec = anywherev1.ClusterSpec{
+		KubernetesVersion: kubernetesVersion,
+		EksaVersion:       managementCluster.Spec.EksaVersion,
+		ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+			Count: int(*kcp.Spec.Replicas),
+			Endpoint: &anywherev1.Endpoint{
+				Host: objs.Cluster.Spec.ControlPlaneEndpoint.Host,
+			},
+		},
+		ClusterNetwork: anywherev1.ClusterNetwork{
+			Pods: anywherev1.Pods{
+				CidrBlocks: objs.Cluster.Spec.ClusterNetwork.Pods.CIDRBlocks,
+			},
+			Services: anywherev1.Services{
+				CidrBlocks: objs.Cluster.Spec.ClusterNetwork.Services.CIDRBlocks,
+			},
+			CNIConfig: &anywherev1.CNIConfig{
+				Cilium: &anywherev1.CiliumConfig{SkipUpgrade: ptr.To(true)},
+			},
+		},
+		ManagementCluster: anywherev1.ManagementCluster{
+			Name: managementCluster.Name,
+		},
+	}
+
+	if init := kcp.Spec.KubeadmConfigSpec.InitConfiguration; init != nil {
+		c.Spec.ControlPlaneConfiguration.Taints = init.NodeRegistration.Taints
+		c.Spec.ControlPlaneConfiguration.Labels = nodeLabels(init.NodeRegistration.KubeletExtraArgs)
+	}
+
+	for _, w := range objs.Workers {
+		md := w.MachineDeployment
+		group := anywherev1.WorkerNodeGroupConfiguration{
+			Name:  strings.TrimPrefix(md.Name, c.Name+"-"),
+			Count: ptr.To(int(*md.Spec.Replicas)),
+		}
+
+		if join := w.KubeadmConfigTemplate.Spec.Template.Spec.JoinConfiguration; join != nil {
+			group.Taints = join.NodeRegistration.Taints
+			group.Labels = nodeLabels(join.NodeRegistration.KubeletExtraArgs)
+		}
+
+		if group.AutoScalingConfiguration, err = autoScalingConfiguration(md); err != nil {
+			return nil, err
+		}
+
+		if md.Spec.Template.Spec.Version != nil {
+			workerVersion, err := parseKubernetesVersion(*md.Spec.Template.Spec.Version)
+			if err != nil {
+				return nil, err
+			}
+			if workerVersion != kubernetesVersion {
+				group.KubernetesVersion = &workerVersion
+			}
+		}
+
+		c.Spec.WorkerNodeGroupConfigurations = append(c.Spec.WorkerNodeGroupConfigurations, group)
+	}
+
+	return c, nil
+}
+
+// validateVersions checks the CAPI objects run the same Kubernetes build the EKS-A bundles provide,
+// otherwise EKS-A would roll the machines to change it.
+func validateVersions(objs *CAPIObjects, spec *cluster.Spec) error {
+	want := spec.RootVersionsBundle().KubeDistro.Kubernetes.Tag
+	if objs.KubeadmControlPlane.Spec.Version != want {
+		return fmt.Errorf("KubeadmControlPlane %s runs Kubernetes %s but EKS-A expects %s, taking ownership would roll the control plane machines",
+			objs.KubeadmControlPlane.Name, objs.KubeadmControlPlane.Spec.Version, want)
+	}
+
+	for idx, w := range objs.Workers {
+		want := spec.WorkerNodeGroupVersionsBundle(spec.Cluster.Spec.WorkerNodeGroupConfigurations[idx]).KubeDistro.Kubernetes.Tag
+		if got := ptr.Deref(w.MachineDeployment.Spec.Template.Spec.Version, ""); got != want {
+			return fmt.Errorf("MachineDeployment %s runs Kubernetes %s but EKS-A expects %s, taking ownership would roll its machines",
+				w.MachineDeployment.Name, got, want)
+		}
+	}
+
+	return nil
+}
+
+func parseKubernetesVersion(version string) (anywherev1.KubernetesVersion, error) {
+	v, err := semver.New(version)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing Kubernetes version %s", version)
+	}
+
+	return anywherev1.KubernetesVersion(fmt.Sprintf("%d.%d", v.Major, v.Minor)), nil
+}
+
+func nodeLabels(kubeletExtraArgs map[string]string) map[string]string {
+	arg, ok := kubeletExtraArgs[nodeLabelsArg]
+	if !ok || arg == "" {
+		return nil
+	}
+
+	labels := map[string]string{}
+	for _, label := range strings.Split(arg, ",") {
+		key, value, _ := strings.Cut(label, "=")
+		labels[key] = value
+	}
+
+	return labels
+}
+
+func autoScalingConfiguration(md *clusterv1.MachineDeployment) (*anywherev1.AutoScalingConfiguration, error) {
+	minSize, hasMin := md.Annotations[clusterv1.AutoscalerMinSizeAnnotation]
+	maxSize, hasMax := md.Annotations[clusterv1.AutoscalerMaxSizeAnnotation]
+	if !hasMin || !hasMax {
+		return nil, nil
+	}
+
+	minCount, err := strconv.Atoi(minSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing autoscaler min size of MachineDeployment %s", md.Name)
+	}
+
+	maxCount, err := strconv.Atoi(maxSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing autoscaler max size of MachineDeployment %s", md.Name)
+	}
+
+	return &anywherev1.AutoScalingConfiguration{MinCount: minCount, MaxCount: maxCount}, nil
+}
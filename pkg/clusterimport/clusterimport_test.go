@@ -0,0 +1,409 @@
+package clusterimport_test
+
+import (
+	"context"
+	"testing"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	vspherev1 "sigs.k8s.io/cluster-api-provider-vsphere/apis/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/clusterimport"
+	"github.com/aws/eks-anywhere/pkg/clusterimport/mocks"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type importerTest struct {
+	*WithT
+	ctx      context.Context
+	client   kubernetes.Client
+	provider *mocks.MockProviderImporter
+	importer *clusterimport.Importer
+}
+
+func newImporterTest(t *testing.T, objs ...client.Object) *importerTest {
+	ctrl := gomock.NewController(t)
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	_ = controlplanev1.AddToScheme(scheme)
+	_ = bootstrapv1.AddToScheme(scheme)
+	_ = vspherev1.AddToScheme(scheme)
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+
+	c := test.NewKubeClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build())
+	provider := mocks.NewMockProviderImporter(ctrl)
+	provider.EXPECT().InfrastructureClusterKind().Return("VSphereCluster")
+
+	return &importerTest{
+		WithT:    NewWithT(t),
+		ctx:      context.Background(),
+		client:   c,
+		provider: provider,
+		importer: clusterimport.NewImporter(c, provider),
+	}
+}
+
+func capiCluster() *clusterv1.Cluster {
+	return &clusterv1.Cluster{
+		TypeMeta:   metav1.TypeMeta{Kind: "Cluster", APIVersion: clusterv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: constants.EksaSystemNamespace},
+		Spec: clusterv1.ClusterSpec{
+			ClusterNetwork: &clusterv1.ClusterNetwork{
+				Pods:     &clusterv1.NetworkRanges{CIDRBlocks: []string{"192.168.0.0/16"}},
+				Services: &clusterv1.NetworkRanges{CIDRBlocks: []string{"10.96.0.0/12"}},
+			},
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{Host: "1.2.3.4", Port: 6443},
+			InfrastructureRef: &corev1.ObjectReference{
+				Kind:       "VSphereCluster",
+				APIVersion: vspherev1.GroupVersion.String(),
+				Name:       "legacy",
+			},
+			ControlPlaneRef: &corev1.ObjectReference{
+				Kind:       "KubeadmControlPlane",
+				APIVersion: controlplanev1.GroupVersion.String(),
+				Name:       "legacy",
+			},
+		},
+	}
+}
+
+func vsphereCluster() *vspherev1.VSphereCluster {
+	return &vspherev1.VSphereCluster{
+		TypeMeta:   metav1.TypeMeta{Kind: "VSphereCluster", APIVersion: vspherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: constants.EksaSystemNamespace},
+	}
+}
+
+func vsphereMachineTemplate(name string) *vspherev1.VSphereMachineTemplate {
+	return &vspherev1.VSphereMachineTemplate{
+		TypeMeta:   metav1.TypeMeta{Kind: "VSphereMachineTemplate", APIVersion: vspherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.EksaSystemNamespace},
+	}
+}
+
+func kubeadmControlPlane() *controlplanev1.KubeadmControlPlane {
+	return &controlplanev1.KubeadmControlPlane{
+		TypeMeta:   metav1.TypeMeta{Kind: "KubeadmControlPlane", APIVersion: controlplanev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: constants.EksaSystemNamespace},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Replicas: ptr.To[int32](3),
+			Version:  "v1.19.8",
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				InfrastructureRef: corev1.ObjectReference{
+					Kind:       "VSphereMachineTemplate",
+					APIVersion: vspherev1.GroupVersion.String(),
+					Name:       "legacy-control-plane",
+				},
+			},
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				InitConfiguration: &bootstrapv1.InitConfiguration{
+					NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+						KubeletExtraArgs: map[string]string{"node-labels": "tier=cp"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func machineDeployment(name string) *clusterv1.MachineDeployment {
+	return &clusterv1.MachineDeployment{
+		TypeMeta: metav1.TypeMeta{Kind: "MachineDeployment", APIVersion: clusterv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "1",
+				clusterv1.AutoscalerMaxSizeAnnotation: "5",
+			},
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			ClusterName: "legacy",
+			Replicas:    ptr.To[int32](2),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					ClusterName: "legacy",
+					Version:     ptr.To("v1.19.8"),
+					Bootstrap: clusterv1.Bootstrap{
+						ConfigRef: &corev1.ObjectReference{
+							Kind:       "KubeadmConfigTemplate",
+							APIVersion: bootstrapv1.GroupVersion.String(),
+							Name:       name,
+						},
+					},
+					InfrastructureRef: corev1.ObjectReference{
+						Kind:       "VSphereMachineTemplate",
+						APIVersion: vspherev1.GroupVersion.String(),
+						Name:       name,
+					},
+				},
+			},
+		},
+	}
+}
+
+func kubeadmConfigTemplate(name string) *bootstrapv1.KubeadmConfigTemplate {
+	return &bootstrapv1.KubeadmConfigTemplate{
+		TypeMeta:   metav1.TypeMeta{Kind: "KubeadmConfigTemplate", APIVersion: bootstrapv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.EksaSystemNamespace},
+		Spec: bootstrapv1.KubeadmConfigTemplateSpec{
+			Template: bootstrapv1.KubeadmConfigTemplateResource{
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					JoinConfiguration: &bootstrapv1.JoinConfiguration{
+						NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+							KubeletExtraArgs: map[string]string{"node-labels": "tier=workers,team=a"},
+							Taints:           []corev1.Taint{{Key: "dedicated", Value: "a", Effect: corev1.TaintEffectNoSchedule}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func managementCluster() *anywherev1.Cluster {
+	c := &anywherev1.Cluster{
+		TypeMeta:   metav1.TypeMeta{Kind: anywherev1.ClusterKind, APIVersion: anywherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			EksaVersion: ptr.To(anywherev1.EksaVersion("v0.19.0-dev+latest")),
+		},
+	}
+	c.SetSelfManaged()
+
+	return c
+}
+
+func capiObjects() []client.Object {
+	return []client.Object{
+		capiCluster(),
+		vsphereCluster(),
+		kubeadmControlPlane(),
+		vsphereMachineTemplate("legacy-control-plane"),
+		machineDeployment("legacy-md-1"),
+		kubeadmConfigTemplate("legacy-md-1"),
+		vsphereMachineTemplate("legacy-md-1"),
+		machineDeployment("legacy-md-0"),
+		kubeadmConfigTemplate("legacy-md-0"),
+		vsphereMachineTemplate("legacy-md-0"),
+	}
+}
+
+func releaseObjects() []client.Object {
+	return []client.Object{
+		managementCluster(),
+		test.EKSARelease(),
+		test.Bundle(),
+		test.EksdRelease("1-19"),
+	}
+}
+
+func generateDatacenter(_ *clusterimport.CAPIObjects, config *cluster.Config) error {
+	config.DockerDatacenter = &anywherev1.DockerDatacenterConfig{
+		TypeMeta:   metav1.TypeMeta{Kind: anywherev1.DockerDatacenterKind, APIVersion: anywherev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: config.Cluster.Name, Namespace: config.Cluster.Namespace},
+	}
+	config.Cluster.Spec.DatacenterRef = anywherev1.Ref{Kind: anywherev1.DockerDatacenterKind, Name: config.Cluster.Name}
+
+	return nil
+}
+
+func TestImporterReadCAPIObjects(t *testing.T) {
+	tt := newImporterTest(t, capiObjects()...)
+
+	objs, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(objs.Cluster.Name).To(Equal("legacy"))
+	tt.Expect(objs.InfrastructureCluster.GetKind()).To(Equal("VSphereCluster"))
+	tt.Expect(objs.KubeadmControlPlane.Name).To(Equal("legacy"))
+	tt.Expect(objs.ControlPlaneMachineTemplate.GetName()).To(Equal("legacy-control-plane"))
+	tt.Expect(objs.Workers).To(HaveLen(2))
+	tt.Expect(objs.Workers[0].MachineDeployment.Name).To(Equal("legacy-md-0"))
+	tt.Expect(objs.Workers[0].KubeadmConfigTemplate.Name).To(Equal("legacy-md-0"))
+	tt.Expect(objs.Workers[0].MachineTemplate.GetName()).To(Equal("legacy-md-0"))
+	tt.Expect(objs.Workers[1].MachineDeployment.Name).To(Equal("legacy-md-1"))
+}
+
+func TestImporterReadCAPIObjectsMissingCluster(t *testing.T) {
+	tt := newImporterTest(t)
+
+	_, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).To(MatchError(ContainSubstring("reading CAPI cluster legacy")))
+}
+
+func TestImporterReadCAPIObjectsUnsupportedControlPlane(t *testing.T) {
+	c := capiCluster()
+	c.Spec.ControlPlaneRef.Kind = "TalosControlPlane"
+	tt := newImporterTest(t, c)
+
+	_, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).To(MatchError("control plane kind TalosControlPlane is not supported, only KubeadmControlPlane can be imported"))
+}
+
+func TestImporterGenerate(t *testing.T) {
+	tt := newImporterTest(t, append(capiObjects(), releaseObjects()...)...)
+	objs, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	tt.provider.EXPECT().GenerateConfig(objs, gomock.Any()).DoAndReturn(generateDatacenter)
+	tt.provider.EXPECT().Validate(objs, gomock.Any()).Return(nil)
+
+	spec, err := tt.importer.Generate(tt.ctx, objs, "default")
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	c := spec.Cluster
+	tt.Expect(c.Name).To(Equal("legacy"))
+	tt.Expect(c.Namespace).To(Equal("default"))
+	tt.Expect(c.ManagedBy()).To(Equal("mgmt"))
+	tt.Expect(c.Annotations).To(HaveKey(reconciler.EKSACiliumInstalledAnnotation))
+	tt.Expect(c.Spec.KubernetesVersion).To(Equal(anywherev1.Kube119))
+	tt.Expect(c.Spec.ControlPlaneConfiguration.Count).To(Equal(3))
+	tt.Expect(c.Spec.ControlPlaneConfiguration.Endpoint.Host).To(Equal("1.2.3.4"))
+	tt.Expect(c.Spec.ControlPlaneConfiguration.Labels).To(Equal(map[string]string{"tier": "cp"}))
+	tt.Expect(c.Spec.ClusterNetwork.Pods.CidrBlocks).To(ConsistOf("192.168.0.0/16"))
+	tt.Expect(c.Spec.ClusterNetwork.Services.CidrBlocks).To(ConsistOf("10.96.0.0/12"))
+	tt.Expect(c.Spec.ClusterNetwork.CNIConfig.Cilium.SkipUpgrade).To(HaveValue(BeTrue()))
+	tt.Expect(c.Spec.WorkerNodeGroupConfigurations).To(HaveLen(2))
+
+	md0 := c.Spec.WorkerNodeGroupConfigurations[0]
+	tt.Expect(md0.Name).To(Equal("md-0"))
+	tt.Expect(md0.Count).To(HaveValue(Equal(2)))
+	tt.Expect(md0.Labels).To(Equal(map[string]string{"tier": "workers", "team": "a"}))
+	tt.Expect(md0.Taints).To(ConsistOf(corev1.Taint{Key: "dedicated", Value: "a", Effect: corev1.TaintEffectNoSchedule}))
+	tt.Expect(md0.AutoScalingConfiguration).To(Equal(&anywherev1.AutoScalingConfiguration{MinCount: 1, MaxCount: 5}))
+	tt.Expect(md0.KubernetesVersion).To(BeNil())
+}
+
+func TestImporterGenerateUnsupportedProvider(t *testing.T) {
+	tt := newImporterTest(t, capiObjects()...)
+	objs, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).NotTo(HaveOccurred())
+	objs.InfrastructureCluster.SetKind("AWSCluster")
+
+	_, err = tt.importer.Generate(tt.ctx, objs, "default")
+	tt.Expect(err).To(MatchError("importing clusters with infrastructure AWSCluster is not supported"))
+}
+
+func TestImporterGenerateAlreadyManaged(t *testing.T) {
+	existing := managementCluster()
+	existing.Name = "legacy"
+	tt := newImporterTest(t, append(capiObjects(), existing)...)
+	objs, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	_, err = tt.importer.Generate(tt.ctx, objs, "default")
+	tt.Expect(err).To(MatchError("cluster legacy is already managed by EKS-A"))
+}
+
+func TestImporterGenerateInvalidCAPIObjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*clusterimport.CAPIObjects)
+		wantErr string
+	}{
+		{
+			name: "external etcd",
+			modify: func(objs *clusterimport.CAPIObjects) {
+				objs.KubeadmControlPlane.Spec.KubeadmConfigSpec.ClusterConfiguration = &bootstrapv1.ClusterConfiguration{
+					Etcd: bootstrapv1.Etcd{External: &bootstrapv1.ExternalEtcd{}},
+				}
+			},
+			wantErr: "importing clusters with external etcd is not supported",
+		},
+		{
+			name: "control plane name",
+			modify: func(objs *clusterimport.CAPIObjects) {
+				objs.KubeadmControlPlane.Name = "legacy-cp"
+			},
+			wantErr: "KubeadmControlPlane legacy-cp must be named after the cluster legacy to be imported",
+		},
+		{
+			name: "machine deployment name",
+			modify: func(objs *clusterimport.CAPIObjects) {
+				objs.Workers[0].MachineDeployment.Name = "workers"
+			},
+			wantErr: "MachineDeployment workers must be prefixed with the cluster name legacy to be imported",
+		},
+		{
+			name: "cluster network",
+			modify: func(objs *clusterimport.CAPIObjects) {
+				objs.Cluster.Spec.ClusterNetwork = nil
+			},
+			wantErr: "CAPI cluster legacy must define its pods and services networks to be imported",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newImporterTest(t, capiObjects()...)
+			objs, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+			tt.Expect(err).NotTo(HaveOccurred())
+			tc.modify(objs)
+
+			_, err = tt.importer.Generate(tt.ctx, objs, "default")
+			tt.Expect(err).To(MatchError(tc.wantErr))
+		})
+	}
+}
+
+func TestImporterGenerateVersionMismatch(t *testing.T) {
+	kcp := kubeadmControlPlane()
+	kcp.Spec.Version = "v1.19.6"
+	objs := capiObjects()
+	objs[2] = kcp
+	tt := newImporterTest(t, append(objs, releaseObjects()...)...)
+	capiObjs, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	tt.provider.EXPECT().GenerateConfig(capiObjs, gomock.Any()).DoAndReturn(generateDatacenter)
+
+	_, err = tt.importer.Generate(tt.ctx, capiObjs, "default")
+	tt.Expect(err).To(MatchError(ContainSubstring("KubeadmControlPlane legacy runs Kubernetes v1.19.6 but EKS-A expects v1.19.8")))
+}
+
+func TestImporterAdopt(t *testing.T) {
+	tt := newImporterTest(t, append(capiObjects(), releaseObjects()...)...)
+	objs, err := tt.importer.ReadCAPIObjects(tt.ctx, "legacy")
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	tt.provider.EXPECT().GenerateConfig(objs, gomock.Any()).DoAndReturn(generateDatacenter)
+	tt.provider.EXPECT().Validate(objs, gomock.Any()).Return(nil)
+
+	spec, err := tt.importer.Generate(tt.ctx, objs, "default")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.importer.Adopt(tt.ctx, objs, spec.Config)).To(Succeed())
+
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	tt.Expect(tt.client.Get(tt.ctx, "legacy", constants.EksaSystemNamespace, kcp)).To(Succeed())
+	tt.Expect(kcp.Annotations).To(HaveKeyWithValue(clusterapi.ImportedAnnotation, "v1.19.8"))
+	tt.Expect(kcp.Labels).To(HaveKeyWithValue(clusterapi.EKSAClusterLabelName, "legacy"))
+	tt.Expect(kcp.Labels).To(HaveKeyWithValue(clusterapi.EKSAClusterLabelNamespace, "default"))
+
+	template := &unstructured.Unstructured{}
+	template.SetGroupVersionKind(vspherev1.GroupVersion.WithKind("VSphereMachineTemplate"))
+	tt.Expect(tt.client.Get(tt.ctx, "legacy-md-0", constants.EksaSystemNamespace, template)).To(Succeed())
+	tt.Expect(template.GetAnnotations()).To(HaveKeyWithValue(clusterapi.ImportedAnnotation, "v1.19.8"))
+
+	tt.Expect(tt.client.Get(tt.ctx, "legacy", "default", &anywherev1.DockerDatacenterConfig{})).To(Succeed())
+	tt.Expect(tt.client.Get(tt.ctx, "legacy", "default", &anywherev1.Cluster{})).To(Succeed())
+}
@@ -0,0 +1,199 @@
+package clusterinfo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterinfo"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func newClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func eksaCluster(name, managedBy string) *anywherev1.Cluster {
+	version := anywherev1.EksaVersion("v0.22.0")
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube131,
+			EksaVersion:       &version,
+			DatacenterRef: anywherev1.Ref{
+				Kind: anywherev1.DockerDatacenterKind,
+				Name: name,
+			},
+			ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{Count: 3},
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{Name: "md-0", Count: ptr.To(2)},
+				{Name: "md-1", Count: ptr.To(1)},
+			},
+			ManagementCluster: anywherev1.ManagementCluster{Name: managedBy},
+		},
+		Status: anywherev1.ClusterStatus{
+			Conditions: clusterv1.Conditions{
+				{Type: anywherev1.ReadyCondition, Status: corev1.ConditionFalse, Reason: anywherev1.ControlPlaneComponentsUnhealthyReason},
+			},
+		},
+	}
+}
+
+func TestListClusters(t *testing.T) {
+	g := NewWithT(t)
+	workload := eksaCluster("workload", "mgmt")
+	workload.Namespace = "workloads"
+	workload.Status.Conditions = nil
+	c := newClient(workload, eksaCluster("mgmt", "mgmt"))
+
+	summaries, err := clusterinfo.ListClusters(context.Background(), c)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(summaries).To(Equal([]clusterinfo.Summary{
+		{
+			Name:              "mgmt",
+			Namespace:         "default",
+			Provider:          "docker",
+			KubernetesVersion: anywherev1.Kube131,
+			EksaVersion:       "v0.22.0",
+			ManagementCluster: "mgmt",
+			ControlPlaneNodes: 3,
+			WorkerNodes:       3,
+			Ready:             corev1.ConditionFalse,
+			Reason:            anywherev1.ControlPlaneComponentsUnhealthyReason,
+		},
+		{
+			Name:              "workload",
+			Namespace:         "workloads",
+			Provider:          "docker",
+			KubernetesVersion: anywherev1.Kube131,
+			EksaVersion:       "v0.22.0",
+			ManagementCluster: "mgmt",
+			ControlPlaneNodes: 3,
+			WorkerNodes:       3,
+			Ready:             corev1.ConditionUnknown,
+		},
+	}))
+}
+
+func TestDescribe(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now().Truncate(time.Second)
+	cluster := eksaCluster("mgmt", "mgmt")
+	datacenter := &anywherev1.DockerDatacenterConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "default"},
+	}
+	controlPlane := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mgmt-cp-1",
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel:         "mgmt",
+				clusterv1.MachineControlPlaneLabel: "",
+			},
+		},
+		Spec: clusterv1.MachineSpec{ClusterName: "mgmt", Version: ptr.To("v1.31.1-eks-1-31-4")},
+		Status: clusterv1.MachineStatus{
+			Phase:      string(clusterv1.MachinePhaseRunning),
+			NodeRef:    &corev1.ObjectReference{Name: "mgmt-cp-1"},
+			Conditions: clusterv1.Conditions{{Type: clusterv1.ReadyCondition, Status: corev1.ConditionTrue}},
+		},
+	}
+	worker := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mgmt-md-0-1",
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel:           "mgmt",
+				clusterv1.MachineDeploymentNameLabel: "mgmt-md-0",
+			},
+		},
+		Spec:   clusterv1.MachineSpec{ClusterName: "mgmt"},
+		Status: clusterv1.MachineStatus{Phase: string(clusterv1.MachinePhaseProvisioning)},
+	}
+	otherCluster := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other-cp-1",
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "other"},
+		},
+		Spec: clusterv1.MachineSpec{ClusterName: "other"},
+	}
+	prefixedCluster := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mgmt-2-cp-1",
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "mgmt-2"},
+		},
+		Spec: clusterv1.MachineSpec{ClusterName: "mgmt-2"},
+	}
+
+	objs := []client.Object{cluster, datacenter, controlPlane, worker, otherCluster, prefixedCluster,
+		event("default", "mgmt-1", "Cluster", "mgmt", now.Add(-time.Minute)),
+		event(constants.EksaSystemNamespace, "kcp-1", "KubeadmControlPlane", "mgmt", now),
+		event(constants.EksaSystemNamespace, "other-1", "Machine", "other-cp-1", now),
+		event(constants.EksaSystemNamespace, "mgmt-2-1", "Machine", "mgmt-2-cp-1", now),
+		event(constants.EksaSystemNamespace, "deleted-1", "Machine", "mgmt-md-0-2", now),
+	}
+	for i := 0; i < clusterinfo.MaxEvents; i++ {
+		objs = append(objs, event(constants.EksaSystemNamespace, fmt.Sprintf("old-%d", i), "Machine", "mgmt-md-0-1", now.Add(-time.Hour)))
+	}
+	c := newClient(objs...)
+
+	d, err := clusterinfo.Describe(context.Background(), c, "mgmt", "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(d.Summary.Name).To(Equal("mgmt"))
+	g.Expect(d.Cluster.TypeMeta.Kind).To(Equal(anywherev1.ClusterKind))
+	g.Expect(d.Configs).To(HaveLen(1))
+	g.Expect(d.Configs[0].GetObjectKind().GroupVersionKind().Kind).To(Equal(anywherev1.DockerDatacenterKind))
+	g.Expect(d.Machines).To(Equal([]clusterinfo.Machine{
+		{Name: "mgmt-cp-1", Role: constants.ControlPlaneComponent, NodeName: "mgmt-cp-1", Phase: "Running", Version: "v1.31.1-eks-1-31-4", Ready: true},
+		{Name: "mgmt-md-0-1", Role: "mgmt-md-0", Phase: "Provisioning"},
+	}))
+	g.Expect(d.Events).To(HaveLen(clusterinfo.MaxEvents))
+	g.Expect(d.Events[0].Object).To(Equal("KubeadmControlPlane/mgmt"))
+	g.Expect(d.Events[1].Object).To(Equal("Cluster/mgmt"))
+}
+
+func TestDescribeClusterNotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := clusterinfo.Describe(context.Background(), newClient(), "mgmt", "default")
+	g.Expect(err).To(MatchError(ContainSubstring("getting cluster mgmt")))
+}
+
+func event(namespace, name, kind, objectName string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       kind,
+			Name:       objectName,
+			Namespace:  namespace,
+		},
+		Type:          corev1.EventTypeNormal,
+		Reason:        "Reconciled",
+		Message:       "reconciled " + objectName,
+		LastTimestamp: metav1.NewTime(lastSeen),
+	}
+}
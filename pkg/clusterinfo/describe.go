@@ -0,0 +1,252 @@
+package clusterinfo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+)
+
+// MaxEvents is the maximum number of events included in a Description.
+const MaxEvents = 20
+
+// Description contains the details of an EKS-A cluster.
+type Description struct {
+	Summary Summary `json:"summary"`
+	// Cluster is the full EKS-A cluster object, including its status.
+	Cluster *anywherev1.Cluster `json:"cluster"`
+	// Configs are the datacenter, machine and other configs referenced by the cluster.
+	Configs  []kubernetes.Object `json:"configs"`
+	Machines []Machine           `json:"machines"`
+	// Events are the most recent events for the cluster and its CAPI objects, newest first.
+	Events []Event `json:"events"`
+}
+
+// Machine is the status of a CAPI machine of the cluster.
+type Machine struct {
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+	NodeName string `json:"nodeName,omitempty"`
+	Phase    string `json:"phase"`
+	Version  string `json:"version,omitempty"`
+	Ready    bool   `json:"ready"`
+}
+
+// Event is a kubernetes event related to the cluster.
+type Event struct {
+	LastSeen time.Time `json:"lastSeen"`
+	Type     string    `json:"type"`
+	Reason   string    `json:"reason"`
+	Object   string    `json:"object"`
+	Message  string    `json:"message"`
+}
+
+// Describe collects the details of an EKS-A cluster from the management cluster.
+func Describe(ctx context.Context, c client.Client, name, namespace string) (*Description, error) {
+	eksaCluster := &anywherev1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, eksaCluster); err != nil {
+		return nil, fmt.Errorf("getting cluster %s: %v", name, err)
+	}
+
+	config, err := cluster.NewDefaultConfigClientBuilder().Build(ctx, clientutil.NewKubeClient(c), eksaCluster)
+	if err != nil {
+		return nil, fmt.Errorf("getting configs for cluster %s: %v", name, err)
+	}
+
+	configs := config.ChildObjects()
+	for _, obj := range append(configs, eksaCluster) {
+		// Typed clients don't populate the TypeMeta, but it's needed to identify the objects in the output.
+		if gvk, err := apiutil.GVKForObject(obj, c.Scheme()); err == nil {
+			obj.GetObjectKind().SetGroupVersionKind(gvk)
+		}
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		ki, kj := configs[i].GetObjectKind().GroupVersionKind().Kind, configs[j].GetObjectKind().GroupVersionKind().Kind
+		if ki != kj {
+			return ki < kj
+		}
+		return configs[i].GetName() < configs[j].GetName()
+	})
+
+	machines, err := machines(ctx, c, eksaCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := events(ctx, c, eksaCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Description{
+		Summary:  Summarize(eksaCluster),
+		Cluster:  eksaCluster,
+		Configs:  configs,
+		Machines: machines,
+		Events:   events,
+	}, nil
+}
+
+func machines(ctx context.Context, c client.Client, eksaCluster *anywherev1.Cluster) ([]Machine, error) {
+	list := &clusterv1.MachineList{}
+	if err := c.List(ctx, list, client.InNamespace(constants.EksaSystemNamespace), client.MatchingLabels{clusterv1.ClusterNameLabel: eksaCluster.Name}); err != nil {
+		return nil, fmt.Errorf("listing machines for cluster %s: %v", eksaCluster.Name, err)
+	}
+
+	machines := make([]Machine, 0, len(list.Items))
+	for i := range list.Items {
+		m := &list.Items[i]
+		machine := Machine{
+			Name:  m.Name,
+			Role:  machineRole(m),
+			Phase: m.Status.Phase,
+			Ready: m.Status.NodeRef != nil && isReady(m),
+		}
+		if m.Status.NodeRef != nil {
+			machine.NodeName = m.Status.NodeRef.Name
+		}
+		if m.Spec.Version != nil {
+			machine.Version = *m.Spec.Version
+		}
+		machines = append(machines, machine)
+	}
+
+	sort.Slice(machines, func(i, j int) bool {
+		if machines[i].Role != machines[j].Role {
+			return machines[i].Role < machines[j].Role
+		}
+		return machines[i].Name < machines[j].Name
+	})
+
+	return machines, nil
+}
+
+func machineRole(m *clusterv1.Machine) string {
+	if _, ok := m.Labels[clusterv1.MachineControlPlaneLabel]; ok {
+		return constants.ControlPlaneComponent
+	}
+	if _, ok := m.Labels[clusterv1.MachineEtcdClusterLabelName]; ok {
+		return constants.EtcdComponent
+	}
+	if md, ok := m.Labels[clusterv1.MachineDeploymentNameLabel]; ok {
+		return md
+	}
+	return ""
+}
+
+func isReady(m *clusterv1.Machine) bool {
+	for _, c := range m.Status.Conditions {
+		if c.Type == clusterv1.ReadyCondition {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// events returns the events for the EKS-A cluster and for the objects in eksa-system that belong to it.
+func events(ctx context.Context, c client.Client, eksaCluster *anywherev1.Cluster) ([]Event, error) {
+	owned := &clusterObjects{client: c, cluster: eksaCluster, belongs: map[corev1.ObjectReference]bool{}}
+	namespaces := []string{eksaCluster.Namespace}
+	if eksaCluster.Namespace != constants.EksaSystemNamespace {
+		namespaces = append(namespaces, constants.EksaSystemNamespace)
+	}
+
+	var events []Event
+	for _, ns := range namespaces {
+		list := &corev1.EventList{}
+		if err := c.List(ctx, list, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("listing events in namespace %s: %v", ns, err)
+		}
+
+		for _, e := range list.Items {
+			if !owned.contains(ctx, e.InvolvedObject) {
+				continue
+			}
+			events = append(events, Event{
+				LastSeen: eventTime(e).UTC(),
+				Type:     e.Type,
+				Reason:   e.Reason,
+				Object:   e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name,
+				Message:  strings.TrimSpace(e.Message),
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeen.After(events[j].LastSeen)
+	})
+	if len(events) > MaxEvents {
+		events = events[:MaxEvents]
+	}
+
+	return events, nil
+}
+
+// clusterObjects decides which objects belong to an EKS-A cluster, caching the lookups.
+type clusterObjects struct {
+	client  client.Client
+	cluster *anywherev1.Cluster
+	belongs map[corev1.ObjectReference]bool
+}
+
+// contains returns true if ref is the EKS-A cluster, an object in eksa-system named after it
+// or an object in eksa-system labeled with its name. The name prefix alone isn't enough:
+// the objects of cluster prod-2 are also prefixed with prod-.
+func (o *clusterObjects) contains(ctx context.Context, ref corev1.ObjectReference) bool {
+	if ref.Namespace == o.cluster.Namespace && ref.Name == o.cluster.Name {
+		return true
+	}
+	if ref.Namespace != constants.EksaSystemNamespace {
+		return false
+	}
+	if ref.Name == o.cluster.Name {
+		return true
+	}
+	if !strings.HasPrefix(ref.Name, o.cluster.Name+"-") {
+		return false
+	}
+
+	key := corev1.ObjectReference{APIVersion: ref.APIVersion, Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name}
+	belongs, ok := o.belongs[key]
+	if !ok {
+		belongs = o.labeledWithCluster(ctx, key)
+		o.belongs[key] = belongs
+	}
+	return belongs
+}
+
+// labeledWithCluster returns true if the object exists and has the CAPI cluster name label of the cluster.
+// Objects that can't be retrieved, like deleted ones, don't belong to it.
+func (o *clusterObjects) labeledWithCluster(ctx context.Context, ref corev1.ObjectReference) bool {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(ref.GroupVersionKind())
+	if err := o.client.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
+		return false
+	}
+	return obj.GetLabels()[clusterv1.ClusterNameLabel] == o.cluster.Name
+}
+
+func eventTime(e corev1.Event) time.Time {
+	for _, t := range []metav1.Time{e.LastTimestamp, e.FirstTimestamp} {
+		if !t.IsZero() {
+			return t.Time
+		}
+	}
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
@@ -0,0 +1,85 @@
+package clusterinfo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// Summary is a one line overview of an EKS-A cluster.
+type Summary struct {
+	Name              string                       `json:"name"`
+	Namespace         string                       `json:"namespace"`
+	Provider          string                       `json:"provider"`
+	KubernetesVersion anywherev1.KubernetesVersion `json:"kubernetesVersion"`
+	EksaVersion       string                       `json:"eksaVersion,omitempty"`
+	ManagementCluster string                       `json:"managementCluster"`
+	ControlPlaneNodes int                          `json:"controlPlaneNodes"`
+	WorkerNodes       int                          `json:"workerNodes"`
+	Ready             corev1.ConditionStatus       `json:"ready"`
+	Reason            string                       `json:"reason,omitempty"`
+}
+
+// ListClusters returns a Summary for every EKS-A cluster in all namespaces, sorted by namespace and name.
+func ListClusters(ctx context.Context, c client.Client) ([]Summary, error) {
+	clusters := &anywherev1.ClusterList{}
+	if err := c.List(ctx, clusters); err != nil {
+		return nil, fmt.Errorf("listing clusters: %v", err)
+	}
+
+	summaries := make([]Summary, 0, len(clusters.Items))
+	for i := range clusters.Items {
+		summaries = append(summaries, Summarize(&clusters.Items[i]))
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Namespace != summaries[j].Namespace {
+			return summaries[i].Namespace < summaries[j].Namespace
+		}
+		return summaries[i].Name < summaries[j].Name
+	})
+
+	return summaries, nil
+}
+
+// Summarize builds the Summary of a cluster.
+func Summarize(cluster *anywherev1.Cluster) Summary {
+	s := Summary{
+		Name:              cluster.Name,
+		Namespace:         cluster.Namespace,
+		Provider:          Provider(cluster),
+		KubernetesVersion: cluster.Spec.KubernetesVersion,
+		ManagementCluster: cluster.ManagedBy(),
+		ControlPlaneNodes: cluster.Spec.ControlPlaneConfiguration.Count,
+		Ready:             corev1.ConditionUnknown,
+	}
+
+	if cluster.Spec.EksaVersion != nil {
+		s.EksaVersion = string(*cluster.Spec.EksaVersion)
+	}
+
+	for _, w := range cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.Count != nil {
+			s.WorkerNodes += *w.Count
+		}
+	}
+
+	if ready := conditions.Get(cluster, anywherev1.ReadyCondition); ready != nil {
+		s.Ready = ready.Status
+		s.Reason = ready.Reason
+	}
+
+	return s
+}
+
+// Provider returns the name of the provider of a cluster, derived from its datacenter kind.
+func Provider(cluster *anywherev1.Cluster) string {
+	return strings.ToLower(strings.TrimSuffix(cluster.Spec.DatacenterRef.Kind, "DatacenterConfig"))
+}
@@ -950,7 +950,7 @@ func updateTinkerbellIPInBootstrapTinkerbellMachineTemplate(ctx context.Context,
 			if cpMachineCfg.Spec.OSImageURL != "" {
 				osImageURL = cpMachineCfg.Spec.OSImageURL
 			}
-			tinkMachineTemplate, err = updateTemplateOverride(spec.Cluster, tinkMachineTemplate, osImageURL, tinkIP, cpMachineCfg.OSFamily())
+			tinkMachineTemplate, err = updateTemplateOverride(spec.Cluster, tinkMachineTemplate, osImageURL, tinkIP, cpMachineCfg.OSFamily(), cpMachineCfg.Spec.Network)
 			if err != nil {
 				return err
 			}
@@ -964,7 +964,7 @@ func updateTinkerbellIPInBootstrapTinkerbellMachineTemplate(ctx context.Context,
 				if wngMachineCfg.Spec.OSImageURL != "" {
 					osImageURL = wngMachineCfg.Spec.OSImageURL
 				}
-				tinkMachineTemplate, err = updateTemplateOverride(spec.Cluster, tinkMachineTemplate, osImageURL, tinkIP, wngMachineCfg.OSFamily())
+				tinkMachineTemplate, err = updateTemplateOverride(spec.Cluster, tinkMachineTemplate, osImageURL, tinkIP, wngMachineCfg.OSFamily(), wngMachineCfg.Spec.Network)
 				if err != nil {
 					return err
 				}
@@ -978,8 +978,8 @@ func updateTinkerbellIPInBootstrapTinkerbellMachineTemplate(ctx context.Context,
 	return nil
 }
 
-func updateTemplateOverride(clusterSpec *v1alpha1.Cluster, template tinkerbellv1.TinkerbellMachineTemplate, osImageOverride, tinkIP string, osFamily v1alpha1.OSFamily) (tinkerbellv1.TinkerbellMachineTemplate, error) {
-	newOverride := v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(clusterSpec, osImageOverride, tinkIP, tinkIP, osFamily)
+func updateTemplateOverride(clusterSpec *v1alpha1.Cluster, template tinkerbellv1.TinkerbellMachineTemplate, osImageOverride, tinkIP string, osFamily v1alpha1.OSFamily, network *v1alpha1.TinkerbellNetworkConfig) (tinkerbellv1.TinkerbellMachineTemplate, error) {
+	newOverride := v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(clusterSpec, osImageOverride, tinkIP, tinkIP, osFamily, network)
 	var err error
 	template.Spec.Template.Spec.TemplateOverride, err = newOverride.ToTemplateString()
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -56,3 +57,55 @@ func SetCredentialsEnv(username, password string) error {
 
 	return nil
 }
+
+// EndpointCredentialsEnv returns the names of the env variables holding the username and password
+// of the registry mirror endpoint credentials stored in the secret secretRef.
+// For example, for the secret harbor-site-a these are REGISTRY_USERNAME_HARBOR_SITE_A and REGISTRY_PASSWORD_HARBOR_SITE_A.
+func EndpointCredentialsEnv(secretRef string) (usernameEnv, passwordEnv string) {
+	suffix := strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(secretRef))
+	return constants.RegistryUsername + "_" + suffix, constants.RegistryPassword + "_" + suffix
+}
+
+// ReadEndpointCredentials reads the credentials of a registry mirror endpoint from the env variables
+// for the secret secretRef.
+func ReadEndpointCredentials(secretRef string) (username, password string, err error) {
+	usernameEnv, passwordEnv := EndpointCredentialsEnv(secretRef)
+	username, ok := os.LookupEnv(usernameEnv)
+	if !ok {
+		return "", "", fmt.Errorf("please set %s env var", usernameEnv)
+	}
+
+	password, ok = os.LookupEnv(passwordEnv)
+	if !ok {
+		return "", "", fmt.Errorf("please set %s env var", passwordEnv)
+	}
+
+	return username, password, nil
+}
+
+// ReadEndpointCredentialsFromSecret reads the credentials of a registry mirror endpoint from
+// the Kubernetes secret secretRef in the eksa-system namespace.
+func ReadEndpointCredentialsFromSecret(ctx context.Context, client client.Client, secretRef string) (username, password string, err error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: secretRef, Namespace: constants.EksaSystemNamespace}
+	if err := client.Get(ctx, key, secret); err != nil {
+		return "", "", errors.Wrapf(err, "fetching registry mirror endpoint credentials secret %s", secretRef)
+	}
+
+	return string(secret.Data["username"]), string(secret.Data["password"]), nil
+}
+
+// SetEndpointCredentialsEnv sets the env variables for the credentials of a registry mirror endpoint
+// stored in the secret secretRef.
+func SetEndpointCredentialsEnv(secretRef, username, password string) error {
+	usernameEnv, passwordEnv := EndpointCredentialsEnv(secretRef)
+	if err := os.Setenv(usernameEnv, username); err != nil {
+		return fmt.Errorf("failed setting env %s: %v", usernameEnv, err)
+	}
+
+	if err := os.Setenv(passwordEnv, password); err != nil {
+		return fmt.Errorf("failed setting env %s: %v", passwordEnv, err)
+	}
+
+	return nil
+}
@@ -71,3 +71,58 @@ func TestReadCredentialsFromSecretNotFound(t *testing.T) {
 	assert.Empty(t, u)
 	assert.Empty(t, p)
 }
+
+func TestEndpointCredentialsEnv(t *testing.T) {
+	usernameEnv, passwordEnv := EndpointCredentialsEnv("harbor-site.a")
+	assert.Equal(t, "REGISTRY_USERNAME_HARBOR_SITE_A", usernameEnv)
+	assert.Equal(t, "REGISTRY_PASSWORD_HARBOR_SITE_A", passwordEnv)
+}
+
+func TestReadEndpointCredentials(t *testing.T) {
+	_, _, err := ReadEndpointCredentials("harbor-central")
+	assert.EqualError(t, err, "please set REGISTRY_USERNAME_HARBOR_CENTRAL env var")
+
+	t.Setenv("REGISTRY_USERNAME_HARBOR_CENTRAL", "testuser")
+	_, _, err = ReadEndpointCredentials("harbor-central")
+	assert.EqualError(t, err, "please set REGISTRY_PASSWORD_HARBOR_CENTRAL env var")
+
+	t.Setenv("REGISTRY_PASSWORD_HARBOR_CENTRAL", "testpass")
+	username, password, err := ReadEndpointCredentials("harbor-central")
+	assert.NoError(t, err)
+	assert.Equal(t, "testuser", username)
+	assert.Equal(t, "testpass", password)
+}
+
+func TestReadEndpointCredentialsFromSecret(t *testing.T) {
+	ctx := context.Background()
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "harbor-central",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("testuser"),
+			"password": []byte("testpass"),
+		},
+	}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(sec).Build()
+
+	u, p, err := ReadEndpointCredentialsFromSecret(ctx, cl, "harbor-central")
+	assert.NoError(t, err)
+	assert.Equal(t, "testuser", u)
+	assert.Equal(t, "testpass", p)
+
+	_, _, err = ReadEndpointCredentialsFromSecret(ctx, cl, "harbor-site-a")
+	assert.ErrorContains(t, err, "fetching registry mirror endpoint credentials secret harbor-site-a")
+}
+
+func TestSetEndpointCredentialsEnv(t *testing.T) {
+	t.Setenv("REGISTRY_USERNAME_HARBOR_CENTRAL", "")
+	t.Setenv("REGISTRY_PASSWORD_HARBOR_CENTRAL", "")
+	assert.NoError(t, SetEndpointCredentialsEnv("harbor-central", "testuser", "testpass"))
+
+	username, password, err := ReadEndpointCredentials("harbor-central")
+	assert.NoError(t, err)
+	assert.Equal(t, "testuser", username)
+	assert.Equal(t, "testpass", password)
+}
@@ -22,6 +22,7 @@ const (
 	DefaultNamespace                        = "default"
 	EtcdAdmBootstrapProviderSystemNamespace = "etcdadm-bootstrap-provider-system"
 	EtcdAdmControllerSystemNamespace        = "etcdadm-controller-system"
+	CapiIPAMInClusterSystemNamespace        = "capi-ipam-in-cluster-system"
 	KubeNodeLeaseNamespace                  = "kube-node-lease"
 	KubePublicNamespace                     = "kube-public"
 	KubeSystemNamespace                     = "kube-system"
@@ -32,6 +33,7 @@ const (
 	LocalPathStorageNamespace               = "local-path-storage"
 	EtcdAdmBootstrapProviderName            = "bootstrap-etcdadm-bootstrap"
 	EtcdadmControllerProviderName           = "bootstrap-etcdadm-controller"
+	InClusterIPAMProviderName               = "ipam-in-cluster"
 	DefaultHttpsPort                        = "443"
 	DefaultWorkerNodeGroupName              = "md-0"
 	DefaultNodeCidrMaskSize                 = 24
@@ -93,10 +95,13 @@ const (
 	EksaNutanixPasswordKey = "EKSA_NUTANIX_PASSWORD"
 	RegistryUsername       = "REGISTRY_USERNAME"
 	RegistryPassword       = "REGISTRY_PASSWORD"
+	EksaBMCUsernameKey     = "EKSA_BMC_USERNAME"
+	EksaBMCPasswordKey     = "EKSA_BMC_PASSWORD"
 
 	SecretKind             = "Secret"
 	ConfigMapKind          = "ConfigMap"
 	ClusterResourceSetKind = "ClusterResourceSet"
+	InClusterIPPoolKind    = "InClusterIPPool"
 
 	NutanixMachineConfigKind = "NutanixMachineConfig"
 
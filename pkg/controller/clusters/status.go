@@ -2,6 +2,8 @@ package clusters
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
 	"github.com/pkg/errors"
@@ -12,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/controller"
 )
@@ -71,6 +74,81 @@ func UpdateClusterStatusForCNI(ctx context.Context, cluster *anywherev1.Cluster)
 	}
 }
 
+// UpdateClusterStatusForCertificates records the certificate expiry of the control plane and external etcd machines
+// and of the cluster certificate secrets in the Cluster status and updates the CertificatesValid condition. The
+// condition is only marked False with a Warning severity when certificates expire within threshold, so it is not
+// part of the Ready summary.
+func UpdateClusterStatusForCertificates(ctx context.Context, client client.Client, cluster *anywherev1.Cluster, threshold time.Duration) error {
+	machineExpirations, err := certificates.MachineExpirations(ctx, client, cluster)
+	if err != nil {
+		return errors.Wrap(err, "getting machine certificate expirations")
+	}
+
+	secretExpirations, err := certificates.SecretExpirations(ctx, client, cluster)
+	if err != nil {
+		return errors.Wrap(err, "getting certificate secret expirations")
+	}
+
+	cluster.Status.CertificateExpirations = nil
+	cluster.Status.CertificateSecretExpirations = nil
+	var first *certificateExpiry
+	if len(machineExpirations) > 0 {
+		cluster.Status.CertificateExpirations = machineExpirations
+		e := machineExpirations[0]
+		first = &certificateExpiry{description: fmt.Sprintf("Certificates on %s machine %s", e.Role, e.Machine), plural: true, expiresAt: e.ExpiresAt.Time}
+	}
+	if len(secretExpirations) > 0 {
+		cluster.Status.CertificateSecretExpirations = secretExpirations
+		e := secretExpirations[0]
+		if first == nil || e.ExpiresAt.Time.Before(first.expiresAt) {
+			first = &certificateExpiry{description: fmt.Sprintf("Certificate in %s secret %s", e.Role, e.Secret), expiresAt: e.ExpiresAt.Time}
+		}
+	}
+
+	if first == nil {
+		conditions.Delete(cluster, anywherev1.CertificatesValidCondition)
+		return nil
+	}
+
+	updateCertificatesValidCondition(cluster, *first, time.Now(), threshold)
+	return nil
+}
+
+// certificateExpiry is the certificate that expires first, described for the CertificatesValid condition message.
+type certificateExpiry struct {
+	description string
+	plural      bool
+	expiresAt   time.Time
+}
+
+func (e certificateExpiry) inflect(plural, singular string) string {
+	if e.plural {
+		return plural
+	}
+	return singular
+}
+
+// updateCertificatesValidCondition updates the CertificatesValid condition based on the certificate that expires first.
+func updateCertificatesValidCondition(cluster *anywherev1.Cluster, first certificateExpiry, now time.Time, threshold time.Duration) {
+	switch certificates.State(first.expiresAt, now, threshold) {
+	case certificates.ExpiryStateExpired:
+		conditions.MarkFalse(cluster, anywherev1.CertificatesValidCondition, anywherev1.CertificatesExpiredReason, clusterv1.ConditionSeverityError,
+			"%s expired on %s", first.description, first.expiresAt.Format(time.RFC3339))
+	case certificates.ExpiryStateExpiringSoon:
+		conditions.MarkFalse(cluster, anywherev1.CertificatesValidCondition, anywherev1.CertificatesExpiringSoonReason, clusterv1.ConditionSeverityWarning,
+			"%s in %s, renew %s with 'eksctl anywhere renew certificates'", first.description+first.inflect(" expire", " expires"), daysString(certificates.DaysUntil(first.expiresAt, now)), first.inflect("them", "it"))
+	default:
+		conditions.MarkTrue(cluster, anywherev1.CertificatesValidCondition)
+	}
+}
+
+func daysString(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
 // updateConditionsForEtcdAndControlPlane updates the ControlPlaneReady condition if etcdadm cluster is not ready.
 func updateConditionsForEtcdAndControlPlane(cluster *anywherev1.Cluster, kcp *controlplanev1.KubeadmControlPlane, etcdadmCluster *etcdv1.EtcdadmCluster) {
 	// Make sure etcd cluster is ready before marking ControlPlaneReady status to true
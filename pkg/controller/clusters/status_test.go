@@ -2,8 +2,15 @@ package clusters_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
 	. "github.com/onsi/gomega"
@@ -1341,3 +1348,159 @@ func TestUpdateClusterStatusForCNI(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateClusterStatusForCertificates(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	tests := []struct {
+		name                  string
+		machines              []*clusterv1.Machine
+		secrets               []*corev1.Secret
+		wantCondition         *anywherev1.Condition
+		wantExpirations       []string
+		wantSecretExpirations []string
+	}{
+		{
+			name:     "no machines",
+			machines: nil,
+		},
+		{
+			name: "certificates valid",
+			machines: []*clusterv1.Machine{
+				certificatesMachine("cp-1", clusterv1.MachineControlPlaneLabel, now.Add(200*24*time.Hour)),
+				certificatesMachine("etcd-1", clusterv1.MachineEtcdClusterLabelName, now.Add(100*24*time.Hour)),
+			},
+			wantCondition: &anywherev1.Condition{
+				Type:   anywherev1.CertificatesValidCondition,
+				Status: "True",
+			},
+			wantExpirations: []string{"etcd-1", "cp-1"},
+		},
+		{
+			name: "certificates expiring soon",
+			machines: []*clusterv1.Machine{
+				certificatesMachine("cp-1", clusterv1.MachineControlPlaneLabel, now.Add(10*24*time.Hour+time.Hour)),
+			},
+			wantCondition: &anywherev1.Condition{
+				Type:     anywherev1.CertificatesValidCondition,
+				Status:   "False",
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   anywherev1.CertificatesExpiringSoonReason,
+				Message:  "Certificates on control-plane machine cp-1 expire in 10 days, renew them with 'eksctl anywhere renew certificates'",
+			},
+			wantExpirations: []string{"cp-1"},
+		},
+		{
+			name: "certificate secret expiring first",
+			machines: []*clusterv1.Machine{
+				certificatesMachine("cp-1", clusterv1.MachineControlPlaneLabel, now.Add(200*24*time.Hour)),
+			},
+			secrets: []*corev1.Secret{
+				certificatesSecret(g, "my-cluster-apiserver-etcd-client", now.Add(10*24*time.Hour+time.Hour)),
+			},
+			wantCondition: &anywherev1.Condition{
+				Type:     anywherev1.CertificatesValidCondition,
+				Status:   "False",
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   anywherev1.CertificatesExpiringSoonReason,
+				Message:  "Certificate in etcd secret my-cluster-apiserver-etcd-client expires in 10 days, renew it with 'eksctl anywhere renew certificates'",
+			},
+			wantExpirations:       []string{"cp-1"},
+			wantSecretExpirations: []string{"my-cluster-apiserver-etcd-client"},
+		},
+		{
+			name: "certificates expired",
+			machines: []*clusterv1.Machine{
+				certificatesMachine("cp-1", clusterv1.MachineControlPlaneLabel, now.Add(-time.Hour)),
+			},
+			wantCondition: &anywherev1.Condition{
+				Type:     anywherev1.CertificatesValidCondition,
+				Status:   "False",
+				Severity: clusterv1.ConditionSeverityError,
+				Reason:   anywherev1.CertificatesExpiredReason,
+			},
+			wantExpirations: []string{"cp-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			cluster := test.Cluster(func(c *anywherev1.Cluster) {
+				c.Name = "my-cluster"
+			})
+
+			objs := make([]client.Object, 0, len(tt.machines)+len(tt.secrets))
+			for _, m := range tt.machines {
+				objs = append(objs, m)
+			}
+			for _, s := range tt.secrets {
+				objs = append(objs, s)
+			}
+			client := fake.NewClientBuilder().WithObjects(objs...).Build()
+
+			g.Expect(clusters.UpdateClusterStatusForCertificates(ctx, client, cluster, 30*24*time.Hour)).To(Succeed())
+
+			gotExpirations := make([]string, 0, len(cluster.Status.CertificateExpirations))
+			for _, e := range cluster.Status.CertificateExpirations {
+				gotExpirations = append(gotExpirations, e.Machine)
+			}
+			g.Expect(gotExpirations).To(ConsistOf(tt.wantExpirations))
+
+			gotSecretExpirations := make([]string, 0, len(cluster.Status.CertificateSecretExpirations))
+			for _, e := range cluster.Status.CertificateSecretExpirations {
+				gotSecretExpirations = append(gotSecretExpirations, e.Secret)
+			}
+			g.Expect(gotSecretExpirations).To(ConsistOf(tt.wantSecretExpirations))
+
+			condition := conditions.Get(cluster, anywherev1.CertificatesValidCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+			if tt.wantCondition.Message != "" {
+				g.Expect(condition.Message).To(Equal(tt.wantCondition.Message))
+			}
+		})
+	}
+}
+
+func certificatesMachine(name, roleLabel string, expiresAt time.Time) *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: "my-cluster",
+				roleLabel:                  "",
+			},
+			Annotations: map[string]string{
+				clusterv1.MachineCertificatesExpiryDateAnnotation: expiresAt.Format(time.RFC3339),
+			},
+		},
+	}
+}
+
+func certificatesSecret(g *WithT, name string, notAfter time.Time) *corev1.Secret {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.EksaSystemNamespace},
+		Data: map[string][]byte{
+			"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		},
+	}
+}
@@ -11,6 +11,7 @@ import (
 	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 	"github.com/aws/eks-anywhere/pkg/semver"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
@@ -33,16 +34,32 @@ type BundleReader struct {
 	kubectl       KubectlRunner
 	bundleManager Manager
 	registry      BundleRegistry
+	releaseSource *releasesource.Source
 }
 
-func NewBundleReader(kubeConfig string, clusterName string, k KubectlRunner, bm Manager, reg BundleRegistry) *BundleReader {
-	return &BundleReader{
+// BundleReaderOpt configures a BundleReader.
+type BundleReaderOpt func(*BundleReader)
+
+// WithReleaseSource makes the reader read the latest package bundle from a release source instead
+// of the registry. A nil source is ignored.
+func WithReleaseSource(source *releasesource.Source) BundleReaderOpt {
+	return func(b *BundleReader) {
+		b.releaseSource = source
+	}
+}
+
+func NewBundleReader(kubeConfig string, clusterName string, k KubectlRunner, bm Manager, reg BundleRegistry, opts ...BundleReaderOpt) *BundleReader {
+	b := &BundleReader{
 		kubeConfig:    kubeConfig,
 		clusterName:   clusterName,
 		kubectl:       k,
 		bundleManager: bm,
 		registry:      reg,
 	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
 }
 
 func (b *BundleReader) GetLatestBundle(ctx context.Context, kubeVersion string) (*packagesv1.PackageBundle, error) {
@@ -63,7 +80,28 @@ func (b *BundleReader) getLatestBundleFromRegistry(ctx context.Context, kubeVers
 		return nil, err
 	}
 
-	return b.bundleManager.LatestBundle(ctx, registryBaseRef, fmt.Sprintf("%d", kubeSemVer.Major), fmt.Sprintf("%d", kubeSemVer.Minor), "")
+	major, minor := fmt.Sprintf("%d", kubeSemVer.Major), fmt.Sprintf("%d", kubeSemVer.Minor)
+	if b.releaseSource != nil {
+		return readLatestBundleFromReleaseSource(b.releaseSource, registryBaseRef, major, minor)
+	}
+
+	return b.bundleManager.LatestBundle(ctx, registryBaseRef, major, minor, "")
+}
+
+// readLatestBundleFromReleaseSource reads the latest package bundle stored in the release source
+// under the reference it would be pulled from.
+func readLatestBundleFromReleaseSource(source *releasesource.Source, registryBaseRef, major, minor string) (*packagesv1.PackageBundle, error) {
+	data, err := source.ReadFile(fmt.Sprintf("%s:v%s-%s-latest", registryBaseRef, major, minor))
+	if err != nil {
+		return nil, fmt.Errorf("reading package bundle: %v", err)
+	}
+
+	bundle := &packagesv1.PackageBundle{}
+	if err = yaml.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("unmarshalling package bundle: %v", err)
+	}
+
+	return bundle, nil
 }
 
 func (b *BundleReader) getActiveBundleFromCluster(ctx context.Context) (*packagesv1.PackageBundle, error) {
@@ -14,6 +14,7 @@ import (
 	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 	"github.com/aws/eks-anywhere/pkg/version"
 )
 
@@ -114,6 +115,28 @@ func TestGetLatestBundleFromRegistrySucceeds(t *testing.T) {
 	tt.Expect(result.Spec.Packages[0].Name).To(BeEquivalentTo(tt.packageBundle.Spec.Packages[0].Name))
 }
 
+func TestGetLatestBundleFromReleaseSource(t *testing.T) {
+	tt := newBundleTest(t)
+	baseRef := "test_host/test_env/eks-anywhere-packages-bundles"
+	content, err := yaml.Marshal(tt.packageBundle)
+	tt.Expect(err).NotTo(HaveOccurred())
+	dir := t.TempDir()
+	b := releasesource.NewBuilder(dir, "https://example.com/eks-a-release.yaml")
+	tt.Expect(b.AddFile(baseRef+":v1-21-latest", "packages/1.21/bundle.yaml", content)).To(Succeed())
+	tt.Expect(b.Write()).To(Succeed())
+	source, err := releasesource.Load(dir)
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	tt.registry.EXPECT().GetRegistryBaseRef(tt.ctx).Return(baseRef, nil).Times(2)
+	tt.Command = curatedpackages.NewBundleReader(tt.kubeConfig, "", tt.kubectl, tt.bundleManager, tt.registry, curatedpackages.WithReleaseSource(source))
+	result, err := tt.Command.GetLatestBundle(tt.ctx, tt.kubeVersion)
+	tt.Expect(err).To(BeNil())
+	tt.Expect(result.Spec.Packages[0].Name).To(BeEquivalentTo(tt.packageBundle.Spec.Packages[0].Name))
+
+	_, err = tt.Command.GetLatestBundle(tt.ctx, "1.22")
+	tt.Expect(err).To(MatchError(ContainSubstring("not found in release source")))
+}
+
 func TestLatestBundleFromClusterUnknownBundle(t *testing.T) {
 	tt := newBundleTest(t)
 	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, gomock.Any()).Return(convertJsonToBytes(tt.bundleCtrl), nil)
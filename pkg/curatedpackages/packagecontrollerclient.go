@@ -492,7 +492,8 @@ func (pc *PackageControllerClient) GetPackageControllerConfiguration() (result s
 	return result, err
 }
 
-// Reconcile installs resources when a full cluster lifecycle cluster is created.
+// Reconcile installs resources when a full cluster lifecycle cluster is created and reconciles
+// the packages install list of the cluster.
 func (pc *PackageControllerClient) Reconcile(ctx context.Context, logger logr.Logger, client client.Client, cluster *anywherev1.Cluster) error {
 	image, err := pc.getBundleFromCluster(ctx, client, cluster)
 	if err != nil {
@@ -519,7 +520,7 @@ func (pc *PackageControllerClient) Reconcile(ctx context.Context, logger logr.Lo
 		return fmt.Errorf("packages client error: %w", err)
 	}
 
-	return nil
+	return pc.ReconcilePackages(ctx, logger, client, cluster)
 }
 
 // getBundleFromCluster based on the cluster's k8s version.
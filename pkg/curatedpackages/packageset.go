@@ -0,0 +1,159 @@
+package curatedpackages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	// PackageSetLabel is set on the Package objects created from the packages install list
+	// of a cluster. Its value is the name of the cluster.
+	PackageSetLabel = "anywhere.eks.amazonaws.com/package-set"
+
+	packageConfigSecretKey = "config"
+)
+
+// ReconcilePackages makes the Package objects of the cluster match the packages install list
+// in the cluster spec, deleting the ones removed from the list, and reports their state in the
+// cluster status.
+func (pc *PackageControllerClient) ReconcilePackages(ctx context.Context, logger logr.Logger, c client.Client, cluster *anywherev1.Cluster) error {
+	var install []anywherev1.PackageInstall
+	if cluster.Spec.Packages != nil {
+		install = cluster.Spec.Packages.Install
+	}
+
+	// The status lists the packages installed from the spec, so when both are empty
+	// there is nothing to install or remove.
+	if len(install) == 0 && len(cluster.Status.Packages) == 0 {
+		return nil
+	}
+
+	namespace := constants.EksaPackagesName + "-" + cluster.Name
+	existing := &packagesv1.PackageList{}
+	if err := c.List(ctx, existing, client.InNamespace(namespace), client.MatchingLabels{PackageSetLabel: cluster.Name}); err != nil {
+		return fmt.Errorf("listing packages for cluster %s: %w", cluster.Name, err)
+	}
+
+	statuses := make([]anywherev1.PackageInstallStatus, 0, len(install))
+	desired := make(map[string]struct{}, len(install))
+	for _, p := range install {
+		desired[p.Name] = struct{}{}
+		pkg, err := applyPackage(ctx, c, cluster, namespace, p)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, anywherev1.PackageInstallStatus{
+			Name:           pkg.Name,
+			PackageName:    pkg.Spec.PackageName,
+			CurrentVersion: pkg.Status.CurrentVersion,
+			State:          string(pkg.Status.State),
+			Detail:         pkg.Status.Detail,
+		})
+	}
+
+	for i := range existing.Items {
+		pkg := &existing.Items[i]
+		if _, ok := desired[pkg.Name]; ok {
+			continue
+		}
+		logger.Info("Removing package no longer in the packages install list", "package", pkg.Name)
+		if err := c.Delete(ctx, pkg); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting package %s/%s: %w", pkg.Namespace, pkg.Name, err)
+		}
+	}
+
+	if len(statuses) == 0 {
+		statuses = nil
+	}
+	cluster.Status.Packages = statuses
+
+	return nil
+}
+
+func applyPackage(ctx context.Context, c client.Client, cluster *anywherev1.Cluster, namespace string, p anywherev1.PackageInstall) (*packagesv1.Package, error) {
+	config, err := packageConfig(ctx, c, p)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := packagesv1.PackageSpec{
+		PackageName:     p.PackageName,
+		PackageVersion:  p.Version,
+		Config:          config,
+		TargetNamespace: p.TargetNamespace,
+	}
+
+	pkg := &packagesv1.Package{}
+	err = c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: p.Name}, pkg)
+	if apierrors.IsNotFound(err) {
+		pkg = &packagesv1.Package{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      p.Name,
+				Namespace: namespace,
+				Labels:    map[string]string{PackageSetLabel: cluster.Name},
+			},
+			Spec: spec,
+		}
+		if err := c.Create(ctx, pkg); err != nil {
+			return nil, fmt.Errorf("creating package %s/%s: %w", namespace, p.Name, err)
+		}
+		return pkg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting package %s/%s: %w", namespace, p.Name, err)
+	}
+
+	if pkg.Labels[PackageSetLabel] == cluster.Name && pkg.Spec == spec {
+		return pkg, nil
+	}
+
+	if pkg.Labels == nil {
+		pkg.Labels = map[string]string{}
+	}
+	pkg.Labels[PackageSetLabel] = cluster.Name
+	pkg.Spec = spec
+	if err := c.Update(ctx, pkg); err != nil {
+		return nil, fmt.Errorf("updating package %s/%s: %w", namespace, p.Name, err)
+	}
+
+	return pkg, nil
+}
+
+func packageConfig(ctx context.Context, c client.Client, p anywherev1.PackageInstall) (string, error) {
+	if p.ConfigSecretRef == "" {
+		return p.Config, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: constants.EksaSystemNamespace, Name: p.ConfigSecretRef}, secret); err != nil {
+		return "", fmt.Errorf("fetching config secret %s for package %s: %w", p.ConfigSecretRef, p.Name, err)
+	}
+
+	config, ok := secret.Data[packageConfigSecretKey]
+	if !ok {
+		return "", fmt.Errorf("config secret %s for package %s is missing the %s key", p.ConfigSecretRef, p.Name, packageConfigSecretKey)
+	}
+
+	return string(config), nil
+}
+
+// PackagesInstalled returns true if all the packages reported in the cluster status have been
+// installed by the package controller.
+func PackagesInstalled(cluster *anywherev1.Cluster) bool {
+	for _, p := range cluster.Status.Packages {
+		if p.State != string(packagesv1.StateInstalled) {
+			return false
+		}
+	}
+	return true
+}
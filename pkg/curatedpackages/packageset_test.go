@@ -0,0 +1,198 @@
+package curatedpackages_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+)
+
+const packageSetNamespace = "eksa-packages-my-workload-cluster"
+
+func newPackageSetClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, anywherev1.AddToScheme, packagesv1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newPackageSetPackage(name string, labels map[string]string, spec packagesv1.PackageSpec) *packagesv1.Package {
+	return &packagesv1.Package{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: packageSetNamespace,
+			Labels:    labels,
+		},
+		Spec: spec,
+	}
+}
+
+func TestReconcilePackagesNotConfigured(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := newReconcileTestCluster()
+	// The Package kind isn't registered, so any call to the API would fail.
+	c := fake.NewClientBuilder().Build()
+
+	pcc := curatedpackages.NewPackageControllerClientFullLifecycle(testr.New(t), nil, nil, nil)
+	g.Expect(pcc.ReconcilePackages(ctx, testr.New(t), c, cluster)).To(Succeed())
+	g.Expect(cluster.Status.Packages).To(BeNil())
+}
+
+func TestReconcilePackagesCreate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := newReconcileTestCluster()
+	cluster.Spec.Packages = &anywherev1.PackageConfiguration{
+		Install: []anywherev1.PackageInstall{
+			{Name: "my-harbor", PackageName: "harbor", Version: "2.7.1", TargetNamespace: "harbor", Config: "secretKey: abc"},
+			{Name: "my-prometheus", PackageName: "prometheus", ConfigSecretRef: "prometheus-config"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus-config", Namespace: constants.EksaSystemNamespace},
+		Data:       map[string][]byte{"config": []byte("server:\n  replicas: 2")},
+	}
+	c := newPackageSetClient(t, secret)
+
+	pcc := curatedpackages.NewPackageControllerClientFullLifecycle(testr.New(t), nil, nil, nil)
+	g.Expect(pcc.ReconcilePackages(ctx, testr.New(t), c, cluster)).To(Succeed())
+
+	harbor := &packagesv1.Package{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: packageSetNamespace, Name: "my-harbor"}, harbor)).To(Succeed())
+	g.Expect(harbor.Labels).To(HaveKeyWithValue(curatedpackages.PackageSetLabel, cluster.Name))
+	g.Expect(harbor.Spec).To(Equal(packagesv1.PackageSpec{
+		PackageName:     "harbor",
+		PackageVersion:  "2.7.1",
+		Config:          "secretKey: abc",
+		TargetNamespace: "harbor",
+	}))
+
+	prometheus := &packagesv1.Package{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: packageSetNamespace, Name: "my-prometheus"}, prometheus)).To(Succeed())
+	g.Expect(prometheus.Spec.Config).To(Equal("server:\n  replicas: 2"))
+
+	g.Expect(cluster.Status.Packages).To(Equal([]anywherev1.PackageInstallStatus{
+		{Name: "my-harbor", PackageName: "harbor"},
+		{Name: "my-prometheus", PackageName: "prometheus"},
+	}))
+}
+
+func TestReconcilePackagesUpdateAndReportStatus(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := newReconcileTestCluster()
+	cluster.Spec.Packages = &anywherev1.PackageConfiguration{
+		Install: []anywherev1.PackageInstall{
+			{Name: "my-harbor", PackageName: "harbor", Version: "2.8.0"},
+		},
+	}
+	existing := newPackageSetPackage("my-harbor", nil, packagesv1.PackageSpec{PackageName: "harbor", PackageVersion: "2.7.1"})
+	existing.Status = packagesv1.PackageStatus{
+		CurrentVersion: "2.7.1",
+		State:          packagesv1.StateInstalled,
+	}
+	c := newPackageSetClient(t, existing)
+
+	pcc := curatedpackages.NewPackageControllerClientFullLifecycle(testr.New(t), nil, nil, nil)
+	g.Expect(pcc.ReconcilePackages(ctx, testr.New(t), c, cluster)).To(Succeed())
+
+	harbor := &packagesv1.Package{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: packageSetNamespace, Name: "my-harbor"}, harbor)).To(Succeed())
+	g.Expect(harbor.Labels).To(HaveKeyWithValue(curatedpackages.PackageSetLabel, cluster.Name))
+	g.Expect(harbor.Spec.PackageVersion).To(Equal("2.8.0"))
+
+	g.Expect(cluster.Status.Packages).To(Equal([]anywherev1.PackageInstallStatus{
+		{Name: "my-harbor", PackageName: "harbor", CurrentVersion: "2.7.1", State: "installed"},
+	}))
+}
+
+func TestReconcilePackagesRemove(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := newReconcileTestCluster()
+	cluster.Status.Packages = []anywherev1.PackageInstallStatus{
+		{Name: "my-harbor", PackageName: "harbor"},
+	}
+	labels := map[string]string{curatedpackages.PackageSetLabel: cluster.Name}
+	managed := newPackageSetPackage("my-harbor", labels, packagesv1.PackageSpec{PackageName: "harbor"})
+	unmanaged := newPackageSetPackage("generated-prometheus", nil, packagesv1.PackageSpec{PackageName: "prometheus"})
+	c := newPackageSetClient(t, managed, unmanaged)
+
+	pcc := curatedpackages.NewPackageControllerClientFullLifecycle(testr.New(t), nil, nil, nil)
+	g.Expect(pcc.ReconcilePackages(ctx, testr.New(t), c, cluster)).To(Succeed())
+
+	err := c.Get(ctx, client.ObjectKeyFromObject(managed), &packagesv1.Package{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "managed package should be deleted")
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(unmanaged), &packagesv1.Package{})).To(Succeed())
+	g.Expect(cluster.Status.Packages).To(BeNil())
+}
+
+func TestReconcilePackagesConfigSecretErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		objs    []client.Object
+		wantErr string
+	}{
+		{
+			name:    "secret not found",
+			wantErr: "fetching config secret harbor-config for package my-harbor",
+		},
+		{
+			name: "missing config key",
+			objs: []client.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "harbor-config", Namespace: constants.EksaSystemNamespace},
+				Data:       map[string][]byte{"values": []byte("secretKey: abc")},
+			}},
+			wantErr: "config secret harbor-config for package my-harbor is missing the config key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			cluster := newReconcileTestCluster()
+			cluster.Spec.Packages = &anywherev1.PackageConfiguration{
+				Install: []anywherev1.PackageInstall{
+					{Name: "my-harbor", PackageName: "harbor", ConfigSecretRef: "harbor-config"},
+				},
+			}
+			c := newPackageSetClient(t, tt.objs...)
+
+			pcc := curatedpackages.NewPackageControllerClientFullLifecycle(testr.New(t), nil, nil, nil)
+			g.Expect(pcc.ReconcilePackages(ctx, testr.New(t), c, cluster)).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestPackagesInstalled(t *testing.T) {
+	g := NewWithT(t)
+	cluster := newReconcileTestCluster()
+	g.Expect(curatedpackages.PackagesInstalled(cluster)).To(BeTrue())
+
+	cluster.Status.Packages = []anywherev1.PackageInstallStatus{
+		{Name: "my-harbor", PackageName: "harbor", State: "installed"},
+		{Name: "my-prometheus", PackageName: "prometheus", State: "installing"},
+	}
+	g.Expect(curatedpackages.PackagesInstalled(cluster)).To(BeFalse())
+
+	cluster.Status.Packages[1].State = "installed"
+	g.Expect(curatedpackages.PackagesInstalled(cluster)).To(BeTrue())
+}
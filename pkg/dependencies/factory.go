@@ -37,6 +37,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/manifests/releases"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 	"github.com/aws/eks-anywhere/pkg/networkutils"
 	"github.com/aws/eks-anywhere/pkg/providers"
@@ -50,6 +51,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/providers/validator"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/version"
@@ -116,6 +118,7 @@ type Dependencies struct {
 	DeleteClusterDefaulter      cli.DeleteClusterDefaulter
 	ClusterDeleter              clustermanager.Deleter
 	ClusterMover                *clustermanager.Mover
+	SSH                         *executables.SSH
 }
 
 // KubeClients defines super struct that exposes all behavior.
@@ -152,6 +155,7 @@ type Factory struct {
 	executablesConfig        *executablesConfig
 	config                   config
 	registryMirror           *registrymirror.RegistryMirror
+	releaseSource            *releasesource.Source
 	proxyConfiguration       map[string]string
 	writerFolder             string
 	diagnosticCollectorImage string
@@ -212,6 +216,14 @@ func (f *Factory) WithRegistryMirror(registryMirror *registrymirror.RegistryMirr
 	return f
 }
 
+// WithReleaseSource configures the factory to read the release manifest, bundles and artifacts
+// from a release source instead of the internet. A nil source is ignored.
+func (f *Factory) WithReleaseSource(source *releasesource.Source) *Factory {
+	f.releaseSource = source
+
+	return f
+}
+
 func (f *Factory) UseProxyConfiguration(proxyConfig map[string]string) *Factory {
 	f.proxyConfiguration = proxyConfig
 	return f
@@ -364,10 +376,15 @@ func (f *Factory) WithExecutableBuilder() *Factory {
 			if f.registryMirror != nil {
 				image = f.registryMirror.ReplaceRegistry(image)
 			}
+			mountDirs := f.executablesConfig.mountDirs
+			// Executables read artifacts like OS images from the release source when there is one.
+			if f.releaseSource != nil {
+				mountDirs = append(mountDirs, f.releaseSource.Dir())
+			}
 			b, err := executables.NewInDockerExecutablesBuilder(
 				f.executablesConfig.dockerClient,
 				image,
-				f.executablesConfig.mountDirs...,
+				mountDirs...,
 			)
 			if err != nil {
 				return err
@@ -468,6 +485,7 @@ func (f *Factory) WithProvider(clusterConfigFile string, clusterConfig *v1alpha1
 				time.Now,
 				skipIPCheck,
 				skippedValidations,
+				vsphere.WithDefaulter(vsphere.NewDefaulter(f.dependencies.Govc, vsphere.WithReleaseSource(f.releaseSource))),
 			)
 
 		case v1alpha1.CloudStackDatacenterKind:
@@ -668,6 +686,22 @@ func (f *Factory) WithKubectl() *Factory {
 	return f
 }
 
+// WithSSH builds an SSH executable.
+func (f *Factory) WithSSH() *Factory {
+	f.WithExecutableBuilder()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.SSH != nil {
+			return nil
+		}
+
+		f.dependencies.SSH = f.executablesConfig.builder.BuildSSHExecutable()
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) WithGovc() *Factory {
 	f.WithExecutableBuilder().WithWriter()
 
@@ -1545,7 +1579,10 @@ func (f *Factory) WithFileReader() *Factory {
 			return nil
 		}
 
-		f.dependencies.FileReader = files.NewReader(files.WithEKSAUserAgent("cli", version.Get().GitVersion))
+		f.dependencies.FileReader = files.NewReader(
+			files.WithEKSAUserAgent("cli", version.Get().GitVersion),
+			files.WithReleaseSource(f.releaseSource),
+		)
 		return nil
 	})
 
@@ -1560,7 +1597,10 @@ func (f *Factory) WithManifestReader() *Factory {
 			return nil
 		}
 
-		f.dependencies.ManifestReader = manifests.NewReader(f.dependencies.FileReader)
+		f.dependencies.ManifestReader = manifests.NewReader(
+			f.dependencies.FileReader,
+			manifests.WithReleasesManifest(releases.ManifestURLForSource(f.releaseSource)),
+		)
 		return nil
 	})
 
@@ -1614,7 +1654,7 @@ func (f *Factory) WithVSphereDefaulter() *Factory {
 			return nil
 		}
 
-		f.dependencies.VSphereDefaulter = vsphere.NewDefaulter(f.dependencies.Govc)
+		f.dependencies.VSphereDefaulter = vsphere.NewDefaulter(f.dependencies.Govc, vsphere.WithReleaseSource(f.releaseSource))
 
 		return nil
 	})
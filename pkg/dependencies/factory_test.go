@@ -330,6 +330,7 @@ func TestFactoryBuildWithMultipleDependencies(t *testing.T) {
 		WithUpgradeClusterDefaulter(&tt.upgradeCLIConfig).
 		WithDeleteClusterDefaulter(&tt.deleteCLIConfig).
 		WithKubernetesRetrierClient().
+		WithSSH().
 		Build(context.Background())
 
 	tt.Expect(err).To(BeNil())
@@ -354,6 +355,7 @@ func TestFactoryBuildWithMultipleDependencies(t *testing.T) {
 	tt.Expect(deps.ClusterApplier).NotTo(BeNil())
 	tt.Expect(deps.UnAuthKubectlClient).NotTo(BeNil())
 	tt.Expect(deps.KubernetesRetrierClient).NotTo(BeNil())
+	tt.Expect(deps.SSH).NotTo(BeNil())
 }
 
 func TestFactoryBuildWithProxyConfiguration(t *testing.T) {
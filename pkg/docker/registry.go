@@ -15,6 +15,7 @@ import (
 // Harbor requires root level projects but curated packages private account currently
 // doesn't have support for root level.
 const (
+	defaultRegistry   = "public.ecr.aws"
 	packageProdDomain = "783794618700.dkr.ecr.us-west-2.amazonaws.com"
 	packageDevDomain  = "067575901363.dkr.ecr.us-west-2.amazonaws.com"
 	publicProdECRName = "eks-anywhere"
@@ -61,6 +62,14 @@ func (d *ImageRegistryDestination) Write(ctx context.Context, images ...string)
 	return nil
 }
 
+// DestinationImage returns the reference an image is pushed to when written to a registry endpoint
+// by ImageRegistryDestination.
+func DestinationImage(registryEndpoint, image string) string {
+	endpoint := getUpdatedEndpoint(registryEndpoint, image)
+	image = removeDigestReference(image)
+	return strings.NewReplacer(defaultRegistry, endpoint, packageProdDomain, endpoint, packageDevDomain, endpoint).Replace(image)
+}
+
 // ImageOriginalRegistrySource implements the ImageSource interface, pulling images and tags from
 // their original registry into the local docker cache.
 type ImageOriginalRegistrySource struct {
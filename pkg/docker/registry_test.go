@@ -142,3 +142,33 @@ func TestOriginalRegistrySourceError(t *testing.T) {
 
 	g.Expect(dstLoader.Load(ctx, images...)).To(MatchError(ContainSubstring("error pulling")))
 }
+
+func TestDestinationImage(t *testing.T) {
+	tests := []struct {
+		name  string
+		image string
+		want  string
+	}{
+		{
+			name:  "public ecr image",
+			image: "public.ecr.aws/eks-anywhere/cli-tools:v0.1.0",
+			want:  "registry:443/eks-anywhere/cli-tools:v0.1.0",
+		},
+		{
+			name:  "image with digest",
+			image: "public.ecr.aws/eks-anywhere/cli-tools@sha256:abc",
+			want:  "registry:443/eks-anywhere/cli-tools:abc",
+		},
+		{
+			name:  "packages prod image",
+			image: "783794618700.dkr.ecr.us-west-2.amazonaws.com/harbor/harbor-core:v2.5.1",
+			want:  "registry:443/eks-anywhere/harbor/harbor-core:v2.5.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(docker.DestinationImage("registry:443", tt.image)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package etcdbackup
+
+import (
+	"fmt"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	// CronJobName is the name of the CronJob that takes the etcd snapshots in the kube-system namespace of the cluster.
+	CronJobName = "eksa-etcd-backup"
+	// CredentialsSecretName is the name of the secret in the kube-system namespace of the cluster
+	// with the credentials for the S3 destination.
+	CredentialsSecretName = "eksa-etcd-backup-credentials"
+	// AppLabel identifies the jobs and pods created by the etcd backup CronJob.
+	AppLabel = "app.kubernetes.io/name"
+
+	// StackedEtcdEndpoint is the endpoint of the stacked etcd member running on each control plane node.
+	StackedEtcdEndpoint = "https://127.0.0.1:2379"
+
+	defaultS3Region      = "us-east-1"
+	snapshotVolume       = "snapshot"
+	snapshotDir          = "/snapshot"
+	snapshotFile         = snapshotDir + "/etcd-snapshot.db"
+	destinationVolume    = "destination"
+	destinationDir       = "/backups"
+	pkiVolume            = "pki"
+	controlPlaneRoleName = "node-role.kubernetes.io/control-plane"
+)
+
+// The snapshot taken by the init container is copied to the destination by the main container,
+// which also prunes the old snapshots and writes the size of the new one to its termination message
+// so it can be reported in the cluster status.
+const localStoreScript = `set -euo pipefail
+name="etcd-snapshot-$(date -u +%Y%m%dT%H%M%SZ).db"
+cp "${SNAPSHOT_FILE}" "${DESTINATION_DIR}/${name}"
+ls -1 "${DESTINATION_DIR}"/etcd-snapshot-*.db | sort -r | tail -n +$((RETENTION + 1)) | xargs -r rm -f
+stat -c %s "${SNAPSHOT_FILE}" > /dev/termination-log
+`
+
+const s3StoreScript = `set -euo pipefail
+name="etcd-snapshot-$(date -u +%Y%m%dT%H%M%SZ).db"
+s3() {
+  curl -sSf --aws-sigv4 "aws:amz:${S3_REGION}:s3" --user "${AWS_ACCESS_KEY_ID}:${AWS_SECRET_ACCESS_KEY}" "$@"
+}
+s3 -T "${SNAPSHOT_FILE}" "${S3_ENDPOINT}/${S3_BUCKET}/${S3_PREFIX}${name}"
+for key in $(s3 "${S3_ENDPOINT}/${S3_BUCKET}?list-type=2&prefix=${S3_PREFIX}etcd-snapshot-" | grep -o '<Key>[^<]*</Key>' | sed -e 's/<Key>//' -e 's/<\/Key>//' | sort -r | tail -n +$((RETENTION + 1))); do
+  s3 -X DELETE "${S3_ENDPOINT}/${S3_BUCKET}/${key}"
+done
+stat -c %s "${SNAPSHOT_FILE}" > /dev/termination-log
+`
+
+// Labels returns the labels set on the jobs and pods created by the etcd backup CronJob.
+func Labels() map[string]string {
+	return map[string]string{AppLabel: CronJobName}
+}
+
+// CronJob builds the CronJob that takes etcd snapshots on a control plane node of the cluster and stores
+// them in the destination configured in the cluster's etcdBackup.
+// etcdEndpoint is the client endpoint of etcd: StackedEtcdEndpoint for stacked etcd or one of the
+// external etcd members' endpoint otherwise.
+func CronJob(spec *cluster.Spec, etcdEndpoint string) (*batchv1.CronJob, error) {
+	backup := spec.Cluster.Spec.EtcdBackup
+	if backup == nil {
+		return nil, fmt.Errorf("cluster %s doesn't have etcdBackup configured", spec.Cluster.Name)
+	}
+
+	pki := newPKIPaths(controlPlaneOSFamily(spec), spec.Cluster.Spec.ExternalEtcdConfiguration != nil)
+	versionsBundle := spec.RootVersionsBundle()
+	if versionsBundle == nil {
+		return nil, fmt.Errorf("no versions bundle found for cluster %s", spec.Cluster.Name)
+	}
+
+	store, err := storeContainer(backup, versionsBundle.Eksa.CliTools.VersionedImage())
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name:         snapshotVolume,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+		{
+			Name: pkiVolume,
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+				Path: pki.dir,
+				Type: ptr.To(corev1.HostPathDirectory),
+			}},
+		},
+	}
+	if v := destinationVolumeSource(backup.Destination); v != nil {
+		volumes = append(volumes, corev1.Volume{Name: destinationVolume, VolumeSource: *v})
+	}
+
+	snapshot := corev1.Container{
+		Name:  "snapshot",
+		Image: versionsBundle.KubeDistro.EtcdImage.VersionedImage(),
+		Command: []string{
+			"etcdctl", "snapshot", "save", snapshotFile,
+			"--endpoints=" + etcdEndpoint,
+			"--cacert=" + pki.caCert,
+			"--cert=" + pki.clientCert,
+			"--key=" + pki.clientKey,
+		},
+		Env: []corev1.EnvVar{{Name: "ETCDCTL_API", Value: "3"}},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: snapshotVolume, MountPath: snapshotDir},
+			{Name: pkiVolume, MountPath: pki.dir, ReadOnly: true},
+		},
+		// Bottlerocket labels the kubeadm PKI so only privileged containers can read it.
+		SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(pki.privileged)},
+	}
+
+	return &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+			Kind:       "CronJob",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CronJobName,
+			Namespace: constants.KubeSystemNamespace,
+			Labels:    Labels(),
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   backup.Schedule,
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: ptr.To[int32](3),
+			FailedJobsHistoryLimit:     ptr.To[int32](3),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: Labels()},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: Labels()},
+						Spec: corev1.PodSpec{
+							RestartPolicy:  corev1.RestartPolicyNever,
+							HostNetwork:    true,
+							NodeSelector:   map[string]string{controlPlaneRoleName: ""},
+							Tolerations:    []corev1.Toleration{{Key: controlPlaneRoleName, Effect: corev1.TaintEffectNoSchedule}},
+							InitContainers: []corev1.Container{snapshot},
+							Containers:     []corev1.Container{*store},
+							Volumes:        volumes,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// CredentialsSecret builds the secret with the S3 credentials in the kube-system namespace of the cluster
+// from the secret referenced in the S3 destination.
+func CredentialsSecret(source *corev1.Secret) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CredentialsSecretName,
+			Namespace: constants.KubeSystemNamespace,
+			Labels:    Labels(),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"AWS_ACCESS_KEY_ID":     source.Data["AWS_ACCESS_KEY_ID"],
+			"AWS_SECRET_ACCESS_KEY": source.Data["AWS_SECRET_ACCESS_KEY"],
+		},
+	}
+}
+
+func storeContainer(backup *anywherev1.EtcdBackup, image string) (*corev1.Container, error) {
+	c := &corev1.Container{
+		Name:    "store",
+		Image:   image,
+		Command: []string{"bash", "-c"},
+		Env: []corev1.EnvVar{
+			{Name: "SNAPSHOT_FILE", Value: snapshotFile},
+			{Name: "RETENTION", Value: strconv.Itoa(backup.RetentionOrDefault())},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: snapshotVolume, MountPath: snapshotDir, ReadOnly: true},
+		},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+
+	dest := backup.Destination
+	switch {
+	case dest.PersistentVolumeClaim != nil, dest.HostPath != nil:
+		c.Args = []string{localStoreScript}
+		c.Env = append(c.Env, corev1.EnvVar{Name: "DESTINATION_DIR", Value: destinationDir})
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{Name: destinationVolume, MountPath: destinationDir})
+	case dest.S3 != nil:
+		region := dest.S3.Region
+		if region == "" {
+			region = defaultS3Region
+		}
+		c.Args = []string{s3StoreScript}
+		c.Env = append(c.Env,
+			corev1.EnvVar{Name: "S3_ENDPOINT", Value: dest.S3.Endpoint},
+			corev1.EnvVar{Name: "S3_BUCKET", Value: dest.S3.Bucket},
+			corev1.EnvVar{Name: "S3_REGION", Value: region},
+			corev1.EnvVar{Name: "S3_PREFIX", Value: dest.S3.Prefix},
+		)
+		c.EnvFrom = []corev1.EnvFromSource{{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: CredentialsSecretName},
+			},
+		}}
+	default:
+		return nil, fmt.Errorf("etcdBackup destination is not configured")
+	}
+
+	return c, nil
+}
+
+func destinationVolumeSource(dest anywherev1.EtcdBackupDestination) *corev1.VolumeSource {
+	switch {
+	case dest.PersistentVolumeClaim != nil:
+		return &corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: dest.PersistentVolumeClaim.ClaimName,
+		}}
+	case dest.HostPath != nil:
+		return &corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+			Path: dest.HostPath.Path,
+			Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+		}}
+	default:
+		return nil
+	}
+}
+
+type pkiPaths struct {
+	dir        string
+	caCert     string
+	clientCert string
+	clientKey  string
+	privileged bool
+}
+
+// newPKIPaths returns the location of the certificates the kube-apiserver uses to talk to etcd
+// in the control plane nodes.
+func newPKIPaths(osFamily anywherev1.OSFamily, externalEtcd bool) pkiPaths {
+	if osFamily == anywherev1.Bottlerocket {
+		p := pkiPaths{
+			dir:        "/var/lib/kubeadm/pki",
+			caCert:     "/var/lib/kubeadm/pki/etcd/ca.crt",
+			clientCert: "/var/lib/kubeadm/pki/apiserver-etcd-client.crt",
+			clientKey:  "/var/lib/kubeadm/pki/apiserver-etcd-client.key",
+			privileged: true,
+		}
+		if externalEtcd {
+			p.clientCert = "/var/lib/kubeadm/pki/server-etcd-client.crt"
+			p.clientKey = "/var/lib/kubeadm/pki/server-etcd-client.key"
+		}
+		return p
+	}
+
+	return pkiPaths{
+		dir:        "/etc/kubernetes/pki",
+		caCert:     "/etc/kubernetes/pki/etcd/ca.crt",
+		clientCert: "/etc/kubernetes/pki/apiserver-etcd-client.crt",
+		clientKey:  "/etc/kubernetes/pki/apiserver-etcd-client.key",
+	}
+}
+
+func controlPlaneOSFamily(spec *cluster.Spec) anywherev1.OSFamily {
+	ref := spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef
+	if ref == nil {
+		return anywherev1.Ubuntu
+	}
+
+	var machineConfig interface{ OSFamily() anywherev1.OSFamily }
+	switch spec.Cluster.Spec.DatacenterRef.Kind {
+	case anywherev1.VSphereDatacenterKind:
+		if m, ok := spec.VSphereMachineConfigs[ref.Name]; ok {
+			machineConfig = m
+		}
+	case anywherev1.CloudStackDatacenterKind:
+		if m, ok := spec.CloudStackMachineConfigs[ref.Name]; ok {
+			machineConfig = m
+		}
+	case anywherev1.SnowDatacenterKind:
+		if m, ok := spec.SnowMachineConfigs[ref.Name]; ok {
+			machineConfig = m
+		}
+	case anywherev1.NutanixDatacenterKind:
+		if m, ok := spec.NutanixMachineConfigs[ref.Name]; ok {
+			machineConfig = m
+		}
+	case anywherev1.TinkerbellDatacenterKind:
+		if m, ok := spec.TinkerbellMachineConfigs[ref.Name]; ok {
+			machineConfig = m
+		}
+	}
+
+	if machineConfig == nil {
+		return anywherev1.Ubuntu
+	}
+
+	return machineConfig.OSFamily()
+}
@@ -0,0 +1,173 @@
+package etcdbackup_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func newSpec(backup *anywherev1.EtcdBackup) *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.EtcdBackup = backup
+		vb := s.VersionsBundles[anywherev1.Kube119]
+		vb.Eksa.CliTools = releasev1.Image{URI: "public.ecr.aws/eks-anywhere/cli-tools:v0.1.0"}
+		vb.KubeDistro.EtcdImage = releasev1.Image{URI: "public.ecr.aws/eks-distro/etcd-io/etcd:v3.5.9"}
+	})
+}
+
+func TestCronJobHostPath(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.EtcdBackup{
+		Schedule:    "0 */6 * * *",
+		Retention:   3,
+		Destination: anywherev1.EtcdBackupDestination{HostPath: &anywherev1.EtcdBackupHostPathDestination{Path: "/var/backups/etcd"}},
+	})
+
+	cronJob, err := etcdbackup.CronJob(spec, etcdbackup.StackedEtcdEndpoint)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cronJob.Name).To(Equal(etcdbackup.CronJobName))
+	g.Expect(cronJob.Namespace).To(Equal(constants.KubeSystemNamespace))
+	g.Expect(cronJob.Spec.Schedule).To(Equal("0 */6 * * *"))
+
+	pod := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	g.Expect(pod.HostNetwork).To(BeTrue())
+	g.Expect(pod.NodeSelector).To(HaveKey("node-role.kubernetes.io/control-plane"))
+
+	snapshot := pod.InitContainers[0]
+	g.Expect(snapshot.Image).To(Equal("public.ecr.aws/eks-distro/etcd-io/etcd:v3.5.9"))
+	g.Expect(snapshot.Command).To(ContainElements(
+		"--endpoints=https://127.0.0.1:2379",
+		"--cacert=/etc/kubernetes/pki/etcd/ca.crt",
+		"--cert=/etc/kubernetes/pki/apiserver-etcd-client.crt",
+		"--key=/etc/kubernetes/pki/apiserver-etcd-client.key",
+	))
+	g.Expect(*snapshot.SecurityContext.Privileged).To(BeFalse())
+
+	store := pod.Containers[0]
+	g.Expect(store.Image).To(Equal("public.ecr.aws/eks-anywhere/cli-tools:v0.1.0"))
+	g.Expect(store.Env).To(ContainElement(corev1.EnvVar{Name: "RETENTION", Value: "3"}))
+	g.Expect(store.EnvFrom).To(BeEmpty())
+	g.Expect(pod.Volumes).To(ContainElement(HaveField("VolumeSource.HostPath.Path", "/var/backups/etcd")))
+}
+
+func TestCronJobPersistentVolumeClaimDefaultRetention(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.EtcdBackup{
+		Schedule:    "@daily",
+		Destination: anywherev1.EtcdBackupDestination{PersistentVolumeClaim: &anywherev1.EtcdBackupPVCDestination{ClaimName: "etcd-backups"}},
+	})
+
+	cronJob, err := etcdbackup.CronJob(spec, etcdbackup.StackedEtcdEndpoint)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	pod := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	g.Expect(pod.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "RETENTION", Value: "7"}))
+	g.Expect(pod.Volumes).To(ContainElement(HaveField("VolumeSource.PersistentVolumeClaim.ClaimName", "etcd-backups")))
+}
+
+func TestCronJobS3ExternalEtcdBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.EtcdBackup{
+		Schedule: "0 0 * * *",
+		Destination: anywherev1.EtcdBackupDestination{S3: &anywherev1.EtcdBackupS3Destination{
+			Endpoint:             "https://s3.us-west-2.amazonaws.com",
+			Bucket:               "backups",
+			Prefix:               "mgmt/",
+			CredentialsSecretRef: "s3-credentials",
+		}},
+	})
+	spec.Cluster.Spec.DatacenterRef = anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "dc"}
+	spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef = &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"}
+	spec.Cluster.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+	spec.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+		"cp": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: anywherev1.Bottlerocket}},
+	}
+
+	cronJob, err := etcdbackup.CronJob(spec, "https://10.0.0.1:2379")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	pod := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	snapshot := pod.InitContainers[0]
+	g.Expect(snapshot.Command).To(ContainElements(
+		"--endpoints=https://10.0.0.1:2379",
+		"--cacert=/var/lib/kubeadm/pki/etcd/ca.crt",
+		"--cert=/var/lib/kubeadm/pki/server-etcd-client.crt",
+		"--key=/var/lib/kubeadm/pki/server-etcd-client.key",
+	))
+	g.Expect(*snapshot.SecurityContext.Privileged).To(BeTrue())
+
+	store := pod.Containers[0]
+	g.Expect(store.Env).To(ContainElements(
+		corev1.EnvVar{Name: "S3_ENDPOINT", Value: "https://s3.us-west-2.amazonaws.com"},
+		corev1.EnvVar{Name: "S3_BUCKET", Value: "backups"},
+		corev1.EnvVar{Name: "S3_REGION", Value: "us-east-1"},
+		corev1.EnvVar{Name: "S3_PREFIX", Value: "mgmt/"},
+	))
+	g.Expect(store.EnvFrom[0].SecretRef.Name).To(Equal(etcdbackup.CredentialsSecretName))
+	g.Expect(pod.Volumes).To(HaveLen(2))
+}
+
+func TestCronJobStackedEtcdBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.EtcdBackup{
+		Schedule: "0 0 * * *",
+		Destination: anywherev1.EtcdBackupDestination{
+			HostPath: &anywherev1.EtcdBackupHostPathDestination{Path: "/var/backups/etcd"},
+		},
+	})
+	spec.Cluster.Spec.DatacenterRef = anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "dc"}
+	spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef = &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"}
+	spec.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+		"cp": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: anywherev1.Bottlerocket}},
+	}
+
+	cronJob, err := etcdbackup.CronJob(spec, etcdbackup.StackedEtcdEndpoint)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers[0].Command).To(ContainElements(
+		"--cacert=/var/lib/kubeadm/pki/etcd/ca.crt",
+		"--cert=/var/lib/kubeadm/pki/apiserver-etcd-client.crt",
+		"--key=/var/lib/kubeadm/pki/apiserver-etcd-client.key",
+	))
+}
+
+func TestCronJobNoDestination(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.EtcdBackup{Schedule: "0 0 * * *"})
+
+	_, err := etcdbackup.CronJob(spec, etcdbackup.StackedEtcdEndpoint)
+	g.Expect(err).To(MatchError(ContainSubstring("etcdBackup destination is not configured")))
+}
+
+func TestCronJobNotConfigured(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := etcdbackup.CronJob(newSpec(nil), etcdbackup.StackedEtcdEndpoint)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't have etcdBackup configured")))
+}
+
+func TestCredentialsSecret(t *testing.T) {
+	g := NewWithT(t)
+	source := &corev1.Secret{
+		Data: map[string][]byte{
+			"AWS_ACCESS_KEY_ID":     []byte("id"),
+			"AWS_SECRET_ACCESS_KEY": []byte("key"),
+			"other":                 []byte("ignored"),
+		},
+	}
+
+	secret := etcdbackup.CredentialsSecret(source)
+	g.Expect(secret.Name).To(Equal(etcdbackup.CredentialsSecretName))
+	g.Expect(secret.Namespace).To(Equal(constants.KubeSystemNamespace))
+	g.Expect(secret.Data).To(Equal(map[string][]byte{
+		"AWS_ACCESS_KEY_ID":     []byte("id"),
+		"AWS_SECRET_ACCESS_KEY": []byte("key"),
+	}))
+}
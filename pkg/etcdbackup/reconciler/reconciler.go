@@ -0,0 +1,293 @@
+package reconciler
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	anywhereCluster "github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+)
+
+// EtcdBackupInstalledAnnotation indicates the etcd backup CronJob has been created in the cluster,
+// so it's removed if the etcdBackup is removed from the cluster spec.
+const EtcdBackupInstalledAnnotation = "anywhere.eks.amazonaws.com/etcd-backup"
+
+// RemoteClientRegistry defines methods for remote cluster controller clients.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler reconciles the scheduled etcd snapshots of a cluster.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile takes the etcd backup CronJob in the cluster to the state defined in the cluster's etcdBackup
+// and reports the outcome of the last snapshots in the cluster status.
+// It uses a controller.Result to indicate when requeues are needed.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if cluster.Spec.EtcdBackup == nil {
+		return r.reconcileRemoved(ctx, log, cluster)
+	}
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, cluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return result, nil
+	}
+
+	rClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile etcd backup")
+	}
+
+	clusterSpec, err := anywhereCluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), cluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	endpoint := etcdbackup.StackedEtcdEndpoint
+	if cluster.Spec.ExternalEtcdConfiguration != nil {
+		endpoint, err = r.externalEtcdEndpoint(ctx, cluster)
+		if err != nil {
+			return controller.Result{}, err
+		}
+		if endpoint == "" {
+			log.Info("External etcd endpoints are not available yet, requeuing")
+			return controller.ResultWithRequeue(30 * time.Second), nil
+		}
+	}
+
+	if s3 := cluster.Spec.EtcdBackup.Destination.S3; s3 != nil {
+		if err := r.reconcileCredentialsSecret(ctx, rClient, s3); err != nil {
+			return controller.Result{}, err
+		}
+	}
+
+	cronJob, err := etcdbackup.CronJob(clusterSpec, endpoint)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	// The annotation is set before creating the CronJob so it's removed even if the status is never written.
+	clientutil.AddAnnotation(cluster, EtcdBackupInstalledAnnotation, "")
+	log.Info("Applying etcd backup CronJob")
+	if err := createOrUpdate(ctx, rClient, cronJob, &batchv1.CronJob{}); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying etcd backup CronJob")
+	}
+
+	status, err := snapshotStatus(ctx, rClient)
+	if err != nil {
+		return controller.Result{}, err
+	}
+	cluster.Status.EtcdBackup = status
+
+	return controller.Result{}, nil
+}
+
+// reconcileRemoved deletes the etcd backup CronJob and credentials of a cluster without etcdBackup.
+// Clusters that never had the CronJob created, with neither the installed annotation nor a status,
+// are skipped so the workload cluster API isn't called for a feature they don't use.
+func (r *Reconciler) reconcileRemoved(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if !installed(cluster) && cluster.Status.EtcdBackup == nil {
+		return controller.Result{}, nil
+	}
+
+	rClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to remove etcd backup")
+	}
+
+	deleted, err := deleteEtcdBackupObjects(ctx, rClient)
+	if err != nil {
+		return controller.Result{}, err
+	}
+	if deleted {
+		log.Info("Removed etcd backup CronJob")
+	}
+	clientutil.RemoveAnnotation(cluster, EtcdBackupInstalledAnnotation)
+	cluster.Status.EtcdBackup = nil
+
+	return controller.Result{}, nil
+}
+
+func installed(cluster *anywherev1.Cluster) bool {
+	_, ok := cluster.Annotations[EtcdBackupInstalledAnnotation]
+	return ok
+}
+
+// externalEtcdEndpoint returns the client endpoint of one of the external etcd members.
+func (r *Reconciler) externalEtcdEndpoint(ctx context.Context, cluster *anywherev1.Cluster) (string, error) {
+	etcdadmCluster := &etcdv1.EtcdadmCluster{}
+	key := types.NamespacedName{Name: clusterapi.EtcdClusterName(cluster.Name), Namespace: constants.EksaSystemNamespace}
+	if err := r.client.Get(ctx, key, etcdadmCluster); err != nil {
+		return "", errors.Wrap(err, "reading etcdadm cluster")
+	}
+
+	endpoints := strings.Split(etcdadmCluster.Status.Endpoints, ",")
+	return strings.TrimSpace(endpoints[0]), nil
+}
+
+func (r *Reconciler) reconcileCredentialsSecret(ctx context.Context, rClient client.Client, s3 *anywherev1.EtcdBackupS3Destination) error {
+	source := &corev1.Secret{}
+	key := types.NamespacedName{Name: s3.CredentialsSecretRef, Namespace: constants.EksaSystemNamespace}
+	if err := r.client.Get(ctx, key, source); err != nil {
+		return errors.Wrapf(err, "reading etcd backup credentials secret %s", s3.CredentialsSecretRef)
+	}
+
+	if err := createOrUpdate(ctx, rClient, etcdbackup.CredentialsSecret(source), &corev1.Secret{}); err != nil {
+		return errors.Wrap(err, "applying etcd backup credentials secret")
+	}
+
+	return nil
+}
+
+// snapshotStatus builds the etcd backup status from the CronJob and the jobs it created.
+func snapshotStatus(ctx context.Context, c client.Client) (*anywherev1.EtcdBackupStatus, error) {
+	status := &anywherev1.EtcdBackupStatus{}
+
+	cronJob := &batchv1.CronJob{}
+	if err := c.Get(ctx, types.NamespacedName{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace}, cronJob); err != nil {
+		return nil, errors.Wrap(err, "reading etcd backup CronJob")
+	}
+	status.LastSuccessfulSnapshotTime = cronJob.Status.LastSuccessfulTime
+
+	jobs := &batchv1.JobList{}
+	if err := c.List(ctx, jobs, client.InNamespace(constants.KubeSystemNamespace), client.MatchingLabels(etcdbackup.Labels())); err != nil {
+		return nil, errors.Wrap(err, "listing etcd backup jobs")
+	}
+
+	var lastComplete *batchv1.Job
+	var lastCompleteTime metav1.Time
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if failed := jobCondition(job, batchv1.JobFailed); failed != nil {
+			if status.LastFailedSnapshotTime == nil || status.LastFailedSnapshotTime.Before(&failed.LastTransitionTime) {
+				status.LastFailedSnapshotTime = failed.LastTransitionTime.DeepCopy()
+				status.LastFailureMessage = failed.Message
+			}
+		}
+		if complete := jobCondition(job, batchv1.JobComplete); complete != nil {
+			if lastComplete == nil || lastCompleteTime.Before(&complete.LastTransitionTime) {
+				lastComplete = job
+				lastCompleteTime = complete.LastTransitionTime
+			}
+		}
+	}
+
+	if lastComplete != nil {
+		size, err := snapshotSize(ctx, c, lastComplete)
+		if err != nil {
+			return nil, err
+		}
+		status.LastSnapshotSizeBytes = size
+		if status.LastSuccessfulSnapshotTime == nil {
+			status.LastSuccessfulSnapshotTime = lastCompleteTime.DeepCopy()
+		}
+	}
+
+	return status, nil
+}
+
+// snapshotSize reads the size of the snapshot a job stored from the termination message of its pod.
+func snapshotSize(ctx context.Context, c client.Client, job *batchv1.Job) (int64, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+		return 0, errors.Wrapf(err, "listing pods for etcd backup job %s", job.Name)
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[j].CreationTimestamp.Before(&pods.Items[i].CreationTimestamp)
+	})
+
+	for _, pod := range pods.Items {
+		for _, s := range pod.Status.ContainerStatuses {
+			if s.State.Terminated == nil || s.State.Terminated.ExitCode != 0 {
+				continue
+			}
+			if size, err := strconv.ParseInt(strings.TrimSpace(s.State.Terminated.Message), 10, 64); err == nil {
+				return size, nil
+			}
+		}
+	}
+
+	// Pods might have been garbage collected, the size is just not reported in that case.
+	return 0, nil
+}
+
+func jobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i := range job.Status.Conditions {
+		c := &job.Status.Conditions[i]
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return c
+		}
+	}
+	return nil
+}
+
+// createOrUpdate creates obj if it doesn't exist or updates the existing object with its content.
+// current is used to read the existing object and needs to be of the same type as obj.
+func createOrUpdate(ctx context.Context, c client.Client, obj, current client.Object) error {
+	err := c.Get(ctx, client.ObjectKeyFromObject(obj), current)
+	if apierrors.IsNotFound(err) {
+		return c.Create(ctx, obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	obj.SetResourceVersion(current.GetResourceVersion())
+	return c.Update(ctx, obj)
+}
+
+// deleteEtcdBackupObjects deletes the etcd backup CronJob and credentials secret and reports if any of them existed.
+func deleteEtcdBackupObjects(ctx context.Context, c client.Client) (bool, error) {
+	objs := []client.Object{
+		&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: etcdbackup.CredentialsSecretName, Namespace: constants.KubeSystemNamespace}},
+	}
+
+	deleted := false
+	for _, obj := range objs {
+		err := c.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "deleting etcd backup %s", obj.GetName())
+		}
+		deleted = true
+	}
+
+	return deleted, nil
+}
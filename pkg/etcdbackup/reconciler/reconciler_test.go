@@ -0,0 +1,333 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup/reconciler"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup/reconciler/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	cluster              *anywherev1.Cluster
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	managementObjs       []runtime.Object
+	workloadClient       client.Client
+}
+
+func newReconcilerTest(t *testing.T, workloadObjs ...client.Object) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	bundle := test.Bundle()
+	version := test.DevEksaVersion()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.22",
+			BundlesRef: &anywherev1.BundlesRef{
+				Name:       bundle.Name,
+				Namespace:  bundle.Namespace,
+				APIVersion: bundle.APIVersion,
+			},
+			EksaVersion: &version,
+			EtcdBackup: &anywherev1.EtcdBackup{
+				Schedule: "0 0 * * *",
+				Destination: anywherev1.EtcdBackupDestination{
+					HostPath: &anywherev1.EtcdBackupHostPathDestination{Path: "/var/backups/etcd"},
+				},
+			},
+		},
+	}
+
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = "test"
+		kcp.Status = controlplanev1.KubeadmControlPlaneStatus{
+			Conditions: clusterv1.Conditions{
+				{
+					Type:               clusterapi.ReadyCondition,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version: ptr.To("test"),
+		}
+	})
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		cluster:              cluster,
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		managementObjs:       []runtime.Object{bundle, test.EKSARelease(), test.EksdRelease("1-22"), kcp},
+		workloadClient:       fake.NewClientBuilder().WithObjects(workloadObjs...).WithStatusSubresource(&batchv1.CronJob{}).Build(),
+	}
+}
+
+func (tt *reconcilerTest) reconciler() *reconciler.Reconciler {
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	_ = controlplanev1.AddToScheme(scheme)
+	_ = etcdv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tt.managementObjs...).Build()
+	return reconciler.New(cl, tt.remoteClientRegistry)
+}
+
+func (tt *reconcilerTest) expectGetWorkloadClient() {
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: constants.EksaSystemNamespace}).Return(tt.workloadClient, nil)
+}
+
+func (tt *reconcilerTest) cronJob() *batchv1.CronJob {
+	cronJob := &batchv1.CronJob{}
+	tt.Expect(tt.workloadClient.Get(tt.ctx, client.ObjectKey{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace}, cronJob)).To(Succeed())
+	return cronJob
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func job(name string, conditionType batchv1.JobConditionType, at time.Time, message string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.KubeSystemNamespace,
+			Labels:    etcdbackup.Labels(),
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:               conditionType,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(at),
+					Message:            message,
+				},
+			},
+		},
+	}
+}
+
+func jobPod(jobName, terminationMessage string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName + "-abcde",
+			Namespace: constants.KubeSystemNamespace,
+			Labels:    map[string]string{batchv1.JobNameLabel: jobName},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "store",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Message: terminationMessage},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestReconcileNotConfigured(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.EtcdBackup = nil
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.cluster.Status.EtcdBackup).To(BeNil())
+}
+
+func TestReconcileNotConfiguredRemovesCronJobWithoutStatus(t *testing.T) {
+	tt := newReconcilerTest(t,
+		&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace}},
+	)
+	tt.cluster.Spec.EtcdBackup = nil
+	tt.cluster.Annotations = map[string]string{reconciler.EtcdBackupInstalledAnnotation: ""}
+	tt.expectGetWorkloadClient()
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Annotations).NotTo(HaveKey(reconciler.EtcdBackupInstalledAnnotation))
+
+	err = tt.workloadClient.Get(tt.ctx, client.ObjectKey{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace}, &batchv1.CronJob{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestReconcileNotConfiguredGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.EtcdBackup = nil
+	tt.cluster.Annotations = map[string]string{reconciler.EtcdBackupInstalledAnnotation: ""}
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.Any()).Return(nil, errors.New("client error"))
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+}
+
+func TestReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.managementObjs = tt.managementObjs[:3]
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcileGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.Any()).Return(nil, errors.New("client error"))
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+}
+
+func TestReconcileStackedEtcd(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	tt := newReconcilerTest(t,
+		job("eksa-etcd-backup-1", batchv1.JobComplete, now.Add(-2*time.Hour), ""),
+		jobPod("eksa-etcd-backup-1", "1024\n"),
+		job("eksa-etcd-backup-2", batchv1.JobFailed, now.Add(-time.Hour), "BackoffLimitExceeded"),
+	)
+	tt.expectGetWorkloadClient()
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	tt.Expect(tt.cluster.Annotations).To(HaveKey(reconciler.EtcdBackupInstalledAnnotation))
+
+	cronJob := tt.cronJob()
+	tt.Expect(cronJob.Spec.Schedule).To(Equal("0 0 * * *"))
+	tt.Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers[0].Command).To(ContainElement("--endpoints=" + etcdbackup.StackedEtcdEndpoint))
+
+	status := tt.cluster.Status.EtcdBackup
+	tt.Expect(status).NotTo(BeNil())
+	tt.Expect(status.LastSuccessfulSnapshotTime.Time).To(BeTemporally("==", now.Add(-2*time.Hour)))
+	tt.Expect(status.LastSnapshotSizeBytes).To(Equal(int64(1024)))
+	tt.Expect(status.LastFailedSnapshotTime.Time).To(BeTemporally("==", now.Add(-time.Hour)))
+	tt.Expect(status.LastFailureMessage).To(Equal("BackoffLimitExceeded"))
+}
+
+func TestReconcileUpdatesExistingCronJob(t *testing.T) {
+	tt := newReconcilerTest(t, &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace},
+		Spec:       batchv1.CronJobSpec{Schedule: "@hourly"},
+	})
+	tt.expectGetWorkloadClient()
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cronJob().Spec.Schedule).To(Equal("0 0 * * *"))
+	tt.Expect(tt.cluster.Status.EtcdBackup).To(Equal(&anywherev1.EtcdBackupStatus{}))
+}
+
+func TestReconcileExternalEtcdS3(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+	tt.cluster.Spec.EtcdBackup.Destination = anywherev1.EtcdBackupDestination{
+		S3: &anywherev1.EtcdBackupS3Destination{
+			Endpoint:             "https://s3.us-west-2.amazonaws.com",
+			Bucket:               "backups",
+			CredentialsSecretRef: "s3-credentials",
+		},
+	}
+	tt.managementObjs = append(tt.managementObjs,
+		&etcdv1.EtcdadmCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-etcd", Namespace: constants.EksaSystemNamespace},
+			Status:     etcdv1.EtcdadmClusterStatus{Endpoints: "https://10.0.0.1:2379,https://10.0.0.2:2379"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3-credentials", Namespace: constants.EksaSystemNamespace},
+			Data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("id"),
+				"AWS_SECRET_ACCESS_KEY": []byte("key"),
+			},
+		},
+	)
+	tt.expectGetWorkloadClient()
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cronJob().Spec.JobTemplate.Spec.Template.Spec.InitContainers[0].Command).To(ContainElement("--endpoints=https://10.0.0.1:2379"))
+
+	secret := &corev1.Secret{}
+	tt.Expect(tt.workloadClient.Get(tt.ctx, client.ObjectKey{Name: etcdbackup.CredentialsSecretName, Namespace: constants.KubeSystemNamespace}, secret)).To(Succeed())
+	tt.Expect(secret.Data).To(HaveKeyWithValue("AWS_ACCESS_KEY_ID", []byte("id")))
+}
+
+func TestReconcileExternalEtcdEndpointsNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+	tt.managementObjs = append(tt.managementObjs, &etcdv1.EtcdadmCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-etcd", Namespace: constants.EksaSystemNamespace},
+	})
+	tt.expectGetWorkloadClient()
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+}
+
+func TestReconcileS3MissingCredentials(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.EtcdBackup.Destination = anywherev1.EtcdBackupDestination{
+		S3: &anywherev1.EtcdBackupS3Destination{
+			Endpoint:             "https://s3.us-west-2.amazonaws.com",
+			Bucket:               "backups",
+			CredentialsSecretRef: "s3-credentials",
+		},
+	}
+	tt.expectGetWorkloadClient()
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading etcd backup credentials secret s3-credentials")))
+}
+
+func TestReconcileRemovedFromSpec(t *testing.T) {
+	tt := newReconcilerTest(t,
+		&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace}},
+	)
+	tt.cluster.Spec.EtcdBackup = nil
+	tt.cluster.Status.EtcdBackup = &anywherev1.EtcdBackupStatus{LastSnapshotSizeBytes: 1024}
+	tt.expectGetWorkloadClient()
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Status.EtcdBackup).To(BeNil())
+
+	err = tt.workloadClient.Get(tt.ctx, client.ObjectKey{Name: etcdbackup.CronJobName, Namespace: constants.KubeSystemNamespace}, &batchv1.CronJob{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// Category classifies the error of a failed task or command.
+type Category string
+
+const (
+	// ValidationCategory is used for errors from the setup and preflight validations.
+	ValidationCategory Category = "Validation"
+	// TimeoutCategory is used for errors caused by an operation exceeding its deadline.
+	TimeoutCategory Category = "Timeout"
+	// CanceledCategory is used for errors caused by the command being canceled.
+	CanceledCategory Category = "Canceled"
+	// NetworkCategory is used for errors communicating with a remote endpoint.
+	NetworkCategory Category = "Network"
+	// UnknownCategory is used for any other error.
+	UnknownCategory Category = "Unknown"
+)
+
+// categorizedError is an error with an explicit category.
+type categorizedError struct {
+	error
+	category Category
+}
+
+func (e *categorizedError) Unwrap() error {
+	return e.error
+}
+
+// WithCategory wraps err so Categorize returns category for it. It returns nil if err is nil.
+func WithCategory(err error, category Category) error {
+	if err == nil {
+		return nil
+	}
+	return &categorizedError{error: err, category: category}
+}
+
+// Categorize returns the category of err.
+func Categorize(err error) Category {
+	var categorized *categorizedError
+	if errors.As(err, &categorized) {
+		return categorized.category
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return TimeoutCategory
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return CanceledCategory
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return TimeoutCategory
+		}
+		return NetworkCategory
+	}
+
+	return UnknownCategory
+}
@@ -0,0 +1,8 @@
+/*
+Package events emits machine-readable progress events for the cluster lifecycle commands so
+automation can track them without scraping the human-oriented logs.
+
+Events are written as package state, like the logger, so tasks and workflows can emit them without
+threading an emitter through every constructor. Emitting is a no-op until Init is called.
+*/
+package events
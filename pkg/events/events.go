@@ -0,0 +1,104 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// JSONFormat is the format that emits one JSON object per line for each event.
+const JSONFormat = "json"
+
+// Type identifies the kind of event.
+type Type string
+
+const (
+	// TaskStarted is emitted when a task starts running.
+	TaskStarted Type = "TaskStarted"
+	// TaskFinished is emitted when a task completes successfully.
+	TaskFinished Type = "TaskFinished"
+	// TaskFailed is emitted when a task fails.
+	TaskFailed Type = "TaskFailed"
+	// CommandFinished is emitted when a command completes successfully.
+	CommandFinished Type = "CommandFinished"
+	// CommandFailed is emitted when a command fails.
+	CommandFailed Type = "CommandFailed"
+)
+
+// Event is a progress event of a lifecycle command.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Type    Type      `json:"type"`
+	Command string    `json:"command,omitempty"`
+	Cluster string    `json:"cluster,omitempty"`
+	Task    string    `json:"task,omitempty"`
+
+	// DurationSeconds is how long the task or command took to finish or fail.
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+
+	// SubtaskDurationsSeconds are the durations of the subtasks profiled by the task.
+	SubtaskDurationsSeconds map[string]float64 `json:"subtaskDurationsSeconds,omitempty"`
+
+	Error         string   `json:"error,omitempty"`
+	ErrorCategory Category `json:"errorCategory,omitempty"`
+
+	// Kubeconfig is the path to the kubeconfig of the cluster created or upgraded by the command.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+}
+
+// WithError returns a copy of e with the error and its category set.
+func (e Event) WithError(err error) Event {
+	e.Error = err.Error()
+	e.ErrorCategory = Categorize(err)
+	return e
+}
+
+var (
+	output    io.Writer
+	outputMtx sync.Mutex
+)
+
+// Init configures the package to write events to w in the given format. Repeat calls overwrite
+// the previous output.
+func Init(format string, w io.Writer) error {
+	if format != JSONFormat {
+		return fmt.Errorf("unsupported events format %s, supported formats: %s", format, JSONFormat)
+	}
+
+	outputMtx.Lock()
+	defer outputMtx.Unlock()
+	output = w
+	return nil
+}
+
+// Reset stops emitting events.
+func Reset() {
+	outputMtx.Lock()
+	defer outputMtx.Unlock()
+	output = nil
+}
+
+// Enabled returns true if the package has been configured to emit events.
+func Enabled() bool {
+	outputMtx.Lock()
+	defer outputMtx.Unlock()
+	return output != nil
+}
+
+// Emit writes e to the configured output. It's a no-op if the package hasn't been initialized.
+// Failing to write an event doesn't stop the command, so write errors are ignored.
+func Emit(e Event) {
+	outputMtx.Lock()
+	defer outputMtx.Unlock()
+	if output == nil {
+		return
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	_ = json.NewEncoder(output).Encode(e)
+}
@@ -0,0 +1,125 @@
+package events_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/events"
+)
+
+func TestEmitJSON(t *testing.T) {
+	g := NewWithT(t)
+	out := &bytes.Buffer{}
+	g.Expect(events.Init(events.JSONFormat, out)).To(Succeed())
+	t.Cleanup(events.Reset)
+	g.Expect(events.Enabled()).To(BeTrue())
+
+	events.Emit(events.Event{Type: events.TaskStarted, Cluster: "test", Task: "setup-validate"})
+	events.Emit(events.Event{Type: events.TaskFailed, Task: "setup-validate", DurationSeconds: 2}.WithError(context.DeadlineExceeded))
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	g.Expect(lines).To(HaveLen(2))
+
+	started := events.Event{}
+	g.Expect(json.Unmarshal(lines[0], &started)).To(Succeed())
+	g.Expect(started.Type).To(Equal(events.TaskStarted))
+	g.Expect(started.Cluster).To(Equal("test"))
+	g.Expect(started.Task).To(Equal("setup-validate"))
+	g.Expect(started.Time).NotTo(BeZero())
+
+	failed := events.Event{}
+	g.Expect(json.Unmarshal(lines[1], &failed)).To(Succeed())
+	g.Expect(failed.Type).To(Equal(events.TaskFailed))
+	g.Expect(failed.DurationSeconds).To(Equal(2.0))
+	g.Expect(failed.Error).To(Equal(context.DeadlineExceeded.Error()))
+	g.Expect(failed.ErrorCategory).To(Equal(events.TimeoutCategory))
+}
+
+func TestEmitNotInitialized(t *testing.T) {
+	g := NewWithT(t)
+	out := &bytes.Buffer{}
+	g.Expect(events.Init(events.JSONFormat, out)).To(Succeed())
+	events.Reset()
+
+	events.Emit(events.Event{Type: events.TaskStarted})
+	g.Expect(events.Enabled()).To(BeFalse())
+	g.Expect(out.Len()).To(BeZero())
+}
+
+func TestInitUnsupportedFormat(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(events.Init("yaml", &bytes.Buffer{})).To(MatchError(ContainSubstring("unsupported events format yaml")))
+	g.Expect(events.Enabled()).To(BeFalse())
+}
+
+func TestCategorize(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want events.Category
+	}{
+		{
+			name: "explicit category",
+			err:  fmt.Errorf("running: %w", events.WithCategory(errors.New("invalid"), events.ValidationCategory)),
+			want: events.ValidationCategory,
+		},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("waiting: %w", context.DeadlineExceeded),
+			want: events.TimeoutCategory,
+		},
+		{
+			name: "canceled",
+			err:  context.Canceled,
+			want: events.CanceledCategory,
+		},
+		{
+			name: "network",
+			err:  &net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			want: events.NetworkCategory,
+		},
+		{
+			name: "network timeout",
+			err:  &net.DNSError{Err: "i/o timeout", IsTimeout: true},
+			want: events.TimeoutCategory,
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			want: events.UnknownCategory,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(events.Categorize(tt.err)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestWithCategoryNil(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(events.WithCategory(nil, events.ValidationCategory)).To(BeNil())
+}
+
+func TestEventTimeNotOverwritten(t *testing.T) {
+	g := NewWithT(t)
+	out := &bytes.Buffer{}
+	g.Expect(events.Init(events.JSONFormat, out)).To(Succeed())
+	t.Cleanup(events.Reset)
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	events.Emit(events.Event{Time: now, Type: events.CommandFinished})
+
+	e := events.Event{}
+	g.Expect(json.Unmarshal(out.Bytes(), &e)).To(Succeed())
+	g.Expect(e.Time).To(Equal(now))
+}
@@ -3,6 +3,7 @@ package executables
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -28,6 +29,7 @@ const (
 	etcdadmBootstrapProviderName  = "etcdadm-bootstrap"
 	etcdadmControllerProviderName = "etcdadm-controller"
 	kubeadmBootstrapProviderName  = "kubeadm"
+	inClusterIPAMProviderName     = "in-cluster"
 )
 
 //go:embed config/clusterctl.yaml
@@ -46,6 +48,7 @@ type clusterctlConfiguration struct {
 	configFile               string
 	etcdadmBootstrapVersion  string
 	etcdadmControllerVersion string
+	ipamVersion              string
 }
 
 // NewClusterctl builds a new [Clusterctl].
@@ -118,6 +121,16 @@ func (c *Clusterctl) buildOverridesLayer(managementComponents *cluster.Managemen
 		},
 	}
 
+	if ipam := managementComponents.VSphere.IPAM; ipam != nil {
+		infraBundles = append(infraBundles, types.InfrastructureBundle{
+			FolderName: filepath.Join("ipam-in-cluster", ipam.Version),
+			Manifests: []v1alpha1.Manifest{
+				ipam.Components,
+				ipam.Metadata,
+			},
+		})
+	}
+
 	infraBundles = append(infraBundles, *provider.GetInfrastructureBundle(managementComponents))
 	for _, infraBundle := range infraBundles {
 		if err := c.writeInfrastructureBundle(prefix, &infraBundle); err != nil {
@@ -174,6 +187,21 @@ func (c *Clusterctl) BackupManagement(ctx context.Context, cluster *types.Cluste
 	return nil
 }
 
+// RestoreManagement creates in the cluster the CAPI resources previously saved by BackupManagement to the provided path.
+func (c *Clusterctl) RestoreManagement(ctx context.Context, cluster *types.Cluster, managementStatePath string) error {
+	filePath := filepath.Join(".", cluster.Name, managementStatePath)
+
+	_, err := c.Execute(
+		ctx, "move",
+		"--from-directory", filePath,
+		"--to-kubeconfig", cluster.KubeconfigFile,
+	)
+	if err != nil {
+		return fmt.Errorf("failed restoring CAPI objects: %v", err)
+	}
+	return nil
+}
+
 // MoveManagement moves management components `from` cluster `to` cluster
 // If `clusterName` is provided, it filters and moves only the provided cluster.
 func (c *Clusterctl) MoveManagement(ctx context.Context, from, to *types.Cluster, clusterName string) error {
@@ -219,17 +247,26 @@ func (c *Clusterctl) InitInfrastructure(ctx context.Context, managementComponent
 		return err
 	}
 
+	providerName := provider.Name()
 	params := []string{
 		"init",
 		"--core", clusterctlConfig.coreVersion,
 		"--bootstrap", clusterctlConfig.bootstrapVersion,
 		"--control-plane", clusterctlConfig.controlPlaneVersion,
-		"--infrastructure", fmt.Sprintf("%s:%s", provider.Name(), provider.Version(managementComponents)),
+		"--infrastructure", fmt.Sprintf("%s:%s", providerName, provider.Version(managementComponents)),
 		"--config", clusterctlConfig.configFile,
 		"--bootstrap", clusterctlConfig.etcdadmBootstrapVersion,
 		"--bootstrap", clusterctlConfig.etcdadmControllerVersion,
 	}
 
+	// The in-cluster IPAM provider is installed in every vSphere management cluster, so the
+	// workload clusters it manages can use ipPools even if the management cluster doesn't.
+	if providerName == constants.VSphereProviderName && clusterctlConfig.ipamVersion != "" {
+		params = append(params, "--ipam", clusterctlConfig.ipamVersion)
+	} else if usesIPPools(clusterSpec) {
+		return errors.New("the bundle doesn't include the in-cluster IPAM provider required by the vSphere ipPools")
+	}
+
 	if cluster.KubeconfigFile != "" {
 		params = append(params, "--kubeconfig", cluster.KubeconfigFile)
 	}
@@ -311,6 +348,14 @@ func (c *Clusterctl) buildConfig(managementComponents *anywherecluster.Managemen
 		"dir":                                             path + "/" + clusterName + capiPrefix,
 	}
 
+	var ipamVersion string
+	if ipam := managementComponents.VSphere.IPAM; ipam != nil {
+		data["InClusterIPAMVersion"] = ipam.Version
+		data["InClusterIPAMControllerRepository"] = imageRepository(ipam.Controller)
+		data["InClusterIPAMControllerTag"] = ipam.Controller.Tag()
+		ipamVersion = fmt.Sprintf("%s:%s", inClusterIPAMProviderName, ipam.Version)
+	}
+
 	filePath, err := t.WriteToFile(clusterctlConfigTemplate, data, clusterctlConfigFile)
 	if err != nil {
 		return nil, fmt.Errorf("generating configuration file for clusterctl: %v", err)
@@ -326,9 +371,15 @@ func (c *Clusterctl) buildConfig(managementComponents *anywherecluster.Managemen
 		coreVersion:              fmt.Sprintf("cluster-api:%s", managementComponents.ClusterAPI.Version),
 		etcdadmBootstrapVersion:  fmt.Sprintf("%s:%s", etcdadmBootstrapProviderName, managementComponents.ExternalEtcdBootstrap.Version),
 		etcdadmControllerVersion: fmt.Sprintf("%s:%s", etcdadmControllerProviderName, managementComponents.ExternalEtcdController.Version),
+		ipamVersion:              ipamVersion,
 	}, nil
 }
 
+// usesIPPools returns true if the cluster machines get their addresses from the in-cluster IPAM provider.
+func usesIPPools(clusterSpec *cluster.Spec) bool {
+	return clusterSpec != nil && clusterSpec.VSphereDatacenter != nil && len(clusterSpec.VSphereDatacenter.Spec.IPPools) > 0
+}
+
 var providerNamespaces = map[string]string{
 	constants.VSphereProviderName:    constants.CapvSystemNamespace,
 	constants.DockerProviderName:     constants.CapdSystemNamespace,
@@ -340,6 +391,7 @@ var providerNamespaces = map[string]string{
 	etcdadmBootstrapProviderName:     constants.EtcdAdmBootstrapProviderSystemNamespace,
 	etcdadmControllerProviderName:    constants.EtcdAdmControllerSystemNamespace,
 	kubeadmBootstrapProviderName:     constants.CapiKubeadmBootstrapSystemNamespace,
+	inClusterIPAMProviderName:        constants.CapiIPAMInClusterSystemNamespace,
 }
 
 // Upgrade executes an upgrade of the cluster to the new management components and the spec.
@@ -373,6 +425,11 @@ func (c *Clusterctl) Upgrade(ctx context.Context, managementCluster *types.Clust
 		upgradeCommand = append(upgradeCommand, "--bootstrap", newBootstrapProvider)
 	}
 
+	if changeDiff.IPAMProvider != nil {
+		newIPAMProvider := fmt.Sprintf("%s/%s:%s", providerNamespaces[changeDiff.IPAMProvider.ComponentName], changeDiff.IPAMProvider.ComponentName, changeDiff.IPAMProvider.NewVersion)
+		upgradeCommand = append(upgradeCommand, "--ipam", newIPAMProvider)
+	}
+
 	providerEnvMap, err := provider.EnvMap(managementComponents, newSpec)
 	if err != nil {
 		return fmt.Errorf("failed generating provider env map for clusterctl upgrade: %v", err)
@@ -385,8 +442,9 @@ func (c *Clusterctl) Upgrade(ctx context.Context, managementCluster *types.Clust
 	return nil
 }
 
-// InstallEtcdadmProviders installs the etcdadm providers for the cluster using clusterctl.
-func (c *Clusterctl) InstallEtcdadmProviders(ctx context.Context, managementComponents *cluster.ManagementComponents, clusterSpec *cluster.Spec, cluster *types.Cluster, infraProvider providers.Provider, installProviders []string) error {
+// InstallProviders installs the etcdadm or in-cluster IPAM providers missing from an existing
+// management cluster using clusterctl.
+func (c *Clusterctl) InstallProviders(ctx context.Context, managementComponents *cluster.ManagementComponents, clusterSpec *cluster.Spec, cluster *types.Cluster, infraProvider providers.Provider, installProviders []string) error {
 	if cluster == nil {
 		return fmt.Errorf("invalid cluster (nil)")
 	}
@@ -410,6 +468,11 @@ func (c *Clusterctl) InstallEtcdadmProviders(ctx context.Context, managementComp
 			params = append(params, "--bootstrap", clusterctlConfig.etcdadmBootstrapVersion)
 		case constants.EtcdadmControllerProviderName:
 			params = append(params, "--bootstrap", clusterctlConfig.etcdadmControllerVersion)
+		case constants.InClusterIPAMProviderName:
+			if clusterctlConfig.ipamVersion == "" {
+				return errors.New("the bundle doesn't include the in-cluster IPAM provider")
+			}
+			params = append(params, "--ipam", clusterctlConfig.ipamVersion)
 		default:
 			return fmt.Errorf("unrecognized capi provider %s", provider)
 		}
@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/constants"
@@ -219,6 +220,140 @@ func TestClusterctlInitInfrastructureInvalidClusterNameError(t *testing.T) {
 	}
 }
 
+func TestClusterctlInitInfrastructureWithIPPools(t *testing.T) {
+	cluster := &types.Cluster{Name: "cluster-name"}
+	defer func() {
+		if !t.Failed() {
+			os.RemoveAll(cluster.Name)
+		}
+	}()
+	tt := newClusterctlTest(t)
+	spec := clusterSpec.DeepCopy()
+	spec.VSphereDatacenter = &anywherev1.VSphereDatacenterConfig{
+		Spec: anywherev1.VSphereDatacenterConfigSpec{
+			IPPools: []anywherev1.VSphereIPPool{{Name: "pool", Addresses: []string{"10.0.0.10-10.0.0.20"}, Prefix: 24, Gateway: "10.0.0.1"}},
+		},
+	}
+	tt.managementComponents.VSphere.IPAM = &v1alpha1.IPAMBundle{
+		Version: "v0.1.0",
+		Controller: v1alpha1.Image{
+			URI: "public.ecr.aws/l0g8r8j6/kubernetes-sigs/cluster-api-ipam-provider-in-cluster:v0.1.0-eks-a-0.0.1.build.38",
+		},
+		Components: v1alpha1.Manifest{URI: "testdata/fake_manifest.yaml"},
+		Metadata:   v1alpha1.Manifest{URI: "testdata/fake_manifest.yaml"},
+	}
+
+	tt.provider.EXPECT().Name().Return("vsphere")
+	tt.provider.EXPECT().Version(tt.managementComponents).Return("v0.7.8")
+	tt.provider.EXPECT().EnvMap(tt.managementComponents, spec).Return(tt.providerEnvMap, nil)
+	tt.provider.EXPECT().GetInfrastructureBundle(tt.managementComponents).Return(&types.InfrastructureBundle{})
+	tt.e.EXPECT().ExecuteWithEnv(tt.ctx, tt.providerEnvMap,
+		"init", "--core", "cluster-api:v0.3.19", "--bootstrap", "kubeadm:v0.3.19", "--control-plane", "kubeadm:v0.3.19", "--infrastructure", "vsphere:v0.7.8", "--config", test.OfType("string"),
+		"--bootstrap", "etcdadm-bootstrap:v0.1.0", "--bootstrap", "etcdadm-controller:v0.1.0", "--ipam", "in-cluster:v0.1.0",
+	).Return(bytes.Buffer{}, nil).Do(
+		func(ctx context.Context, envs map[string]string, args ...string) (stdout bytes.Buffer, err error) {
+			config, err := os.ReadFile(args[10])
+			tt.Expect(err).NotTo(HaveOccurred())
+			tt.Expect(string(config)).To(ContainSubstring("ipam-in-cluster/v0.1.0/ipam-components.yaml"))
+			tt.Expect(string(config)).To(ContainSubstring("type: \"IPAMProvider\""))
+			return bytes.Buffer{}, nil
+		},
+	)
+
+	tt.Expect(tt.clusterctl.InitInfrastructure(tt.ctx, tt.managementComponents, spec, cluster, tt.provider)).To(Succeed())
+}
+
+func TestClusterctlInitInfrastructureVSphereWithoutIPPoolsInstallsIPAM(t *testing.T) {
+	cluster := &types.Cluster{Name: "cluster-name"}
+	defer func() {
+		if !t.Failed() {
+			os.RemoveAll(cluster.Name)
+		}
+	}()
+	tt := newClusterctlTest(t)
+	tt.managementComponents.VSphere.IPAM = &v1alpha1.IPAMBundle{
+		Version:    "v0.1.0",
+		Components: v1alpha1.Manifest{URI: "testdata/fake_manifest.yaml"},
+		Metadata:   v1alpha1.Manifest{URI: "testdata/fake_manifest.yaml"},
+	}
+
+	tt.provider.EXPECT().Name().Return("vsphere")
+	tt.provider.EXPECT().Version(tt.managementComponents).Return("v0.7.8")
+	tt.provider.EXPECT().EnvMap(tt.managementComponents, clusterSpec).Return(tt.providerEnvMap, nil)
+	tt.provider.EXPECT().GetInfrastructureBundle(tt.managementComponents).Return(&types.InfrastructureBundle{})
+	tt.e.EXPECT().ExecuteWithEnv(tt.ctx, tt.providerEnvMap,
+		"init", "--core", "cluster-api:v0.3.19", "--bootstrap", "kubeadm:v0.3.19", "--control-plane", "kubeadm:v0.3.19", "--infrastructure", "vsphere:v0.7.8", "--config", test.OfType("string"),
+		"--bootstrap", "etcdadm-bootstrap:v0.1.0", "--bootstrap", "etcdadm-controller:v0.1.0", "--ipam", "in-cluster:v0.1.0",
+	)
+
+	tt.Expect(tt.clusterctl.InitInfrastructure(tt.ctx, tt.managementComponents, clusterSpec, cluster, tt.provider)).To(Succeed())
+}
+
+func TestClusterctlInstallProvidersIPAM(t *testing.T) {
+	cluster := &types.Cluster{Name: "cluster-name", KubeconfigFile: "cluster-name.kubeconfig"}
+	defer func() {
+		if !t.Failed() {
+			os.RemoveAll(cluster.Name)
+		}
+	}()
+	tt := newClusterctlTest(t)
+	tt.managementComponents.VSphere.IPAM = &v1alpha1.IPAMBundle{
+		Version:    "v0.1.0",
+		Components: v1alpha1.Manifest{URI: "testdata/fake_manifest.yaml"},
+		Metadata:   v1alpha1.Manifest{URI: "testdata/fake_manifest.yaml"},
+	}
+
+	tt.provider.EXPECT().Name().Return("vsphere").AnyTimes()
+	tt.provider.EXPECT().EnvMap(tt.managementComponents, clusterSpec).Return(tt.providerEnvMap, nil)
+	tt.provider.EXPECT().GetInfrastructureBundle(tt.managementComponents).Return(&types.InfrastructureBundle{})
+	tt.e.EXPECT().ExecuteWithEnv(tt.ctx, tt.providerEnvMap,
+		"init", "--config", test.OfType("string"), "--ipam", "in-cluster:v0.1.0", "--kubeconfig", "cluster-name.kubeconfig",
+	)
+
+	tt.Expect(tt.clusterctl.InstallProviders(tt.ctx, tt.managementComponents, clusterSpec, cluster, tt.provider, []string{constants.InClusterIPAMProviderName})).To(Succeed())
+}
+
+func TestClusterctlInstallProvidersIPAMMissingBundle(t *testing.T) {
+	cluster := &types.Cluster{Name: "cluster-name"}
+	defer func() {
+		if !t.Failed() {
+			os.RemoveAll(cluster.Name)
+		}
+	}()
+	tt := newClusterctlTest(t)
+
+	tt.provider.EXPECT().Name().Return("vsphere").AnyTimes()
+	tt.provider.EXPECT().GetInfrastructureBundle(tt.managementComponents).Return(&types.InfrastructureBundle{})
+
+	tt.Expect(tt.clusterctl.InstallProviders(tt.ctx, tt.managementComponents, clusterSpec, cluster, tt.provider, []string{constants.InClusterIPAMProviderName})).To(
+		MatchError(ContainSubstring("doesn't include the in-cluster IPAM provider")),
+	)
+}
+
+func TestClusterctlInitInfrastructureWithIPPoolsMissingIPAMBundle(t *testing.T) {
+	cluster := &types.Cluster{Name: "cluster-name"}
+	defer func() {
+		if !t.Failed() {
+			os.RemoveAll(cluster.Name)
+		}
+	}()
+	tt := newClusterctlTest(t)
+	spec := clusterSpec.DeepCopy()
+	spec.VSphereDatacenter = &anywherev1.VSphereDatacenterConfig{
+		Spec: anywherev1.VSphereDatacenterConfigSpec{
+			IPPools: []anywherev1.VSphereIPPool{{Name: "pool", Addresses: []string{"10.0.0.10-10.0.0.20"}, Prefix: 24, Gateway: "10.0.0.1"}},
+		},
+	}
+
+	tt.provider.EXPECT().Name()
+	tt.provider.EXPECT().Version(tt.managementComponents)
+	tt.provider.EXPECT().GetInfrastructureBundle(tt.managementComponents).Return(&types.InfrastructureBundle{})
+
+	tt.Expect(tt.clusterctl.InitInfrastructure(tt.ctx, tt.managementComponents, spec, cluster, tt.provider)).To(
+		MatchError(ContainSubstring("doesn't include the in-cluster IPAM provider")),
+	)
+}
+
 func TestClusterctlBackupManagement(t *testing.T) {
 	managementClusterState := fmt.Sprintf("cluster-state-backup-%s", time.Now().Format("2006-01-02T15_04_05"))
 	clusterName := "cluster"
@@ -274,6 +409,32 @@ func TestClusterctlBackupManagementFailed(t *testing.T) {
 	}
 }
 
+func TestClusterctlRestoreManagement(t *testing.T) {
+	tt := newClusterctlTest(t)
+	cluster := &types.Cluster{
+		Name:           "cluster",
+		KubeconfigFile: "cluster.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--from-directory", "cluster/cluster-restore/capi", "--to-kubeconfig", "cluster.kubeconfig")
+	if err := tt.clusterctl.RestoreManagement(tt.ctx, cluster, "cluster-restore/capi"); err != nil {
+		t.Fatalf("Clusterctl.RestoreManagement() error = %v, want nil", err)
+	}
+}
+
+func TestClusterctlRestoreManagementFailed(t *testing.T) {
+	tt := newClusterctlTest(t)
+	cluster := &types.Cluster{
+		Name:           "cluster",
+		KubeconfigFile: "cluster.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--from-directory", "cluster/cluster-restore/capi", "--to-kubeconfig", "cluster.kubeconfig").Return(bytes.Buffer{}, fmt.Errorf("error restoring"))
+	if err := tt.clusterctl.RestoreManagement(tt.ctx, cluster, "cluster-restore/capi"); err == nil {
+		t.Fatal("Clusterctl.RestoreManagement() error = nil, want not nil")
+	}
+}
+
 func TestClusterctlMoveManagement(t *testing.T) {
 	tests := []struct {
 		testName     string
@@ -405,6 +566,28 @@ func TestClusterctlUpgradeInfrastructureProvidersSucess(t *testing.T) {
 	tt.Expect(tt.clusterctl.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.managementComponents, clusterSpec, changeDiff)).To(Succeed())
 }
 
+func TestClusterctlUpgradeIPAMProviderSuccess(t *testing.T) {
+	tt := newClusterctlTest(t)
+
+	changeDiff := &clusterapi.CAPIChangeDiff{
+		IPAMProvider: &types.ComponentChangeDiff{
+			ComponentName: "in-cluster",
+			NewVersion:    "v0.1.1",
+		},
+	}
+
+	tt.expectBuildOverrideLayer()
+	tt.expectGetProviderEnvMap()
+	tt.e.EXPECT().ExecuteWithEnv(tt.ctx, tt.providerEnvMap,
+		"upgrade", "apply",
+		"--config", test.OfType("string"),
+		"--kubeconfig", tt.cluster.KubeconfigFile,
+		"--ipam", "capi-ipam-in-cluster-system/in-cluster:v0.1.1",
+	)
+
+	tt.Expect(tt.clusterctl.Upgrade(tt.ctx, tt.cluster, tt.provider, tt.managementComponents, clusterSpec, changeDiff)).To(Succeed())
+}
+
 func TestClusterctlUpgradeInfrastructureProvidersError(t *testing.T) {
 	tt := newClusterctlTest(t)
 
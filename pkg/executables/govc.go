@@ -299,6 +299,82 @@ func (g *Govc) GetHardDiskSize(ctx context.Context, vm, datacenter string) (map[
 	return hardDiskMap, nil
 }
 
+// GetHardDisksCapacityInKB returns the capacity of the VM hard disks, in the order they are attached.
+func (g *Govc) GetHardDisksCapacityInKB(ctx context.Context, vm, datacenter string) ([]float64, error) {
+	devicesInfo, err := g.DevicesInfo(ctx, datacenter, vm, "disk-*")
+	if err != nil {
+		return nil, fmt.Errorf("getting hard disks for vm %s: %v", vm, err)
+	}
+
+	capacities := make([]float64, 0, len(devicesInfo))
+	for _, deviceInfo := range devicesInfo {
+		capacities = append(capacities, deviceInfo.CapacityInKB)
+	}
+	return capacities, nil
+}
+
+// PCIDevice is a PCI device of an ESXi host.
+type PCIDevice struct {
+	VendorID int32
+	DeviceID int32
+}
+
+type hostInfoResponse struct {
+	HostSystems []struct {
+		Name     string `json:"name"`
+		Hardware struct {
+			PciDevice []struct {
+				ID       string `json:"id"`
+				VendorID int16  `json:"vendorId"`
+				DeviceID int16  `json:"deviceId"`
+			} `json:"pciDevice"`
+		} `json:"hardware"`
+		Config struct {
+			PciPassthruInfo []struct {
+				ID             string `json:"id"`
+				PassthruActive bool   `json:"passthruActive"`
+			} `json:"pciPassthruInfo"`
+		} `json:"config"`
+	} `json:"hostSystems"`
+}
+
+// GetHostsPCIPassthroughDevices returns the PCI devices with passthrough active on each host of a
+// compute cluster, keyed by host name.
+func (g *Govc) GetHostsPCIPassthroughDevices(ctx context.Context, computeCluster string) (map[string][]PCIDevice, error) {
+	response, err := g.exec(ctx, "host.info", "-json", computeCluster+"/*")
+	if err != nil {
+		return nil, fmt.Errorf("getting hosts information for compute cluster %s: %v", computeCluster, err)
+	}
+
+	var info hostInfoResponse
+	if err := json.Unmarshal(response.Bytes(), &info); err != nil {
+		return nil, fmt.Errorf("unmarshalling hosts information: %v", err)
+	}
+
+	hosts := make(map[string][]PCIDevice, len(info.HostSystems))
+	for _, host := range info.HostSystems {
+		active := make(map[string]bool, len(host.Config.PciPassthruInfo))
+		for _, passthru := range host.Config.PciPassthruInfo {
+			active[passthru.ID] = passthru.PassthruActive
+		}
+
+		devices := []PCIDevice{}
+		for _, device := range host.Hardware.PciDevice {
+			if !active[device.ID] {
+				continue
+			}
+			// vSphere reports the IDs as signed 16 bit integers.
+			devices = append(devices, PCIDevice{
+				VendorID: int32(uint16(device.VendorID)),
+				DeviceID: int32(uint16(device.DeviceID)),
+			})
+		}
+		hosts[host.Name] = devices
+	}
+
+	return hosts, nil
+}
+
 func (g *Govc) TemplateHasSnapshot(ctx context.Context, template string) (bool, error) {
 	envMap, err := g.validateAndSetupCreds()
 	if err != nil {
@@ -2365,6 +2365,94 @@ func TestGovcGetHardDiskSize(t *testing.T) {
 	gt.Expect(diskSizeMap).To(Equal(wantDiskMap))
 }
 
+func TestGovcGetHardDisksCapacityInKB(t *testing.T) {
+	datacenter := "SDDC-Datacenter"
+	template := "ubuntu-kube-v1-27"
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+	gt := NewWithT(t)
+
+	response := map[string][]interface{}{
+		"Devices": {
+			map[string]interface{}{
+				"Name":         "disk-1000-0",
+				"DeviceInfo":   map[string]string{"Label": "Hard disk 1"},
+				"CapacityInKB": 26214400,
+			},
+			map[string]interface{}{
+				"Name":         "disk-1000-1",
+				"DeviceInfo":   map[string]string{"Label": "Hard disk 2"},
+				"CapacityInKB": 10485760,
+			},
+		},
+	}
+	marshaledResponse, err := json.Marshal(response)
+	gt.Expect(err).NotTo(HaveOccurred())
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "device.info", "-dc", datacenter, "-vm", template, "-json", "disk-*").Return(*bytes.NewBuffer(marshaledResponse), nil)
+
+	capacities, err := g.GetHardDisksCapacityInKB(ctx, template, datacenter)
+	gt.Expect(err).NotTo(HaveOccurred())
+	gt.Expect(capacities).To(Equal([]float64{26214400, 10485760}))
+}
+
+func TestGovcGetHardDisksCapacityInKBError(t *testing.T) {
+	datacenter := "SDDC-Datacenter"
+	template := "ubuntu-kube-v1-27"
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+	gt := NewWithT(t)
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "device.info", "-dc", datacenter, "-vm", template, "-json", "disk-*").Return(bytes.Buffer{}, errors.New("govc error"))
+
+	_, err := g.GetHardDisksCapacityInKB(ctx, template, datacenter)
+	gt.Expect(err).To(MatchError("getting hard disks for vm ubuntu-kube-v1-27: getting template device information: govc error"))
+}
+
+func TestGovcGetHostsPCIPassthroughDevices(t *testing.T) {
+	ctx := context.Background()
+	computeCluster := "/SDDC-Datacenter/host/Cluster-1"
+	_, g, executable, env := setup(t)
+	gt := NewWithT(t)
+
+	response := `{"hostSystems":[
+		{
+			"name": "esxi-1",
+			"hardware": {"pciDevice": [
+				{"id": "0000:3b:00.0", "vendorId": 4318, "deviceId": 8373},
+				{"id": "0000:af:00.0", "vendorId": 4318, "deviceId": 8373},
+				{"id": "0000:00:1f.0", "vendorId": -32634, "deviceId": -24560}
+			]},
+			"config": {"pciPassthruInfo": [
+				{"id": "0000:3b:00.0", "passthruActive": true},
+				{"id": "0000:af:00.0", "passthruActive": false},
+				{"id": "0000:00:1f.0", "passthruActive": true}
+			]}
+		},
+		{"name": "esxi-2", "hardware": {}, "config": {}}
+	]}`
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "host.info", "-json", computeCluster+"/*").Return(*bytes.NewBufferString(response), nil)
+
+	hosts, err := g.GetHostsPCIPassthroughDevices(ctx, computeCluster)
+	gt.Expect(err).NotTo(HaveOccurred())
+	gt.Expect(hosts).To(Equal(map[string][]executables.PCIDevice{
+		"esxi-1": {{VendorID: 0x10de, DeviceID: 0x20b5}, {VendorID: 0x8086, DeviceID: 0xa010}},
+		"esxi-2": {},
+	}))
+}
+
+func TestGovcGetHostsPCIPassthroughDevicesError(t *testing.T) {
+	ctx := context.Background()
+	computeCluster := "/SDDC-Datacenter/host/Cluster-1"
+	_, g, executable, env := setup(t)
+	gt := NewWithT(t)
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "host.info", "-json", computeCluster+"/*").Return(bytes.Buffer{}, errors.New("govc error"))
+
+	_, err := g.GetHostsPCIPassthroughDevices(ctx, computeCluster)
+	gt.Expect(err).To(MatchError("getting hosts information for compute cluster /SDDC-Datacenter/host/Cluster-1: govc error"))
+}
+
 func TestGovcGetHardDiskSizeError(t *testing.T) {
 	datacenter := "SDDC-Datacenter"
 	template := "bottlerocket-kube-v1-21"
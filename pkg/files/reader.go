@@ -13,17 +13,21 @@ import (
 	"time"
 
 	"golang.org/x/net/http/httpproxy"
+
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 )
 
 const (
+	httpScheme  = "http"
 	httpsScheme = "https"
 	embedScheme = "embed"
 )
 
 type Reader struct {
-	embedFS    embed.FS
-	httpClient *http.Client
-	userAgent  string
+	embedFS       embed.FS
+	httpClient    *http.Client
+	userAgent     string
+	releaseSource *releasesource.Source
 }
 
 type ReaderOpt func(*Reader)
@@ -47,6 +51,14 @@ func WithEKSAUserAgent(eksAComponent, version string) ReaderOpt {
 	return WithUserAgent(eksaUserAgent(eksAComponent, version))
 }
 
+// WithReleaseSource makes the reader read remote files from a release source instead of
+// the network. A nil source is ignored.
+func WithReleaseSource(source *releasesource.Source) ReaderOpt {
+	return func(r *Reader) {
+		r.releaseSource = source
+	}
+}
+
 // WithRootCACerts configures the HTTP client's trusted CAs. Note that this will overwrite
 // the defaults so the host's trust will be ignored. This option is only for testing.
 func WithRootCACerts(certs []*x509.Certificate) ReaderOpt {
@@ -114,6 +126,11 @@ func (r *Reader) ReadFile(uri string) ([]byte, error) {
 		return nil, fmt.Errorf("can't build cluster spec, invalid release manifest url: %v", err)
 	}
 
+	// When reading from a release source, remote files are never fetched from the network.
+	if r.releaseSource != nil && (url.Scheme == httpScheme || url.Scheme == httpsScheme) {
+		return r.releaseSource.ReadFile(uri)
+	}
+
 	switch url.Scheme {
 	case httpsScheme:
 		return r.readHttpFile(uri)
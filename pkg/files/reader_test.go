@@ -18,6 +18,7 @@ import (
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/files"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 )
 
 //go:embed testdata
@@ -95,6 +96,23 @@ func TestReaderReadFileHTTPSSuccess(t *testing.T) {
 	test.AssertContentToFile(t, string(got), filePath)
 }
 
+func TestReaderReadFileHTTPSFromReleaseSource(t *testing.T) {
+	g := NewWithT(t)
+	uri := "https://anywhere-assets.eks.amazonaws.com/releases/eks-a/manifest.yaml"
+	dir := t.TempDir()
+	b := releasesource.NewBuilder(dir, uri)
+	g.Expect(b.AddFile(uri, "manifest.yaml", []byte("release"))).To(Succeed())
+	g.Expect(b.Write()).To(Succeed())
+	source, err := releasesource.Load(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	r := files.NewReader(files.WithReleaseSource(source))
+	g.Expect(r.ReadFile(uri)).To(BeEquivalentTo("release"))
+	_, err = r.ReadFile("https://anywhere-assets.eks.amazonaws.com/releases/bundles/1/manifest.yaml")
+	g.Expect(err).To(MatchError(ContainSubstring("not found in release source")))
+	g.Expect(r.ReadFile("testdata/file.yaml")).NotTo(BeEmpty())
+}
+
 func TestReaderReadFileHTTPSProxySuccess(t *testing.T) {
 	t.Skip("Flaky (https://github.com/aws/eks-anywhere/issues/5775)")
 
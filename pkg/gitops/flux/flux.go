@@ -256,7 +256,9 @@ func (f *Flux) Validations(ctx context.Context, clusterSpec *cluster.Spec) []val
 	return []validations.Validation{
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.FluxPath,
 				Name:        "Flux path",
+				Category:    validations.GitOpsCategory,
 				Remediation: "Please provide a different path or different cluster name",
 				Err:         fc.validateRemoteConfigPathDoesNotExist(ctx),
 			}
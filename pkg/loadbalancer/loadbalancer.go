@@ -0,0 +1,354 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	// KubeVipName is the name of the kube-vip DaemonSet that announces the LoadBalancer Service
+	// addresses, and of its RBAC objects.
+	KubeVipName = "eksa-kube-vip"
+	// CloudProviderName is the name of the kube-vip cloud provider Deployment that assigns the
+	// LoadBalancer Service addresses from the pools, and of its RBAC objects.
+	CloudProviderName = "kube-vip-cloud-provider"
+	// ConfigMapName is the name of the ConfigMap in the kube-system namespace the cloud provider
+	// reads the address pools from.
+	ConfigMapName = "kubevip"
+	// LoadBalancerClass is the loadBalancerClass served by the EKS-A kube-vip, in addition to the
+	// Services without class. Other kube-vip instances, like the Tinkerbell stack one, serve their own class.
+	LoadBalancerClass = "anywhere.eks.amazonaws.com/kube-vip"
+	// AppLabel identifies the pods of the load balancer components.
+	AppLabel = "app.kubernetes.io/name"
+
+	globalPoolKey        = "range-global"
+	namespacePoolPrefix  = "range-"
+	controlPlaneRoleName = "node-role.kubernetes.io/control-plane"
+	// The control plane kube-vip static pod uses the default metrics port on the host network.
+	kubeVipMetricsAddress = ":2113"
+)
+
+// Objects builds the kube-vip objects that implement the load balancer configured in the
+// cluster's loadBalancer. They are ordered so they can be applied in sequence.
+func Objects(spec *cluster.Spec) ([]client.Object, error) {
+	lb := spec.Cluster.Spec.LoadBalancer
+	if lb == nil {
+		return nil, fmt.Errorf("cluster %s doesn't have loadBalancer configured", spec.Cluster.Name)
+	}
+
+	versionsBundle := spec.RootVersionsBundle()
+	if versionsBundle == nil {
+		return nil, fmt.Errorf("no versions bundle found for cluster %s", spec.Cluster.Name)
+	}
+	images := versionsBundle.LoadBalancer
+	if images == nil {
+		return nil, fmt.Errorf("bundle for kubernetes version %s doesn't include the load balancer images", versionsBundle.KubeVersion)
+	}
+
+	configMap, err := ConfigMap(lb)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := rbacObjects(CloudProviderName, cloudProviderRules())
+	objs = append(objs, configMap, cloudProviderDeployment(images.CloudProvider.VersionedImage()))
+	objs = append(objs, rbacObjects(KubeVipName, kubeVipRules())...)
+	objs = append(objs, kubeVipDaemonSet(lb, images.KubeVip.VersionedImage()))
+
+	return objs, nil
+}
+
+// DeleteObjects returns the objects created by Objects, with only their identity populated,
+// so they can be deleted when the loadBalancer is removed from the cluster.
+func DeleteObjects() []client.Object {
+	return []client.Object{
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: KubeVipName, Namespace: constants.KubeSystemNamespace}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: CloudProviderName, Namespace: constants.KubeSystemNamespace}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: constants.KubeSystemNamespace}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: KubeVipName}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: KubeVipName}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: KubeVipName, Namespace: constants.KubeSystemNamespace}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: CloudProviderName}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: CloudProviderName}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: CloudProviderName, Namespace: constants.KubeSystemNamespace}},
+	}
+}
+
+// ConfigMap builds the kube-vip cloud provider ConfigMap with the address pools. The pools of the
+// same namespace are merged and all the addresses are converted to ranges.
+func ConfigMap(lb *anywherev1.LoadBalancerConfiguration) (*corev1.ConfigMap, error) {
+	ranges := map[string][]string{}
+	for i := range lb.AddressPools {
+		pool := &lb.AddressPools[i]
+		poolRanges, err := pool.Ranges()
+		if err != nil {
+			return nil, fmt.Errorf("loadBalancer addressPool %s: %v", pool.Name, err)
+		}
+
+		key := globalPoolKey
+		if pool.Namespace != "" {
+			key = namespacePoolPrefix + pool.Namespace
+		}
+		for _, r := range poolRanges {
+			ranges[key] = append(ranges[key], r.String())
+		}
+	}
+
+	data := make(map[string]string, len(ranges))
+	for key, r := range ranges {
+		data[key] = strings.Join(r, ",")
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName,
+			Namespace: constants.KubeSystemNamespace,
+		},
+		Data: data,
+	}, nil
+}
+
+func cloudProviderDeployment(image string) *appsv1.Deployment {
+	labels := map[string]string{AppLabel: CloudProviderName}
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CloudProviderName,
+			Namespace: constants.KubeSystemNamespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: CloudProviderName,
+					Containers: []corev1.Container{
+						{
+							Name:  CloudProviderName,
+							Image: image,
+							Command: []string{
+								"/kube-vip-cloud-provider",
+								"--leader-elect-resource-name=kube-vip-cloud-controller",
+							},
+							ImagePullPolicy: corev1.PullIfNotPresent,
+						},
+					},
+					Tolerations: controlPlaneTolerations(),
+				},
+			},
+		},
+	}
+}
+
+func kubeVipDaemonSet(lb *anywherev1.LoadBalancerConfiguration, image string) *appsv1.DaemonSet {
+	labels := map[string]string{AppLabel: KubeVipName}
+	return &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "DaemonSet",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KubeVipName,
+			Namespace: constants.KubeSystemNamespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: KubeVipName,
+					HostNetwork:        true,
+					Containers: []corev1.Container{
+						{
+							Name:            "kube-vip",
+							Image:           image,
+							Args:            []string{"manager"},
+							Env:             kubeVipEnv(lb),
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							SecurityContext: &corev1.SecurityContext{
+								Capabilities: &corev1.Capabilities{
+									Add: []corev1.Capability{"NET_ADMIN", "NET_RAW"},
+								},
+							},
+						},
+					},
+					Tolerations: controlPlaneTolerations(),
+				},
+			},
+		},
+	}
+}
+
+func kubeVipEnv(lb *anywherev1.LoadBalancerConfiguration) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: "svc_enable", Value: "true"},
+		{Name: "svc_leasename", Value: "plndr-svcs-lock"},
+		{Name: "lb_class_name", Value: LoadBalancerClass},
+		{Name: "prometheus_server", Value: kubeVipMetricsAddress},
+		{
+			Name: "vip_nodename",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+			},
+		},
+	}
+
+	if lb.ModeOrDefault() == anywherev1.LoadBalancerModeBGP {
+		return append(env,
+			corev1.EnvVar{Name: "vip_arp", Value: "false"},
+			corev1.EnvVar{Name: "bgp_enable", Value: "true"},
+			corev1.EnvVar{Name: "bgp_as", Value: strconv.FormatInt(lb.BGP.LocalASN, 10)},
+			corev1.EnvVar{Name: "bgp_peers", Value: bgpPeers(lb.BGP.Peers)},
+			corev1.EnvVar{
+				Name: "bgp_routerid",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.hostIP"},
+				},
+			},
+		)
+	}
+
+	// In L2 mode a leader is elected for each Service, so the addresses are announced from different nodes.
+	return append(env,
+		corev1.EnvVar{Name: "vip_arp", Value: "true"},
+		corev1.EnvVar{Name: "vip_leaderelection", Value: "true"},
+		corev1.EnvVar{Name: "svc_election", Value: "true"},
+		corev1.EnvVar{Name: "vip_leaseduration", Value: "15"},
+		corev1.EnvVar{Name: "vip_renewdeadline", Value: "10"},
+		corev1.EnvVar{Name: "vip_retryperiod", Value: "2"},
+	)
+}
+
+// bgpPeers formats the peers as kube-vip expects them: address:asn:password:multihop.
+func bgpPeers(peers []anywherev1.LoadBalancerBGPPeer) string {
+	formatted := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		address := peer.Address
+		if addr, err := netip.ParseAddr(address); err == nil && addr.Is6() {
+			address = "[" + address + "]"
+		}
+		formatted = append(formatted, fmt.Sprintf("%s:%d::false", address, peer.ASN))
+	}
+	return strings.Join(formatted, ",")
+}
+
+func controlPlaneTolerations() []corev1.Toleration {
+	return []corev1.Toleration{
+		{Key: controlPlaneRoleName, Effect: corev1.TaintEffectNoSchedule},
+	}
+}
+
+func rbacObjects(name string, rules []rbacv1.PolicyRule) []client.Object {
+	return []client.Object{
+		&corev1.ServiceAccount{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ServiceAccount",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: constants.KubeSystemNamespace,
+			},
+		},
+		&rbacv1.ClusterRole{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: rbacv1.SchemeGroupVersion.String(),
+				Kind:       "ClusterRole",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Rules:      rules,
+		},
+		&rbacv1.ClusterRoleBinding{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: rbacv1.SchemeGroupVersion.String(),
+				Kind:       "ClusterRoleBinding",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     name,
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:      rbacv1.ServiceAccountKind,
+					Name:      name,
+					Namespace: constants.KubeSystemNamespace,
+				},
+			},
+		},
+	}
+}
+
+func cloudProviderRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"coordination.k8s.io"},
+			Resources: []string{"leases"},
+			Verbs:     []string{"get", "create", "update", "list", "watch"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"configmaps", "endpoints", "events", "services/status"},
+			Verbs:     []string{"*"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"nodes", "services"},
+			Verbs:     []string{"list", "get", "watch", "update"},
+		},
+	}
+}
+
+func kubeVipRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"services/status"},
+			Verbs:     []string{"update"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"services", "endpoints"},
+			Verbs:     []string{"list", "get", "watch", "update"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"nodes"},
+			Verbs:     []string{"list", "get", "watch", "update", "patch"},
+		},
+		{
+			APIGroups: []string{"coordination.k8s.io"},
+			Resources: []string{"leases"},
+			Verbs:     []string{"list", "get", "watch", "update", "create"},
+		},
+		{
+			APIGroups: []string{"discovery.k8s.io"},
+			Resources: []string{"endpointslices"},
+			Verbs:     []string{"list", "get", "watch", "update"},
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package loadbalancer_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/loadbalancer"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func newSpec(lb *anywherev1.LoadBalancerConfiguration) *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.LoadBalancer = lb
+		s.VersionsBundles[anywherev1.Kube119].LoadBalancer = &releasev1.LoadBalancerBundle{
+			KubeVip:       releasev1.Image{URI: "public.ecr.aws/eks-anywhere/kube-vip/kube-vip:v0.8.0"},
+			CloudProvider: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/kube-vip/kube-vip-cloud-provider:v0.0.10"},
+		}
+	})
+}
+
+func kubeVipEnv(ds *appsv1.DaemonSet) map[string]string {
+	env := map[string]string{}
+	for _, e := range ds.Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	return env
+}
+
+func TestObjectsL2(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.LoadBalancerConfiguration{
+		AddressPools: []anywherev1.LoadBalancerAddressPool{
+			{Name: "default", Addresses: []string{"10.0.0.100-10.0.0.120"}},
+		},
+	})
+
+	objs, err := loadbalancer.Objects(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objs).To(HaveLen(9))
+	for _, o := range objs {
+		g.Expect(o.GetObjectKind().GroupVersionKind().Kind).NotTo(BeEmpty())
+	}
+
+	deployment := objs[4].(*appsv1.Deployment)
+	g.Expect(deployment.Name).To(Equal(loadbalancer.CloudProviderName))
+	g.Expect(deployment.Namespace).To(Equal(constants.KubeSystemNamespace))
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("public.ecr.aws/eks-anywhere/kube-vip/kube-vip-cloud-provider:v0.0.10"))
+
+	ds := objs[8].(*appsv1.DaemonSet)
+	g.Expect(ds.Name).To(Equal(loadbalancer.KubeVipName))
+	g.Expect(ds.Spec.Template.Spec.HostNetwork).To(BeTrue())
+	g.Expect(ds.Spec.Template.Spec.Containers[0].Image).To(Equal("public.ecr.aws/eks-anywhere/kube-vip/kube-vip:v0.8.0"))
+
+	env := kubeVipEnv(ds)
+	g.Expect(env).To(HaveKeyWithValue("svc_enable", "true"))
+	g.Expect(env).To(HaveKeyWithValue("vip_arp", "true"))
+	g.Expect(env).To(HaveKeyWithValue("lb_class_name", loadbalancer.LoadBalancerClass))
+	g.Expect(env).NotTo(HaveKey("bgp_enable"))
+}
+
+func TestObjectsBGP(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.LoadBalancerConfiguration{
+		Mode: anywherev1.LoadBalancerModeBGP,
+		AddressPools: []anywherev1.LoadBalancerAddressPool{
+			{Name: "default", Addresses: []string{"10.0.0.100-10.0.0.120"}},
+		},
+		BGP: &anywherev1.LoadBalancerBGPConfiguration{
+			LocalASN: 64512,
+			Peers: []anywherev1.LoadBalancerBGPPeer{
+				{Address: "10.0.0.1", ASN: 64513},
+				{Address: "fd00::1", ASN: 64514},
+			},
+		},
+	})
+
+	objs, err := loadbalancer.Objects(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objs).To(HaveLen(9))
+
+	env := kubeVipEnv(objs[8].(*appsv1.DaemonSet))
+	g.Expect(env).To(HaveKeyWithValue("vip_arp", "false"))
+	g.Expect(env).To(HaveKeyWithValue("bgp_enable", "true"))
+	g.Expect(env).To(HaveKeyWithValue("bgp_as", "64512"))
+	g.Expect(env).To(HaveKeyWithValue("bgp_peers", "10.0.0.1:64513::false,[fd00::1]:64514::false"))
+	g.Expect(env).NotTo(HaveKey("vip_leaderelection"))
+}
+
+func TestObjectsMissingBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := newSpec(&anywherev1.LoadBalancerConfiguration{
+		AddressPools: []anywherev1.LoadBalancerAddressPool{{Name: "default", Addresses: []string{"10.0.0.100"}}},
+	})
+	spec.VersionsBundles[anywherev1.Kube119].LoadBalancer = nil
+
+	_, err := loadbalancer.Objects(spec)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't include the load balancer images")))
+}
+
+func TestObjectsNotConfigured(t *testing.T) {
+	g := NewWithT(t)
+	_, err := loadbalancer.Objects(newSpec(nil))
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't have loadBalancer configured")))
+}
+
+func TestConfigMap(t *testing.T) {
+	g := NewWithT(t)
+	cm, err := loadbalancer.ConfigMap(&anywherev1.LoadBalancerConfiguration{
+		AddressPools: []anywherev1.LoadBalancerAddressPool{
+			{Name: "default", Addresses: []string{"10.0.0.100-10.0.0.120", "10.0.1.0/30"}},
+			{Name: "team-a", Addresses: []string{"10.0.2.5"}, Namespace: "team-a"},
+			{Name: "team-a-v6", Addresses: []string{"fd00::/126"}, Namespace: "team-a"},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cm.Name).To(Equal(loadbalancer.ConfigMapName))
+	g.Expect(cm.Namespace).To(Equal(constants.KubeSystemNamespace))
+	g.Expect(cm.Data).To(Equal(map[string]string{
+		"range-global": "10.0.0.100-10.0.0.120,10.0.1.0-10.0.1.3",
+		"range-team-a": "10.0.2.5-10.0.2.5,fd00::-fd00::3",
+	}))
+}
+
+func TestDeleteObjects(t *testing.T) {
+	g := NewWithT(t)
+	objs := loadbalancer.DeleteObjects()
+	g.Expect(objs).To(HaveLen(9))
+	for _, o := range objs {
+		g.Expect(o.GetName()).NotTo(BeEmpty())
+	}
+	g.Expect(objs).To(ContainElement(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: loadbalancer.ConfigMapName, Namespace: constants.KubeSystemNamespace}}))
+}
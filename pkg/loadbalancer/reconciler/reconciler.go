@@ -0,0 +1,106 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	anywhereCluster "github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+	"github.com/aws/eks-anywhere/pkg/loadbalancer"
+)
+
+// LoadBalancerInstalledAnnotation indicates the load balancer has been installed in the cluster,
+// so it's removed if the loadBalancer is removed from the cluster spec.
+const LoadBalancerInstalledAnnotation = "anywhere.eks.amazonaws.com/load-balancer"
+
+// RemoteClientRegistry defines methods for remote cluster controller clients.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler reconciles the in-cluster load balancer of a cluster.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile takes the kube-vip load balancer in the cluster to the state defined in the cluster's
+// loadBalancer, removing it if it was installed and the loadBalancer is no longer configured.
+// It uses a controller.Result to indicate when requeues are needed.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if cluster.Spec.LoadBalancer == nil && !installed(cluster) {
+		return controller.Result{}, nil
+	}
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, cluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return result, nil
+	}
+
+	rClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile load balancer")
+	}
+
+	if cluster.Spec.LoadBalancer == nil {
+		log.Info("Removing load balancer")
+		if err := deleteObjects(ctx, rClient); err != nil {
+			return controller.Result{}, err
+		}
+		clientutil.RemoveAnnotation(cluster, LoadBalancerInstalledAnnotation)
+		return controller.Result{}, nil
+	}
+
+	clusterSpec, err := anywhereCluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), cluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	objs, err := loadbalancer.Objects(clusterSpec)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	log.Info("Applying load balancer objects", "mode", cluster.Spec.LoadBalancer.ModeOrDefault())
+	if err := serverside.ReconcileObjects(ctx, rClient, objs); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying load balancer objects")
+	}
+	clientutil.AddAnnotation(cluster, LoadBalancerInstalledAnnotation, "")
+
+	return controller.Result{}, nil
+}
+
+func installed(cluster *anywherev1.Cluster) bool {
+	_, ok := cluster.Annotations[LoadBalancerInstalledAnnotation]
+	return ok
+}
+
+func deleteObjects(ctx context.Context, c client.Client) error {
+	for _, obj := range loadbalancer.DeleteObjects() {
+		if err := c.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "deleting load balancer %s", obj.GetName())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/loadbalancer"
+	"github.com/aws/eks-anywhere/pkg/loadbalancer/reconciler"
+	"github.com/aws/eks-anywhere/pkg/loadbalancer/reconciler/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	cluster              *anywherev1.Cluster
+	bundle               *releasev1.Bundles
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	managementObjs       []runtime.Object
+	workloadClient       client.Client
+}
+
+func newReconcilerTest(t *testing.T, workloadObjs ...client.Object) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	bundle := test.Bundle()
+	for i := range bundle.Spec.VersionsBundles {
+		bundle.Spec.VersionsBundles[i].LoadBalancer = &releasev1.LoadBalancerBundle{
+			KubeVip:       releasev1.Image{URI: "public.ecr.aws/eks-anywhere/kube-vip/kube-vip:v0.8.0"},
+			CloudProvider: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/kube-vip/kube-vip-cloud-provider:v0.0.10"},
+		}
+	}
+	version := test.DevEksaVersion()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.22",
+			BundlesRef: &anywherev1.BundlesRef{
+				Name:       bundle.Name,
+				Namespace:  bundle.Namespace,
+				APIVersion: bundle.APIVersion,
+			},
+			EksaVersion: &version,
+			LoadBalancer: &anywherev1.LoadBalancerConfiguration{
+				AddressPools: []anywherev1.LoadBalancerAddressPool{
+					{Name: "default", Addresses: []string{"10.0.0.100-10.0.0.120"}},
+				},
+			},
+		},
+	}
+
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = "test"
+		kcp.Status = controlplanev1.KubeadmControlPlaneStatus{
+			Conditions: clusterv1.Conditions{
+				{
+					Type:               clusterapi.ReadyCondition,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version: ptr.To("test"),
+		}
+	})
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		cluster:              cluster,
+		bundle:               bundle,
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		managementObjs:       []runtime.Object{bundle, test.EKSARelease(), test.EksdRelease("1-22"), kcp},
+		workloadClient:       fake.NewClientBuilder().WithObjects(workloadObjs...).Build(),
+	}
+}
+
+func (tt *reconcilerTest) reconciler() *reconciler.Reconciler {
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	_ = controlplanev1.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tt.managementObjs...).Build()
+	return reconciler.New(cl, tt.remoteClientRegistry)
+}
+
+func (tt *reconcilerTest) expectGetWorkloadClient() {
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: constants.EksaSystemNamespace}).Return(tt.workloadClient, nil)
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNotConfigured(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.LoadBalancer = nil
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.cluster.Annotations).NotTo(HaveKey(reconciler.LoadBalancerInstalledAnnotation))
+}
+
+func TestReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.managementObjs = tt.managementObjs[:3]
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcileGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.Any()).Return(nil, errors.New("client error"))
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+}
+
+func TestReconcileAppliesObjects(t *testing.T) {
+	tt := newReconcilerTest(t)
+	// The fake client doesn't support server-side apply, so the applied objects are recorded instead.
+	applied := map[string]client.Object{}
+	tt.workloadClient = fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+			tt.Expect(patch.Type()).To(Equal(types.ApplyPatchType))
+			applied[obj.GetName()+"/"+obj.GetObjectKind().GroupVersionKind().Kind] = obj
+			return nil
+		},
+	}).Build()
+	tt.expectGetWorkloadClient()
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.cluster.Annotations).To(HaveKey(reconciler.LoadBalancerInstalledAnnotation))
+	tt.Expect(applied).To(HaveLen(9))
+
+	tt.Expect(applied).To(HaveKey(loadbalancer.ConfigMapName + "/ConfigMap"))
+	cm := applied[loadbalancer.ConfigMapName+"/ConfigMap"].(*corev1.ConfigMap)
+	tt.Expect(cm.Data).To(HaveKeyWithValue("range-global", "10.0.0.100-10.0.0.120"))
+
+	tt.Expect(applied).To(HaveKey(loadbalancer.KubeVipName + "/DaemonSet"))
+	ds := applied[loadbalancer.KubeVipName+"/DaemonSet"].(*appsv1.DaemonSet)
+	tt.Expect(ds.Spec.Template.Spec.Containers[0].Image).To(Equal("public.ecr.aws/eks-anywhere/kube-vip/kube-vip:v0.8.0"))
+}
+
+func TestReconcileMissingBundleImages(t *testing.T) {
+	tt := newReconcilerTest(t)
+	for i := range tt.bundle.Spec.VersionsBundles {
+		tt.bundle.Spec.VersionsBundles[i].LoadBalancer = nil
+	}
+	tt.expectGetWorkloadClient()
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("doesn't include the load balancer images")))
+	tt.Expect(tt.cluster.Annotations).NotTo(HaveKey(reconciler.LoadBalancerInstalledAnnotation))
+}
+
+func TestReconcileRemovedFromSpec(t *testing.T) {
+	tt := newReconcilerTest(t,
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: loadbalancer.ConfigMapName, Namespace: constants.KubeSystemNamespace}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: loadbalancer.KubeVipName, Namespace: constants.KubeSystemNamespace}},
+	)
+	tt.cluster.Spec.LoadBalancer = nil
+	tt.cluster.Annotations = map[string]string{reconciler.LoadBalancerInstalledAnnotation: ""}
+	tt.expectGetWorkloadClient()
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Annotations).NotTo(HaveKey(reconciler.LoadBalancerInstalledAnnotation))
+
+	err = tt.workloadClient.Get(tt.ctx, client.ObjectKey{Name: loadbalancer.ConfigMapName, Namespace: constants.KubeSystemNamespace}, &corev1.ConfigMap{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	err = tt.workloadClient.Get(tt.ctx, client.ObjectKey{Name: loadbalancer.KubeVipName, Namespace: constants.KubeSystemNamespace}, &appsv1.DaemonSet{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
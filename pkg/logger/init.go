@@ -2,6 +2,7 @@ package logger
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -45,10 +46,15 @@ func Init(opts Options) error {
 
 	// Build the encoders and logger.
 
+	var console io.Writer = os.Stdout
+	if opts.Console != nil {
+		console = opts.Console
+	}
+
 	fileEncoder := zapcore.NewJSONEncoder(encoderCfg)
 	consoleEncoder := zapcore.NewConsoleEncoder(encoderCfg)
 	core := zapcore.NewTee(
-		zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), logrAtomicLevel(opts.Level)),
+		zapcore.NewCore(consoleEncoder, zapcore.AddSync(console), logrAtomicLevel(opts.Level)),
 		zapcore.NewCore(fileEncoder, logFile, logrAtomicLevel(MaxLogLevel)),
 	)
 	logger := zap.New(core)
@@ -69,6 +75,9 @@ type Options struct {
 	// OutputFilePath is an absolute file path. The file will be created if it doesn't exist.
 	// All logs available at level 9 will be written to the file.
 	OutputFilePath string
+
+	// Console is where logs are written at the configured Level. Defaults to os.Stdout.
+	Console io.Writer
 }
 
 // logrAtomicLevel creates a zapcore.AtomicLevel compatible with go-logr.
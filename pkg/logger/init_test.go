@@ -43,3 +43,20 @@ func TestInit(t *testing.T) {
 		t.Fatalf("Log file does not contain expected message: %s", message)
 	}
 }
+
+func TestInitConsole(t *testing.T) {
+	var console bytes.Buffer
+	err := logger.Init(logger.Options{
+		Console: &console,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := "log me"
+	logger.Info(message)
+
+	if !bytes.Contains(console.Bytes(), []byte(message)) {
+		t.Fatalf("Console does not contain expected message: %s", message)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 	"github.com/aws/eks-anywhere/pkg/semver"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
@@ -16,10 +17,20 @@ import (
 // this is injected at build time, this is just a sane default for development.
 var manifestURL = "https://dev-release-assets.eks-anywhere.model-rocket.aws.dev/eks-a-release.yaml"
 
+// ManifestURL returns the url to the eksa releases manifest.
 func ManifestURL() string {
 	return manifestURL
 }
 
+// ManifestURLForSource returns the url to the eksa releases manifest the release source was built
+// from, the default one when there is no source.
+func ManifestURLForSource(source *releasesource.Source) string {
+	if source != nil && source.ReleaseManifestURL() != "" {
+		return source.ReleaseManifestURL()
+	}
+	return ManifestURL()
+}
+
 type Reader interface {
 	ReadFile(url string) ([]byte, error)
 }
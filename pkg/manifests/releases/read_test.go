@@ -10,9 +10,21 @@ import (
 
 	"github.com/aws/eks-anywhere/internal/test/mocks"
 	"github.com/aws/eks-anywhere/pkg/manifests/releases"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
+func TestManifestURLForSource(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	g.Expect(releasesource.NewBuilder(dir, "https://example.com/eks-a-release.yaml").Write()).To(Succeed())
+	source, err := releasesource.Load(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(releases.ManifestURLForSource(source)).To(Equal("https://example.com/eks-a-release.yaml"))
+	g.Expect(releases.ManifestURLForSource(nil)).To(Equal(releases.ManifestURL()))
+}
+
 func TestReadReleasesFromURL(t *testing.T) {
 	g := NewWithT(t)
 	ctrl := gomock.NewController(t)
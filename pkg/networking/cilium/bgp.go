@@ -0,0 +1,126 @@
+package cilium
+
+import (
+	_ "embed"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+//go:embed bgp_peering_policy.yaml
+var bgpPeeringPolicyTemplate string
+
+const (
+	// BGPPeeringPolicyName is the name of the CiliumBGPPeeringPolicy EKS-A creates for the
+	// BGP control plane.
+	BGPPeeringPolicyName = "eksa-bgp-peering-policy"
+
+	// BGPServiceOptOutLabel is the label that excludes a LoadBalancer service from the BGP
+	// advertisements when set to "false".
+	BGPServiceOptOutLabel = "anywhere.eks.amazonaws.com/bgp-advertise"
+)
+
+// BGPPeeringPolicyGVK is the GroupVersionKind of the CiliumBGPPeeringPolicy.
+var BGPPeeringPolicyGVK = schema.GroupVersionKind{
+	Group:   "cilium.io",
+	Version: "v2alpha1",
+	Kind:    "CiliumBGPPeeringPolicy",
+}
+
+// GenerateBGPPeeringPolicyManifest generates the CiliumBGPPeeringPolicy that peers all the
+// cluster nodes with the configured routers.
+func GenerateBGPPeeringPolicyManifest(bgp *anywherev1.CiliumBGPControlPlaneConfig) ([]byte, error) {
+	values := map[string]interface{}{
+		"name":               BGPPeeringPolicyName,
+		"localASN":           bgp.LocalASN,
+		"exportPodCIDR":      bgp.AdvertisePodCIDRs,
+		"advertiseServices":  bgp.AdvertiseServices,
+		"serviceOptOutLabel": BGPServiceOptOutLabel,
+		"neighbors":          bgpNeighbors(bgp),
+	}
+
+	return templater.Execute(bgpPeeringPolicyTemplate, values)
+}
+
+type bgpNeighbor struct {
+	PeerAddress string
+	PeerASN     int64
+}
+
+func bgpNeighbors(bgp *anywherev1.CiliumBGPControlPlaneConfig) []bgpNeighbor {
+	neighbors := make([]bgpNeighbor, 0, len(bgp.Peers))
+	for _, peer := range bgp.Peers {
+		neighbors = append(neighbors, bgpNeighbor{
+			PeerAddress: peerCIDR(peer.PeerAddress),
+			PeerASN:     peer.PeerASN,
+		})
+	}
+	return neighbors
+}
+
+// peerCIDR returns the host CIDR for a peer address, which is the format Cilium expects.
+func peerCIDR(address string) string {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return address
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()).String()
+}
+
+// bgpConfigValue summarizes the desired BGP configuration so it can be compared with
+// the one from an existing CiliumBGPPeeringPolicy. It's empty when BGP is disabled.
+func bgpConfigValue(bgp *anywherev1.CiliumBGPControlPlaneConfig) string {
+	if bgp == nil {
+		return ""
+	}
+	return formatBGPConfigValue(bgp.LocalASN, bgp.AdvertisePodCIDRs, bgp.AdvertiseServices, bgpNeighbors(bgp))
+}
+
+// bgpPolicyValue summarizes the configuration of an existing CiliumBGPPeeringPolicy in the
+// same format as bgpConfigValue. It's empty when there is no policy.
+func bgpPolicyValue(policy *unstructured.Unstructured) string {
+	if policy == nil {
+		return ""
+	}
+
+	routers, _, _ := unstructured.NestedSlice(policy.Object, "spec", "virtualRouters")
+	if len(routers) == 0 {
+		return ""
+	}
+	router, ok := routers[0].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	localASN, _, _ := unstructured.NestedInt64(router, "localASN")
+	exportPodCIDR, _, _ := unstructured.NestedBool(router, "exportPodCIDR")
+	_, advertiseServices, _ := unstructured.NestedMap(router, "serviceSelector")
+
+	rawNeighbors, _, _ := unstructured.NestedSlice(router, "neighbors")
+	neighbors := make([]bgpNeighbor, 0, len(rawNeighbors))
+	for _, n := range rawNeighbors {
+		neighbor, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		address, _, _ := unstructured.NestedString(neighbor, "peerAddress")
+		asn, _, _ := unstructured.NestedInt64(neighbor, "peerASN")
+		neighbors = append(neighbors, bgpNeighbor{PeerAddress: address, PeerASN: asn})
+	}
+
+	return formatBGPConfigValue(localASN, exportPodCIDR, advertiseServices, neighbors)
+}
+
+func formatBGPConfigValue(localASN int64, exportPodCIDR, advertiseServices bool, neighbors []bgpNeighbor) string {
+	peers := make([]string, 0, len(neighbors))
+	for _, n := range neighbors {
+		peers = append(peers, fmt.Sprintf("%s/AS%d", n.PeerAddress, n.PeerASN))
+	}
+	return fmt.Sprintf("AS%d exportPodCIDR=%t advertiseServices=%t peers=%s", localASN, exportPodCIDR, advertiseServices, strings.Join(peers, ","))
+}
@@ -7,6 +7,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -28,6 +30,10 @@ const (
 	ConfigMapName = "cilium-config"
 	// ServiceName is the default name for the Cilium Service installed in EKS-A clusters.
 	ServiceName = "cilium-agent"
+	// HubbleRelayName is the name of the Hubble relay Deployment and Service.
+	HubbleRelayName = "hubble-relay"
+	// HubbleUIName is the name of the Hubble UI Deployment and Service.
+	HubbleUIName = "hubble-ui"
 
 	ciliumConfigMapName   = "cilium-config"
 	ciliumConfigNamespace = "kube-system"
@@ -35,9 +41,12 @@ const (
 
 // Installation is an installation of EKSA Cilium components.
 type Installation struct {
-	DaemonSet *appsv1.DaemonSet
-	Operator  *appsv1.Deployment
-	ConfigMap *corev1.ConfigMap
+	DaemonSet        *appsv1.DaemonSet
+	Operator         *appsv1.Deployment
+	ConfigMap        *corev1.ConfigMap
+	HubbleRelay      *appsv1.Deployment
+	HubbleUI         *appsv1.Deployment
+	BGPPeeringPolicy *unstructured.Unstructured
 }
 
 // Installed determines if all EKS-A Embedded Cilium components are present. It identifies
@@ -53,15 +62,15 @@ func (i Installation) Installed() bool {
 	return i.DaemonSet != nil && i.Operator != nil && isEKSACilium
 }
 
-// GetInstallation creates a new Installation instance. The returned installation's DaemonSet,
-// Operator and ConfigMap fields will be nil if they could not be found within the target cluster.
+// GetInstallation creates a new Installation instance. The returned installation's fields
+// will be nil if they could not be found within the target cluster.
 func GetInstallation(ctx context.Context, client client.Client) (*Installation, error) {
 	ds, err := getDaemonSet(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
-	operator, err := getDeployment(ctx, client)
+	operator, err := getDeployment(ctx, client, DeploymentName)
 	if err != nil {
 		return nil, err
 	}
@@ -71,10 +80,28 @@ func GetInstallation(ctx context.Context, client client.Client) (*Installation,
 		return nil, err
 	}
 
+	hubbleRelay, err := getDeployment(ctx, client, HubbleRelayName)
+	if err != nil {
+		return nil, err
+	}
+
+	hubbleUI, err := getDeployment(ctx, client, HubbleUIName)
+	if err != nil {
+		return nil, err
+	}
+
+	bgpPeeringPolicy, err := getBGPPeeringPolicy(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Installation{
-		DaemonSet: ds,
-		Operator:  operator,
-		ConfigMap: cm,
+		DaemonSet:        ds,
+		Operator:         operator,
+		ConfigMap:        cm,
+		HubbleRelay:      hubbleRelay,
+		HubbleUI:         hubbleUI,
+		BGPPeeringPolicy: bgpPeeringPolicy,
 	}, nil
 }
 
@@ -104,10 +131,10 @@ func getConfigMap(ctx context.Context, client client.Client, name string, namesp
 	return c, nil
 }
 
-func getDeployment(ctx context.Context, client client.Client) (*appsv1.Deployment, error) {
+func getDeployment(ctx context.Context, client client.Client, name string) (*appsv1.Deployment, error) {
 	deployment := &appsv1.Deployment{}
 	key := types.NamespacedName{
-		Name:      DeploymentName,
+		Name:      name,
 		Namespace: constants.KubeSystemNamespace,
 	}
 	err := client.Get(ctx, key, deployment)
@@ -120,3 +147,19 @@ func getDeployment(ctx context.Context, client client.Client) (*appsv1.Deploymen
 
 	return deployment, nil
 }
+
+// getBGPPeeringPolicy returns nil when the policy doesn't exist, including when the Cilium
+// operator hasn't created the CRD yet.
+func getBGPPeeringPolicy(ctx context.Context, client client.Client) (*unstructured.Unstructured, error) {
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(BGPPeeringPolicyGVK)
+	err := client.Get(ctx, types.NamespacedName{Name: BGPPeeringPolicyName}, policy)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,101 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium"
+)
+
+const kubeProxyName = "kube-proxy"
+
+// deleteDisabledComponents removes the optional Cilium components that have been turned off.
+// Applying the new manifest doesn't delete the objects that are no longer part of it.
+func (r *Reconciler) deleteDisabledComponents(ctx context.Context, logger logr.Logger, c client.Client, installation *cilium.Installation, spec *cluster.Spec) error {
+	ciliumCfg := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium
+
+	if installation.HubbleRelay != nil && (ciliumCfg.Hubble == nil || !ciliumCfg.Hubble.Relay) {
+		logger.Info("Deleting Hubble relay")
+		if err := deleteKubeSystemObjects(ctx, c, hubbleObjects(cilium.HubbleRelayName, "hubble-relay-config")...); err != nil {
+			return errors.Wrap(err, "deleting hubble relay")
+		}
+	}
+
+	if installation.HubbleUI != nil && (ciliumCfg.Hubble == nil || !ciliumCfg.Hubble.UI) {
+		logger.Info("Deleting Hubble UI")
+		if err := deleteKubeSystemObjects(ctx, c, hubbleObjects(cilium.HubbleUIName, "hubble-ui-nginx")...); err != nil {
+			return errors.Wrap(err, "deleting hubble ui")
+		}
+	}
+
+	if installation.BGPPeeringPolicy != nil && ciliumCfg.BGPControlPlane == nil {
+		logger.Info("Deleting Cilium BGP peering policy")
+		if err := client.IgnoreNotFound(c.Delete(ctx, installation.BGPPeeringPolicy)); err != nil {
+			return errors.Wrap(err, "deleting cilium bgp peering policy")
+		}
+	}
+
+	return nil
+}
+
+// removeKubeProxy deletes the kube-proxy DaemonSet once all the Cilium agents run with
+// kube-proxy replacement, so services are never left without a proxy.
+func (r *Reconciler) removeKubeProxy(ctx context.Context, logger logr.Logger, c client.Client, installation *cilium.Installation, upgradeInfo cilium.UpgradePlan) (controller.Result, error) {
+	kubeProxy, err := getDaemonSet(ctx, c, kubeProxyName)
+	if err != nil {
+		return controller.Result{}, err
+	}
+	if kubeProxy == nil {
+		return controller.Result{}, nil
+	}
+
+	// The Cilium pods are rolled out after a config change, so the installation we have is stale.
+	if upgradeInfo.Needed() {
+		logger.Info("Cilium config updated, requeueing to remove kube-proxy once the Cilium agents are ready")
+		return controller.ResultWithRequeue(defaultRequeueTime), nil
+	}
+
+	if err := cilium.CheckDaemonSetReady(installation.DaemonSet); err != nil {
+		logger.Info("Cilium DS is not ready, requeueing before removing kube-proxy", "reason", err.Error())
+		return controller.ResultWithRequeue(defaultRequeueTime), nil
+	}
+
+	if status := installation.DaemonSet.Status; status.UpdatedNumberScheduled != status.DesiredNumberScheduled {
+		logger.Info("Cilium DS is rolling out, requeueing before removing kube-proxy", "updated", status.UpdatedNumberScheduled, "desired", status.DesiredNumberScheduled)
+		return controller.ResultWithRequeue(defaultRequeueTime), nil
+	}
+
+	logger.Info("Deleting kube-proxy, Cilium is handling services")
+	if err := client.IgnoreNotFound(c.Delete(ctx, kubeProxy)); err != nil {
+		return controller.Result{}, errors.Wrap(err, "deleting kube-proxy")
+	}
+
+	return controller.Result{}, nil
+}
+
+func hubbleObjects(name, configMapName string) []client.Object {
+	return []client.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: configMapName}},
+	}
+}
+
+func deleteKubeSystemObjects(ctx context.Context, c client.Client, objs ...client.Object) error {
+	for _, o := range objs {
+		o.SetNamespace(constants.KubeSystemNamespace)
+		if err := client.IgnoreNotFound(c.Delete(ctx, o)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
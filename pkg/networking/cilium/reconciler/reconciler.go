@@ -107,6 +107,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client c
 
 		markCiliumInstalled(ctx, spec.Cluster)
 		conditions.MarkTrue(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition)
+
+		if ciliumCfg.KubeProxyReplacement {
+			// kube-proxy can only be removed once the new Cilium agents are ready.
+			return controller.ResultWithRequeue(defaultRequeueTime), nil
+		}
 		return controller.Result{}, nil
 	}
 
@@ -137,6 +142,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client c
 		logger.Info("Cilium is already up to date")
 	}
 
+	if err := r.deleteDisabledComponents(ctx, logger, client, installation, spec); err != nil {
+		return controller.Result{}, err
+	}
+
+	if ciliumCfg.KubeProxyReplacement {
+		if result, err := r.removeKubeProxy(ctx, logger, client, installation, upgradeInfo); err != nil || result.Return() {
+			return result, err
+		}
+	}
+
 	// Upgrade process has run its course, and so we can now mark that the default cni has been configured.
 	conditions.MarkTrue(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition)
 
@@ -437,6 +437,55 @@ func TestReconcilerReconcileUpdateConfigConfigMapEnablePolicyChange(t *testing.T
 	tt.expectDefaultCNIConfigured(defaultCNIConfiguredCondition("True", "", "", ""))
 }
 
+func TestReconcilerReconcileDeletesDisabledHubbleRelay(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	relay := simpleDeployment(cilium.HubbleRelayName, "hubble-relay:1.10.1")
+	tt := newReconcileTest(t).withObjects(ds, operator, cm, relay)
+
+	tt.templater.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Not(gomock.Nil())).Return(tt.buildManifest(ds, operator, cm), nil)
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.Result{}),
+	)
+	tt.expectDeploymentToNotExist(cilium.HubbleRelayName, "kube-system")
+	tt.expectDefaultCNIConfigured(defaultCNIConfiguredCondition("True", "", "", ""))
+}
+
+func TestReconcilerReconcileKubeProxyReplacementRemovesKubeProxy(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	cm.Data[cilium.KubeProxyReplacementConfigMapKey] = "true"
+	kubeProxy := simpleDaemonSet("kube-proxy", "kube-proxy:v1.19.8")
+	tt := newReconcileTest(t).withObjects(ds, operator, cm, kubeProxy)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = true
+	tt.makeCiliumDaemonSetReady()
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.Result{}),
+	)
+	tt.expectDSToNotExist("kube-proxy", "kube-system")
+	tt.expectDefaultCNIConfigured(defaultCNIConfiguredCondition("True", "", "", ""))
+}
+
+func TestReconcilerReconcileKubeProxyReplacementWaitsForConfigUpdate(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	kubeProxy := simpleDaemonSet("kube-proxy", "kube-proxy:v1.19.8")
+	tt := newReconcileTest(t).withObjects(ds, operator, cm, kubeProxy)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = true
+
+	tt.templater.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Not(gomock.Nil())).Return(tt.buildManifest(ds, operator, cm), nil)
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.ResultWithRequeue(10 * time.Second)),
+	)
+	tt.Expect(tt.getDaemonSet("kube-proxy", "kube-system")).NotTo(BeNil())
+}
+
 func TestReconcilerReconcileSkipUpgradeWithoutCiliumInstalled(t *testing.T) {
 	ds := ciliumDaemonSet()
 	operator := ciliumOperator()
@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"net/netip"
 	"strings"
 	"time"
 
@@ -158,6 +159,14 @@ func (t *Templater) GenerateManifest(ctx context.Context, spec *cluster.Spec, op
 		manifest = templater.AppendYamlResources(manifest, networkPolicyManifest)
 	}
 
+	if bgp := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.BGPControlPlane; bgp != nil {
+		bgpPeeringPolicyManifest, err := GenerateBGPPeeringPolicyManifest(bgp)
+		if err != nil {
+			return nil, err
+		}
+		manifest = templater.AppendYamlResources(manifest, bgpPeeringPolicyManifest)
+	}
+
 	return manifest, nil
 }
 
@@ -228,6 +237,17 @@ func templateValues(spec *cluster.Spec, versionsBundle *cluster.VersionsBundle)
 		val["operator"].(values)["replicas"] = 1
 	}
 
+	if spec.Cluster.Spec.ClusterNetwork.HasIPFamily(anywherev1.IPv6Family) {
+		val["ipv6"] = values{
+			"enabled": true,
+		}
+		if !spec.Cluster.Spec.ClusterNetwork.HasIPFamily(anywherev1.IPv4Family) {
+			val["ipv4"] = values{
+				"enabled": false,
+			}
+		}
+	}
+
 	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.PolicyEnforcementMode != "" {
 		val["policyEnforcementMode"] = spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.PolicyEnforcementMode
 	}
@@ -251,9 +271,48 @@ func templateValues(spec *cluster.Spec, versionsBundle *cluster.VersionsBundle)
 
 	}
 
+	// Hubble is left to the chart defaults when not configured so existing clusters don't
+	// see their Hubble settings change on upgrade.
+	if hubble := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble; hubble != nil {
+		val["hubble"] = values{
+			"enabled": true,
+			"relay": values{
+				"enabled": hubble.Relay,
+			},
+			"ui": values{
+				"enabled": hubble.UI,
+			},
+		}
+	}
+
+	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.BGPControlPlane != nil {
+		val["bgpControlPlane"] = values{
+			"enabled": true,
+		}
+	}
+
+	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement {
+		// Without kube-proxy the agents can't use the kubernetes service to reach the API server.
+		host, port := controlPlaneHostPort(spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+		val["kubeProxyReplacement"] = "true"
+		val["k8sServiceHost"] = host
+		val["k8sServicePort"] = port
+	}
+
 	return val
 }
 
+func controlPlaneHostPort(endpoint string) (host, port string) {
+	if _, err := netip.ParseAddr(endpoint); err == nil {
+		return endpoint, anywherev1.ControlEndpointDefaultPort
+	}
+	host, port, err := anywherev1.GetControlPlaneHostPort(endpoint, anywherev1.ControlEndpointDefaultPort)
+	if err != nil {
+		return endpoint, anywherev1.ControlEndpointDefaultPort
+	}
+	return host, port
+}
+
 func getChartURIAndVersion(versionsBundle *cluster.VersionsBundle) (uri, version string) {
 	chart := versionsBundle.Cilium.HelmChart
 	uri = fmt.Sprintf("oci://%s", chart.Image())
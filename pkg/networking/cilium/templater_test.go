@@ -381,6 +381,139 @@ func TestTemplaterGenerateManifestDirectModeManualIPCIDRSuccess(t *testing.T) {
 	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
 }
 
+func TestTemplaterGenerateManifestIPFamilies(t *testing.T) {
+	tests := []struct {
+		name       string
+		podCIDRs   []string
+		wantValues map[string]interface{}
+	}{
+		{
+			name:     "ipv6",
+			podCIDRs: []string{"fd00:100::/56"},
+			wantValues: map[string]interface{}{
+				"ipv4": map[string]interface{}{"enabled": false},
+				"ipv6": map[string]interface{}{"enabled": true},
+			},
+		},
+		{
+			name:     "dual-stack",
+			podCIDRs: []string{"192.168.0.0/16", "fd00:100::/56"},
+			wantValues: map[string]interface{}{
+				"ipv6": map[string]interface{}{"enabled": true},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			wantValues := map[string]interface{}{
+				"cni": map[string]interface{}{
+					"chainingMode": "portmap",
+				},
+				"ipam": map[string]interface{}{
+					"mode": "kubernetes",
+				},
+				"identityAllocationMode": "crd",
+				"prometheus": map[string]interface{}{
+					"enabled": true,
+				},
+				"rollOutCiliumPods": true,
+				"routingMode":       "tunnel",
+				"tunnelProtocol":    "geneve",
+				"image": map[string]interface{}{
+					"repository": "public.ecr.aws/isovalent/cilium",
+					"tag":        "v1.9.11-eksa.1",
+				},
+				"operator": map[string]interface{}{
+					"image": map[string]interface{}{
+						"repository": "public.ecr.aws/isovalent/operator",
+						"tag":        "v1.9.11-eksa.1",
+					},
+					"prometheus": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			}
+			for k, v := range tc.wantValues {
+				wantValues[k] = v
+			}
+
+			tt := newtemplaterTest(t)
+			tt.spec.Cluster.Spec.ManagementCluster.Name = "managed"
+			tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = tc.podCIDRs
+			tt.expectHelmClientFactoryGet("", "")
+			tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+			tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
+		})
+	}
+}
+
+func TestTemplaterGenerateManifestOptionalFeatures(t *testing.T) {
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.2.3.4"}
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium = &v1alpha1.CiliumConfig{
+		Hubble: &v1alpha1.CiliumHubbleConfig{Relay: true, UI: true},
+		BGPControlPlane: &v1alpha1.CiliumBGPControlPlaneConfig{
+			LocalASN:          65000,
+			AdvertisePodCIDRs: true,
+			AdvertiseServices: true,
+			Peers: []v1alpha1.CiliumBGPPeer{
+				{PeerAddress: "10.0.0.1", PeerASN: 65001},
+				{PeerAddress: "fd00::1", PeerASN: 65002},
+			},
+		},
+		KubeProxyReplacement: true,
+	}
+
+	wantValues := map[string]interface{}{
+		"cni": map[string]interface{}{
+			"chainingMode": "portmap",
+		},
+		"ipam": map[string]interface{}{
+			"mode": "kubernetes",
+		},
+		"identityAllocationMode": "crd",
+		"prometheus": map[string]interface{}{
+			"enabled": true,
+		},
+		"rollOutCiliumPods": true,
+		"routingMode":       "tunnel",
+		"tunnelProtocol":    "geneve",
+		"image": map[string]interface{}{
+			"repository": "public.ecr.aws/isovalent/cilium",
+			"tag":        "v1.9.11-eksa.1",
+		},
+		"operator": map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": "public.ecr.aws/isovalent/operator",
+				"tag":        "v1.9.11-eksa.1",
+			},
+			"prometheus": map[string]interface{}{
+				"enabled": true,
+			},
+		},
+		"hubble": map[string]interface{}{
+			"enabled": true,
+			"relay":   map[string]interface{}{"enabled": true},
+			"ui":      map[string]interface{}{"enabled": true},
+		},
+		"bgpControlPlane": map[string]interface{}{
+			"enabled": true,
+		},
+		"kubeProxyReplacement": "true",
+		"k8sServiceHost":       "1.2.3.4",
+		"k8sServicePort":       "6443",
+	}
+
+	tt.expectHelmClientFactoryGet("", "")
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	manifest, err := tt.t.GenerateManifest(tt.ctx, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/manifest_bgp_peering_policy.yaml")
+}
+
 func TestTemplaterGenerateManifestError(t *testing.T) {
 	expectedAttempts := 2
 	tt := newtemplaterTest(t)
@@ -2,6 +2,7 @@ package cilium
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -27,6 +28,38 @@ const (
 	// EgressMasqueradeInterfacesComponentName is the ConfigComponentUpdatePlan name for the
 	// egressMasqueradeInterfaces configuration component.
 	EgressMasqueradeInterfacesComponentName = "EgressMasqueradeInterfaces"
+
+	// HubbleConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store whether Hubble is enabled.
+	HubbleConfigMapKey = "enable-hubble"
+
+	// HubbleComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble configuration component.
+	HubbleComponentName = "Hubble"
+
+	// HubbleRelayComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble relay configuration component.
+	HubbleRelayComponentName = "HubbleRelay"
+
+	// HubbleUIComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble UI configuration component.
+	HubbleUIComponentName = "HubbleUI"
+
+	// BGPControlPlaneConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store whether the BGP control plane is enabled.
+	BGPControlPlaneConfigMapKey = "enable-bgp-control-plane"
+
+	// BGPControlPlaneComponentName is the ConfigComponentUpdatePlan name for the
+	// BGP control plane configuration component.
+	BGPControlPlaneComponentName = "BGPControlPlane"
+
+	// KubeProxyReplacementConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store the value for the KubeProxyReplacement.
+	KubeProxyReplacementConfigMapKey = "kube-proxy-replacement"
+
+	// KubeProxyReplacementComponentName is the ConfigComponentUpdatePlan name for the
+	// KubeProxyReplacement configuration component.
+	KubeProxyReplacementComponentName = "KubeProxyReplacement"
 )
 
 // UpgradePlan contains information about a Cilium installation upgrade.
@@ -138,7 +171,7 @@ func BuildUpgradePlan(installation *Installation, clusterSpec *cluster.Spec) Upg
 	return UpgradePlan{
 		DaemonSet: daemonSetUpgradePlan(installation.DaemonSet, clusterSpec),
 		Operator:  operatorUpgradePlan(installation.Operator, clusterSpec),
-		ConfigMap: configMapUpgradePlan(installation.ConfigMap, clusterSpec),
+		ConfigMap: configMapUpgradePlan(installation, clusterSpec),
 	}
 }
 
@@ -199,7 +232,8 @@ func operatorUpgradePlan(operator *appsv1.Deployment, clusterSpec *cluster.Spec)
 	return info
 }
 
-func configMapUpgradePlan(configMap *corev1.ConfigMap, clusterSpec *cluster.Spec) ConfigUpdatePlan {
+func configMapUpgradePlan(installation *Installation, clusterSpec *cluster.Spec) ConfigUpdatePlan {
+	configMap := installation.ConfigMap
 	updatePlan := &ConfigUpdatePlan{}
 
 	var newEnforcementPolicy string
@@ -247,11 +281,80 @@ func configMapUpgradePlan(configMap *corev1.ConfigMap, clusterSpec *cluster.Spec
 
 	updatePlan.Components = append(updatePlan.Components, egressMasqueradeUpdate)
 
+	if configMap != nil {
+		updatePlan.Components = append(updatePlan.Components, optionalComponentsUpdatePlan(installation, clusterSpec)...)
+	}
+
 	updatePlan.generateUpdateReasonFromComponents()
 
 	return *updatePlan
 }
 
+// optionalComponentsUpdatePlan compares the optional Cilium features with the installation.
+// A feature is only part of the plan when it's configured or installed, so clusters that
+// don't use them keep the chart defaults.
+func optionalComponentsUpdatePlan(installation *Installation, clusterSpec *cluster.Spec) []ConfigComponentUpdatePlan {
+	ciliumConfig := clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium
+	configMap := installation.ConfigMap
+	var components []ConfigComponentUpdatePlan
+
+	if ciliumConfig.Hubble != nil {
+		components = append(components, boolComponentUpdatePlan(
+			HubbleComponentName, configMap.Data[HubbleConfigMapKey] == "true", true,
+		))
+	}
+
+	wantRelay := ciliumConfig.Hubble != nil && ciliumConfig.Hubble.Relay
+	if wantRelay || installation.HubbleRelay != nil {
+		components = append(components, boolComponentUpdatePlan(
+			HubbleRelayComponentName, installation.HubbleRelay != nil, wantRelay,
+		))
+	}
+
+	wantUI := ciliumConfig.Hubble != nil && ciliumConfig.Hubble.UI
+	if wantUI || installation.HubbleUI != nil {
+		components = append(components, boolComponentUpdatePlan(
+			HubbleUIComponentName, installation.HubbleUI != nil, wantUI,
+		))
+	}
+
+	var oldBGP string
+	if configMap.Data[BGPControlPlaneConfigMapKey] == "true" {
+		oldBGP = bgpPolicyValue(installation.BGPPeeringPolicy)
+	}
+	if newBGP := bgpConfigValue(ciliumConfig.BGPControlPlane); oldBGP != "" || newBGP != "" {
+		bgpUpdate := ConfigComponentUpdatePlan{
+			Name:     BGPControlPlaneComponentName,
+			OldValue: oldBGP,
+			NewValue: newBGP,
+		}
+		if oldBGP != newBGP {
+			bgpUpdate.UpdateReason = fmt.Sprintf("BGP control plane changed: [%s] -> [%s]", oldBGP, newBGP)
+		}
+		components = append(components, bgpUpdate)
+	}
+
+	if ciliumConfig.KubeProxyReplacement || configMap.Data[KubeProxyReplacementConfigMapKey] == "true" {
+		components = append(components, boolComponentUpdatePlan(
+			KubeProxyReplacementComponentName, configMap.Data[KubeProxyReplacementConfigMapKey] == "true", ciliumConfig.KubeProxyReplacement,
+		))
+	}
+
+	return components
+}
+
+func boolComponentUpdatePlan(name string, oldValue, newValue bool) ConfigComponentUpdatePlan {
+	update := ConfigComponentUpdatePlan{
+		Name:     name,
+		OldValue: strconv.FormatBool(oldValue),
+		NewValue: strconv.FormatBool(newValue),
+	}
+	if oldValue != newValue {
+		update.UpdateReason = fmt.Sprintf("%s changed: [%t] -> [%t]", name, oldValue, newValue)
+	}
+	return update
+}
+
 // ChangeDiff returns the change diff between the current and new cluster specs.
 func ChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
 	return ciliumChangeDiff(currentSpec, newSpec)
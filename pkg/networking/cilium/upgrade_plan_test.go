@@ -7,6 +7,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -587,6 +588,156 @@ func ciliumConfigMap(enforcementMode, egressMasqueradeInterface string, opts ...
 	return cm
 }
 
+func TestBuildUpgradePlanOptionalComponents(t *testing.T) {
+	bgp := &anywherev1.CiliumBGPControlPlaneConfig{
+		LocalASN:          65000,
+		AdvertisePodCIDRs: true,
+		Peers:             []anywherev1.CiliumBGPPeer{{PeerAddress: "10.0.0.1", PeerASN: 65001}},
+	}
+	bgpValue := "AS65000 exportPodCIDR=true advertiseServices=false peers=10.0.0.1/32/AS65001"
+	bgpPolicy := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"virtualRouters": []interface{}{
+				map[string]interface{}{
+					"localASN":      int64(65000),
+					"exportPodCIDR": true,
+					"neighbors": []interface{}{
+						map[string]interface{}{"peerAddress": "10.0.0.1/32", "peerASN": int64(65001)},
+					},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name           string
+		installation   *cilium.Installation
+		ciliumConfig   *anywherev1.CiliumConfig
+		wantComponents []cilium.ConfigComponentUpdatePlan
+		wantReason     string
+	}{
+		{
+			name: "hubble enabled with relay and ui",
+			installation: &cilium.Installation{
+				ConfigMap: ciliumConfigMap("default", ""),
+			},
+			ciliumConfig: &anywherev1.CiliumConfig{
+				Hubble: &anywherev1.CiliumHubbleConfig{Relay: true, UI: true},
+			},
+			wantComponents: []cilium.ConfigComponentUpdatePlan{
+				{Name: cilium.HubbleComponentName, OldValue: "false", NewValue: "true", UpdateReason: "Hubble changed: [false] -> [true]"},
+				{Name: cilium.HubbleRelayComponentName, OldValue: "false", NewValue: "true", UpdateReason: "HubbleRelay changed: [false] -> [true]"},
+				{Name: cilium.HubbleUIComponentName, OldValue: "false", NewValue: "true", UpdateReason: "HubbleUI changed: [false] -> [true]"},
+			},
+			wantReason: "Hubble changed: [false] -> [true] - HubbleRelay changed: [false] -> [true] - HubbleUI changed: [false] -> [true]",
+		},
+		{
+			name: "hubble ui disabled",
+			installation: &cilium.Installation{
+				ConfigMap: ciliumConfigMap("default", "", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.HubbleConfigMapKey] = "true"
+				}),
+				HubbleRelay: deployment("hubble-relay:v1.0.0"),
+				HubbleUI:    deployment("hubble-ui:v1.0.0"),
+			},
+			ciliumConfig: &anywherev1.CiliumConfig{
+				Hubble: &anywherev1.CiliumHubbleConfig{Relay: true},
+			},
+			wantComponents: []cilium.ConfigComponentUpdatePlan{
+				{Name: cilium.HubbleComponentName, OldValue: "true", NewValue: "true"},
+				{Name: cilium.HubbleRelayComponentName, OldValue: "true", NewValue: "true"},
+				{Name: cilium.HubbleUIComponentName, OldValue: "true", NewValue: "false", UpdateReason: "HubbleUI changed: [true] -> [false]"},
+			},
+			wantReason: "HubbleUI changed: [true] -> [false]",
+		},
+		{
+			name: "bgp up to date",
+			installation: &cilium.Installation{
+				ConfigMap: ciliumConfigMap("default", "", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.BGPControlPlaneConfigMapKey] = "true"
+				}),
+				BGPPeeringPolicy: bgpPolicy,
+			},
+			ciliumConfig: &anywherev1.CiliumConfig{
+				BGPControlPlane: bgp,
+			},
+			wantComponents: []cilium.ConfigComponentUpdatePlan{
+				{Name: cilium.BGPControlPlaneComponentName, OldValue: bgpValue, NewValue: bgpValue},
+			},
+		},
+		{
+			name: "bgp peer changed",
+			installation: &cilium.Installation{
+				ConfigMap: ciliumConfigMap("default", "", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.BGPControlPlaneConfigMapKey] = "true"
+				}),
+				BGPPeeringPolicy: bgpPolicy,
+			},
+			ciliumConfig: &anywherev1.CiliumConfig{
+				BGPControlPlane: &anywherev1.CiliumBGPControlPlaneConfig{
+					LocalASN:          65000,
+					AdvertisePodCIDRs: true,
+					Peers:             []anywherev1.CiliumBGPPeer{{PeerAddress: "10.0.0.2", PeerASN: 65001}},
+				},
+			},
+			wantComponents: []cilium.ConfigComponentUpdatePlan{
+				{
+					Name:         cilium.BGPControlPlaneComponentName,
+					OldValue:     bgpValue,
+					NewValue:     "AS65000 exportPodCIDR=true advertiseServices=false peers=10.0.0.2/32/AS65001",
+					UpdateReason: "BGP control plane changed: [" + bgpValue + "] -> [AS65000 exportPodCIDR=true advertiseServices=false peers=10.0.0.2/32/AS65001]",
+				},
+			},
+			wantReason: "BGP control plane changed: [" + bgpValue + "] -> [AS65000 exportPodCIDR=true advertiseServices=false peers=10.0.0.2/32/AS65001]",
+		},
+		{
+			name: "bgp disabled",
+			installation: &cilium.Installation{
+				ConfigMap: ciliumConfigMap("default", "", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.BGPControlPlaneConfigMapKey] = "true"
+				}),
+				BGPPeeringPolicy: bgpPolicy,
+			},
+			ciliumConfig: &anywherev1.CiliumConfig{},
+			wantComponents: []cilium.ConfigComponentUpdatePlan{
+				{Name: cilium.BGPControlPlaneComponentName, OldValue: bgpValue, UpdateReason: "BGP control plane changed: [" + bgpValue + "] -> []"},
+			},
+			wantReason: "BGP control plane changed: [" + bgpValue + "] -> []",
+		},
+		{
+			name: "kube proxy replacement enabled",
+			installation: &cilium.Installation{
+				ConfigMap: ciliumConfigMap("default", "", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.KubeProxyReplacementConfigMapKey] = "false"
+				}),
+			},
+			ciliumConfig: &anywherev1.CiliumConfig{
+				KubeProxyReplacement: true,
+			},
+			wantComponents: []cilium.ConfigComponentUpdatePlan{
+				{Name: cilium.KubeProxyReplacementComponentName, OldValue: "false", NewValue: "true", UpdateReason: "KubeProxyReplacement changed: [false] -> [true]"},
+			},
+			wantReason: "KubeProxyReplacement changed: [false] -> [true]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			tt.ciliumConfig.PolicyEnforcementMode = anywherev1.CiliumPolicyModeDefault
+			spec := test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: tt.ciliumConfig,
+				}
+			})
+
+			got := cilium.BuildUpgradePlan(tt.installation, spec).ConfigMap
+			g.Expect(got.Components[2:]).To(Equal(tt.wantComponents))
+			g.Expect(got.UpdateReason).To(Equal(tt.wantReason))
+		})
+	}
+}
+
 func TestConfigUpdatePlanNeeded(t *testing.T) {
 	tests := []struct {
 		name string
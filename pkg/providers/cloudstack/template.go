@@ -198,6 +198,8 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		"eksaSystemNamespace":                        constants.EksaSystemNamespace,
 	}
 
+	values["skipPhases"] = clusterapi.InitSkipPhases(clusterSpec.Cluster)
+
 	auditPolicy, err := common.GetAuditPolicy(clusterSpec.Cluster.Spec.KubernetesVersion)
 	if err != nil {
 		return nil, err
@@ -213,12 +215,22 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorFallbacks"] = containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors())
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		values["insecureSkip"] = registryMirror.InsecureSkipVerify
 		values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
 		if len(registryMirror.CACertContent) > 0 {
 			values["registryCACert"] = registryMirror.CACertContent
 		}
+
+		hosts, err := containerd.HostConfigs(registryMirror)
+		if err != nil {
+			return values, err
+		}
+		values["registryMirrorHosts"] = hosts
+		if caBundle := containerd.CACertBundle(registryMirror); len(caBundle) > 0 {
+			values["registryCACertBundle"] = caBundle
+		}
 	}
 
 	if clusterSpec.Cluster.Spec.ProxyConfiguration != nil {
@@ -396,11 +408,21 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupConfiguration
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorFallbacks"] = containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors())
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		values["insecureSkip"] = registryMirror.InsecureSkipVerify
 		if len(registryMirror.CACertContent) > 0 {
 			values["registryCACert"] = registryMirror.CACertContent
 		}
+
+		hosts, err := containerd.HostConfigs(registryMirror)
+		if err != nil {
+			return values, err
+		}
+		values["registryMirrorHosts"] = hosts
+		if caBundle := containerd.CACertBundle(registryMirror); len(caBundle) > 0 {
+			values["registryCACertBundle"] = caBundle
+		}
 	}
 
 	if clusterSpec.Cluster.Spec.ProxyConfiguration != nil {
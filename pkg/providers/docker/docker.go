@@ -315,6 +315,8 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		"apiServerCertSANs":             clusterSpec.Cluster.Spec.ControlPlaneConfiguration.CertSANs,
 	}
 
+	values["skipPhases"] = clusterapi.InitSkipPhases(clusterSpec.Cluster)
+
 	if clusterSpec.Cluster.Spec.ExternalEtcdConfiguration != nil {
 		values["externalEtcd"] = true
 		values["externalEtcdReplicas"] = clusterSpec.Cluster.Spec.ExternalEtcdConfiguration.Count
@@ -804,6 +806,7 @@ func (p *Provider) PreCoreComponentsUpgrade(
 func populateRegistryMirrorValues(clusterSpec *cluster.Spec, values map[string]interface{}) (map[string]interface{}, error) {
 	registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 	values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+	values["registryMirrorFallbacks"] = containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors())
 	values["mirrorBase"] = registryMirror.BaseRegistry
 	values["insecureSkip"] = registryMirror.InsecureSkipVerify
 	values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
@@ -811,6 +814,15 @@ func populateRegistryMirrorValues(clusterSpec *cluster.Spec, values map[string]i
 		values["registryCACert"] = registryMirror.CACertContent
 	}
 
+	hosts, err := containerd.HostConfigs(registryMirror)
+	if err != nil {
+		return values, err
+	}
+	values["registryMirrorHosts"] = hosts
+	if caBundle := containerd.CACertBundle(registryMirror); len(caBundle) > 0 {
+		values["registryCACertBundle"] = caBundle
+	}
+
 	if registryMirror.Auth {
 		values["registryAuth"] = registryMirror.Auth
 		username, password, err := config.ReadCredentials()
@@ -229,6 +229,8 @@ func buildTemplateMapCP(
 		"nutanixPCPassword":            creds.PrismCentral.BasicAuth.Password,
 	}
 
+	values["skipPhases"] = clusterapi.InitSkipPhases(clusterSpec.Cluster)
+
 	if controlPlaneMachineSpec.Project != nil {
 		values["projectIDType"] = controlPlaneMachineSpec.Project.Type
 		values["projectName"] = controlPlaneMachineSpec.Project.Name
@@ -246,6 +248,7 @@ func buildTemplateMapCP(
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorFallbacks"] = containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors())
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
 		values["insecureSkip"] = registryMirror.InsecureSkipVerify
@@ -253,6 +256,15 @@ func buildTemplateMapCP(
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		hosts, err := containerd.HostConfigs(registryMirror)
+		if err != nil {
+			return values, err
+		}
+		values["registryMirrorHosts"] = hosts
+		if caBundle := containerd.CACertBundle(registryMirror); len(caBundle) > 0 {
+			values["registryCACertBundle"] = caBundle
+		}
+
 		if registryMirror.Auth {
 			values["registryAuth"] = registryMirror.Auth
 			username, password, err := config.ReadCredentials()
@@ -436,6 +448,7 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorFallbacks"] = containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors())
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
 		values["insecureSkip"] = registryMirror.InsecureSkipVerify
@@ -443,6 +456,15 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		hosts, err := containerd.HostConfigs(registryMirror)
+		if err != nil {
+			return values, err
+		}
+		values["registryMirrorHosts"] = hosts
+		if caBundle := containerd.CACertBundle(registryMirror); len(caBundle) > 0 {
+			values["registryCACertBundle"] = caBundle
+		}
+
 		if registryMirror.Auth {
 			values["registryAuth"] = registryMirror.Auth
 			username, password, err := config.ReadCredentials()
@@ -90,6 +90,9 @@ func (cm *ConfigManager) snowEntry(ctx context.Context) *cluster.ConfigManagerEn
 			func(c *cluster.Config) error {
 				return cm.validator.ValidateControlPlaneIP(ctx, c.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
 			},
+			func(c *cluster.Config) error {
+				return cm.validator.ValidateIPPoolsIPFamily(c)
+			},
 		},
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
 )
 
 const (
@@ -229,3 +230,35 @@ func (v *Validator) ValidateControlPlaneIP(ctx context.Context, controlPlaneIP s
 
 	return nil
 }
+
+// ValidateIPPoolsIPFamily checks the ip pools of the primary DNIs, which provide the node IPs,
+// belong to the cluster primary IP family.
+func (v *Validator) ValidateIPPoolsIPFamily(c *cluster.Config) error {
+	families := c.Cluster.Spec.ClusterNetwork.IPFamilies()
+	if len(families) == 0 {
+		return nil
+	}
+
+	for _, m := range c.SnowMachineConfigs {
+		for _, dni := range m.Spec.Network.DirectNetworkInterfaces {
+			if !dni.Primary || dni.IPPoolRef == nil {
+				continue
+			}
+			pool := c.SnowIPPool(dni.IPPoolRef.Name)
+			if pool == nil {
+				continue
+			}
+			for _, p := range pool.Spec.Pools {
+				family, err := v1alpha1.IPFamilyOf(p.IPStart)
+				if err != nil {
+					return fmt.Errorf("SnowIPPool %s: %v", pool.Name, err)
+				}
+				if family != families[0] {
+					return fmt.Errorf("SnowIPPool %s used by the primary DNI of SnowMachineConfig %s has %s addresses but the cluster primary IP family is %s", pool.Name, m.Name, family, families[0])
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/filewriter"
 	"github.com/aws/eks-anywhere/pkg/providers/snow"
 	"github.com/aws/eks-anywhere/pkg/providers/snow/mocks"
@@ -268,3 +269,31 @@ func TestValidateDeviceSoftwareConvertToIntegerError(t *testing.T) {
 	err := g.validator.ValidateDeviceSoftware(g.ctx, g.machineConfig)
 	g.Expect(err).To(MatchError(ContainSubstring("invalid syntax")))
 }
+
+func TestValidateIPPoolsIPFamily(t *testing.T) {
+	g := newConfigManagerTest(t)
+	g.machineConfig.Spec.Network.DirectNetworkInterfaces = []v1alpha1.SnowDirectNetworkInterface{
+		{Index: 1, Primary: true, IPPoolRef: &v1alpha1.Ref{Kind: v1alpha1.SnowIPPoolKind, Name: "ip-pool"}},
+	}
+	pool := &v1alpha1.SnowIPPool{
+		ObjectMeta: v1.ObjectMeta{Name: "ip-pool"},
+		Spec: v1alpha1.SnowIPPoolSpec{
+			Pools: []v1alpha1.IPPool{{IPStart: "fd00::10", IPEnd: "fd00::20", Subnet: "fd00::/64", Gateway: "fd00::1"}},
+		},
+	}
+	config := &cluster.Config{
+		Cluster: &v1alpha1.Cluster{
+			Spec: v1alpha1.ClusterSpec{
+				ClusterNetwork: v1alpha1.ClusterNetwork{
+					Pods: v1alpha1.Pods{CidrBlocks: []string{"fd00:100::/56"}},
+				},
+			},
+		},
+		SnowMachineConfigs: map[string]*v1alpha1.SnowMachineConfig{"cp-machine": g.machineConfig},
+		SnowIPPools:        map[string]*v1alpha1.SnowIPPool{"ip-pool": pool},
+	}
+	g.Expect(g.validator.ValidateIPPoolsIPFamily(config)).To(Succeed())
+
+	config.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16", "fd00:100::/56"}
+	g.Expect(g.validator.ValidateIPPoolsIPFamily(config)).To(MatchError(ContainSubstring("SnowIPPool ip-pool used by the primary DNI of SnowMachineConfig cp-machine has IPv6 addresses but the cluster primary IP family is IPv4")))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
 	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -143,6 +144,17 @@ func AssertTinkerbellIPAndControlPlaneIPNotSame(spec *ClusterSpec) error {
 	return nil
 }
 
+// AssertIPv4PrimaryIPFamily ensures the cluster primary IP family is IPv4. Machines are netbooted
+// over DHCPv4 and keep the hardware IP as node IP, so IPv6 is only supported as the secondary IP
+// family of dual-stack clusters.
+func AssertIPv4PrimaryIPFamily(spec *ClusterSpec) error {
+	families := spec.Cluster.Spec.ClusterNetwork.IPFamilies()
+	if len(families) > 0 && families[0] != v1alpha1.IPv4Family {
+		return errors.New("the cluster primary IP family must be IPv4 for Tinkerbell, use dual-stack pods and services CIDR blocks with the IPv4 CIDR first")
+	}
+	return nil
+}
+
 // AssertHookRetrievableWithoutProxy ensures the executing machine can retrieve Hook
 // from the host URL without a proxy configured. It does not guarantee the target node
 // will be able to download Hook.
@@ -197,23 +209,91 @@ func HardwareSatisfiesOnlyOneSelectorAssertion(catalogue *hardware.Catalogue) Cl
 	}
 }
 
+// HardwareIPFamilyAssertion ensures the hardware in catalogue has IPv4 addresses, the primary IP
+// family of Tinkerbell clusters.
+func HardwareIPFamilyAssertion(catalogue *hardware.Catalogue) ClusterSpecAssertion {
+	return func(spec *ClusterSpec) error {
+		for _, hw := range catalogue.AllHardware() {
+			for _, iface := range hw.Spec.Interfaces {
+				if iface.DHCP == nil || iface.DHCP.IP == nil || iface.DHCP.IP.Address == "" {
+					continue
+				}
+				family, err := v1alpha1.IPFamilyOf(iface.DHCP.IP.Address)
+				if err != nil {
+					return fmt.Errorf("hardware %s: %v", hw.Name, err)
+				}
+				if family != v1alpha1.IPv4Family {
+					return fmt.Errorf("hardware %s IP %s is not an IPv4 address, hardware is netbooted over DHCPv4", hw.Name, iface.DHCP.IP.Address)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// HardwareNetworkInterfacesAssertion ensures the hardware in catalogue selected by a MachineConfig with a
+// network layout declares all the interfaces of the layout, and that the additional interfaces have an IP address.
+func HardwareNetworkInterfacesAssertion(catalogue *hardware.Catalogue) ClusterSpecAssertion {
+	return func(spec *ClusterSpec) error {
+		for _, mc := range spec.MachineConfigs {
+			if mc.Spec.Network == nil {
+				continue
+			}
+
+			selector := mc.Spec.HardwareLabelSelector()
+			for _, hw := range catalogue.AllHardware() {
+				if !selector.Matches(hw.Labels) {
+					continue
+				}
+
+				for _, name := range mc.Spec.Network.Interfaces {
+					if _, ok := hardwareInterface(hw, name); !ok {
+						return fmt.Errorf("hardware %s selected by TinkerbellMachineConfig %s is missing interface %s", hw.Name, mc.Name, name)
+					}
+				}
+
+				for _, i := range mc.Spec.Network.AdditionalInterfaces {
+					iface, ok := hardwareInterface(hw, i.Name)
+					if !ok {
+						return fmt.Errorf("hardware %s selected by TinkerbellMachineConfig %s is missing interface %s", hw.Name, mc.Name, i.Name)
+					}
+					if iface.DHCP.IP == nil || iface.DHCP.IP.Address == "" {
+						return fmt.Errorf("hardware %s interface %s has no IP address", hw.Name, i.Name)
+					}
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// hardwareInterface returns the interface of hw named name.
+func hardwareInterface(hw *tinkv1alpha1.Hardware, name string) (tinkv1alpha1.Interface, bool) {
+	for _, iface := range hw.Spec.Interfaces {
+		if iface.DHCP != nil && iface.DHCP.IfaceName == name {
+			return iface, true
+		}
+	}
+	return tinkv1alpha1.Interface{}, false
+}
+
 // selectorsFromClusterSpec extracts all selectors specified on MachineConfig's from spec.
 func selectorsFromClusterSpec(spec *ClusterSpec) (selectorSet, error) {
 	selectors := selectorSet{}
 
-	if err := selectors.Add(spec.ControlPlaneMachineConfig().Spec.HardwareSelector); err != nil {
+	if err := selectors.Add(spec.ControlPlaneMachineConfig().Spec.HardwareLabelSelector()); err != nil {
 		return nil, err
 	}
 
 	for _, nodeGroup := range spec.WorkerNodeGroupConfigurations() {
-		err := selectors.Add(spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareSelector)
+		err := selectors.Add(spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareLabelSelector())
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if spec.HasExternalEtcd() {
-		if err := selectors.Add(spec.ExternalEtcdMachineConfig().Spec.HardwareSelector); err != nil {
+		if err := selectors.Add(spec.ExternalEtcdMachineConfig().Spec.HardwareLabelSelector()); err != nil {
 			return nil, err
 		}
 	}
@@ -238,8 +318,8 @@ func MinimumHardwareAvailableAssertionForCreate(catalogue *hardware.Catalogue) C
 		// will account for the same selector being specified on different groups.
 		requirements := MinimumHardwareRequirements{}
 
-		err := requirements.Add(
-			spec.ControlPlaneMachineConfig().Spec.HardwareSelector,
+		err := requirements.AddSelector(
+			spec.ControlPlaneMachineConfig().Spec.HardwareLabelSelector(),
 			spec.ControlPlaneConfiguration().Count,
 		)
 		if err != nil {
@@ -247,8 +327,8 @@ func MinimumHardwareAvailableAssertionForCreate(catalogue *hardware.Catalogue) C
 		}
 
 		for _, nodeGroup := range spec.WorkerNodeGroupConfigurations() {
-			err := requirements.Add(
-				spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareSelector,
+			err := requirements.AddSelector(
+				spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareLabelSelector(),
 				*nodeGroup.Count,
 			)
 			if err != nil {
@@ -257,8 +337,8 @@ func MinimumHardwareAvailableAssertionForCreate(catalogue *hardware.Catalogue) C
 		}
 
 		if spec.HasExternalEtcd() {
-			err := requirements.Add(
-				spec.ExternalEtcdMachineConfig().Spec.HardwareSelector,
+			err := requirements.AddSelector(
+				spec.ExternalEtcdMachineConfig().Spec.HardwareLabelSelector(),
 				spec.ExternalEtcdConfiguration().Count,
 			)
 			if err != nil {
@@ -392,8 +472,8 @@ func AssertionsForScaleUpDown(catalogue *hardware.Catalogue, current Validatable
 				return fmt.Errorf("cannot perform scale up or down during rolling upgrades")
 			}
 			if current.ControlPlaneReplicaCount() < spec.Cluster.Spec.ControlPlaneConfiguration.Count {
-				err := requirements.Add(
-					spec.ControlPlaneMachineConfig().Spec.HardwareSelector,
+				err := requirements.AddSelector(
+					spec.ControlPlaneMachineConfig().Spec.HardwareLabelSelector(),
 					spec.Cluster.Spec.ControlPlaneConfiguration.Count-current.ControlPlaneReplicaCount(),
 				)
 				if err != nil {
@@ -415,8 +495,8 @@ func AssertionsForScaleUpDown(catalogue *hardware.Catalogue, current Validatable
 						return fmt.Errorf("cannot perform scale up or down during rolling upgrades")
 					}
 					if *nodeGroupNewSpec.Count > workerNodeGroupOldSpec.Replicas {
-						err := requirements.Add(
-							spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec).Spec.HardwareSelector,
+						err := requirements.AddSelector(
+							spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec).Spec.HardwareLabelSelector(),
 							*nodeGroupNewSpec.Count-workerNodeGroupOldSpec.Replicas,
 						)
 						if err != nil {
@@ -428,8 +508,8 @@ func AssertionsForScaleUpDown(catalogue *hardware.Catalogue, current Validatable
 				if rollingUpgrade {
 					return fmt.Errorf("cannot perform scale up or down during rolling upgrades")
 				}
-				err := requirements.Add(
-					spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec).Spec.HardwareSelector,
+				err := requirements.AddSelector(
+					spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec).Spec.HardwareLabelSelector(),
 					*nodeGroupNewSpec.Count,
 				)
 				if err != nil {
@@ -487,8 +567,8 @@ func ensureCPHardwareAvailability(spec *ClusterSpec, hwReq MinimumHardwareRequir
 	if rolloutStrategy != nil && rolloutStrategy.Type == "RollingUpdate" {
 		maxSurge = spec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
 	}
-	err := hwReq.Add(
-		spec.ControlPlaneMachineConfig().Spec.HardwareSelector,
+	err := hwReq.AddSelector(
+		spec.ControlPlaneMachineConfig().Spec.HardwareLabelSelector(),
 		maxSurge,
 	)
 	if err != nil {
@@ -508,8 +588,8 @@ func ensureWorkerHardwareAvailability(spec *ClusterSpec, current ValidatableClus
 			if nodeGroup.UpgradeRolloutStrategy != nil && nodeGroup.UpgradeRolloutStrategy.Type == "RollingUpdate" {
 				maxSurge = nodeGroup.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
 			}
-			err := hwReq.Add(
-				spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareSelector,
+			err := hwReq.AddSelector(
+				spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareLabelSelector(),
 				maxSurge,
 			)
 			if err != nil {
@@ -523,14 +603,14 @@ func ensureWorkerHardwareAvailability(spec *ClusterSpec, current ValidatableClus
 // ensureHardwareSelectorsSpecified ensures each machine config present in spec has a hardware
 // selector.
 func ensureHardwareSelectorsSpecified(spec *ClusterSpec) error {
-	if len(spec.ControlPlaneMachineConfig().Spec.HardwareSelector) == 0 {
+	if spec.ControlPlaneMachineConfig().Spec.HardwareLabelSelector().IsEmpty() {
 		return missingHardwareSelectorErr{
 			Name: spec.ControlPlaneMachineConfig().Name,
 		}
 	}
 
 	for _, nodeGroup := range spec.WorkerNodeGroupConfigurations() {
-		if len(spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareSelector) == 0 {
+		if spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareLabelSelector().IsEmpty() {
 			return missingHardwareSelectorErr{
 				Name: spec.WorkerNodeGroupMachineConfig(nodeGroup).Name,
 			}
@@ -538,7 +618,7 @@ func ensureHardwareSelectorsSpecified(spec *ClusterSpec) error {
 	}
 
 	if spec.HasExternalEtcd() {
-		if len(spec.ExternalEtcdMachineConfig().Spec.HardwareSelector) == 0 {
+		if spec.ExternalEtcdMachineConfig().Spec.HardwareLabelSelector().IsEmpty() {
 			return missingHardwareSelectorErr{
 				Name: spec.ExternalEtcdMachineConfig().Name,
 			}
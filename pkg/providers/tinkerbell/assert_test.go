@@ -515,6 +515,83 @@ func TestAssertTinkerbellIPAndControlPlaneIPNotSame_SameFails(t *testing.T) {
 	g.Expect(tinkerbell.AssertTinkerbellIPAndControlPlaneIPNotSame(clusterSpec)).ToNot(gomega.Succeed())
 }
 
+func TestAssertIPv4PrimaryIPFamily_DualStackSucceeds(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16", "fd00:100::/56"}
+
+	g.Expect(tinkerbell.AssertIPv4PrimaryIPFamily(clusterSpec)).To(gomega.Succeed())
+}
+
+func TestAssertIPv4PrimaryIPFamily_IPv6PrimaryFails(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:100::/56", "192.168.0.0/16"}
+
+	g.Expect(tinkerbell.AssertIPv4PrimaryIPFamily(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring("primary IP family must be IPv4")))
+}
+
+func TestHardwareIPFamilyAssertion(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(hardwareWithIP("hw-1", "10.0.0.10"))).To(gomega.Succeed())
+
+	assertion := tinkerbell.HardwareIPFamilyAssertion(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
+
+	g.Expect(catalogue.InsertHardware(hardwareWithIP("hw-2", "fd00::10"))).To(gomega.Succeed())
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring("hardware hw-2 IP fd00::10 is not an IPv4 address")))
+}
+
+func TestHardwareNetworkInterfacesAssertion(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	cpMachineConfig := clusterSpec.ControlPlaneMachineConfig()
+	cpMachineConfig.Spec.Network = &eksav1alpha1.TinkerbellNetworkConfig{
+		Interfaces:           []string{"eno1", "eno2"},
+		Bond:                 &eksav1alpha1.TinkerbellBondConfig{Mode: eksav1alpha1.BondMode8023ad},
+		AdditionalInterfaces: []eksav1alpha1.TinkerbellAdditionalInterface{{Name: "ens1f0"}},
+	}
+
+	hw := &v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Name: "hw-1", Labels: cpMachineConfig.Spec.HardwareSelector},
+		Spec: v1alpha1.HardwareSpec{
+			Interfaces: []v1alpha1.Interface{
+				{DHCP: &v1alpha1.DHCP{IfaceName: "eno1", IP: &v1alpha1.IP{Address: "10.0.0.10"}}},
+				{DHCP: &v1alpha1.DHCP{IfaceName: "eno2"}},
+				{DHCP: &v1alpha1.DHCP{IfaceName: "ens1f0"}},
+			},
+		},
+	}
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(hw)).To(gomega.Succeed())
+
+	assertion := tinkerbell.HardwareNetworkInterfacesAssertion(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError("hardware hw-1 interface ens1f0 has no IP address"))
+
+	hw.Spec.Interfaces[2].DHCP.IP = &v1alpha1.IP{Address: "10.0.20.10"}
+	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
+
+	hw.Spec.Interfaces = hw.Spec.Interfaces[:1]
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring("hardware hw-1 selected by TinkerbellMachineConfig")))
+}
+
+func hardwareWithIP(name, ip string) *v1alpha1.Hardware {
+	return &v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Name: name},
+		Spec: v1alpha1.HardwareSpec{
+			Interfaces: []v1alpha1.Interface{
+				{DHCP: &v1alpha1.DHCP{IP: &v1alpha1.IP{Address: ip}}},
+			},
+		},
+	}
+}
+
 func TestAssertPortsNotInUse_Succeeds(t *testing.T) {
 	g := gomega.NewWithT(t)
 	ctrl := gomock.NewController(t)
@@ -1027,6 +1104,66 @@ func TestHardwareSatisfiesOnlyOneSelectorAssertion_MeetsOnlyOneSelector(t *testi
 	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
 }
 
+func TestMinimumHardwareAvailableAssertionForCreate_SelectorExpressions(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	clusterSpec.Spec.Cluster.Spec.ExternalEtcdConfiguration = nil
+	workerMachineConfig := clusterSpec.WorkerNodeGroupMachineConfig(clusterSpec.WorkerNodeGroupConfigurations()[0])
+	workerMachineConfig.Spec.HardwareSelectorExpressions = []v1.LabelSelectorRequirement{
+		{Key: "rack", Operator: v1.LabelSelectorOpIn, Values: []string{"r1", "r2"}},
+		{Key: "gpu", Operator: v1.LabelSelectorOpDoesNotExist},
+	}
+
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Labels: map[string]string{"type": "cp"}},
+	})).To(gomega.Succeed())
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Labels: map[string]string{"type": "worker", "rack": "r3"}},
+	})).To(gomega.Succeed())
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Labels: map[string]string{"type": "worker", "rack": "r1", "gpu": "true"}},
+	})).To(gomega.Succeed())
+
+	assertion := tinkerbell.MinimumHardwareAvailableAssertionForCreate(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring(
+		"minimum hardware count not met for selector '!gpu,rack in (r1,r2),type=worker'",
+	)))
+
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Labels: map[string]string{"type": "worker", "rack": "r2"}},
+	})).To(gomega.Succeed())
+	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
+}
+
+func TestHardwareSatisfiesOnlyOneSelectorAssertion_SelectorExpressions(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	clusterSpec.Spec.Cluster.Spec.ExternalEtcdConfiguration = nil
+	workerMachineConfig := clusterSpec.WorkerNodeGroupMachineConfig(clusterSpec.WorkerNodeGroupConfigurations()[0])
+	workerMachineConfig.Spec.HardwareSelector = nil
+	workerMachineConfig.Spec.HardwareSelectorExpressions = []v1.LabelSelectorRequirement{
+		{Key: "type", Operator: v1.LabelSelectorOpNotIn, Values: []string{"cp"}},
+	}
+
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Name: "cp", Labels: map[string]string{"type": "cp"}},
+	})).To(gomega.Succeed())
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Name: "worker", Labels: map[string]string{"type": "gpu-worker"}},
+	})).To(gomega.Succeed())
+
+	assertion := tinkerbell.HardwareSatisfiesOnlyOneSelectorAssertion(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
+
+	workerMachineConfig.Spec.HardwareSelectorExpressions[0].Operator = v1.LabelSelectorOpExists
+	workerMachineConfig.Spec.HardwareSelectorExpressions[0].Values = nil
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring("hardware must only satisfy 1 selector: hardware name 'cp'")))
+}
+
 func TestHardwareSatisfiesOnlyOneSelectorAssertion_MeetsMultipleSelectorFails(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -111,6 +111,7 @@ func NewClusterSpecValidator(assertions ...ClusterSpecAssertion) *ClusterSpecVal
 		AssertOSImageURL,
 		AssertISOURL,
 		AssertTinkerbellIPAndControlPlaneIPNotSame,
+		AssertIPv4PrimaryIPFamily,
 		AssertHookRetrievableWithoutProxy,
 		AssertUpgradeRolloutStrategyValid,
 		AssertAutoScalerDisabledForInPlace,
@@ -197,6 +197,8 @@ func (p *Provider) SetupAndValidateCreateCluster(ctx context.Context, clusterSpe
 	clusterSpecValidator := NewClusterSpecValidator(
 		MinimumHardwareAvailableAssertionForCreate(p.catalogue),
 		HardwareSatisfiesOnlyOneSelectorAssertion(p.catalogue),
+		HardwareIPFamilyAssertion(p.catalogue),
+		HardwareNetworkInterfacesAssertion(p.catalogue),
 	)
 
 	clusterSpecValidator.Register(AssertPortsNotInUse(p.netClient))
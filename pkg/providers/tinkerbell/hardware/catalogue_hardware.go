@@ -3,6 +3,7 @@ package hardware
 import (
 	"fmt"
 	"math"
+	"strings"
 
 	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -13,7 +14,7 @@ import (
 )
 
 // serializeHardwareSelector returns a key for use in a map unique selector.
-func serializeHardwareSelector(selector eksav1alpha1.HardwareSelector) (string, error) {
+func serializeHardwareSelector(selector eksav1alpha1.HardwareLabelSelector) (string, error) {
 	return selector.ToString()
 }
 
@@ -98,6 +99,18 @@ func (c *Catalogue) LookupHardware(index, key string) ([]*tinkv1alpha1.Hardware,
 	return hardware, nil
 }
 
+// LookupHardwareBySelector retrieves the Hardware instances whose labels satisfy selector.
+// Set-based selectors can't be resolved with a field index so all the catalogued Hardware is evaluated.
+func (c *Catalogue) LookupHardwareBySelector(selector eksav1alpha1.HardwareLabelSelector) []*tinkv1alpha1.Hardware {
+	var hardware []*tinkv1alpha1.Hardware
+	for _, h := range c.hardware {
+		if LabelsMatchSelector(selector, h.Labels) {
+			hardware = append(hardware, h)
+		}
+	}
+	return hardware
+}
+
 // TotalHardware returns the total hardware registered in the catalogue.
 func (c *Catalogue) TotalHardware() int {
 	return len(c.hardware)
@@ -152,7 +165,7 @@ func hardwareFromMachine(m Machine) *tinkv1alpha1.Hardware {
 	allow := true
 
 	// TODO(chrisdoherty4) Set the namespace to the CAPT namespace.
-	return &tinkv1alpha1.Hardware{
+	hw := &tinkv1alpha1.Hardware{
 		TypeMeta: newHardwareTypeMeta(),
 		ObjectMeta: v1.ObjectMeta{
 			Name:      m.Hostname,
@@ -218,6 +231,52 @@ func hardwareFromMachine(m Machine) *tinkv1alpha1.Hardware {
 			},
 		},
 	}
+
+	addNetworkInterfaces(hw, m)
+
+	return hw
+}
+
+// addNetworkInterfaces adds the named interfaces of m to hw. The boot interface, identified by its MAC address,
+// is only given its name. Other interfaces are never netbooted and their addresses, if any, are added to the
+// instance metadata.
+func addNetworkInterfaces(hw *tinkv1alpha1.Hardware, m Machine) {
+	deny := false
+	for _, i := range m.Interfaces {
+		if strings.EqualFold(i.MACAddress, m.MACAddress) {
+			hw.Spec.Interfaces[0].DHCP.IfaceName = i.Name
+			continue
+		}
+
+		iface := tinkv1alpha1.Interface{
+			Netboot: &tinkv1alpha1.Netboot{
+				AllowPXE:      &deny,
+				AllowWorkflow: &deny,
+			},
+			DHCP: &tinkv1alpha1.DHCP{
+				Arch:      "x86_64",
+				MAC:       i.MACAddress,
+				IfaceName: i.Name,
+				Hostname:  m.Hostname,
+				UEFI:      true,
+			},
+		}
+
+		if i.IPAddress != "" {
+			iface.DHCP.IP = &tinkv1alpha1.IP{
+				Address: i.IPAddress,
+				Netmask: i.Netmask,
+				Family:  4,
+			}
+			hw.Spec.Metadata.Instance.Ips = append(hw.Spec.Metadata.Instance.Ips, &tinkv1alpha1.MetadataInstanceIP{
+				Address: i.IPAddress,
+				Netmask: i.Netmask,
+				Family:  4,
+			})
+		}
+
+		hw.Spec.Interfaces = append(hw.Spec.Interfaces, iface)
+	}
 }
 
 // newBMCRefFromMachine returns a BMCRef pointer for Hardware.
@@ -280,3 +280,42 @@ func TestHardwareCatalogueWriter_Write(t *testing.T) {
 	g.Expect(hardware).To(gomega.HaveLen(1))
 	g.Expect(hardware[0].Name).To(gomega.Equal(machine.Hostname))
 }
+
+func TestHardwareCatalogueWriter_WriteWithInterfaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	catalogue := hardware.NewCatalogue()
+	writer := hardware.NewHardwareCatalogueWriter(catalogue)
+	machine := NewValidMachine()
+	machine.Interfaces = hardware.NetworkInterfaces{
+		{Name: "eno1", MACAddress: machine.MACAddress},
+		{Name: "eno2", MACAddress: "00:00:00:00:00:01"},
+		{Name: "ens1f0", MACAddress: "00:00:00:00:00:02", IPAddress: "10.10.20.10", Netmask: "255.255.255.0"},
+	}
+
+	err := writer.Write(machine)
+	g.Expect(err).To(gomega.Succeed())
+
+	hw := catalogue.AllHardware()[0]
+	g.Expect(hw.Spec.Interfaces).To(gomega.HaveLen(3))
+
+	boot := hw.Spec.Interfaces[0]
+	g.Expect(boot.DHCP.MAC).To(gomega.Equal(machine.MACAddress))
+	g.Expect(boot.DHCP.IfaceName).To(gomega.Equal("eno1"))
+	g.Expect(*boot.Netboot.AllowPXE).To(gomega.BeTrue())
+
+	bondMember := hw.Spec.Interfaces[1]
+	g.Expect(bondMember.DHCP.IfaceName).To(gomega.Equal("eno2"))
+	g.Expect(bondMember.DHCP.IP).To(gomega.BeNil())
+	g.Expect(*bondMember.Netboot.AllowPXE).To(gomega.BeFalse())
+	g.Expect(*bondMember.Netboot.AllowWorkflow).To(gomega.BeFalse())
+
+	storage := hw.Spec.Interfaces[2]
+	g.Expect(storage.DHCP.IfaceName).To(gomega.Equal("ens1f0"))
+	g.Expect(storage.DHCP.IP.Address).To(gomega.Equal("10.10.20.10"))
+
+	ips := hw.Spec.Metadata.Instance.Ips
+	g.Expect(ips).To(gomega.HaveLen(2))
+	g.Expect(ips[1].Address).To(gomega.Equal("10.10.20.10"))
+	g.Expect(ips[1].Public).To(gomega.BeFalse())
+}
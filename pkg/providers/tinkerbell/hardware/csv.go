@@ -98,6 +98,53 @@ func ensureRequiredColumnsInCSV(unmatched []string) error {
 	return nil
 }
 
+// csvColumns are the columns written by CSVWriter, in order.
+var csvColumns = []string{
+	"hostname", "bmc_ip", "bmc_username", "bmc_password", "mac", "ip_address", "netmask", "gateway", "nameservers", "labels", "disk",
+}
+
+// CSVWriter writes Machine instances as csv records that can be read by CSVReader. It satisfies the
+// MachineWriter interface. The header is written with the first Machine.
+type CSVWriter struct {
+	writer        *stdcsv.Writer
+	headerWritten bool
+}
+
+// NewCSVWriter returns a new CSVWriter instance that writes csv data to w.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{writer: stdcsv.NewWriter(w)}
+}
+
+// Write writes m as a csv record.
+func (cw *CSVWriter) Write(m Machine) error {
+	if !cw.headerWritten {
+		if err := cw.writer.Write(csvColumns); err != nil {
+			return err
+		}
+		cw.headerWritten = true
+	}
+
+	record := []string{
+		m.Hostname,
+		m.BMCIPAddress,
+		m.BMCUsername,
+		m.BMCPassword,
+		m.MACAddress,
+		m.IPAddress,
+		m.Netmask,
+		m.Gateway,
+		m.Nameservers.String(),
+		m.Labels.String(),
+		m.Disk,
+	}
+	if err := cw.writer.Write(record); err != nil {
+		return err
+	}
+
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
 // BuildHardwareYAML builds a hardware yaml from the csv at the provided path.
 func BuildHardwareYAML(path string, opts *BMCOptions) ([]byte, error) {
 	reader, err := NewNormalizedCSVReaderFromFile(path, opts)
@@ -53,6 +53,46 @@ func TestCSVReaderWithMultipleLabels(t *testing.T) {
 	g.Expect(machine).To(gomega.BeEquivalentTo(expect))
 }
 
+func TestCSVReaderWithInterfaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	buf := NewBufferedCSV()
+
+	expect := NewValidMachine()
+	expect.Interfaces = hardware.NetworkInterfaces{
+		{Name: "eno1", MACAddress: expect.MACAddress},
+		{Name: "ens1f0", MACAddress: "00:00:00:00:00:02", IPAddress: "10.10.20.10", Netmask: "255.255.255.0"},
+	}
+
+	err := csv.MarshalCSV([]hardware.Machine{expect}, buf)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	reader, err := hardware.NewCSVReader(buf.Buffer, nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	machine, err := reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine).To(gomega.BeEquivalentTo(expect))
+}
+
+func TestCSVReaderWithBadlyFormattedInterfaces(t *testing.T) {
+	for _, interfaces := range []string{"eno1", "eno1=00:00:00:00:00:01;10.10.20.10"} {
+		t.Run(interfaces, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			buf := bytes.NewBufferString(
+				"hostname,ip_address,netmask,gateway,nameservers,mac,disk,labels,interfaces\n" +
+					"worker1,10.10.10.10,255.255.255.0,10.10.10.1,1.1.1.1,00:00:00:00:00:01,/dev/sda,type=cp," + interfaces + "\n",
+			)
+
+			reader, err := hardware.NewCSVReader(buf, nil)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			_, err = reader.Read()
+			g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("badly formatted interface")))
+		})
+	}
+}
+
 func TestCSVReaderFromFile(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -0,0 +1,384 @@
+package hardware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/netip"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+)
+
+const (
+	// maxDiscoveryEndpoints limits the number of addresses a BMC CIDR can expand to.
+	maxDiscoveryEndpoints = 4096
+	discoveryWorkers      = 16
+)
+
+// DiscoveryOptions configures the discovery of machines from their BMCs.
+type DiscoveryOptions struct {
+	// Endpoints are the BMCs to query. Each entry is an IP, a CIDR or a URL like https://10.0.0.10:8443.
+	// Addresses of a CIDR that don't respond are skipped.
+	Endpoints []string
+	// Username and Password are the BMC credentials, shared by all the BMCs. They are read from
+	// the environment with SetCredentialsFromEnv so the password doesn't end up in the shell history.
+	Username string
+	Password string
+	// InsecureSkipVerify disables the verification of the BMC TLS certificates.
+	InsecureSkipVerify bool
+	// Timeout for each request to a BMC.
+	Timeout time.Duration
+
+	// IPAddresses are assigned to the discovered machines in BMC address order. Each entry is a single IP
+	// or a start-end range.
+	IPAddresses []string
+	Netmask     string
+	Gateway     string
+	Nameservers Nameservers
+	Labels      Labels
+	// Disk overrides the disk derived from the discovered drives.
+	Disk string
+
+	// BMCOptions used in the discovered machines.
+	BMCOptions *BMCOptions
+}
+
+// SetCredentialsFromEnv sets the BMC credentials from the EKSA_BMC_USERNAME and EKSA_BMC_PASSWORD
+// env vars. A username that is already set takes precedence over the env var.
+func (o *DiscoveryOptions) SetCredentialsFromEnv() error {
+	if o.Username == "" {
+		username, ok := os.LookupEnv(constants.EksaBMCUsernameKey)
+		if !ok || len(username) == 0 {
+			return fmt.Errorf("%s is not set or is empty", constants.EksaBMCUsernameKey)
+		}
+		o.Username = username
+	}
+
+	password, ok := os.LookupEnv(constants.EksaBMCPasswordKey)
+	if !ok || len(password) == 0 {
+		return fmt.Errorf("%s is not set or is empty", constants.EksaBMCPasswordKey)
+	}
+	o.Password = password
+
+	return nil
+}
+
+// DiscoverHardwareYAML discovers the machines of the BMCs in opts and builds their hardware yaml.
+func DiscoverHardwareYAML(ctx context.Context, opts DiscoveryOptions) ([]byte, error) {
+	var b bytes.Buffer
+	if err := discoverAndTranslate(ctx, opts, NewTinkerbellManifestYAML(&b)); err != nil {
+		return nil, err
+	}
+
+	return unstructuredutil.StripNull(b.Bytes())
+}
+
+// DiscoverHardwareCSV discovers the machines of the BMCs in opts and builds a hardware csv that can be
+// used with the create cluster command.
+func DiscoverHardwareCSV(ctx context.Context, opts DiscoveryOptions) ([]byte, error) {
+	var b bytes.Buffer
+	if err := discoverAndTranslate(ctx, opts, NewCSVWriter(&b)); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func discoverAndTranslate(ctx context.Context, opts DiscoveryOptions, writer MachineWriter) error {
+	machines, err := Discover(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	reader := NewNormalizer(&machineSliceReader{machines: machines})
+	if err := TranslateAll(reader, writer, NewDefaultMachineValidator()); err != nil {
+		return fmt.Errorf("generating hardware from discovered machines: %v", err)
+	}
+
+	return nil
+}
+
+// Discover queries the BMCs in opts with Redfish and returns a Machine for each of them, sorted by BMC address.
+// The MAC address is taken from the first enabled network interface with a link, and the disk from the
+// first drive.
+func Discover(ctx context.Context, opts DiscoveryOptions) ([]Machine, error) {
+	targets, err := discoveryTargets(opts.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	ips, err := expandIPAddresses(opts.IPAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Machine, len(targets))
+	errs := make([]error, len(targets))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < discoveryWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = discoverMachine(ctx, targets[i], opts)
+			}
+		}()
+	}
+	for i := range targets {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var machines []Machine
+	for i, t := range targets {
+		if errs[i] != nil {
+			if t.fromCIDR {
+				logger.V(4).Info("Skipping BMC", "address", t.address, "reason", errs[i])
+				continue
+			}
+			return nil, errs[i]
+		}
+		machines = append(machines, *results[i])
+	}
+
+	if len(machines) == 0 {
+		return nil, fmt.Errorf("no machines discovered from BMC endpoints %s", strings.Join(opts.Endpoints, ", "))
+	}
+
+	if len(ips) < len(machines) {
+		return nil, fmt.Errorf("discovered %d machines but only %d ip addresses were provided", len(machines), len(ips))
+	}
+	for i := range machines {
+		machines[i].IPAddress = ips[i].String()
+	}
+
+	return machines, nil
+}
+
+type discoveryTarget struct {
+	address  netip.Addr
+	url      string
+	fromCIDR bool
+}
+
+func discoverMachine(ctx context.Context, t discoveryTarget, opts DiscoveryOptions) (*Machine, error) {
+	clientOpts := []RedfishClientOpt{}
+	if opts.InsecureSkipVerify {
+		clientOpts = append(clientOpts, WithRedfishInsecureSkipVerify())
+	}
+	if opts.Timeout > 0 {
+		clientOpts = append(clientOpts, WithRedfishTimeout(opts.Timeout))
+	}
+	client := NewRedfishClient(t.url, opts.Username, opts.Password, clientOpts...)
+
+	systems, err := client.Systems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(systems) != 1 {
+		return nil, fmt.Errorf("bmc %s manages %d systems, only BMCs with a single system are supported", t.address, len(systems))
+	}
+	system := systems[0]
+
+	hostname := strings.ToLower(system.HostName)
+	if hostname == "" {
+		hostname = strings.ToLower(system.SerialNumber)
+	}
+	if hostname == "" {
+		return nil, fmt.Errorf("bmc %s didn't report a host name or serial number for system %s", t.address, system.ID)
+	}
+
+	nic, ok := bootNIC(system.NICs)
+	if !ok {
+		return nil, fmt.Errorf("bmc %s didn't report any enabled network interface for system %s", t.address, system.ID)
+	}
+
+	disk := opts.Disk
+	if disk == "" {
+		if len(system.Drives) == 0 {
+			return nil, fmt.Errorf("bmc %s didn't report any drive for system %s, the disk has to be provided", t.address, system.ID)
+		}
+		disk = diskPath(system.Drives[0])
+	}
+
+	labels := make(Labels, len(opts.Labels))
+	for k, v := range opts.Labels {
+		labels[k] = v
+	}
+
+	return &Machine{
+		Hostname:     hostname,
+		Netmask:      opts.Netmask,
+		Gateway:      opts.Gateway,
+		Nameservers:  append(Nameservers{}, opts.Nameservers...),
+		MACAddress:   nic.MACAddress,
+		Disk:         disk,
+		Labels:       labels,
+		BMCIPAddress: t.address.String(),
+		BMCUsername:  opts.Username,
+		BMCPassword:  opts.Password,
+		BMCOptions:   opts.BMCOptions,
+	}, nil
+}
+
+// bootNIC returns the first enabled interface with a link, or the first enabled interface
+// if none of them has a link.
+func bootNIC(nics []RedfishNIC) (RedfishNIC, bool) {
+	var fallback *RedfishNIC
+	for i := range nics {
+		if !nics[i].Enabled {
+			continue
+		}
+		if nics[i].LinkUp {
+			return nics[i], true
+		}
+		if fallback == nil {
+			fallback = &nics[i]
+		}
+	}
+
+	if fallback == nil {
+		return RedfishNIC{}, false
+	}
+	return *fallback, true
+}
+
+// diskPath returns the linux device the OS is expected to assign to the first drive of a protocol.
+func diskPath(drive RedfishDrive) string {
+	if strings.EqualFold(drive.Protocol, "NVMe") {
+		return "/dev/nvme0n1"
+	}
+	return "/dev/sda"
+}
+
+func discoveryTargets(endpoints []string) ([]discoveryTarget, error) {
+	var targets []discoveryTarget
+	seen := map[netip.Addr]struct{}{}
+	add := func(t discoveryTarget) {
+		if _, ok := seen[t.address]; ok {
+			return
+		}
+		seen[t.address] = struct{}{}
+		targets = append(targets, t)
+	}
+
+	for _, endpoint := range endpoints {
+		endpoint = strings.TrimSpace(endpoint)
+		switch {
+		case strings.Contains(endpoint, "://"):
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("bmc endpoint %s is not a valid URL: %v", endpoint, err)
+			}
+			addr, err := netip.ParseAddr(u.Hostname())
+			if err != nil {
+				return nil, fmt.Errorf("bmc endpoint %s must use an IP address", endpoint)
+			}
+			add(discoveryTarget{address: addr, url: endpoint})
+		case strings.Contains(endpoint, "/"):
+			prefix, err := netip.ParsePrefix(endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("bmc endpoint %s is not a valid CIDR", endpoint)
+			}
+			addrs, err := prefixHosts(prefix)
+			if err != nil {
+				return nil, err
+			}
+			for _, addr := range addrs {
+				add(discoveryTarget{address: addr, url: redfishURL(addr), fromCIDR: true})
+			}
+		default:
+			addr, err := netip.ParseAddr(endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("bmc endpoint %s is not a valid IP", endpoint)
+			}
+			add(discoveryTarget{address: addr, url: redfishURL(addr)})
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("at least one bmc endpoint is required")
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		return targets[i].address.Less(targets[j].address)
+	})
+
+	return targets, nil
+}
+
+func redfishURL(addr netip.Addr) string {
+	return (&url.URL{Scheme: "https", Host: netip.AddrPortFrom(addr, 443).String()}).String()
+}
+
+// prefixHosts returns the addresses of prefix, excluding the network and broadcast addresses of IPv4 networks
+// bigger than /31.
+func prefixHosts(prefix netip.Prefix) ([]netip.Addr, error) {
+	prefix = prefix.Masked()
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	if hostBits > 12 {
+		return nil, fmt.Errorf("bmc endpoint %s is too big, it can't contain more than %d addresses", prefix, maxDiscoveryEndpoints)
+	}
+
+	var addrs []netip.Addr
+	for addr := prefix.Addr(); prefix.Contains(addr); addr = addr.Next() {
+		addrs = append(addrs, addr)
+	}
+
+	if prefix.Addr().Is4() && hostBits > 1 {
+		addrs = addrs[1 : len(addrs)-1]
+	}
+
+	return addrs, nil
+}
+
+func expandIPAddresses(entries []string) ([]netip.Addr, error) {
+	var ips []netip.Addr
+	for _, entry := range entries {
+		start, end, isRange := strings.Cut(entry, "-")
+		first, err := netip.ParseAddr(strings.TrimSpace(start))
+		if err != nil {
+			return nil, fmt.Errorf("ip address %s is invalid", entry)
+		}
+		if !isRange {
+			ips = append(ips, first)
+			continue
+		}
+
+		last, err := netip.ParseAddr(strings.TrimSpace(end))
+		if err != nil || first.Is4() != last.Is4() || last.Less(first) {
+			return nil, fmt.Errorf("ip address range %s is invalid", entry)
+		}
+		for addr := first; addr.Compare(last) <= 0 && len(ips) < maxDiscoveryEndpoints; addr = addr.Next() {
+			ips = append(ips, addr)
+		}
+	}
+
+	return ips, nil
+}
+
+// machineSliceReader is a MachineReader that reads Machines from a slice.
+type machineSliceReader struct {
+	machines []Machine
+	next     int
+}
+
+func (r *machineSliceReader) Read() (Machine, error) {
+	if r.next >= len(r.machines) {
+		return Machine{}, io.EOF
+	}
+	m := r.machines[r.next]
+	r.next++
+	return m, nil
+}
@@ -0,0 +1,295 @@
+package hardware_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+// redfishSystem configures the system served by newRedfishServer.
+type redfishSystem struct {
+	serial   string
+	hostname string
+	nics     []map[string]interface{}
+	drives   []map[string]interface{}
+}
+
+// newRedfishServer returns a mock Redfish BMC that manages a single system.
+func newRedfishServer(t *testing.T, system redfishSystem) *httptest.Server {
+	resources := map[string]interface{}{
+		"/redfish/v1/Systems": map[string]interface{}{
+			"Members": []map[string]string{{"@odata.id": "/redfish/v1/Systems/1"}},
+		},
+		"/redfish/v1/Systems/1": map[string]interface{}{
+			"Id":                 "1",
+			"SerialNumber":       system.serial,
+			"HostName":           system.hostname,
+			"EthernetInterfaces": map[string]string{"@odata.id": "/redfish/v1/Systems/1/EthernetInterfaces"},
+			"Storage":            map[string]string{"@odata.id": "/redfish/v1/Systems/1/Storage"},
+		},
+		"/redfish/v1/Systems/1/Storage": map[string]interface{}{
+			"Members": []map[string]string{{"@odata.id": "/redfish/v1/Systems/1/Storage/1"}},
+		},
+	}
+
+	var nicLinks []map[string]string
+	for i, nic := range system.nics {
+		path := fmt.Sprintf("/redfish/v1/Systems/1/EthernetInterfaces/%d", i)
+		nicLinks = append(nicLinks, map[string]string{"@odata.id": path})
+		resources[path] = nic
+	}
+	resources["/redfish/v1/Systems/1/EthernetInterfaces"] = map[string]interface{}{"Members": nicLinks}
+
+	var driveLinks []map[string]string
+	for i, drive := range system.drives {
+		path := fmt.Sprintf("/redfish/v1/Systems/1/Storage/1/Drives/%d", i)
+		driveLinks = append(driveLinks, map[string]string{"@odata.id": path})
+		resources[path] = drive
+	}
+	resources["/redfish/v1/Systems/1/Storage/1"] = map[string]interface{}{"Drives": driveLinks}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		resource, ok := resources[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resource)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func defaultRedfishSystem() redfishSystem {
+	return redfishSystem{
+		serial: "SN0001",
+		nics: []map[string]interface{}{
+			{"MACAddress": "AA:BB:CC:00:00:01", "InterfaceEnabled": false, "LinkStatus": "LinkUp"},
+			{"MACAddress": "AA:BB:CC:00:00:02", "LinkStatus": "NoLink"},
+			{"PermanentMACAddress": "AA:BB:CC:00:00:03", "MACAddress": "AA:BB:CC:FF:FF:03", "LinkStatus": "LinkUp"},
+		},
+		drives: []map[string]interface{}{
+			{"Name": "Drive 0", "CapacityBytes": 960197124096, "Protocol": "NVMe"},
+			{"Name": "Drive 1", "CapacityBytes": 480103981056, "Protocol": "SATA"},
+		},
+	}
+}
+
+func discoveryOptions(endpoints ...string) hardware.DiscoveryOptions {
+	return hardware.DiscoveryOptions{
+		Endpoints:   endpoints,
+		Username:    "admin",
+		Password:    "password",
+		Timeout:     time.Second,
+		IPAddresses: []string{"10.10.10.10-10.10.10.20"},
+		Netmask:     "255.255.255.0",
+		Gateway:     "10.10.10.1",
+		Nameservers: hardware.Nameservers{"1.1.1.1"},
+		Labels:      hardware.Labels{"type": "cp"},
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	g := gomega.NewWithT(t)
+	server := newRedfishServer(t, defaultRedfishSystem())
+
+	machines, err := hardware.Discover(context.Background(), discoveryOptions(server.URL))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machines).To(gomega.Equal([]hardware.Machine{
+		{
+			Hostname:     "sn0001",
+			IPAddress:    "10.10.10.10",
+			Netmask:      "255.255.255.0",
+			Gateway:      "10.10.10.1",
+			Nameservers:  hardware.Nameservers{"1.1.1.1"},
+			MACAddress:   "AA:BB:CC:00:00:03",
+			Disk:         "/dev/nvme0n1",
+			Labels:       hardware.Labels{"type": "cp"},
+			BMCIPAddress: "127.0.0.1",
+			BMCUsername:  "admin",
+			BMCPassword:  "password",
+		},
+	}))
+}
+
+func TestDiscoverFallbacks(t *testing.T) {
+	g := gomega.NewWithT(t)
+	system := defaultRedfishSystem()
+	system.hostname = "Node-1"
+	system.nics = system.nics[:2]
+	system.drives = system.drives[1:]
+	server := newRedfishServer(t, system)
+
+	machines, err := hardware.Discover(context.Background(), discoveryOptions(server.URL))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machines).To(gomega.HaveLen(1))
+	g.Expect(machines[0].Hostname).To(gomega.Equal("node-1"))
+	g.Expect(machines[0].MACAddress).To(gomega.Equal("AA:BB:CC:00:00:02"))
+	g.Expect(machines[0].Disk).To(gomega.Equal("/dev/sda"))
+}
+
+func TestDiscoverDiskOverride(t *testing.T) {
+	g := gomega.NewWithT(t)
+	system := defaultRedfishSystem()
+	system.drives = nil
+	server := newRedfishServer(t, system)
+
+	_, err := hardware.Discover(context.Background(), discoveryOptions(server.URL))
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("didn't report any drive")))
+
+	opts := discoveryOptions(server.URL)
+	opts.Disk = "/dev/sdb"
+	machines, err := hardware.Discover(context.Background(), opts)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machines[0].Disk).To(gomega.Equal("/dev/sdb"))
+}
+
+func TestDiscoverErrors(t *testing.T) {
+	server := newRedfishServer(t, defaultRedfishSystem())
+
+	tests := []struct {
+		name    string
+		opts    func(*hardware.DiscoveryOptions)
+		wantErr string
+	}{
+		{
+			name:    "wrong credentials",
+			opts:    func(o *hardware.DiscoveryOptions) { o.Password = "wrong" },
+			wantErr: "unexpected status 401",
+		},
+		{
+			name:    "not enough ip addresses",
+			opts:    func(o *hardware.DiscoveryOptions) { o.IPAddresses = nil },
+			wantErr: "discovered 1 machines but only 0 ip addresses were provided",
+		},
+		{
+			name:    "invalid ip address range",
+			opts:    func(o *hardware.DiscoveryOptions) { o.IPAddresses = []string{"10.10.10.20-10.10.10.10"} },
+			wantErr: "ip address range 10.10.10.20-10.10.10.10 is invalid",
+		},
+		{
+			name:    "invalid endpoint",
+			opts:    func(o *hardware.DiscoveryOptions) { o.Endpoints = []string{"bmc.example.com"} },
+			wantErr: "bmc endpoint bmc.example.com is not a valid IP",
+		},
+		{
+			name:    "endpoint url with a host name",
+			opts:    func(o *hardware.DiscoveryOptions) { o.Endpoints = []string{"https://bmc.example.com"} },
+			wantErr: "must use an IP address",
+		},
+		{
+			name:    "cidr too big",
+			opts:    func(o *hardware.DiscoveryOptions) { o.Endpoints = []string{"10.0.0.0/16"} },
+			wantErr: "bmc endpoint 10.0.0.0/16 is too big",
+		},
+		{
+			name:    "no endpoints",
+			opts:    func(o *hardware.DiscoveryOptions) { o.Endpoints = nil },
+			wantErr: "at least one bmc endpoint is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			opts := discoveryOptions(server.URL)
+			tt.opts(&opts)
+
+			_, err := hardware.Discover(context.Background(), opts)
+			g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestDiscoverSkipsUnreachableCIDRAddresses(t *testing.T) {
+	g := gomega.NewWithT(t)
+	opts := discoveryOptions("127.0.0.0/30")
+	opts.Timeout = 100 * time.Millisecond
+
+	_, err := hardware.Discover(context.Background(), opts)
+	g.Expect(err).To(gomega.MatchError("no machines discovered from BMC endpoints 127.0.0.0/30"))
+}
+
+func TestDiscoverHardwareCSV(t *testing.T) {
+	g := gomega.NewWithT(t)
+	server := newRedfishServer(t, defaultRedfishSystem())
+
+	csvData, err := hardware.DiscoverHardwareCSV(context.Background(), discoveryOptions(server.URL))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(string(csvData)).To(gomega.Equal(strings.Join([]string{
+		"hostname,bmc_ip,bmc_username,bmc_password,mac,ip_address,netmask,gateway,nameservers,labels,disk",
+		"sn0001,127.0.0.1,admin,password,aa:bb:cc:00:00:03,10.10.10.10,255.255.255.0,10.10.10.1,1.1.1.1,type=cp,/dev/nvme0n1",
+		"",
+	}, "\n")))
+
+	reader, err := hardware.NewCSVReader(bytes.NewReader(csvData), nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	machine, err := reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(hardware.NewDefaultMachineValidator().Validate(machine)).To(gomega.Succeed())
+}
+
+func TestDiscoverHardwareYAML(t *testing.T) {
+	g := gomega.NewWithT(t)
+	server := newRedfishServer(t, defaultRedfishSystem())
+
+	yaml, err := hardware.DiscoverHardwareYAML(context.Background(), discoveryOptions(server.URL))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(string(yaml)).To(gomega.ContainSubstring("kind: Hardware"))
+	g.Expect(string(yaml)).To(gomega.ContainSubstring("name: sn0001"))
+	g.Expect(string(yaml)).To(gomega.ContainSubstring("mac: aa:bb:cc:00:00:03"))
+	g.Expect(string(yaml)).To(gomega.ContainSubstring("kind: Machine"))
+}
+
+func TestDiscoverHardwareYAMLInvalidMachine(t *testing.T) {
+	g := gomega.NewWithT(t)
+	server := newRedfishServer(t, defaultRedfishSystem())
+	opts := discoveryOptions(server.URL)
+	opts.Gateway = ""
+
+	_, err := hardware.DiscoverHardwareYAML(context.Background(), opts)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("Gateway is empty")))
+}
+
+func TestDiscoveryOptionsSetCredentialsFromEnv(t *testing.T) {
+	g := gomega.NewWithT(t)
+	t.Setenv(constants.EksaBMCUsernameKey, "admin")
+	t.Setenv(constants.EksaBMCPasswordKey, "password")
+
+	opts := &hardware.DiscoveryOptions{}
+	g.Expect(opts.SetCredentialsFromEnv()).To(gomega.Succeed())
+	g.Expect(opts.Username).To(gomega.Equal("admin"))
+	g.Expect(opts.Password).To(gomega.Equal("password"))
+
+	opts = &hardware.DiscoveryOptions{Username: "root"}
+	g.Expect(opts.SetCredentialsFromEnv()).To(gomega.Succeed())
+	g.Expect(opts.Username).To(gomega.Equal("root"))
+}
+
+func TestDiscoveryOptionsSetCredentialsFromEnvMissing(t *testing.T) {
+	g := gomega.NewWithT(t)
+	t.Setenv(constants.EksaBMCUsernameKey, "")
+	t.Setenv(constants.EksaBMCPasswordKey, "")
+
+	opts := &hardware.DiscoveryOptions{}
+	g.Expect(opts.SetCredentialsFromEnv()).To(gomega.MatchError("EKSA_BMC_USERNAME is not set or is empty"))
+
+	opts = &hardware.DiscoveryOptions{Username: "root"}
+	g.Expect(opts.SetCredentialsFromEnv()).To(gomega.MatchError("EKSA_BMC_PASSWORD is not set or is empty"))
+}
@@ -0,0 +1,179 @@
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
+)
+
+// Inventory is the allocation and health of the tinkerbell hardware of a cluster.
+type Inventory struct {
+	Hardware []HardwareStatus   `json:"hardware"`
+	Capacity []SelectorCapacity `json:"capacity,omitempty"`
+}
+
+// HardwareStatus is the allocation and health of a Hardware object.
+type HardwareStatus struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// Owner is the TinkerbellMachine that claimed the hardware. It's empty when the hardware is free.
+	Owner string `json:"owner,omitempty"`
+	// Cluster and Machine are the CAPI cluster and machine the hardware is provisioned for.
+	Cluster string `json:"cluster,omitempty"`
+	Machine string `json:"machine,omitempty"`
+	// BMC is the name of the rufio Machine of the hardware.
+	BMC        string                   `json:"bmc,omitempty"`
+	PowerState rufiov1alpha1.PowerState `json:"powerState,omitempty"`
+	// BMCContactable reflects the rufio Contactable condition. It's empty when the condition hasn't been reported.
+	BMCContactable rufiov1alpha1.ConditionStatus `json:"bmcContactable,omitempty"`
+	BMCMessage     string                        `json:"bmcMessage,omitempty"`
+	// LastWorkflow and LastWorkflowState are the most recent workflow that targeted the hardware and its state.
+	LastWorkflow      string                     `json:"lastWorkflow,omitempty"`
+	LastWorkflowState tinkv1alpha1.WorkflowState `json:"lastWorkflowState,omitempty"`
+}
+
+// Free returns true if the hardware isn't claimed by any machine.
+func (s HardwareStatus) Free() bool {
+	return s.Owner == ""
+}
+
+// SelectorCapacity is the hardware matching the selector of a TinkerbellMachineConfig.
+type SelectorCapacity struct {
+	MachineConfig string `json:"machineConfig"`
+	Namespace     string `json:"namespace"`
+	Selector      string `json:"selector"`
+	Total         int    `json:"total"`
+	Allocated     int    `json:"allocated"`
+	Free          int    `json:"free"`
+	// Unreachable is the number of free hardware whose BMC isn't contactable.
+	Unreachable int `json:"unreachable"`
+}
+
+// BuildInventory reads the hardware, rufio machines, CAPI machines and workflows with kr and returns the
+// status of each hardware, sorted by name, and the capacity of each machine config selector.
+func BuildInventory(ctx context.Context, kr *KubeReader, machineConfigs []v1alpha1.TinkerbellMachineConfig) (*Inventory, error) {
+	if err := kr.LoadAllHardware(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := kr.LoadRufioMachines(ctx); err != nil {
+		return nil, err
+	}
+
+	capiMachines, err := kr.GetCAPIMachines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows, err := kr.GetWorkflows(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The owner label holds the name of the TinkerbellMachine, which is the infrastructure of a CAPI machine.
+	machinesByInfraName := make(map[string]*clusterv1.Machine, len(capiMachines))
+	for i := range capiMachines {
+		machinesByInfraName[capiMachines[i].Spec.InfrastructureRef.Name] = &capiMachines[i]
+	}
+
+	lastWorkflows := make(map[string]*tinkv1alpha1.Workflow, len(workflows))
+	for i := range workflows {
+		w := &workflows[i]
+		last, ok := lastWorkflows[w.Spec.HardwareRef]
+		if !ok || last.CreationTimestamp.Before(&w.CreationTimestamp) {
+			lastWorkflows[w.Spec.HardwareRef] = w
+		}
+	}
+
+	catalogue := kr.GetCatalogue()
+	inventory := &Inventory{}
+	for _, hw := range catalogue.AllHardware() {
+		status := HardwareStatus{
+			Name:   hw.Name,
+			Labels: hw.Labels,
+			Owner:  hw.Labels[OwnerNameLabel],
+		}
+
+		if status.Owner != "" {
+			if m, ok := machinesByInfraName[status.Owner]; ok {
+				status.Cluster = m.Spec.ClusterName
+				status.Machine = m.Name
+			}
+		}
+
+		if hw.Spec.BMCRef != nil {
+			status.BMC = hw.Spec.BMCRef.Name
+			bmcs, err := catalogue.LookupBMC(BMCNameIndex, hw.Spec.BMCRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			if len(bmcs) > 0 {
+				status.PowerState = bmcs[0].Status.Power
+				for _, c := range bmcs[0].Status.Conditions {
+					if c.Type == rufiov1alpha1.Contactable {
+						status.BMCContactable = c.Status
+						status.BMCMessage = c.Message
+					}
+				}
+			}
+		}
+
+		if w, ok := lastWorkflows[hw.Name]; ok {
+			status.LastWorkflow = w.Name
+			status.LastWorkflowState = w.Status.State
+		}
+
+		inventory.Hardware = append(inventory.Hardware, status)
+	}
+
+	sort.Slice(inventory.Hardware, func(i, j int) bool {
+		return inventory.Hardware[i].Name < inventory.Hardware[j].Name
+	})
+
+	for i := range machineConfigs {
+		capacity, err := selectorCapacity(&machineConfigs[i], inventory.Hardware)
+		if err != nil {
+			return nil, err
+		}
+		inventory.Capacity = append(inventory.Capacity, capacity)
+	}
+
+	return inventory, nil
+}
+
+func selectorCapacity(mc *v1alpha1.TinkerbellMachineConfig, hardware []HardwareStatus) (SelectorCapacity, error) {
+	selector := mc.Spec.HardwareLabelSelector()
+	selectorString, err := selector.ToString()
+	if err != nil {
+		return SelectorCapacity{}, fmt.Errorf("serializing hardware selector of TinkerbellMachineConfig %s: %v", mc.Name, err)
+	}
+
+	capacity := SelectorCapacity{
+		MachineConfig: mc.Name,
+		Namespace:     mc.Namespace,
+		Selector:      selectorString,
+	}
+
+	for _, hw := range hardware {
+		if !selector.Matches(hw.Labels) {
+			continue
+		}
+		capacity.Total++
+		if !hw.Free() {
+			capacity.Allocated++
+			continue
+		}
+		capacity.Free++
+		if hw.BMC != "" && hw.BMCContactable != rufiov1alpha1.ConditionTrue {
+			capacity.Unreachable++
+		}
+	}
+
+	return capacity, nil
+}
@@ -0,0 +1,144 @@
+package hardware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+func inventoryHardware(name string, labels map[string]string) *tinkv1alpha1.Hardware {
+	return &tinkv1alpha1.Hardware{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    labels,
+		},
+		Spec: tinkv1alpha1.HardwareSpec{
+			BMCRef: &corev1.TypedLocalObjectReference{Name: "bmc-" + name, Kind: "Machine"},
+			Metadata: &tinkv1alpha1.HardwareMetadata{
+				Instance: &tinkv1alpha1.MetadataInstance{ID: name},
+			},
+		},
+	}
+}
+
+func inventoryBMC(name string, power rufiov1alpha1.PowerState, contactable rufiov1alpha1.ConditionStatus) *rufiov1alpha1.Machine {
+	return &rufiov1alpha1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.EksaSystemNamespace},
+		Status: rufiov1alpha1.MachineStatus{
+			Power: power,
+			Conditions: []rufiov1alpha1.MachineCondition{
+				{Type: rufiov1alpha1.Contactable, Status: contactable},
+			},
+		},
+	}
+}
+
+func inventoryWorkflow(name, hardwareName string, created time.Time, state tinkv1alpha1.WorkflowState) *tinkv1alpha1.Workflow {
+	return &tinkv1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         constants.EksaSystemNamespace,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec:   tinkv1alpha1.WorkflowSpec{HardwareRef: hardwareName},
+		Status: tinkv1alpha1.WorkflowStatus{State: state},
+	}
+}
+
+func newInventoryClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = tinkv1alpha1.AddToScheme(scheme)
+	_ = rufiov1alpha1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestBuildInventory(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now().Truncate(time.Second)
+
+	capiMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "mgmt-cp-abcde", Namespace: constants.EksaSystemNamespace},
+		Spec: clusterv1.MachineSpec{
+			ClusterName:       "mgmt",
+			InfrastructureRef: corev1.ObjectReference{Name: "mgmt-control-plane-template-xyz"},
+		},
+	}
+
+	c := newInventoryClient(
+		inventoryHardware("hw-1", map[string]string{"type": "cp", hardware.OwnerNameLabel: "mgmt-control-plane-template-xyz"}),
+		inventoryHardware("hw-2", map[string]string{"type": "cp"}),
+		inventoryHardware("hw-3", map[string]string{"type": "worker", "rack": "r1"}),
+		inventoryBMC("bmc-hw-1", rufiov1alpha1.On, rufiov1alpha1.ConditionTrue),
+		inventoryBMC("bmc-hw-2", rufiov1alpha1.Off, rufiov1alpha1.ConditionTrue),
+		inventoryBMC("bmc-hw-3", rufiov1alpha1.Unknown, rufiov1alpha1.ConditionFalse),
+		inventoryWorkflow("old", "hw-1", now.Add(-time.Hour), tinkv1alpha1.WorkflowStateFailed),
+		inventoryWorkflow("mgmt-control-plane-template-xyz", "hw-1", now, tinkv1alpha1.WorkflowStateSuccess),
+		capiMachine,
+	)
+
+	machineConfigs := []v1alpha1.TinkerbellMachineConfig{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mgmt-cp", Namespace: "default"},
+			Spec:       v1alpha1.TinkerbellMachineConfigSpec{HardwareSelector: v1alpha1.HardwareSelector{"type": "cp"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mgmt-md", Namespace: "default"},
+			Spec: v1alpha1.TinkerbellMachineConfigSpec{
+				HardwareSelectorExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "rack", Operator: metav1.LabelSelectorOpIn, Values: []string{"r1", "r2"}},
+				},
+			},
+		},
+	}
+
+	inventory, err := hardware.BuildInventory(context.Background(), hardware.NewKubeReader(c), machineConfigs)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(inventory.Hardware).To(HaveLen(3))
+
+	g.Expect(inventory.Hardware[0]).To(Equal(hardware.HardwareStatus{
+		Name:              "hw-1",
+		Labels:            map[string]string{"type": "cp", hardware.OwnerNameLabel: "mgmt-control-plane-template-xyz"},
+		Owner:             "mgmt-control-plane-template-xyz",
+		Cluster:           "mgmt",
+		Machine:           "mgmt-cp-abcde",
+		BMC:               "bmc-hw-1",
+		PowerState:        rufiov1alpha1.On,
+		BMCContactable:    rufiov1alpha1.ConditionTrue,
+		LastWorkflow:      "mgmt-control-plane-template-xyz",
+		LastWorkflowState: tinkv1alpha1.WorkflowStateSuccess,
+	}))
+	g.Expect(inventory.Hardware[1].Free()).To(BeTrue())
+	g.Expect(inventory.Hardware[1].LastWorkflow).To(BeEmpty())
+	g.Expect(inventory.Hardware[2].BMCContactable).To(Equal(rufiov1alpha1.ConditionFalse))
+
+	g.Expect(inventory.Capacity).To(Equal([]hardware.SelectorCapacity{
+		{MachineConfig: "mgmt-cp", Namespace: "default", Selector: `{"type":"cp"}`, Total: 2, Allocated: 1, Free: 1},
+		{MachineConfig: "mgmt-md", Namespace: "default", Selector: "rack in (r1,r2)", Total: 1, Free: 1, Unreachable: 1},
+	}))
+}
+
+func TestBuildInventoryListError(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	_ = tinkv1alpha1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	_, err := hardware.BuildInventory(context.Background(), hardware.NewKubeReader(c), nil)
+	g.Expect(err).To(MatchError(ContainSubstring("listing rufio machines")))
+}
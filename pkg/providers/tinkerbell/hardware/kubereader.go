@@ -6,6 +6,7 @@ import (
 
 	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
@@ -52,6 +53,23 @@ func (kr *KubeReader) LoadHardware(ctx context.Context) error {
 	return nil
 }
 
+// LoadAllHardware fetches all the tinkerbell hardware objects, including the ones owned by a cluster,
+// and inserts them in to KubeReader catalogue.
+func (kr *KubeReader) LoadAllHardware(ctx context.Context) error {
+	var hwList tinkv1alpha1.HardwareList
+	if err := kr.client.List(ctx, &hwList, client.InNamespace(constants.EksaSystemNamespace)); err != nil {
+		return fmt.Errorf("listing hardware: %v", err)
+	}
+
+	for i := range hwList.Items {
+		if err := kr.catalogue.InsertHardware(&hwList.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetCatalogue returns the KubeReader catalogue.
 func (kr *KubeReader) GetCatalogue() *Catalogue {
 	return kr.catalogue
@@ -94,3 +112,23 @@ func (kr *KubeReader) LoadRufioMachines(ctx context.Context) error {
 
 	return nil
 }
+
+// GetCAPIMachines fetches the CAPI machine objects from the cluster.
+func (kr *KubeReader) GetCAPIMachines(ctx context.Context) ([]clusterv1.Machine, error) {
+	var machines clusterv1.MachineList
+	if err := kr.client.List(ctx, &machines, client.InNamespace(constants.EksaSystemNamespace)); err != nil {
+		return nil, fmt.Errorf("listing capi machines: %v", err)
+	}
+
+	return machines.Items, nil
+}
+
+// GetWorkflows fetches the tinkerbell workflow objects from the cluster.
+func (kr *KubeReader) GetWorkflows(ctx context.Context) ([]tinkv1alpha1.Workflow, error) {
+	var workflows tinkv1alpha1.WorkflowList
+	if err := kr.client.List(ctx, &workflows, client.InNamespace(constants.EksaSystemNamespace)); err != nil {
+		return nil, fmt.Errorf("listing workflows: %v", err)
+	}
+
+	return workflows.Items, nil
+}
@@ -29,6 +29,10 @@ type Machine struct {
 	BMCPassword  string `csv:"bmc_password, omitempty"`
 	VLANID       string `csv:"vlan_id, omitempty"`
 
+	// Interfaces are the named network interfaces of the machine, such as bond members or the
+	// interfaces of additional networks. They may include the boot interface identified by MACAddress.
+	Interfaces NetworkInterfaces `csv:"interfaces, omitempty"`
+
 	// BMCOptions are the options used for Rufio providers.
 	BMCOptions *BMCOptions `csv:"-"`
 }
@@ -104,6 +108,28 @@ func (m *Machine) HasBMC() bool {
 	return m.BMCIPAddress != "" || m.BMCUsername != "" || m.BMCPassword != ""
 }
 
+// macAddresses returns the MAC addresses of the boot interface and of m's Interfaces.
+func (m *Machine) macAddresses() []string {
+	macs := []string{m.MACAddress}
+	for _, i := range m.Interfaces {
+		if i.MACAddress != m.MACAddress {
+			macs = append(macs, i.MACAddress)
+		}
+	}
+	return macs
+}
+
+// ipAddresses returns the IP address of m and the IP addresses of its Interfaces.
+func (m *Machine) ipAddresses() []string {
+	ips := []string{m.IPAddress}
+	for _, i := range m.Interfaces {
+		if i.IPAddress != "" {
+			ips = append(ips, i.IPAddress)
+		}
+	}
+	return ips
+}
+
 // NameserversSeparator is used to unmarshal Nameservers.
 const NameserversSeparator = "|"
 
@@ -126,6 +152,101 @@ func (n *Nameservers) MarshalCSV() (string, error) {
 	return n.String(), nil
 }
 
+const (
+	// InterfacesSeparator is used to separate the interfaces of NetworkInterfaces.
+	InterfacesSeparator = "|"
+	// interfaceFieldsSeparator separates the MAC, IP address and netmask of a NetworkInterface.
+	interfaceFieldsSeparator = ";"
+)
+
+// NetworkInterface is a named network interface of a Machine. IPAddress and Netmask are set for
+// interfaces that carry an additional network.
+type NetworkInterface struct {
+	Name       string
+	MACAddress string
+	IPAddress  string
+	Netmask    string
+}
+
+func (i NetworkInterface) String() string {
+	s := fmt.Sprintf("%v=%v", i.Name, i.MACAddress)
+	if i.IPAddress != "" || i.Netmask != "" {
+		s = strings.Join([]string{s, i.IPAddress, i.Netmask}, interfaceFieldsSeparator)
+	}
+	return s
+}
+
+// NetworkInterfaces is a custom type that can unmarshal a CSV representation of network interfaces.
+// Interfaces are separated by InterfacesSeparator and each of them has the form name=mac or
+// name=mac;ip;netmask, for example eno2=00:00:00:00:00:02|eno3=00:00:00:00:00:03;10.10.20.10;255.255.255.0.
+type NetworkInterfaces []NetworkInterface
+
+func (n NetworkInterfaces) String() string {
+	interfaces := make([]string, 0, len(n))
+	for _, i := range n {
+		interfaces = append(interfaces, i.String())
+	}
+	return strings.Join(interfaces, InterfacesSeparator)
+}
+
+// Names returns the names of the interfaces in n.
+func (n NetworkInterfaces) Names() []string {
+	names := make([]string, 0, len(n))
+	for _, i := range n {
+		names = append(names, i.Name)
+	}
+	return names
+}
+
+// Get returns the interface named name.
+func (n NetworkInterfaces) Get(name string) (NetworkInterface, bool) {
+	for _, i := range n {
+		if i.Name == name {
+			return i, true
+		}
+	}
+	return NetworkInterface{}, false
+}
+
+// MarshalCSV marshalls NetworkInterfaces into a string list of interfaces separated by InterfacesSeparator.
+func (n *NetworkInterfaces) MarshalCSV() (string, error) {
+	return n.String(), nil
+}
+
+// UnmarshalCSV unmarshalls s where s is a list of interfaces separated by InterfacesSeparator.
+func (n *NetworkInterfaces) UnmarshalCSV(s string) error {
+	*n = nil
+
+	// Cater for no interfaces being specified.
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+
+	for _, entry := range strings.Split(s, InterfacesSeparator) {
+		fields := strings.Split(strings.TrimSpace(entry), interfaceFieldsSeparator)
+		if len(fields) != 1 && len(fields) != 3 {
+			return fmt.Errorf("badly formatted interface, it must be name=mac or name=mac;ip;netmask: %v", entry)
+		}
+
+		name, mac, ok := strings.Cut(fields[0], "=")
+		if !ok {
+			return fmt.Errorf("badly formatted interface, it must be name=mac or name=mac;ip;netmask: %v", entry)
+		}
+
+		i := NetworkInterface{
+			Name:       strings.TrimSpace(name),
+			MACAddress: strings.TrimSpace(mac),
+		}
+		if len(fields) == 3 {
+			i.IPAddress = strings.TrimSpace(fields[1])
+			i.Netmask = strings.TrimSpace(fields[2])
+		}
+
+		*n = append(*n, i)
+	}
+	return nil
+}
+
 // LabelSSeparator is used to separate key value label pairs.
 const LabelsSeparator = "|"
 
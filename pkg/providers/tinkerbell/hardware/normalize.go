@@ -52,10 +52,26 @@ func LowercaseMACAddress(m Machine) Machine {
 	return m
 }
 
+// LowercaseInterfacesMACAddress ensures the MACAddress field of m's Interfaces have lower case characters.
+func LowercaseInterfacesMACAddress(m Machine) Machine {
+	if len(m.Interfaces) == 0 {
+		return m
+	}
+
+	interfaces := make(NetworkInterfaces, 0, len(m.Interfaces))
+	for _, i := range m.Interfaces {
+		i.MACAddress = strings.ToLower(i.MACAddress)
+		interfaces = append(interfaces, i)
+	}
+	m.Interfaces = interfaces
+	return m
+}
+
 // RegisterDefaultNormalizations registers a set of default normalizations on n.
 func RegisterDefaultNormalizations(n *Normalizer) {
 	for _, fn := range []NormalizerFunc{
 		LowercaseMACAddress,
+		LowercaseInterfacesMACAddress,
 	} {
 		n.Register(fn)
 	}
@@ -32,6 +32,24 @@ func TestNormalizer(t *testing.T) {
 	g.Expect(machine).To(gomega.Equal(expect))
 }
 
+func TestNormalizerInterfaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctrl := gomock.NewController(t)
+	reader := mocks.NewMockMachineReader(ctrl)
+
+	normalizer := hardware.NewNormalizer(reader)
+
+	given := NewValidMachine()
+	given.Interfaces = hardware.NetworkInterfaces{{Name: "eno2", MACAddress: "AA:BB:CC:DD:EE:01"}}
+	reader.EXPECT().Read().Return(given, (error)(nil))
+
+	machine, err := normalizer.Read()
+
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine.Interfaces).To(gomega.Equal(hardware.NetworkInterfaces{{Name: "eno2", MACAddress: "aa:bb:cc:dd:ee:01"}}))
+	g.Expect(given.Interfaces[0].MACAddress).To(gomega.Equal("AA:BB:CC:DD:EE:01"))
+}
+
 func TestRawNormalizer(t *testing.T) {
 	g := gomega.NewWithT(t)
 	ctrl := gomock.NewController(t)
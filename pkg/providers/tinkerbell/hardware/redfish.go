@@ -0,0 +1,258 @@
+package hardware
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RedfishSystem is the inventory of a computer system discovered through a Redfish BMC.
+type RedfishSystem struct {
+	// ID is the Redfish identifier of the system.
+	ID string
+	// SerialNumber is the manufacturer serial number of the system.
+	SerialNumber string
+	// HostName is the host name reported by the BMC. It's usually empty until an OS reports it.
+	HostName string
+	// NICs are the network interfaces of the system in the order the BMC lists them.
+	NICs []RedfishNIC
+	// Drives are the drives of the system in the order the BMC lists them.
+	Drives []RedfishDrive
+}
+
+// RedfishNIC is a network interface of a RedfishSystem.
+type RedfishNIC struct {
+	MACAddress string
+	Enabled    bool
+	LinkUp     bool
+}
+
+// RedfishDrive is a drive of a RedfishSystem.
+type RedfishDrive struct {
+	Name          string
+	CapacityBytes int64
+	// Protocol is the protocol used to talk to the drive, for example NVMe, SATA or SAS.
+	Protocol string
+}
+
+// RedfishClient reads the inventory of the systems managed by a Redfish BMC.
+type RedfishClient struct {
+	endpoint string
+	username string
+	password string
+	client   *http.Client
+}
+
+// RedfishClientOpt configures a RedfishClient.
+type RedfishClientOpt func(*RedfishClient)
+
+// WithRedfishInsecureSkipVerify disables the verification of the BMC TLS certificate.
+// Most BMCs ship with self-signed certificates.
+func WithRedfishInsecureSkipVerify() RedfishClientOpt {
+	return func(c *RedfishClient) {
+		c.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // #nosec G402
+		}
+	}
+}
+
+// WithRedfishTimeout sets the timeout for each request to the BMC.
+func WithRedfishTimeout(timeout time.Duration) RedfishClientOpt {
+	return func(c *RedfishClient) {
+		c.client.Timeout = timeout
+	}
+}
+
+// NewRedfishClient returns a RedfishClient for the BMC at endpoint. endpoint is the base URL of the
+// BMC, for example https://10.0.0.10.
+func NewRedfishClient(endpoint, username, password string, opts ...RedfishClientOpt) *RedfishClient {
+	c := &RedfishClient{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		username: username,
+		password: password,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+type redfishLink struct {
+	ID string `json:"@odata.id"`
+}
+
+type redfishCollection struct {
+	Members []redfishLink `json:"Members"`
+}
+
+type redfishComputerSystem struct {
+	ID                 string      `json:"Id"`
+	SerialNumber       string      `json:"SerialNumber"`
+	HostName           string      `json:"HostName"`
+	EthernetInterfaces redfishLink `json:"EthernetInterfaces"`
+	Storage            redfishLink `json:"Storage"`
+}
+
+type redfishEthernetInterface struct {
+	MACAddress          string `json:"MACAddress"`
+	PermanentMACAddress string `json:"PermanentMACAddress"`
+	InterfaceEnabled    *bool  `json:"InterfaceEnabled"`
+	LinkStatus          string `json:"LinkStatus"`
+}
+
+type redfishStorage struct {
+	Drives []redfishLink `json:"Drives"`
+}
+
+type redfishDrive struct {
+	Name          string `json:"Name"`
+	CapacityBytes int64  `json:"CapacityBytes"`
+	Protocol      string `json:"Protocol"`
+}
+
+// Systems returns the inventory of all the systems managed by the BMC.
+func (c *RedfishClient) Systems(ctx context.Context) ([]RedfishSystem, error) {
+	systems := &redfishCollection{}
+	if err := c.get(ctx, "/redfish/v1/Systems", systems); err != nil {
+		return nil, err
+	}
+
+	result := make([]RedfishSystem, 0, len(systems.Members))
+	for _, member := range systems.Members {
+		system, err := c.system(ctx, member.ID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, system)
+	}
+
+	return result, nil
+}
+
+func (c *RedfishClient) system(ctx context.Context, path string) (RedfishSystem, error) {
+	cs := &redfishComputerSystem{}
+	if err := c.get(ctx, path, cs); err != nil {
+		return RedfishSystem{}, err
+	}
+
+	system := RedfishSystem{
+		ID:           cs.ID,
+		SerialNumber: strings.TrimSpace(cs.SerialNumber),
+		HostName:     cs.HostName,
+	}
+
+	if cs.EthernetInterfaces.ID != "" {
+		nics, err := c.nics(ctx, cs.EthernetInterfaces.ID)
+		if err != nil {
+			return RedfishSystem{}, err
+		}
+		system.NICs = nics
+	}
+
+	if cs.Storage.ID != "" {
+		drives, err := c.drives(ctx, cs.Storage.ID)
+		if err != nil {
+			return RedfishSystem{}, err
+		}
+		system.Drives = drives
+	}
+
+	return system, nil
+}
+
+func (c *RedfishClient) nics(ctx context.Context, path string) ([]RedfishNIC, error) {
+	interfaces := &redfishCollection{}
+	if err := c.get(ctx, path, interfaces); err != nil {
+		return nil, err
+	}
+
+	nics := make([]RedfishNIC, 0, len(interfaces.Members))
+	for _, member := range interfaces.Members {
+		iface := &redfishEthernetInterface{}
+		if err := c.get(ctx, member.ID, iface); err != nil {
+			return nil, err
+		}
+
+		mac := iface.PermanentMACAddress
+		if mac == "" {
+			mac = iface.MACAddress
+		}
+		if mac == "" {
+			continue
+		}
+
+		nics = append(nics, RedfishNIC{
+			MACAddress: mac,
+			// Interfaces are enabled unless the BMC says otherwise.
+			Enabled: iface.InterfaceEnabled == nil || *iface.InterfaceEnabled,
+			LinkUp:  iface.LinkStatus == "LinkUp",
+		})
+	}
+
+	return nics, nil
+}
+
+func (c *RedfishClient) drives(ctx context.Context, path string) ([]RedfishDrive, error) {
+	controllers := &redfishCollection{}
+	if err := c.get(ctx, path, controllers); err != nil {
+		return nil, err
+	}
+
+	var drives []RedfishDrive
+	for _, member := range controllers.Members {
+		storage := &redfishStorage{}
+		if err := c.get(ctx, member.ID, storage); err != nil {
+			return nil, err
+		}
+
+		for _, link := range storage.Drives {
+			drive := &redfishDrive{}
+			if err := c.get(ctx, link.ID, drive); err != nil {
+				return nil, err
+			}
+			drives = append(drives, RedfishDrive{
+				Name:          drive.Name,
+				CapacityBytes: drive.CapacityBytes,
+				Protocol:      drive.Protocol,
+			})
+		}
+	}
+
+	return drives, nil
+}
+
+func (c *RedfishClient) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+path, nil)
+	if err != nil {
+		return fmt.Errorf("creating redfish request for %s: %v", path, err)
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting %s from bmc %s: %v", path, c.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading %s from bmc %s: %v", path, c.endpoint, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("requesting %s from bmc %s: unexpected status %s", path, c.endpoint, resp.Status)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parsing %s from bmc %s: %v", path, c.endpoint, err)
+	}
+
+	return nil
+}
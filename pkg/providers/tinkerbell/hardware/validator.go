@@ -50,6 +50,10 @@ func (mv *DefaultMachineValidator) Register(v ...MachineAssertion) {
 var (
 	linuxPathRegex      = `^(/dev/[\w-]+)+$`
 	linuxPathValidation = regexp.MustCompile(linuxPathRegex)
+
+	// interfaceNameRegex matches linux interface names, that can't be longer than 15 characters.
+	interfaceNameRegex      = `^[a-zA-Z][\w-]{0,14}$`
+	interfaceNameValidation = regexp.MustCompile(interfaceNameRegex)
 )
 
 // StaticMachineAssertions defines all static data assertions performed on a Machine.
@@ -154,35 +158,94 @@ func StaticMachineAssertions() MachineAssertion {
 			}
 		}
 
+		if err := validateInterfaces(m); err != nil {
+			return fmt.Errorf("Interfaces: %v", err)
+		}
+
 		return nil
 	}
 }
 
-// UniqueIPAddress asserts a given Machine instance has a unique IPAddress field relative to previously seen Machine
+func validateInterfaces(m Machine) error {
+	names := make(map[string]struct{}, len(m.Interfaces))
+	macs := make(map[string]struct{}, len(m.Interfaces))
+	for _, i := range m.Interfaces {
+		if !interfaceNameValidation.MatchString(i.Name) {
+			return fmt.Errorf("interface name %q must match %v", i.Name, interfaceNameRegex)
+		}
+		if _, seen := names[i.Name]; seen {
+			return fmt.Errorf("duplicate interface name: %v", i.Name)
+		}
+		names[i.Name] = struct{}{}
+
+		if _, err := net.ParseMAC(i.MACAddress); err != nil {
+			return fmt.Errorf("interface %v: %v", i.Name, err)
+		}
+		mac := strings.ToLower(i.MACAddress)
+		if _, seen := macs[mac]; seen {
+			return fmt.Errorf("duplicate interface MACAddress: %v", i.MACAddress)
+		}
+		macs[mac] = struct{}{}
+
+		if i.IPAddress == "" && i.Netmask == "" {
+			continue
+		}
+
+		if strings.EqualFold(i.MACAddress, m.MACAddress) {
+			return fmt.Errorf("interface %v is the boot interface, its IP address must be set with IPAddress", i.Name)
+		}
+
+		if err := networkutils.ValidateIP(i.IPAddress); err != nil {
+			return fmt.Errorf("interface %v: IPAddress: %v", i.Name, err)
+		}
+
+		if i.IPAddress == m.IPAddress {
+			return fmt.Errorf("interface %v: IPAddress %v is already the machine IPAddress", i.Name, i.IPAddress)
+		}
+
+		if mask := net.ParseIP(i.Netmask); mask == nil || mask.To4() == nil {
+			return fmt.Errorf("interface %v: Netmask %q is not a valid netmask", i.Name, i.Netmask)
+		}
+	}
+
+	return nil
+}
+
+// UniqueIPAddress asserts a given Machine instance has a unique IPAddress field, and unique Interfaces IP addresses, relative to previously seen Machine
 // instances. It is not thread safe. It has a 1 time use.
 func UniqueIPAddress() MachineAssertion {
 	ips := make(map[string]struct{})
 	return func(m Machine) error {
-		if _, seen := ips[m.IPAddress]; seen {
-			return fmt.Errorf("duplicate IPAddress: %v", m.IPAddress)
+		addresses := m.ipAddresses()
+		for _, ip := range addresses {
+			if _, seen := ips[ip]; seen {
+				return fmt.Errorf("duplicate IPAddress: %v", ip)
+			}
 		}
 
-		ips[m.IPAddress] = struct{}{}
+		for _, ip := range addresses {
+			ips[ip] = struct{}{}
+		}
 
 		return nil
 	}
 }
 
-// UniqueMACAddress asserts a given Machine instance has a unique MACAddress field relative to previously seen Machine
+// UniqueMACAddress asserts a given Machine instance has a unique MACAddress field, and unique Interfaces MAC addresses, relative to previously seen Machine
 // instances. It is not thread safe. It has a 1 time use.
 func UniqueMACAddress() MachineAssertion {
 	macs := make(map[string]struct{})
 	return func(m Machine) error {
-		if _, seen := macs[m.MACAddress]; seen {
-			return fmt.Errorf("duplicate MACAddress: %v", m.MACAddress)
+		addresses := m.macAddresses()
+		for _, mac := range addresses {
+			if _, seen := macs[mac]; seen {
+				return fmt.Errorf("duplicate MACAddress: %v", mac)
+			}
 		}
 
-		macs[m.MACAddress] = struct{}{}
+		for _, mac := range addresses {
+			macs[mac] = struct{}{}
+		}
 
 		return nil
 	}
@@ -253,14 +316,8 @@ func validateLabelValue(v string) error {
 	return nil
 }
 
-// LabelsMatchSelector ensures all selector key-value pairs can be found in labels.
-// If selector is empty true is always returned.
-func LabelsMatchSelector(selector v1alpha1.HardwareSelector, labels Labels) bool {
-	for expectKey, expectValue := range selector {
-		labelValue, hasLabel := labels[expectKey]
-		if !hasLabel || labelValue != expectValue {
-			return false
-		}
-	}
-	return true
+// LabelsMatchSelector ensures all selector key-value pairs can be found in labels and all the
+// selector expressions are satisfied by labels. If selector is empty true is always returned.
+func LabelsMatchSelector(selector v1alpha1.HardwareLabelSelector, labels Labels) bool {
+	return selector.Matches(labels)
 }
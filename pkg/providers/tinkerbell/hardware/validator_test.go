@@ -112,6 +112,20 @@ func TestUniquenessAssertionsWithDupes(t *testing.T) {
 				{MACAddress: "foo"},
 			},
 		},
+		"InterfaceIPAddresses": {
+			Assertion: hardware.UniqueIPAddress(),
+			Machines: []hardware.Machine{
+				{IPAddress: "foo", Interfaces: hardware.NetworkInterfaces{{Name: "eno2", IPAddress: "baz"}}},
+				{IPAddress: "bar", Interfaces: hardware.NetworkInterfaces{{Name: "eno2", IPAddress: "baz"}}},
+			},
+		},
+		"InterfaceMACAddresses": {
+			Assertion: hardware.UniqueMACAddress(),
+			Machines: []hardware.Machine{
+				{MACAddress: "foo", Interfaces: hardware.NetworkInterfaces{{Name: "eno1", MACAddress: "foo"}}},
+				{MACAddress: "bar", Interfaces: hardware.NetworkInterfaces{{Name: "eno2", MACAddress: "foo"}}},
+			},
+		},
 		"Hostnames": {
 			Assertion: hardware.UniqueHostnames(),
 			Machines: []hardware.Machine{
@@ -146,6 +160,20 @@ func TestStaticMachineAssertions_ValidMachine(t *testing.T) {
 	g.Expect(validate(machine)).ToNot(gomega.HaveOccurred())
 }
 
+func TestStaticMachineAssertions_ValidMachineWithInterfaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	machine := NewValidMachine()
+	machine.Interfaces = hardware.NetworkInterfaces{
+		{Name: "eno1", MACAddress: machine.MACAddress},
+		{Name: "eno2", MACAddress: "00:00:00:00:00:01"},
+		{Name: "ens1f0", MACAddress: "00:00:00:00:00:02", IPAddress: "10.10.20.10", Netmask: "255.255.255.0"},
+	}
+
+	validate := hardware.StaticMachineAssertions()
+	g.Expect(validate(machine)).ToNot(gomega.HaveOccurred())
+}
+
 func TestStaticMachineAssertions_InvalidMachines(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -216,6 +244,44 @@ func TestStaticMachineAssertions_InvalidMachines(t *testing.T) {
 		"NonIntVLAN": func(h *hardware.Machine) {
 			h.VLANID = "im not an int"
 		},
+		"InvalidInterfaceName": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{{Name: "eth/0", MACAddress: "00:00:00:00:00:01"}}
+		},
+		"DuplicateInterfaceName": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{
+				{Name: "eno2", MACAddress: "00:00:00:00:00:01"},
+				{Name: "eno2", MACAddress: "00:00:00:00:00:02"},
+			}
+		},
+		"InvalidInterfaceMACAddress": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{{Name: "eno2", MACAddress: "invalid mac"}}
+		},
+		"DuplicateInterfaceMACAddress": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{
+				{Name: "eno2", MACAddress: "00:00:00:00:00:01"},
+				{Name: "eno3", MACAddress: "00:00:00:00:00:01"},
+			}
+		},
+		"InvalidInterfaceIPAddress": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{
+				{Name: "eno2", MACAddress: "00:00:00:00:00:01", IPAddress: "invalid", Netmask: "255.255.255.0"},
+			}
+		},
+		"InterfaceIPAddressWithoutNetmask": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{
+				{Name: "eno2", MACAddress: "00:00:00:00:00:01", IPAddress: "10.10.20.10"},
+			}
+		},
+		"InterfaceIPAddressIsMachineIPAddress": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{
+				{Name: "eno2", MACAddress: "00:00:00:00:00:01", IPAddress: h.IPAddress, Netmask: "255.255.255.0"},
+			}
+		},
+		"BootInterfaceWithIPAddress": func(h *hardware.Machine) {
+			h.Interfaces = hardware.NetworkInterfaces{
+				{Name: "eno1", MACAddress: h.MACAddress, IPAddress: "10.10.20.10", Netmask: "255.255.255.0"},
+			}
+		},
 	}
 
 	validate := hardware.StaticMachineAssertions()
@@ -303,6 +303,7 @@ func (r *Reconciler) ValidateHardware(ctx context.Context, log logr.Logger, tink
 
 	var v tinkerbell.ClusterSpecValidator
 	v.Register(tinkerbell.HardwareSatisfiesOnlyOneSelectorAssertion(kubeReader.GetCatalogue()))
+	v.Register(tinkerbell.HardwareNetworkInterfacesAssertion(kubeReader.GetCatalogue()))
 
 	o, err := r.DetectOperation(ctx, log, tinkerbellScope)
 	if err != nil {
@@ -406,7 +407,7 @@ func (r *Reconciler) validateHardwareReqForKCP(validatableCAPI *tinkerbell.Valid
 		if upgradeStrategy != nil && upgradeStrategy.Type == anywherev1.RollingUpdateStrategyType {
 			maxSurge = upgradeStrategy.RollingUpdate.MaxSurge
 		}
-		if err := requirements.Add(tinkerbellClusterSpec.ControlPlaneMachineConfig().Spec.HardwareSelector, maxSurge); err != nil {
+		if err := requirements.AddSelector(tinkerbellClusterSpec.ControlPlaneMachineConfig().Spec.HardwareLabelSelector(), maxSurge); err != nil {
 			return nil, err
 		}
 	}
@@ -445,7 +446,7 @@ func (r *Reconciler) validateHardwareReqForMachineDeployments(ctx context.Contex
 			if upgradeStrategy != nil && upgradeStrategy.Type == clusterv1.RollingUpdateMachineDeploymentStrategyType {
 				maxSurge = int(upgradeStrategy.RollingUpdate.MaxSurge.IntVal)
 			}
-			if err := requirements.Add(tinkerbellClusterSpec.WorkerNodeGroupMachineConfig(workerNodeGroup).Spec.HardwareSelector, maxSurge); err != nil {
+			if err := requirements.AddSelector(tinkerbellClusterSpec.WorkerNodeGroupMachineConfig(workerNodeGroup).Spec.HardwareLabelSelector(), maxSurge); err != nil {
 				return nil, err
 			}
 		}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/aws/eks-anywhere/internal/test"
@@ -362,3 +363,68 @@ func TestTemplateBuilderMDHookIso(t *testing.T) {
 		test.AssertContentToFile(t, string(data), tc.Output)
 	}
 }
+
+func TestTemplateBuilderHardwareSelectorExpressions(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_hook_iso_boot.yaml")
+	expressions := []metav1.LabelSelectorRequirement{
+		{Key: "rack", Operator: metav1.LabelSelectorOpIn, Values: []string{"r1", "r2"}},
+		{Key: "gpu", Operator: metav1.LabelSelectorOpDoesNotExist},
+	}
+	cpRef := clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name
+	clusterSpec.TinkerbellMachineConfigs[cpRef].Spec.HardwareSelectorExpressions = expressions
+	wnRef := clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineGroupRef.Name
+	clusterSpec.TinkerbellMachineConfigs[wnRef].Spec.HardwareSelectorExpressions = expressions
+
+	cpMachineCfg, err := getControlPlaneMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	wngMachineCfgs, err := getWorkerNodeGroupMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	bldr := NewTemplateBuilder(&clusterSpec.TinkerbellDatacenter.Spec, cpMachineCfg, nil, wngMachineCfgs, "0.0.0.0", time.Now)
+
+	wantExpressions := `
+            matchExpressions:
+            - key: rack
+              operator: In
+              values:
+              - "r1"
+              - "r2"
+            - key: gpu
+              operator: DoesNotExist
+      bootOptions:`
+
+	cp, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(cp)).To(ContainSubstring(wantExpressions))
+
+	workerTemplateNames, kubeadmTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+	workers, err := bldr.GenerateCAPISpecWorkers(clusterSpec, workerTemplateNames, kubeadmTemplateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(workers)).To(ContainSubstring(wantExpressions))
+}
+
+func TestTemplateBuilderNetwork(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_hook_iso_boot.yaml")
+	cpRef := clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name
+	clusterSpec.TinkerbellMachineConfigs[cpRef].Spec.Network = &v1alpha1.TinkerbellNetworkConfig{
+		Interfaces: []string{"eno1", "eno2"},
+		Bond:       &v1alpha1.TinkerbellBondConfig{Mode: v1alpha1.BondMode8023ad},
+	}
+
+	cpMachineCfg, err := getControlPlaneMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	wngMachineCfgs, err := getWorkerNodeGroupMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	bldr := NewTemplateBuilder(&clusterSpec.TinkerbellDatacenter.Spec, cpMachineCfg, nil, wngMachineCfgs, "0.0.0.0", time.Now)
+
+	cp, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(cp)).To(ContainSubstring("interfaces: [eno1, eno2]"))
+	g.Expect(string(cp)).To(ContainSubstring("mode: 802.3ad"))
+
+	workerTemplateNames, kubeadmTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+	workers, err := bldr.GenerateCAPISpecWorkers(clusterSpec, workerTemplateNames, kubeadmTemplateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(workers)).NotTo(ContainSubstring("bonds:"))
+}
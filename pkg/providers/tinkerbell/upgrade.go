@@ -163,6 +163,8 @@ func (p *Provider) SetupAndValidateUpgradeManagementComponents(_ context.Context
 func (p *Provider) validateAvailableHardwareForUpgrade(ctx context.Context, currentSpec, newClusterSpec *cluster.Spec) (err error) {
 	clusterSpecValidator := NewClusterSpecValidator(
 		HardwareSatisfiesOnlyOneSelectorAssertion(p.catalogue),
+		HardwareIPFamilyAssertion(p.catalogue),
+		HardwareNetworkInterfacesAssertion(p.catalogue),
 	)
 	eksaVersionUpgrade := currentSpec.Bundles.Spec.Number != newClusterSpec.Bundles.Spec.Number
 
@@ -215,7 +217,7 @@ func (p *Provider) validateHardwareReqForControlPlaneRollOut(currentSpec, newClu
 		maxSurge = newClusterSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
 	}
 	if oldCP.Spec.OSImageURL != newCP.Spec.OSImageURL {
-		if err := requirements.Add(newCP.Spec.HardwareSelector, maxSurge); err != nil {
+		if err := requirements.AddSelector(newCP.Spec.HardwareLabelSelector(), maxSurge); err != nil {
 			return nil, fmt.Errorf("validating hardware requirements for control-plane nodes roll out: %v", err)
 		}
 	}
@@ -237,7 +239,7 @@ func (p *Provider) validateHardwareReqForWorkerNodeGroupsRollOut(currentSpec, ne
 			if rolloutStrategy != nil && rolloutStrategy.Type == "RollingUpdate" {
 				maxSurge = rolloutStrategy.RollingUpdate.MaxSurge
 			}
-			if err := requirements.Add(newWng.Spec.HardwareSelector, maxSurge); err != nil {
+			if err := requirements.AddSelector(newWng.Spec.HardwareLabelSelector(), maxSurge); err != nil {
 				return nil, fmt.Errorf("validating hardware requirements for worker node groups roll out: %v", err)
 			}
 		}
@@ -507,6 +509,14 @@ func (p *Provider) validateMachineCfg(ctx context.Context, cluster *types.Cluste
 		return fmt.Errorf("spec.HardwareSelector is immutable. Previous value %v,   New value %v", prevMachineConfig.Spec.HardwareSelector, newConfig.Spec.HardwareSelector)
 	}
 
+	if !reflect.DeepEqual(newConfig.Spec.HardwareSelectorExpressions, prevMachineConfig.Spec.HardwareSelectorExpressions) {
+		return fmt.Errorf("spec.HardwareSelectorExpressions is immutable. Previous value %v,   New value %v", prevMachineConfig.Spec.HardwareSelectorExpressions, newConfig.Spec.HardwareSelectorExpressions)
+	}
+
+	if !reflect.DeepEqual(newConfig.Spec.Network, prevMachineConfig.Spec.Network) {
+		return fmt.Errorf("spec.Network is immutable. Previous value %v,   New value %v", prevMachineConfig.Spec.Network, newConfig.Spec.Network)
+	}
+
 	return nil
 }
 
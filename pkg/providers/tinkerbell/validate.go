@@ -264,7 +264,7 @@ type minimumHardwareRequirement struct {
 	MinCount int
 	// Selector defines what labels should be present on Hardware to consider it eligable for
 	// this requirement.
-	Selector v1alpha1.HardwareSelector
+	Selector v1alpha1.HardwareLabelSelector
 	// count is used internally by validation to sum the actual available hardware.
 	count int
 }
@@ -274,8 +274,13 @@ type minimumHardwareRequirement struct {
 // specifying the same key-value pairs are combined.
 type MinimumHardwareRequirements map[string]*minimumHardwareRequirement
 
-// Add a minimumHardwareRequirement to r.
+// Add a minimumHardwareRequirement for a key-value selector to r.
 func (r *MinimumHardwareRequirements) Add(selector v1alpha1.HardwareSelector, min int) error {
+	return r.AddSelector(v1alpha1.HardwareLabelSelector{MatchLabels: selector}, min)
+}
+
+// AddSelector adds a minimumHardwareRequirement for a selector that can include set-based expressions to r.
+func (r *MinimumHardwareRequirements) AddSelector(selector v1alpha1.HardwareLabelSelector, min int) error {
 	name, err := selector.ToString()
 	if err != nil {
 		return err
@@ -295,12 +300,8 @@ func validateMinimumHardwareRequirements(requirements MinimumHardwareRequirement
 	// Count all hardware that meets the selector requirements for each requirement.
 	// This does not consider whether or not a piece of hardware is selectable by multiple
 	// selectors. That requires a different validation ideally run before this one.
-	for _, h := range catalogue.AllHardware() {
-		for _, r := range requirements {
-			if hardware.LabelsMatchSelector(r.Selector, h.Labels) {
-				r.count++
-			}
-		}
+	for _, r := range requirements {
+		r.count = len(catalogue.LookupHardwareBySelector(r.Selector))
 	}
 
 	// Validate counts of hardware meet the minimum required count.
@@ -344,10 +345,10 @@ func validateHardwareSatisfiesOnlyOneSelector(allHardware []*tinkv1alpha1.Hardwa
 // selectorSet defines a set of selectors. Selectors should be added using the Add method to ensure
 // deterministic key generation. The construct is useful to avoid treating selectors that are the
 // same as different.
-type selectorSet map[string]v1alpha1.HardwareSelector
+type selectorSet map[string]v1alpha1.HardwareLabelSelector
 
 // Add adds selector to ss.
-func (ss *selectorSet) Add(selector v1alpha1.HardwareSelector) error {
+func (ss *selectorSet) Add(selector v1alpha1.HardwareLabelSelector) error {
 	slctrStr, err := selector.ToString()
 	if err != nil {
 		return err
@@ -361,8 +362,8 @@ func (ss *selectorSet) Add(selector v1alpha1.HardwareSelector) error {
 func getMatchingHardwareSelectors(
 	hw *tinkv1alpha1.Hardware,
 	selectors selectorSet,
-) []v1alpha1.HardwareSelector {
-	var satisfies []v1alpha1.HardwareSelector
+) []v1alpha1.HardwareLabelSelector {
+	var satisfies []v1alpha1.HardwareLabelSelector
 	for _, selector := range selectors {
 		if hardware.LabelsMatchSelector(selector, hw.Labels) {
 			satisfies = append(satisfies, selector)
@@ -371,7 +372,7 @@ func getMatchingHardwareSelectors(
 	return satisfies
 }
 
-func getHardwareSelectorsAsStrings(selectors []v1alpha1.HardwareSelector) ([]string, error) {
+func getHardwareSelectorsAsStrings(selectors []v1alpha1.HardwareLabelSelector) ([]string, error) {
 	var slctrs []string
 	for _, selector := range selectors {
 		s, err := selector.ToString()
@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	vspherev1 "sigs.k8s.io/cluster-api-provider-vsphere/apis/v1beta1"
 	addonsv1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
 
@@ -27,6 +28,8 @@ type ControlPlane struct {
 	Secrets             []*corev1.Secret
 	ConfigMaps          []*corev1.ConfigMap
 	ClusterResourceSets []*addonsv1.ClusterResourceSet
+	// IPPools are the in-cluster IPAM pools the machines claim their static IPs from.
+	IPPools []*unstructured.Unstructured
 }
 
 // Objects returns the control plane objects associated with the VSphere cluster.
@@ -35,6 +38,7 @@ func (p ControlPlane) Objects() []kubernetes.Object {
 	o = getSecrets(o, p.Secrets)
 	o = getConfigMaps(o, p.ConfigMaps)
 	o = getClusterResourceSets(o, p.ClusterResourceSets)
+	o = getIPPools(o, p.IPPools)
 
 	return o
 }
@@ -121,6 +125,9 @@ func newControlPlaneParser(logger logr.Logger) (*yamlutil.Parser, *ControlPlaneB
 		yamlutil.NewMapping(constants.ClusterResourceSetKind, func() yamlutil.APIObject {
 			return &addonsv1.ClusterResourceSet{}
 		}),
+		yamlutil.NewMapping(constants.InClusterIPPoolKind, func() yamlutil.APIObject {
+			return &unstructured.Unstructured{}
+		}),
 	)
 
 	if err != nil {
@@ -144,6 +151,8 @@ func processObjects(c *ControlPlane, lookup yamlutil.ObjectLookup) {
 			c.ConfigMaps = append(c.ConfigMaps, obj.(*corev1.ConfigMap))
 		case constants.ClusterResourceSetKind:
 			c.ClusterResourceSets = append(c.ClusterResourceSets, obj.(*addonsv1.ClusterResourceSet))
+		case constants.InClusterIPPoolKind:
+			c.IPPools = append(c.IPPools, obj.(*unstructured.Unstructured))
 		}
 	}
 }
@@ -168,3 +177,10 @@ func getClusterResourceSets(o []kubernetes.Object, clusterResourceSets []*addons
 	}
 	return o
 }
+
+func getIPPools(o []kubernetes.Object, pools []*unstructured.Unstructured) []kubernetes.Object {
+	for _, p := range pools {
+		o = append(o, p)
+	}
+	return o
+}
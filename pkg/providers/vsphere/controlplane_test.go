@@ -117,6 +117,30 @@ func TestControlPlaneSpecNewCluster(t *testing.T) {
 	g.Expect(cp.EtcdMachineTemplate.Name).To(Equal("test-etcd-1"))
 }
 
+func TestControlPlaneSpecWithIPPools(t *testing.T) {
+	g := NewWithT(t)
+	logger := test.NewNullLogger()
+	ctx := context.Background()
+	client := test.NewFakeKubeClient()
+	spec := test.NewFullClusterSpec(t, testClusterConfigMainFilename)
+	spec.VSphereDatacenter.Spec.IPPools = []anywherev1.VSphereIPPool{
+		{Name: "nodes", Addresses: []string{"10.0.0.10-10.0.0.30"}, Prefix: 24, Gateway: "10.0.0.1"},
+	}
+	spec.VSphereMachineConfigs[spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name].Spec.IPPool = "nodes"
+
+	cp, err := vsphere.ControlPlaneSpec(ctx, logger, client, spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cp.IPPools).To(HaveLen(1))
+	g.Expect(cp.IPPools[0].GetName()).To(Equal("test-nodes"))
+	g.Expect(cp.Objects()).To(ContainElement(cp.IPPools[0]))
+
+	devices := cp.ControlPlaneMachineTemplate.Spec.Template.Spec.Network.Devices
+	g.Expect(devices).To(HaveLen(1))
+	g.Expect(devices[0].DHCP4).To(BeFalse())
+	g.Expect(devices[0].AddressesFromPools).To(HaveLen(1))
+	g.Expect(devices[0].AddressesFromPools[0].Name).To(Equal("test-nodes"))
+}
+
 func TestControlPlaneSpecUpdateMachineTemplates(t *testing.T) {
 	g := NewWithT(t)
 	logger := test.NewNullLogger()
@@ -10,19 +10,36 @@ import (
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere/internal/templates"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 const minDiskGib int = 20
 
 type Defaulter struct {
-	govc ProviderGovcClient
+	govc          ProviderGovcClient
+	releaseSource *releasesource.Source
 }
 
-func NewDefaulter(govc ProviderGovcClient) *Defaulter {
-	return &Defaulter{
+// DefaulterOpt configures a Defaulter.
+type DefaulterOpt func(*Defaulter)
+
+// WithReleaseSource makes the defaulter import the default templates from the OVAs in a release
+// source instead of downloading them. A nil source is ignored.
+func WithReleaseSource(source *releasesource.Source) DefaulterOpt {
+	return func(d *Defaulter) {
+		d.releaseSource = source
+	}
+}
+
+func NewDefaulter(govc ProviderGovcClient, opts ...DefaulterOpt) *Defaulter {
+	d := &Defaulter{
 		govc: govc,
 	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
 }
 
 func (d *Defaulter) setDefaultsForMachineConfig(ctx context.Context, spec *Spec) error {
@@ -123,7 +140,15 @@ func (d *Defaulter) setupDefaultTemplate(ctx context.Context, spec *Spec, machin
 	templateFactory := templates.NewFactory(d.govc, spec.VSphereDatacenter.Spec.Datacenter, machineConfig.Spec.Datastore, spec.VSphereDatacenter.Spec.Network, machineConfig.Spec.ResourcePool, defaultTemplateLibrary)
 
 	// TODO: remove the factory's dependency on a machineConfig
-	if err := templateFactory.CreateIfMissing(ctx, spec.VSphereDatacenter.Spec.Datacenter, machineConfig, ova.URI, tags); err != nil {
+	// Import the OVA from the release source when it holds it so it isn't downloaded from the internet.
+	ovaURI := ova.URI
+	if d.releaseSource != nil {
+		if path, err := d.releaseSource.Path(ova.URI); err == nil {
+			ovaURI = path
+		}
+	}
+
+	if err := templateFactory.CreateIfMissing(ctx, spec.VSphereDatacenter.Spec.Datacenter, machineConfig, ovaURI, tags); err != nil {
 		return err
 	}
 
@@ -0,0 +1,254 @@
+package vsphere
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	vspherev1 "sigs.k8s.io/cluster-api-provider-vsphere/apis/v1beta1"
+	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/clusterimport"
+)
+
+// Importer generates the vSphere EKS-A objects for existing CAPI vSphere clusters.
+// Implements clusterimport.ProviderImporter.
+type Importer struct{}
+
+// NewImporter builds a vSphere Importer.
+func NewImporter() *Importer {
+	return &Importer{}
+}
+
+// InfrastructureClusterKind returns the kind of the CAPI vSphere infrastructure cluster.
+func (i *Importer) InfrastructureClusterKind() string {
+	return "VSphereCluster"
+}
+
+// GenerateConfig builds the VSphereDatacenterConfig and VSphereMachineConfigs from the existing
+// VSphereCluster and VSphereMachineTemplates and sets their references in the Cluster.
+func (i *Importer) GenerateConfig(objs *clusterimport.CAPIObjects, config *cluster.Config) error {
+	vsphereCluster := &vspherev1.VSphereCluster{}
+	if err := fromUnstructured(objs.InfrastructureCluster, vsphereCluster); err != nil {
+		return err
+	}
+
+	controlPlaneTemplate := &vspherev1.VSphereMachineTemplate{}
+	if err := fromUnstructured(objs.ControlPlaneMachineTemplate, controlPlaneTemplate); err != nil {
+		return err
+	}
+
+	network, err := templateNetwork(controlPlaneTemplate)
+	if err != nil {
+		return err
+	}
+
+	name := config.Cluster.Name
+	namespace := config.Cluster.Namespace
+	config.VSphereDatacenter = &anywherev1.VSphereDatacenterConfig{}
+	config.VSphereDatacenter.SetGroupVersionKind(anywherev1.GroupVersion.WithKind(anywherev1.VSphereDatacenterKind))
+	config.VSphereDatacenter.Name = name
+	config.VSphereDatacenter.Namespace = namespace
+	config.VSphereDatacenter.Spec = anywherev1.VSphereDatacenterConfigSpec{
+		Datacenter: controlPlaneTemplate.Spec.Template.Spec.Datacenter,
+		Network:    network,
+		Server:     vsphereCluster.Spec.Server,
+		Thumbprint: vsphereCluster.Spec.Thumbprint,
+		Insecure:   vsphereCluster.Spec.Thumbprint == "",
+	}
+	config.Cluster.Spec.DatacenterRef = anywherev1.Ref{
+		Kind: anywherev1.VSphereDatacenterKind,
+		Name: name,
+	}
+
+	config.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{}
+	controlPlaneMachineConfig, err := machineConfigFromTemplate(
+		fmt.Sprintf("%s-cp", name), namespace, controlPlaneTemplate, objs.KubeadmControlPlane.Spec.KubeadmConfigSpec,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "generating control plane VSphereMachineConfig")
+	}
+	config.VSphereMachineConfigs[controlPlaneMachineConfig.Name] = controlPlaneMachineConfig
+	config.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef = &anywherev1.Ref{
+		Kind: anywherev1.VSphereMachineConfigKind,
+		Name: controlPlaneMachineConfig.Name,
+	}
+
+	for idx, w := range objs.Workers {
+		group := &config.Cluster.Spec.WorkerNodeGroupConfigurations[idx]
+		template := &vspherev1.VSphereMachineTemplate{}
+		if err := fromUnstructured(w.MachineTemplate, template); err != nil {
+			return err
+		}
+
+		if workerNetwork, err := templateNetwork(template); err != nil {
+			return err
+		} else if workerNetwork != network {
+			return fmt.Errorf("VSphereMachineTemplate %s uses network %s but the control plane uses %s, all machines must be in the same network", template.Name, workerNetwork, network)
+		}
+
+		machineConfig, err := machineConfigFromTemplate(
+			fmt.Sprintf("%s-%s", name, group.Name), namespace, template, w.KubeadmConfigTemplate.Spec.Template.Spec,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "generating VSphereMachineConfig for worker node group %s", group.Name)
+		}
+		config.VSphereMachineConfigs[machineConfig.Name] = machineConfig
+		group.MachineGroupRef = &anywherev1.Ref{
+			Kind: anywherev1.VSphereMachineConfigKind,
+			Name: machineConfig.Name,
+		}
+	}
+
+	return nil
+}
+
+// Validate generates the CAPI control plane and workers for the spec with the vSphere template builder
+// and checks the VSphereMachineTemplates match the existing ones. Otherwise, reconciling the imported
+// cluster would create new machine templates and roll the machines.
+func (i *Importer) Validate(objs *clusterimport.CAPIObjects, spec *cluster.Spec) error {
+	templateBuilder := NewVsphereTemplateBuilder(time.Now)
+	controlPlaneYaml, err := templateBuilder.GenerateCAPISpecControlPlane(
+		spec,
+		func(values map[string]interface{}) {
+			values["controlPlaneTemplateName"] = objs.ControlPlaneMachineTemplate.GetName()
+			values["etcdTemplateName"] = clusterapi.EtcdMachineTemplateName(spec.Cluster)
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "generating vsphere control plane yaml spec")
+	}
+
+	parser, controlPlaneBuilder, err := newControlPlaneParser(logr.Discard())
+	if err != nil {
+		return err
+	}
+
+	if err = parser.Parse(controlPlaneYaml, controlPlaneBuilder); err != nil {
+		return errors.Wrap(err, "parsing vsphere control plane yaml")
+	}
+
+	if err = validateImportedMachineTemplate(objs.ControlPlaneMachineTemplate, controlPlaneBuilder.ControlPlane.ControlPlaneMachineTemplate); err != nil {
+		return err
+	}
+
+	workersYaml, err := templateBuilder.CAPIWorkersSpecWithInitialNames(spec)
+	if err != nil {
+		return errors.Wrap(err, "generating vsphere workers yaml spec")
+	}
+
+	parser, workersBuilder, err := newWorkersParserAndBuilder(logr.Discard())
+	if err != nil {
+		return err
+	}
+
+	if err = parser.Parse(workersYaml, workersBuilder); err != nil {
+		return errors.Wrap(err, "parsing vsphere workers yaml")
+	}
+
+	generated := make(map[string]*vspherev1.VSphereMachineTemplate, len(workersBuilder.Workers.Groups))
+	for _, g := range workersBuilder.Workers.Groups {
+		generated[g.MachineDeployment.Name] = g.ProviderMachineTemplate
+	}
+
+	for _, w := range objs.Workers {
+		template, ok := generated[w.MachineDeployment.Name]
+		if !ok {
+			return fmt.Errorf("no worker node group generated for MachineDeployment %s", w.MachineDeployment.Name)
+		}
+		if err = validateImportedMachineTemplate(w.MachineTemplate, template); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateImportedMachineTemplate(existing *unstructured.Unstructured, generated *vspherev1.VSphereMachineTemplate) error {
+	current := &vspherev1.VSphereMachineTemplate{}
+	if err := fromUnstructured(existing, current); err != nil {
+		return err
+	}
+
+	if !machineTemplateEqual(generated, current) {
+		return fmt.Errorf("VSphereMachineTemplate %s doesn't match the one generated for the imported config, taking ownership would roll its machines", current.Name)
+	}
+
+	return nil
+}
+
+func machineConfigFromTemplate(name, namespace string, template *vspherev1.VSphereMachineTemplate, kubeadmConfig bootstrapv1.KubeadmConfigSpec) (*anywherev1.VSphereMachineConfig, error) {
+	users := make([]anywherev1.UserConfiguration, 0, len(kubeadmConfig.Users))
+	for _, u := range kubeadmConfig.Users {
+		if len(u.SSHAuthorizedKeys) == 0 {
+			continue
+		}
+		users = append(users, anywherev1.UserConfiguration{
+			Name:              u.Name,
+			SshAuthorizedKeys: u.SSHAuthorizedKeys,
+		})
+	}
+	if len(users) == 0 {
+		return nil, errors.New("kubeadm config doesn't define any user with ssh authorized keys")
+	}
+
+	spec := template.Spec.Template.Spec
+	m := &anywherev1.VSphereMachineConfig{}
+	m.SetGroupVersionKind(anywherev1.GroupVersion.WithKind(anywherev1.VSphereMachineConfigKind))
+	m.Name = name
+	m.Namespace = namespace
+	m.Spec = anywherev1.VSphereMachineConfigSpec{
+		DiskGiB:           int(spec.DiskGiB),
+		Datastore:         spec.Datastore,
+		Folder:            spec.Folder,
+		NumCPUs:           int(spec.NumCPUs),
+		MemoryMiB:         int(spec.MemoryMiB),
+		OSFamily:          osFamily(spec.Template, kubeadmConfig),
+		ResourcePool:      spec.ResourcePool,
+		StoragePolicyName: spec.StoragePolicyName,
+		Template:          spec.Template,
+		Users:             users,
+		TagIDs:            spec.TagIDs,
+		CloneMode:         anywherev1.CloneMode(spec.CloneMode),
+	}
+
+	return m, nil
+}
+
+// osFamily infers the OS family of the machines from the kubeadm bootstrap format and the template name.
+func osFamily(template string, kubeadmConfig bootstrapv1.KubeadmConfigSpec) anywherev1.OSFamily {
+	if string(kubeadmConfig.Format) == string(anywherev1.Bottlerocket) {
+		return anywherev1.Bottlerocket
+	}
+
+	template = strings.ToLower(template)
+	if strings.Contains(template, "rhel") || strings.Contains(template, "redhat") {
+		return anywherev1.RedHat
+	}
+
+	return anywherev1.Ubuntu
+}
+
+func templateNetwork(template *vspherev1.VSphereMachineTemplate) (string, error) {
+	devices := template.Spec.Template.Spec.Network.Devices
+	if len(devices) != 1 {
+		return "", fmt.Errorf("VSphereMachineTemplate %s has %d network devices, only one is supported", template.Name, len(devices))
+	}
+
+	return devices[0].NetworkName, nil
+}
+
+func fromUnstructured(u *unstructured.Unstructured, obj runtime.Object) error {
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), obj); err != nil {
+		return errors.Wrapf(err, "converting %s %s", u.GetKind(), u.GetName())
+	}
+
+	return nil
+}
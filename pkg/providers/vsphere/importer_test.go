@@ -0,0 +1,183 @@
+package vsphere_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterimport"
+	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("converting to unstructured: %s", err)
+	}
+
+	return &unstructured.Unstructured{Object: content}
+}
+
+func capiObjectsForSpec(t *testing.T, spec *cluster.Spec) *clusterimport.CAPIObjects {
+	t.Helper()
+	ctx := context.Background()
+	logger := test.NewNullLogger()
+	client := test.NewFakeKubeClient()
+
+	cp, err := vsphere.ControlPlaneSpec(ctx, logger, client, spec)
+	if err != nil {
+		t.Fatalf("generating control plane: %s", err)
+	}
+	workers, err := vsphere.WorkersSpec(ctx, logger, client, spec)
+	if err != nil {
+		t.Fatalf("generating workers: %s", err)
+	}
+
+	objs := &clusterimport.CAPIObjects{
+		Cluster:                     cp.Cluster,
+		InfrastructureCluster:       toUnstructured(t, cp.ProviderCluster),
+		KubeadmControlPlane:         cp.KubeadmControlPlane,
+		ControlPlaneMachineTemplate: toUnstructured(t, cp.ControlPlaneMachineTemplate),
+	}
+	for _, g := range workers.Groups {
+		objs.Workers = append(objs.Workers, clusterimport.Worker{
+			MachineDeployment:     g.MachineDeployment,
+			KubeadmConfigTemplate: g.KubeadmConfigTemplate,
+			MachineTemplate:       toUnstructured(t, g.ProviderMachineTemplate),
+		})
+	}
+
+	return objs
+}
+
+func importedConfig() *cluster.Config {
+	return &cluster.Config{
+		Cluster: &anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test-namespace"},
+			Spec: anywherev1.ClusterSpec{
+				WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+					{Name: "md-0"},
+				},
+			},
+		},
+	}
+}
+
+func TestImporterGenerateConfig(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main_stacked_etcd.yaml")
+	objs := capiObjectsForSpec(t, spec)
+	config := importedConfig()
+
+	g.Expect(vsphere.NewImporter().GenerateConfig(objs, config)).To(Succeed())
+
+	g.Expect(config.VSphereDatacenter.Name).To(Equal("test"))
+	g.Expect(config.VSphereDatacenter.Namespace).To(Equal("test-namespace"))
+	g.Expect(config.VSphereDatacenter.TypeMeta.Kind).To(Equal(anywherev1.VSphereDatacenterKind))
+	g.Expect(config.VSphereDatacenter.Spec).To(Equal(spec.VSphereDatacenter.Spec))
+	g.Expect(config.Cluster.Spec.DatacenterRef).To(Equal(anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "test"}))
+
+	g.Expect(config.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef).To(Equal(
+		&anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "test-cp"},
+	))
+	g.Expect(config.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineGroupRef).To(Equal(
+		&anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "test-md-0"},
+	))
+
+	g.Expect(config.VSphereMachineConfigs).To(HaveLen(2))
+	for name, original := range map[string]*anywherev1.VSphereMachineConfig{
+		"test-cp":   spec.VSphereMachineConfigs["test-cp"],
+		"test-md-0": spec.VSphereMachineConfigs["test-wn"],
+	} {
+		m := config.VSphereMachineConfigs[name]
+		g.Expect(m).NotTo(BeNil(), name)
+		g.Expect(m.TypeMeta.Kind).To(Equal(anywherev1.VSphereMachineConfigKind))
+		g.Expect(m.Spec.Template).To(Equal(original.Spec.Template))
+		g.Expect(m.Spec.NumCPUs).To(Equal(original.Spec.NumCPUs))
+		g.Expect(m.Spec.MemoryMiB).To(Equal(original.Spec.MemoryMiB))
+		g.Expect(m.Spec.DiskGiB).To(Equal(original.Spec.DiskGiB))
+		g.Expect(m.Spec.Datastore).To(Equal(original.Spec.Datastore))
+		g.Expect(m.Spec.Folder).To(Equal(original.Spec.Folder))
+		g.Expect(m.Spec.ResourcePool).To(Equal(original.Spec.ResourcePool))
+		g.Expect(m.Spec.StoragePolicyName).To(Equal(original.Spec.StoragePolicyName))
+		g.Expect(m.Spec.CloneMode).To(Equal(original.Spec.CloneMode))
+		g.Expect(m.Spec.OSFamily).To(Equal(anywherev1.Ubuntu))
+		g.Expect(m.Spec.Users).To(HaveLen(1))
+		g.Expect(m.Spec.Users[0].Name).To(Equal("capv"))
+	}
+}
+
+func TestImporterGenerateConfigMultipleNetworks(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main_stacked_etcd.yaml")
+	objs := capiObjectsForSpec(t, spec)
+	devices, _, _ := unstructured.NestedSlice(objs.ControlPlaneMachineTemplate.Object, "spec", "template", "spec", "network", "devices")
+	g.Expect(unstructured.SetNestedSlice(
+		objs.ControlPlaneMachineTemplate.Object, append(devices, devices[0]), "spec", "template", "spec", "network", "devices",
+	)).To(Succeed())
+
+	g.Expect(vsphere.NewImporter().GenerateConfig(objs, importedConfig())).To(
+		MatchError(ContainSubstring("has 2 network devices, only one is supported")),
+	)
+}
+
+func TestImporterGenerateConfigNoSSHUsers(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main_stacked_etcd.yaml")
+	objs := capiObjectsForSpec(t, spec)
+	objs.KubeadmControlPlane.Spec.KubeadmConfigSpec.Users = nil
+
+	g.Expect(vsphere.NewImporter().GenerateConfig(objs, importedConfig())).To(
+		MatchError(ContainSubstring("kubeadm config doesn't define any user with ssh authorized keys")),
+	)
+}
+
+func TestImporterValidate(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main_stacked_etcd.yaml")
+	objs := capiObjectsForSpec(t, spec)
+
+	g.Expect(vsphere.NewImporter().Validate(objs, spec)).To(Succeed())
+}
+
+func TestImporterValidateMachineTemplateChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		template func(*clusterimport.CAPIObjects) *unstructured.Unstructured
+	}{
+		{
+			name: "control plane",
+			template: func(objs *clusterimport.CAPIObjects) *unstructured.Unstructured {
+				return objs.ControlPlaneMachineTemplate
+			},
+		},
+		{
+			name: "workers",
+			template: func(objs *clusterimport.CAPIObjects) *unstructured.Unstructured {
+				return objs.Workers[0].MachineTemplate
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := test.NewFullClusterSpec(t, "testdata/cluster_main_stacked_etcd.yaml")
+			objs := capiObjectsForSpec(t, spec)
+			template := tt.template(objs)
+			g.Expect(unstructured.SetNestedField(template.Object, int64(16), "spec", "template", "spec", "numCPUs")).To(Succeed())
+
+			g.Expect(vsphere.NewImporter().Validate(objs, spec)).To(
+				MatchError(ContainSubstring("taking ownership would roll its machines")),
+			)
+		})
+	}
+}
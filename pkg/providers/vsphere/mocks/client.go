@@ -286,6 +286,36 @@ func (mr *MockProviderGovcClientMockRecorder) GetHardDiskSize(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardDiskSize", reflect.TypeOf((*MockProviderGovcClient)(nil).GetHardDiskSize), arg0, arg1, arg2)
 }
 
+// GetHardDisksCapacityInKB mocks base method.
+func (m *MockProviderGovcClient) GetHardDisksCapacityInKB(arg0 context.Context, arg1, arg2 string) ([]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHardDisksCapacityInKB", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHardDisksCapacityInKB indicates an expected call of GetHardDisksCapacityInKB.
+func (mr *MockProviderGovcClientMockRecorder) GetHardDisksCapacityInKB(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardDisksCapacityInKB", reflect.TypeOf((*MockProviderGovcClient)(nil).GetHardDisksCapacityInKB), arg0, arg1, arg2)
+}
+
+// GetHostsPCIPassthroughDevices mocks base method.
+func (m *MockProviderGovcClient) GetHostsPCIPassthroughDevices(arg0 context.Context, arg1 string) (map[string][]executables.PCIDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostsPCIPassthroughDevices", arg0, arg1)
+	ret0, _ := ret[0].(map[string][]executables.PCIDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostsPCIPassthroughDevices indicates an expected call of GetHostsPCIPassthroughDevices.
+func (mr *MockProviderGovcClientMockRecorder) GetHostsPCIPassthroughDevices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsPCIPassthroughDevices", reflect.TypeOf((*MockProviderGovcClient)(nil).GetHostsPCIPassthroughDevices), arg0, arg1)
+}
+
 // GetLibraryElementContentVersion mocks base method.
 func (m *MockProviderGovcClient) GetLibraryElementContentVersion(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
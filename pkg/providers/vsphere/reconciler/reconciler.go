@@ -171,6 +171,12 @@ func (r *Reconciler) ValidateMachineConfigs(ctx context.Context, log logr.Logger
 		clusterSpec.Cluster.SetFailure(anywherev1.MachineConfigInvalidReason, failureMessage)
 		return controller.ResultWithReturn(), nil
 	}
+
+	if err := r.validator.ValidateIPPools(vsphereClusterSpec); err != nil {
+		log.Error(err, "Invalid VSphereMachineConfig ip pool")
+		clusterSpec.Cluster.SetFailure(anywherev1.MachineConfigInvalidReason, err.Error())
+		return controller.ResultWithReturn(), nil
+	}
 	return controller.Result{}, nil
 }
 
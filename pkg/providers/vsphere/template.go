@@ -2,7 +2,10 @@ package vsphere
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
+	corev1 "k8s.io/api/core/v1"
 	vspherev1 "sigs.k8s.io/cluster-api-provider-vsphere/apis/v1beta1"
 	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
 	"sigs.k8s.io/yaml"
@@ -188,6 +191,7 @@ func buildTemplateMapCP(
 	values := map[string]interface{}{
 		"clusterName":                          clusterSpec.Cluster.Name,
 		"controlPlaneEndpointIp":               clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host,
+		"kubeVipCIDR":                          clusterapi.KubeVipCIDR(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host),
 		"controlPlaneReplicas":                 clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count,
 		"apiServerCertSANs":                    clusterSpec.Cluster.Spec.ControlPlaneConfiguration.CertSANs,
 		"kubernetesRepository":                 versionsBundle.KubeDistro.Kubernetes.Repository,
@@ -240,6 +244,26 @@ func buildTemplateMapCP(
 		"etcdCloneMode":                        etcdMachineSpec.CloneMode,
 	}
 
+	values["skipPhases"] = clusterapi.InitSkipPhases(clusterSpec.Cluster)
+
+	if len(datacenterSpec.IPPools) > 0 {
+		values["ipPools"] = ipPoolsTemplateValues(clusterSpec.Cluster.Name, datacenterSpec)
+	}
+	if pool := ipPoolTemplateValues(clusterSpec.Cluster.Name, datacenterSpec, controlPlaneMachineSpec.IPPool); pool != nil {
+		values["controlPlaneIPPool"] = pool
+	}
+	if pool := ipPoolTemplateValues(clusterSpec.Cluster.Name, datacenterSpec, etcdMachineSpec.IPPool); pool != nil {
+		values["etcdIPPool"] = pool
+	}
+	values["dhcp4"], values["dhcp6"] = dhcpTemplateValues(clusterSpec.Cluster.Spec.ClusterNetwork)
+	values["controlPlaneNetworks"] = machineNetworksTemplateValues(clusterSpec.Cluster.Name, datacenterSpec, controlPlaneMachineSpec)
+	values["controlPlaneAdditionalDisksGiB"] = additionalDisksGiB(controlPlaneMachineSpec)
+	values["controlPlaneDiskMounts"] = diskMountsTemplateValues(controlPlaneMachineSpec)
+	values["etcdNetworks"] = machineNetworksTemplateValues(clusterSpec.Cluster.Name, datacenterSpec, etcdMachineSpec)
+	values["etcdAdditionalDisksGiB"] = additionalDisksGiB(etcdMachineSpec)
+	values["controlPlanePCIDevices"] = controlPlaneMachineSpec.PCIDevices
+	values["etcdPCIDevices"] = etcdMachineSpec.PCIDevices
+
 	auditPolicy, err := common.GetAuditPolicy(clusterSpec.Cluster.Spec.KubernetesVersion)
 	if err != nil {
 		return nil, err
@@ -249,6 +273,7 @@ func buildTemplateMapCP(
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorFallbacks"] = containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors())
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		values["insecureSkip"] = registryMirror.InsecureSkipVerify
 		values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
@@ -256,8 +281,18 @@ func buildTemplateMapCP(
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		hosts, err := containerd.HostConfigs(registryMirror)
+		if err != nil {
+			return values, err
+		}
+		values["registryMirrorHosts"] = hosts
+		if caBundle := containerd.CACertBundle(registryMirror); len(caBundle) > 0 {
+			values["registryCACertBundle"] = caBundle
+		}
+
 		if controlPlaneMachineSpec.OSFamily == anywherev1.Bottlerocket &&
 			len(registryMirror.NamespacedRegistryMap) == 1 &&
+			!registryMirror.HasFallbacks() &&
 			registryMirror.CoreEKSAMirror() != "" {
 			values["publicECRMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
 		}
@@ -448,6 +483,7 @@ func buildTemplateMapMD(
 		return nil, fmt.Errorf("could not find VersionsBundle")
 	}
 	format := "cloud-config"
+	workerNodeGroupConfiguration = withPCIPassthroughDefaults(workerNodeGroupConfiguration, workerNodeGroupMachineSpec)
 
 	firstUser := workerNodeGroupMachineSpec.Users[0]
 	sshKey, err := common.StripSshAuthorizedKeyComment(firstUser.SshAuthorizedKeys[0])
@@ -482,9 +518,19 @@ func buildTemplateMapMD(
 		"workerCloneMode":                workerNodeGroupMachineSpec.CloneMode,
 	}
 
+	if pool := ipPoolTemplateValues(clusterSpec.Cluster.Name, datacenterSpec, workerNodeGroupMachineSpec.IPPool); pool != nil {
+		values["workerIPPool"] = pool
+	}
+	values["dhcp4"], values["dhcp6"] = dhcpTemplateValues(clusterSpec.Cluster.Spec.ClusterNetwork)
+	values["workerNetworks"] = machineNetworksTemplateValues(clusterSpec.Cluster.Name, datacenterSpec, workerNodeGroupMachineSpec)
+	values["workerAdditionalDisksGiB"] = additionalDisksGiB(workerNodeGroupMachineSpec)
+	values["workerDiskMounts"] = diskMountsTemplateValues(workerNodeGroupMachineSpec)
+	values["workerPCIDevices"] = workerNodeGroupMachineSpec.PCIDevices
+
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorFallbacks"] = containerd.ToAPIEndpointsList(registryMirror.FallbackMirrors())
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		values["insecureSkip"] = registryMirror.InsecureSkipVerify
 		values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
@@ -492,8 +538,18 @@ func buildTemplateMapMD(
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		hosts, err := containerd.HostConfigs(registryMirror)
+		if err != nil {
+			return values, err
+		}
+		values["registryMirrorHosts"] = hosts
+		if caBundle := containerd.CACertBundle(registryMirror); len(caBundle) > 0 {
+			values["registryCACertBundle"] = caBundle
+		}
+
 		if workerNodeGroupMachineSpec.OSFamily == anywherev1.Bottlerocket &&
 			len(registryMirror.NamespacedRegistryMap) == 1 &&
+			!registryMirror.HasFallbacks() &&
 			registryMirror.CoreEKSAMirror() != "" {
 			values["publicECRMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
 		}
@@ -602,6 +658,139 @@ func buildTemplateMapMD(
 	return values, nil
 }
 
+// PCIPassthroughNodeLabel is the label and taint key added by default to the nodes of worker node
+// groups with PCI devices, so only the workloads that need the devices are scheduled on them.
+const PCIPassthroughNodeLabel = "anywhere.eks.amazonaws.com/pci-passthrough"
+
+// withPCIPassthroughDefaults returns a copy of the worker node group with the PCI passthrough label
+// and taint when its machines have PCI devices, unless the group already sets that label or taint.
+func withPCIPassthroughDefaults(wng anywherev1.WorkerNodeGroupConfiguration, machineSpec anywherev1.VSphereMachineConfigSpec) anywherev1.WorkerNodeGroupConfiguration {
+	if len(machineSpec.PCIDevices) == 0 {
+		return wng
+	}
+
+	if _, ok := wng.Labels[PCIPassthroughNodeLabel]; !ok {
+		labels := maps.Clone(wng.Labels)
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[PCIPassthroughNodeLabel] = "true"
+		wng.Labels = labels
+	}
+
+	hasTaint := slices.ContainsFunc(wng.Taints, func(t corev1.Taint) bool {
+		return t.Key == PCIPassthroughNodeLabel
+	})
+	if !hasTaint {
+		taints := slices.Clone(wng.Taints)
+		wng.Taints = append(taints, corev1.Taint{
+			Key:    PCIPassthroughNodeLabel,
+			Value:  "true",
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+	}
+
+	return wng
+}
+
+// IPPoolObjectName returns the name of the InClusterIPPool that backs one of the
+// VSphereDatacenterConfig ip pools of a cluster.
+func IPPoolObjectName(clusterName, poolName string) string {
+	return fmt.Sprintf("%s-%s", clusterName, poolName)
+}
+
+func ipPoolsTemplateValues(clusterName string, datacenterSpec anywherev1.VSphereDatacenterConfigSpec) []map[string]interface{} {
+	pools := make([]map[string]interface{}, 0, len(datacenterSpec.IPPools))
+	for _, pool := range datacenterSpec.IPPools {
+		pools = append(pools, map[string]interface{}{
+			"name":      IPPoolObjectName(clusterName, pool.Name),
+			"addresses": pool.Addresses,
+			"gateway":   pool.Gateway,
+			"prefix":    pool.Prefix,
+		})
+	}
+	return pools
+}
+
+// ipPoolTemplateValues returns the values to claim a NIC address from an ip pool,
+// or nil if the NIC uses DHCP.
+func ipPoolTemplateValues(clusterName string, datacenterSpec anywherev1.VSphereDatacenterConfigSpec, poolName string) map[string]interface{} {
+	if poolName == "" {
+		return nil
+	}
+	for _, pool := range datacenterSpec.IPPools {
+		if pool.Name == poolName {
+			return map[string]interface{}{
+				"name":        IPPoolObjectName(clusterName, pool.Name),
+				"nameservers": pool.Nameservers,
+			}
+		}
+	}
+	return nil
+}
+
+// dhcpTemplateValues returns whether the primary NIC uses DHCPv4 and DHCPv6 to get the node
+// addresses for the cluster IP families.
+func dhcpTemplateValues(clusterNetwork anywherev1.ClusterNetwork) (dhcp4, dhcp6 bool) {
+	dhcp6 = clusterNetwork.HasIPFamily(anywherev1.IPv6Family)
+	return !dhcp6 || clusterNetwork.IsDualStack(), dhcp6
+}
+
+func machineNetworksTemplateValues(clusterName string, datacenterSpec anywherev1.VSphereDatacenterConfigSpec, machineSpec anywherev1.VSphereMachineConfigSpec) []map[string]interface{} {
+	networks := make([]map[string]interface{}, 0, len(machineSpec.Networks))
+	for _, network := range machineSpec.Networks {
+		values := map[string]interface{}{
+			"networkName": network.Network,
+			"routes":      network.Routes,
+		}
+		if pool := ipPoolTemplateValues(clusterName, datacenterSpec, network.IPPool); pool != nil {
+			values["ipPool"] = pool
+		}
+		networks = append(networks, values)
+	}
+	return networks
+}
+
+// additionalDisksGiB returns the sizes for the template disks that follow the OS disk. CAPV resizes
+// the disks cloned from the template and ignores the sizes without a template disk, so the validator
+// checks the template has them. Bottlerocket templates already have a data disk after the OS disk,
+// it keeps the size it has in the template.
+func additionalDisksGiB(machineSpec anywherev1.VSphereMachineConfigSpec) []int {
+	if len(machineSpec.AdditionalDisks) == 0 {
+		return nil
+	}
+	sizes := make([]int, 0, len(machineSpec.AdditionalDisks)+1)
+	if machineSpec.OSFamily == anywherev1.Bottlerocket {
+		sizes = append(sizes, 0)
+	}
+	for _, disk := range machineSpec.AdditionalDisks {
+		sizes = append(sizes, disk.SizeGiB)
+	}
+	return sizes
+}
+
+// diskMountsTemplateValues returns the values to format and mount the additional disks that have
+// a mount path. The disks are attached after the OS disk, so the first one is /dev/sdb.
+func diskMountsTemplateValues(machineSpec anywherev1.VSphereMachineConfigSpec) []map[string]interface{} {
+	var mounts []map[string]interface{}
+	for i, disk := range machineSpec.AdditionalDisks {
+		if disk.MountPath == "" {
+			continue
+		}
+		filesystem := disk.Filesystem
+		if filesystem == "" {
+			filesystem = "ext4"
+		}
+		mounts = append(mounts, map[string]interface{}{
+			"device":     fmt.Sprintf("/dev/sd%c", 'b'+i),
+			"label":      disk.Name,
+			"filesystem": filesystem,
+			"mountPath":  disk.MountPath,
+		})
+	}
+	return mounts
+}
+
 func buildTemplateMapFailureDomain(
 	clusterSpec *cluster.Spec,
 	failureDomain anywherev1.FailureDomain,
@@ -1,11 +1,14 @@
 package vsphere_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -217,3 +220,228 @@ func TestVsphereTemplateBuilderGenerateCAPISpecVCenterTags(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_vcenter_tags.yaml")
 }
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithIPPools(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.VSphereDatacenter.Spec.IPPools = []v1alpha1.VSphereIPPool{
+		{
+			Name:        "nodes",
+			Addresses:   []string{"10.0.0.10-10.0.0.30"},
+			Prefix:      24,
+			Gateway:     "10.0.0.1",
+			Nameservers: []string{"10.0.0.2"},
+		},
+	}
+	for _, machineConfig := range spec.VSphereMachineConfigs {
+		machineConfig.Spec.IPPool = "nodes"
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+
+	cp, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+		values["etcdTemplateName"] = clusterapi.EtcdMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(cp)).To(ContainSubstring(`apiVersion: ipam.cluster.x-k8s.io/v1alpha2
+kind: InClusterIPPool
+metadata:
+  name: test-nodes
+  namespace: eksa-system
+spec:
+  addresses:
+  - 10.0.0.10-10.0.0.30
+  gateway: 10.0.0.1
+  prefix: 24`))
+	g.Expect(string(cp)).To(ContainSubstring(`        - addressesFromPools:
+          - apiGroup: ipam.cluster.x-k8s.io
+            kind: InClusterIPPool
+            name: test-nodes
+          nameservers:
+          - 10.0.0.2
+          networkName: /SDDC-Datacenter/network/sddc-cgw-network-1`))
+	g.Expect(string(cp)).NotTo(ContainSubstring("dhcp4: true"))
+
+	workers, err := builder.CAPIWorkersSpecWithInitialNames(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(workers)).To(ContainSubstring("name: test-nodes"))
+	g.Expect(string(workers)).NotTo(ContainSubstring("dhcp4: true"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecIPv6(t *testing.T) {
+	tests := []struct {
+		name         string
+		pods         []string
+		services     []string
+		endpoint     string
+		wantDevice   string
+		wantVipCIDR  string
+		wantNotFound string
+	}{
+		{
+			name:     "ipv6",
+			pods:     []string{"fd00:100::/56"},
+			services: []string{"fd00:200::/108"},
+			endpoint: "fd00::100",
+			wantDevice: `        - dhcp6: true
+          networkName: /SDDC-Datacenter/network/sddc-cgw-network-1`,
+			wantVipCIDR:  "value: \"128\"",
+			wantNotFound: "dhcp4: true",
+		},
+		{
+			name:     "dual-stack",
+			pods:     []string{"192.168.0.0/16", "fd00:100::/56"},
+			services: []string{"10.96.0.0/12", "fd00:200::/108"},
+			endpoint: "1.2.3.4",
+			wantDevice: `        - dhcp4: true
+          dhcp6: true
+          networkName: /SDDC-Datacenter/network/sddc-cgw-network-1`,
+			wantVipCIDR: "value: \"32\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+			spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = tt.pods
+			spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = tt.services
+			spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = tt.endpoint
+			builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+
+			cp, err := builder.GenerateCAPISpecControlPlane(spec)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(cp)).To(ContainSubstring(tt.wantDevice))
+			g.Expect(string(cp)).To(ContainSubstring("- name: vip_cidr\n              " + tt.wantVipCIDR))
+			capiCluster := capiClusterFromSpec(t, cp)
+			g.Expect(capiCluster.Spec.ClusterNetwork.Pods.CIDRBlocks).To(Equal(tt.pods))
+			g.Expect(capiCluster.Spec.ClusterNetwork.Services.CIDRBlocks).To(Equal(tt.services))
+
+			workers, err := builder.CAPIWorkersSpecWithInitialNames(spec)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(workers)).To(ContainSubstring(tt.wantDevice))
+			if tt.wantNotFound != "" {
+				g.Expect(string(cp)).NotTo(ContainSubstring(tt.wantNotFound))
+				g.Expect(string(workers)).NotTo(ContainSubstring(tt.wantNotFound))
+			}
+		})
+	}
+}
+
+// capiClusterFromSpec returns the CAPI Cluster of a generated control plane spec.
+func capiClusterFromSpec(t *testing.T, spec []byte) *clusterv1.Cluster {
+	t.Helper()
+	for _, doc := range strings.Split(string(spec), "\n---\n") {
+		cluster := &clusterv1.Cluster{}
+		if err := yaml.Unmarshal([]byte(doc), cluster); err != nil {
+			t.Fatalf("unmarshalling control plane spec: %v", err)
+		}
+		if cluster.Kind == "Cluster" {
+			return cluster
+		}
+	}
+	t.Fatal("control plane spec has no CAPI Cluster")
+	return nil
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithPCIDevices(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	workerMachineConfig := spec.VSphereMachineConfigs[spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineGroupRef.Name]
+	workerMachineConfig.Spec.PCIDevices = []v1alpha1.VSpherePCIDevice{{VendorID: 0x10de, DeviceID: 0x20b5}}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+
+	workers, err := builder.CAPIWorkersSpecWithInitialNames(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(workers)).To(ContainSubstring(`      pciDevices:
+      - deviceId: 8373
+        vendorId: 4318`))
+	g.Expect(string(workers)).To(ContainSubstring(`            - key: anywhere.eks.amazonaws.com/pci-passthrough
+              value: true
+              effect: NoSchedule`))
+	g.Expect(string(workers)).To(ContainSubstring("node-labels: anywhere.eks.amazonaws.com/pci-passthrough=true"))
+	g.Expect(spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].Taints).To(BeEmpty())
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneKubeProxyReplacement(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{KubeProxyReplacement: true}}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+
+	cp, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(cp)).To(ContainSubstring(`    initConfiguration:
+      skipPhases:
+      - addon/kube-proxy
+`))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithNetworksAndDisks(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.VSphereDatacenter.Spec.IPPools = []v1alpha1.VSphereIPPool{
+		{
+			Name:      "storage",
+			Addresses: []string{"192.168.10.10-192.168.10.30"},
+			Prefix:    24,
+			Gateway:   "192.168.10.1",
+		},
+	}
+	for _, machineConfig := range spec.VSphereMachineConfigs {
+		machineConfig.Spec.OSFamily = v1alpha1.Ubuntu
+		machineConfig.Spec.Networks = []v1alpha1.VSphereMachineNetwork{
+			{
+				Network: "/SDDC-Datacenter/network/storage",
+				IPPool:  "storage",
+				Routes:  []v1alpha1.VSphereNetworkRoute{{To: "192.168.20.0/24", Via: "192.168.10.1", Metric: 100}},
+			},
+		}
+		machineConfig.Spec.AdditionalDisks = []v1alpha1.VSphereAdditionalDisk{
+			{Name: "data", SizeGiB: 100, MountPath: "/var/lib/data", Filesystem: "xfs"},
+			{Name: "raw", SizeGiB: 50},
+		}
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+
+	cp, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+		values["etcdTemplateName"] = clusterapi.EtcdMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(cp)).To(ContainSubstring(`        - dhcp4: true
+          networkName: /SDDC-Datacenter/network/sddc-cgw-network-1
+        - networkName: /SDDC-Datacenter/network/storage
+          addressesFromPools:
+          - apiGroup: ipam.cluster.x-k8s.io
+            kind: InClusterIPPool
+            name: test-storage
+          routes:
+          - metric: 100
+            to: 192.168.20.0/24
+            via: 192.168.10.1`))
+	g.Expect(string(cp)).To(ContainSubstring(`      additionalDisksGiB:
+      - 100
+      - 50`))
+	g.Expect(string(cp)).To(ContainSubstring(`    diskSetup:
+      filesystems:
+      - device: /dev/sdb1
+        filesystem: xfs
+        label: data
+      partitions:
+      - device: /dev/sdb
+        layout: true
+        overwrite: false
+        tableType: gpt
+    mounts:
+    - - LABEL=data
+      - /var/lib/data`))
+	g.Expect(string(cp)).NotTo(ContainSubstring("/dev/sdc"))
+
+	workers, err := builder.CAPIWorkersSpecWithInitialNames(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(workers)).To(ContainSubstring("name: test-storage"))
+	g.Expect(string(workers)).To(ContainSubstring(`      mounts:
+      - - LABEL=data
+        - /var/lib/data`))
+}
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/collection"
 	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/features"
 	"github.com/aws/eks-anywhere/pkg/govmomi"
 	"github.com/aws/eks-anywhere/pkg/logger"
@@ -244,9 +247,322 @@ func (v *Validator) ValidateClusterMachineConfigs(ctx context.Context, vsphereCl
 		}
 	}
 
+	for _, mc := range vsphereClusterSpec.VSphereMachineConfigs {
+		if err := v.validateMachineNetworks(ctx, vsphereClusterSpec, mc); err != nil {
+			return err
+		}
+		if err := v.validateAdditionalDisks(ctx, vsphereClusterSpec, mc); err != nil {
+			return err
+		}
+	}
+
+	if err := v.validatePCIDevices(ctx, vsphereClusterSpec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePCIDevices checks that every compute cluster the VMs of a machine config with PCI devices
+// can be placed on has at least one host with all those devices available for passthrough.
+func (v *Validator) validatePCIDevices(ctx context.Context, spec *Spec) error {
+	type placement struct {
+		machineConfig  *anywherev1.VSphereMachineConfig
+		computeCluster string
+	}
+	var placements []placement
+	seen := map[string]bool{}
+	add := func(mc *anywherev1.VSphereMachineConfig, computeCluster string) {
+		if mc == nil || len(mc.Spec.PCIDevices) == 0 || seen[mc.Name+computeCluster] {
+			return
+		}
+		seen[mc.Name+computeCluster] = true
+		placements = append(placements, placement{machineConfig: mc, computeCluster: computeCluster})
+	}
+
+	for _, mc := range sliceIfNotNil(spec.controlPlaneMachineConfig(), spec.etcdMachineConfig()) {
+		add(mc, computeClusterFromResourcePool(mc.Spec.ResourcePool))
+	}
+	for _, wng := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		mc := spec.workerMachineConfig(wng)
+		if mc == nil {
+			continue
+		}
+		if len(wng.FailureDomains) == 0 {
+			add(mc, computeClusterFromResourcePool(mc.Spec.ResourcePool))
+			continue
+		}
+		for _, fd := range spec.VSphereDatacenter.Spec.FailureDomains {
+			if slices.Contains(wng.FailureDomains, fd.Name) {
+				add(mc, fd.ComputeCluster)
+			}
+		}
+	}
+
+	hostsByComputeCluster := map[string]map[string][]executables.PCIDevice{}
+	for _, p := range placements {
+		hosts, ok := hostsByComputeCluster[p.computeCluster]
+		if !ok {
+			var err error
+			hosts, err = v.govc.GetHostsPCIPassthroughDevices(ctx, p.computeCluster)
+			if err != nil {
+				return fmt.Errorf("validating PCI devices for VSphereMachineConfig %s: %v", p.machineConfig.Name, err)
+			}
+			hostsByComputeCluster[p.computeCluster] = hosts
+		}
+
+		if !anyHostHasPCIDevices(hosts, p.machineConfig.Spec.PCIDevices) {
+			return fmt.Errorf("no host in compute cluster %s has passthrough enabled for all the pciDevices of VSphereMachineConfig %s", p.computeCluster, p.machineConfig.Name)
+		}
+	}
+	if len(placements) > 0 {
+		logger.MarkPass("PCI devices validated")
+	}
+
+	return nil
+}
+
+// computeClusterFromResourcePool returns the compute cluster that owns a resource pool path
+// like /datacenter/host/cluster/Resources/pool.
+func computeClusterFromResourcePool(resourcePool string) string {
+	computeCluster, _, _ := strings.Cut(resourcePool, "/Resources")
+	return computeCluster
+}
+
+func anyHostHasPCIDevices(hosts map[string][]executables.PCIDevice, requested []anywherev1.VSpherePCIDevice) bool {
+	for _, available := range hosts {
+		free := map[executables.PCIDevice]int{}
+		for _, device := range available {
+			free[device]++
+		}
+
+		found := true
+		for _, device := range requested {
+			key := executables.PCIDevice{VendorID: device.VendorID, DeviceID: device.DeviceID}
+			if free[key] == 0 {
+				found = false
+				break
+			}
+			free[key]--
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *Validator) validateMachineNetworks(ctx context.Context, spec *Spec, machineConfig *anywherev1.VSphereMachineConfig) error {
+	for _, network := range machineConfig.Spec.Networks {
+		if network.Network == spec.VSphereDatacenter.Spec.Network {
+			return fmt.Errorf("VSphereMachineConfig %s network %s is already the VSphereDatacenterConfig network", machineConfig.Name, network.Network)
+		}
+		if err := v.validateNetwork(ctx, network.Network); err != nil {
+			return fmt.Errorf("validating networks for VSphereMachineConfig %s: %v", machineConfig.Name, err)
+		}
+	}
+
 	return nil
 }
 
+// validateAdditionalDisks checks the machine template has a disk for every additional disk. CAPV
+// can only resize the disks cloned from the template, it doesn't create new ones.
+func (v *Validator) validateAdditionalDisks(ctx context.Context, spec *Spec, machineConfig *anywherev1.VSphereMachineConfig) error {
+	disks := machineConfig.Spec.AdditionalDisks
+	if len(disks) == 0 {
+		return nil
+	}
+
+	if etcd := spec.etcdMachineConfig(); etcd != nil && etcd.Name == machineConfig.Name {
+		for _, disk := range disks {
+			if disk.MountPath != "" {
+				return fmt.Errorf("VSphereMachineConfig %s disk %s mountPath is not supported for etcd machines", machineConfig.Name, disk.Name)
+			}
+		}
+	}
+
+	template := machineConfig.Spec.Template
+	capacities, err := v.govc.GetHardDisksCapacityInKB(ctx, template, spec.VSphereDatacenter.Spec.Datacenter)
+	if err != nil {
+		return fmt.Errorf("validating additional disks for VSphereMachineConfig %s: %v", machineConfig.Name, err)
+	}
+
+	// Bottlerocket templates come with an OS disk and a data disk.
+	firstDisk := 1
+	if machineConfig.OSFamily() == anywherev1.Bottlerocket {
+		firstDisk = 2
+	}
+	if len(capacities) < firstDisk+len(disks) {
+		return fmt.Errorf("template %s has %d disks but VSphereMachineConfig %s needs %d, build the template with a disk for every additional disk", template, len(capacities), machineConfig.Name, firstDisk+len(disks))
+	}
+
+	for i, disk := range disks {
+		templateSizeKB := capacities[firstDisk+i]
+		if float64(disk.SizeGiB)*1024*1024 < templateSizeKB {
+			return fmt.Errorf("VSphereMachineConfig %s disk %s sizeGiB %d is smaller than the %v KB of the template disk", machineConfig.Name, disk.Name, disk.SizeGiB, templateSizeKB)
+		}
+	}
+	logger.MarkPass("Additional disks validated", "machineConfig", machineConfig.Name)
+
+	return nil
+}
+
+// ValidateIPPools validates the ip pools referenced by the machine configs exist and have enough
+// addresses for every node claiming from them. Rolling upgrades create new nodes before removing
+// the old ones, so the surge nodes are counted too. This catches pool exhaustion before a create
+// or scale up starts instead of leaving machines waiting for an address.
+func (v *Validator) ValidateIPPools(vsphereClusterSpec *Spec) error {
+	datacenter := vsphereClusterSpec.VSphereDatacenter
+	clusterNetwork := vsphereClusterSpec.Cluster.Spec.ClusterNetwork
+	ipv6Only := !clusterNetwork.HasIPFamily(anywherev1.IPv4Family) && clusterNetwork.HasIPFamily(anywherev1.IPv6Family)
+	for _, mc := range vsphereClusterSpec.machineConfigs() {
+		for _, pool := range machineIPPools(mc) {
+			if datacenter.IPPool(pool) == nil {
+				return fmt.Errorf("ipPool %s referenced by VSphereMachineConfig %s is not defined in VSphereDatacenterConfig %s", pool, mc.Name, datacenter.Name)
+			}
+		}
+		// ipPools only hold IPv4 addresses, IPv6 node addresses come from DHCPv6.
+		if ipv6Only && mc.Spec.IPPool != "" {
+			return fmt.Errorf("VSphereMachineConfig %s can't use ipPool %s for the node addresses of an IPv6 cluster, ipPools only support IPv4", mc.Name, mc.Spec.IPPool)
+		}
+	}
+
+	if len(datacenter.Spec.IPPools) == 0 {
+		return nil
+	}
+
+	endpoint := vsphereClusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host
+	claims := ipPoolClaims(vsphereClusterSpec)
+	for i := range datacenter.Spec.IPPools {
+		pool := &datacenter.Spec.IPPools[i]
+		if pool.Contains(endpoint) {
+			return fmt.Errorf("ipPool %s contains the control plane endpoint %s, remove it from the pool addresses", pool.Name, endpoint)
+		}
+
+		if err := validateIPPoolLoadBalancerOverlap(pool, vsphereClusterSpec.Cluster.Spec.LoadBalancer); err != nil {
+			return err
+		}
+
+		size, err := pool.Size()
+		if err != nil {
+			return fmt.Errorf("ipPool %s is invalid: %v", pool.Name, err)
+		}
+		if claims[pool.Name] > size {
+			return fmt.Errorf("ipPool %s has %d available addresses but the cluster needs up to %d, including the nodes created during rolling upgrades", pool.Name, size, claims[pool.Name])
+		}
+	}
+	logger.MarkPass("IP pools validated")
+
+	return nil
+}
+
+// validateIPPoolLoadBalancerOverlap checks the node addresses of pool are not also assigned to
+// LoadBalancer Services by the cluster's load balancer.
+func validateIPPoolLoadBalancerOverlap(pool *anywherev1.VSphereIPPool, lb *anywherev1.LoadBalancerConfiguration) error {
+	if lb == nil {
+		return nil
+	}
+
+	nodeRanges, err := pool.IPRanges()
+	if err != nil {
+		return fmt.Errorf("ipPool %s is invalid: %v", pool.Name, err)
+	}
+
+	for i := range lb.AddressPools {
+		lbPool := &lb.AddressPools[i]
+		lbRanges, err := lbPool.Ranges()
+		if err != nil {
+			return fmt.Errorf("loadBalancer addressPool %s is invalid: %v", lbPool.Name, err)
+		}
+		for _, nodeRange := range nodeRanges {
+			for _, lbRange := range lbRanges {
+				if nodeRange.Overlaps(lbRange) {
+					return fmt.Errorf("ipPool %s overlaps with loadBalancer addressPool %s", pool.Name, lbPool.Name)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ipPoolClaims returns the maximum number of addresses claimed from each ip pool at any given time.
+func ipPoolClaims(spec *Spec) map[string]int {
+	claims := map[string]int{}
+	cp := spec.Cluster.Spec.ControlPlaneConfiguration
+	if mc := spec.controlPlaneMachineConfig(); mc != nil {
+		for _, pool := range machineIPPools(mc) {
+			claims[pool] += cp.Count + controlPlaneSurge(cp.UpgradeRolloutStrategy)
+		}
+	}
+
+	if mc := spec.etcdMachineConfig(); mc != nil {
+		for _, pool := range machineIPPools(mc) {
+			// etcdadm replaces etcd machines one at a time.
+			claims[pool] += spec.Cluster.Spec.ExternalEtcdConfiguration.Count + 1
+		}
+	}
+
+	for _, wng := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		mc := spec.workerMachineConfig(wng)
+		if mc == nil {
+			continue
+		}
+		count := 0
+		if wng.Count != nil {
+			count = *wng.Count
+		}
+		if wng.AutoScalingConfiguration != nil && wng.AutoScalingConfiguration.MaxCount > count {
+			count = wng.AutoScalingConfiguration.MaxCount
+		}
+		for _, pool := range machineIPPools(mc) {
+			claims[pool] += count + workersSurge(wng.UpgradeRolloutStrategy)
+		}
+	}
+
+	return claims
+}
+
+// machineIPPools returns the ip pools each machine claims an address from, one per NIC.
+func machineIPPools(mc *anywherev1.VSphereMachineConfig) []string {
+	var pools []string
+	if mc.Spec.IPPool != "" {
+		pools = append(pools, mc.Spec.IPPool)
+	}
+	for _, network := range mc.Spec.Networks {
+		if network.IPPool != "" {
+			pools = append(pools, network.IPPool)
+		}
+	}
+	return pools
+}
+
+func controlPlaneSurge(strategy *anywherev1.ControlPlaneUpgradeRolloutStrategy) int {
+	if strategy == nil {
+		return 1
+	}
+	if strategy.Type == anywherev1.InPlaceStrategyType {
+		return 0
+	}
+	if strategy.RollingUpdate == nil {
+		return 1
+	}
+	return strategy.RollingUpdate.MaxSurge
+}
+
+func workersSurge(strategy *anywherev1.WorkerNodesUpgradeRolloutStrategy) int {
+	if strategy == nil {
+		return 1
+	}
+	if strategy.Type == anywherev1.InPlaceStrategyType {
+		return 0
+	}
+	if strategy.RollingUpdate == nil {
+		return 1
+	}
+	return strategy.RollingUpdate.MaxSurge
+}
+
 func (v *Validator) validateControlPlaneIp(ip string) error {
 	// check if controlPlaneEndpointIp is valid
 	parsedIp := net.ParseIP(ip)
@@ -11,6 +11,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
@@ -20,6 +21,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/govmomi"
 	"github.com/aws/eks-anywhere/pkg/govmomi/mocks"
 	govcmocks "github.com/aws/eks-anywhere/pkg/providers/vsphere/mocks"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
@@ -1096,3 +1098,346 @@ func TestValidator_validateTemplates(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIPPools(t *testing.T) {
+	withPool := func(addresses ...string) func(*Spec) {
+		return func(s *Spec) {
+			s.VSphereDatacenter.Name = "datacenter"
+			s.VSphereDatacenter.Spec.IPPools = []v1alpha1.VSphereIPPool{
+				{Name: "nodes", Addresses: addresses, Prefix: 24, Gateway: "10.0.0.1"},
+			}
+			s.Cluster.Spec.ControlPlaneConfiguration.Count = 3
+			s.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "10.0.0.100"}
+			s.VSphereMachineConfigs["test-cp"].Spec.IPPool = "nodes"
+			s.VSphereMachineConfigs["test-wn"] = &v1alpha1.VSphereMachineConfig{
+				Spec: v1alpha1.VSphereMachineConfigSpec{IPPool: "nodes"},
+			}
+			s.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{
+				{Name: "md-0", Count: ptr.Int(3), MachineGroupRef: &v1alpha1.Ref{Name: "test-wn"}},
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		spec    *Spec
+		wantErr string
+	}{
+		{
+			name: "no pools",
+			spec: clusterSpec(),
+		},
+		{
+			name: "enough addresses",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17")),
+		},
+		{
+			name:    "pool exhausted by rolling upgrade surge",
+			spec:    clusterSpec(withPool("10.0.0.10-10.0.0.16")),
+			wantErr: "ipPool nodes has 7 available addresses but the cluster needs up to 8",
+		},
+		{
+			name: "pool exhausted by autoscaling",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17"), func(s *Spec) {
+				s.Cluster.Spec.WorkerNodeGroupConfigurations[0].AutoScalingConfiguration = &v1alpha1.AutoScalingConfiguration{MinCount: 1, MaxCount: 5}
+			}),
+			wantErr: "ipPool nodes has 8 available addresses but the cluster needs up to 10",
+		},
+		{
+			name: "in place upgrades don't surge",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.15"), func(s *Spec) {
+				s.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy = &v1alpha1.ControlPlaneUpgradeRolloutStrategy{Type: v1alpha1.InPlaceStrategyType}
+				s.Cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = &v1alpha1.WorkerNodesUpgradeRolloutStrategy{Type: v1alpha1.InPlaceStrategyType}
+			}),
+		},
+		{
+			name: "undefined pool",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17"), func(s *Spec) {
+				s.VSphereMachineConfigs["test-wn"].Name = "test-wn"
+				s.VSphereMachineConfigs["test-wn"].Spec.IPPool = "other"
+			}),
+			wantErr: "ipPool other referenced by VSphereMachineConfig test-wn is not defined in VSphereDatacenterConfig datacenter",
+		},
+		{
+			name:    "pool contains control plane endpoint",
+			spec:    clusterSpec(withPool("10.0.0.10-10.0.0.17", "10.0.0.100")),
+			wantErr: "ipPool nodes contains the control plane endpoint 10.0.0.100",
+		},
+		{
+			name: "pool overlaps with load balancer addresses",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17"), func(s *Spec) {
+				s.Cluster.Spec.LoadBalancer = &v1alpha1.LoadBalancerConfiguration{
+					AddressPools: []v1alpha1.LoadBalancerAddressPool{{Name: "services", Addresses: []string{"10.0.0.16/30"}}},
+				}
+			}),
+			wantErr: "ipPool nodes overlaps with loadBalancer addressPool services",
+		},
+		{
+			name: "extra networks claim an address per node",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17"), func(s *Spec) {
+				s.VSphereMachineConfigs["test-cp"].Spec.Networks = []v1alpha1.VSphereMachineNetwork{{Network: "storage", IPPool: "nodes"}}
+			}),
+			wantErr: "ipPool nodes has 8 available addresses but the cluster needs up to 12",
+		},
+		{
+			name: "undefined network pool",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17"), func(s *Spec) {
+				s.VSphereMachineConfigs["test-cp"].Name = "test-cp"
+				s.VSphereMachineConfigs["test-cp"].Spec.Networks = []v1alpha1.VSphereMachineNetwork{{Network: "storage", IPPool: "storage"}}
+			}),
+			wantErr: "ipPool storage referenced by VSphereMachineConfig test-cp is not defined",
+		},
+		{
+			name: "node pool in an ipv6 cluster",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17"), func(s *Spec) {
+				s.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:100::/56"}
+				s.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:200::/108"}
+				s.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "fd00::100"}
+			}),
+			wantErr: "can't use ipPool nodes for the node addresses of an IPv6 cluster",
+		},
+		{
+			name: "node pool in a dual-stack cluster",
+			spec: clusterSpec(withPool("10.0.0.10-10.0.0.17"), func(s *Spec) {
+				s.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16", "fd00:100::/56"}
+				s.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"10.96.0.0/12", "fd00:200::/108"}
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			v := &Validator{}
+			err := v.ValidateIPPools(tt.spec)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateMachineNetworks(t *testing.T) {
+	ctx := context.Background()
+	spec := clusterSpec(func(s *Spec) {
+		s.VSphereDatacenter.Spec.Network = "/SDDC-Datacenter/network/default"
+	})
+	machineConfig := spec.VSphereMachineConfigs["test-cp"]
+	machineConfig.Name = "test-cp"
+
+	tests := []struct {
+		name     string
+		network  string
+		exists   bool
+		existErr error
+		wantErr  string
+	}{
+		{
+			name:    "network exists",
+			network: "/SDDC-Datacenter/network/storage",
+			exists:  true,
+		},
+		{
+			name:    "network not found",
+			network: "/SDDC-Datacenter/network/storage",
+			wantErr: "validating networks for VSphereMachineConfig test-cp: network /SDDC-Datacenter/network/storage not found",
+		},
+		{
+			name:     "govc error",
+			network:  "/SDDC-Datacenter/network/storage",
+			existErr: errors.New("govc error"),
+			wantErr:  "govc error",
+		},
+		{
+			name:    "datacenter network",
+			network: "/SDDC-Datacenter/network/default",
+			wantErr: "VSphereMachineConfig test-cp network /SDDC-Datacenter/network/default is already the VSphereDatacenterConfig network",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctrl := gomock.NewController(t)
+			govc := govcmocks.NewMockProviderGovcClient(ctrl)
+			v := &Validator{govc: govc}
+			machineConfig.Spec.Networks = []v1alpha1.VSphereMachineNetwork{{Network: tt.network}}
+			if tt.network != spec.VSphereDatacenter.Spec.Network {
+				govc.EXPECT().NetworkExists(ctx, tt.network).Return(tt.exists, tt.existErr)
+			}
+
+			err := v.validateMachineNetworks(ctx, spec, machineConfig)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateAdditionalDisks(t *testing.T) {
+	ctx := context.Background()
+	gib := float64(1024 * 1024)
+
+	tests := []struct {
+		name       string
+		osFamily   v1alpha1.OSFamily
+		etcd       bool
+		disks      []v1alpha1.VSphereAdditionalDisk
+		capacities []float64
+		wantErr    string
+	}{
+		{
+			name:       "ubuntu template with data disks",
+			osFamily:   v1alpha1.Ubuntu,
+			disks:      []v1alpha1.VSphereAdditionalDisk{{Name: "data", SizeGiB: 100, MountPath: "/data"}},
+			capacities: []float64{25 * gib, 10 * gib},
+		},
+		{
+			name:       "ubuntu template without enough data disks",
+			osFamily:   v1alpha1.Ubuntu,
+			disks:      []v1alpha1.VSphereAdditionalDisk{{Name: "data", SizeGiB: 100}, {Name: "logs", SizeGiB: 100}},
+			capacities: []float64{25 * gib, 10 * gib},
+			wantErr:    "template temp has 2 disks but VSphereMachineConfig test-cp needs 3",
+		},
+		{
+			name:       "bottlerocket template needs a disk after its data disk",
+			osFamily:   v1alpha1.Bottlerocket,
+			disks:      []v1alpha1.VSphereAdditionalDisk{{Name: "data", SizeGiB: 100}},
+			capacities: []float64{2 * gib, 20 * gib},
+			wantErr:    "template temp has 2 disks but VSphereMachineConfig test-cp needs 3",
+		},
+		{
+			name:       "disk smaller than template disk",
+			osFamily:   v1alpha1.Ubuntu,
+			disks:      []v1alpha1.VSphereAdditionalDisk{{Name: "data", SizeGiB: 5}},
+			capacities: []float64{25 * gib, 10 * gib},
+			wantErr:    "VSphereMachineConfig test-cp disk data sizeGiB 5 is smaller than",
+		},
+		{
+			name:     "mount path on etcd machines",
+			osFamily: v1alpha1.Ubuntu,
+			etcd:     true,
+			disks:    []v1alpha1.VSphereAdditionalDisk{{Name: "data", SizeGiB: 100, MountPath: "/data"}},
+			wantErr:  "VSphereMachineConfig test-cp disk data mountPath is not supported for etcd machines",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctrl := gomock.NewController(t)
+			govc := govcmocks.NewMockProviderGovcClient(ctrl)
+			v := &Validator{govc: govc}
+			spec := clusterSpec()
+			machineConfig := spec.VSphereMachineConfigs["test-cp"]
+			machineConfig.Name = "test-cp"
+			machineConfig.Spec.OSFamily = tt.osFamily
+			machineConfig.Spec.AdditionalDisks = tt.disks
+			if tt.etcd {
+				spec.Cluster.Spec.ExternalEtcdConfiguration = &v1alpha1.ExternalEtcdConfiguration{
+					Count:           3,
+					MachineGroupRef: &v1alpha1.Ref{Name: "test-cp"},
+				}
+			} else {
+				govc.EXPECT().GetHardDisksCapacityInKB(ctx, "temp", "SDDC-Datacenter").Return(tt.capacities, nil)
+			}
+
+			err := v.validateAdditionalDisks(ctx, spec, machineConfig)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidatePCIDevices(t *testing.T) {
+	ctx := context.Background()
+	a100 := v1alpha1.VSpherePCIDevice{VendorID: 0x10de, DeviceID: 0x20b5}
+	a100Device := executables.PCIDevice{VendorID: 0x10de, DeviceID: 0x20b5}
+	withGPUWorkers := func(s *Spec) {
+		s.VSphereMachineConfigs["test-cp"].Spec.ResourcePool = "/SDDC-Datacenter/host/Cluster-1/Resources"
+		s.VSphereMachineConfigs["test-gpu"] = &v1alpha1.VSphereMachineConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-gpu"},
+			Spec: v1alpha1.VSphereMachineConfigSpec{
+				ResourcePool: "/SDDC-Datacenter/host/Cluster-1/Resources/gpu",
+				PCIDevices:   []v1alpha1.VSpherePCIDevice{a100, a100},
+			},
+		}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{
+			{Name: "gpu", Count: ptr.Int(1), MachineGroupRef: &v1alpha1.Ref{Name: "test-gpu"}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		spec    *Spec
+		hosts   map[string]map[string][]executables.PCIDevice
+		wantErr string
+	}{
+		{
+			name: "no pci devices",
+			spec: clusterSpec(),
+		},
+		{
+			name: "a host has all the devices",
+			spec: clusterSpec(withGPUWorkers),
+			hosts: map[string]map[string][]executables.PCIDevice{
+				"/SDDC-Datacenter/host/Cluster-1": {
+					"esxi-1": {a100Device},
+					"esxi-2": {a100Device, a100Device},
+				},
+			},
+		},
+		{
+			name: "devices spread across hosts",
+			spec: clusterSpec(withGPUWorkers),
+			hosts: map[string]map[string][]executables.PCIDevice{
+				"/SDDC-Datacenter/host/Cluster-1": {
+					"esxi-1": {a100Device},
+					"esxi-2": {a100Device},
+				},
+			},
+			wantErr: "no host in compute cluster /SDDC-Datacenter/host/Cluster-1 has passthrough enabled for all the pciDevices of VSphereMachineConfig test-gpu",
+		},
+		{
+			name: "failure domain compute cluster without devices",
+			spec: clusterSpec(withGPUWorkers, func(s *Spec) {
+				s.VSphereDatacenter.Spec.FailureDomains = []v1alpha1.FailureDomain{
+					{Name: "fd-1", ComputeCluster: "/SDDC-Datacenter/host/Cluster-1"},
+					{Name: "fd-2", ComputeCluster: "/SDDC-Datacenter/host/Cluster-2"},
+				}
+				s.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomains = []string{"fd-1", "fd-2"}
+			}),
+			hosts: map[string]map[string][]executables.PCIDevice{
+				"/SDDC-Datacenter/host/Cluster-1": {"esxi-1": {a100Device, a100Device}},
+				"/SDDC-Datacenter/host/Cluster-2": {"esxi-3": {}},
+			},
+			wantErr: "no host in compute cluster /SDDC-Datacenter/host/Cluster-2 has passthrough enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctrl := gomock.NewController(t)
+			govc := govcmocks.NewMockProviderGovcClient(ctrl)
+			v := &Validator{govc: govc}
+			for computeCluster, hosts := range tt.hosts {
+				govc.EXPECT().GetHostsPCIPassthroughDevices(ctx, computeCluster).Return(hosts, nil)
+			}
+
+			err := v.validatePCIDevices(ctx, tt.spec)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"text/template"
 	"time"
 
@@ -128,6 +129,8 @@ type ProviderGovcClient interface {
 	CreateRole(ctx context.Context, name string, privileges []string) error
 	SetGroupRoleOnObject(ctx context.Context, principal, role, object, domain string) error
 	GetHardDiskSize(ctx context.Context, vm, datacenter string) (map[string]float64, error)
+	GetHardDisksCapacityInKB(ctx context.Context, vm, datacenter string) ([]float64, error)
+	GetHostsPCIPassthroughDevices(ctx context.Context, computeCluster string) (map[string][]executables.PCIDevice, error)
 	GetResourcePoolInfo(ctx context.Context, datacenter, resourcepool string, args ...string) (map[string]int, error)
 }
 
@@ -157,6 +160,16 @@ type IPValidator interface {
 	ValidateControlPlaneIPUniqueness(cluster *v1alpha1.Cluster) error
 }
 
+// ProviderOpt configures a vsphereProvider.
+type ProviderOpt func(*vsphereProvider)
+
+// WithDefaulter sets the Defaulter the provider uses for the datacenter and machine configs.
+func WithDefaulter(d *Defaulter) ProviderOpt {
+	return func(p *vsphereProvider) {
+		p.defaulter = d
+	}
+}
+
 // NewProvider initializes and returns a new vsphereProvider.
 func NewProvider(
 	datacenterConfig *v1alpha1.VSphereDatacenterConfig,
@@ -168,6 +181,7 @@ func NewProvider(
 	now types.NowFunc,
 	skipIPCheck bool,
 	skippedValidations map[string]bool,
+	opts ...ProviderOpt,
 ) *vsphereProvider { //nolint:revive
 	// TODO(g-gaston): ignoring linter error for exported function returning unexported member
 	// We should make it exported, but that would involve a bunch of changes, so will do it separately
@@ -188,6 +202,7 @@ func NewProvider(
 		skipIPCheck,
 		v,
 		skippedValidations,
+		opts...,
 	)
 }
 
@@ -203,11 +218,12 @@ func NewProviderCustomNet(
 	skipIPCheck bool,
 	v *Validator,
 	skippedValidations map[string]bool,
+	opts ...ProviderOpt,
 ) *vsphereProvider { //nolint:revive
 	// TODO(g-gaston): ignoring linter error for exported function returning unexported member
 	// We should make it exported, but that would involve a bunch of changes, so will do it separately
 	retrier := retrier.NewWithMaxRetries(maxRetries, backOffPeriod)
-	return &vsphereProvider{
+	p := &vsphereProvider{
 		clusterConfig:         clusterConfig,
 		providerGovcClient:    providerGovcClient,
 		providerKubectlClient: providerKubectlClient,
@@ -222,6 +238,10 @@ func NewProviderCustomNet(
 		ipValidator:        ipValidator,
 		skippedValidations: skippedValidations,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 func (p *vsphereProvider) UpdateKubeConfig(_ *[]byte, _ string) error {
@@ -341,6 +361,10 @@ func (p *vsphereProvider) SetupAndValidateCreateCluster(ctx context.Context, clu
 	if err := p.validator.ValidateClusterMachineConfigs(ctx, vSphereClusterSpec); err != nil {
 		return err
 	}
+
+	if err := p.validator.ValidateIPPools(vSphereClusterSpec); err != nil {
+		return err
+	}
 	if err := p.validateDatastoreUsageForCreate(ctx, vSphereClusterSpec); err != nil {
 		return fmt.Errorf("validating vsphere machine configs datastore usage: %v", err)
 	}
@@ -424,6 +448,10 @@ func (p *vsphereProvider) SetupAndValidateUpgradeCluster(ctx context.Context, cl
 		return err
 	}
 
+	if err := p.validator.ValidateIPPools(vSphereClusterSpec); err != nil {
+		return err
+	}
+
 	if err := p.validateDatastoreUsageForUpgrade(ctx, vSphereClusterSpec, cluster); err != nil {
 		return fmt.Errorf("validating vsphere machine configs datastore usage: %v", err)
 	}
@@ -764,7 +792,10 @@ func NeedsNewWorkloadTemplate(oldSpec, newSpec *cluster.Spec, oldVdc, newVdc *v1
 
 func NeedsNewKubeadmConfigTemplate(newWorkerNodeGroup, oldWorkerNodeGroup *v1alpha1.WorkerNodeGroupConfiguration, oldWorkerNodeVmc, newWorkerNodeVmc *v1alpha1.VSphereMachineConfig) bool {
 	return !v1alpha1.TaintsSliceEqual(newWorkerNodeGroup.Taints, oldWorkerNodeGroup.Taints) || !v1alpha1.MapEqual(newWorkerNodeGroup.Labels, oldWorkerNodeGroup.Labels) ||
-		!v1alpha1.UsersSliceEqual(oldWorkerNodeVmc.Spec.Users, newWorkerNodeVmc.Spec.Users)
+		!v1alpha1.UsersSliceEqual(oldWorkerNodeVmc.Spec.Users, newWorkerNodeVmc.Spec.Users) ||
+		!reflect.DeepEqual(oldWorkerNodeVmc.Spec.AdditionalDisks, newWorkerNodeVmc.Spec.AdditionalDisks) ||
+		// The PCI passthrough label and taint are only added to groups with PCI devices.
+		(len(oldWorkerNodeVmc.Spec.PCIDevices) == 0) != (len(newWorkerNodeVmc.Spec.PCIDevices) == 0)
 }
 
 func NeedsNewEtcdTemplate(oldSpec, newSpec *cluster.Spec, oldVdc, newVdc *v1alpha1.VSphereDatacenterConfig, oldVmc, newVmc *v1alpha1.VSphereMachineConfig) bool {
@@ -805,6 +836,24 @@ func AnyImmutableFieldChanged(oldVdc, newVdc *v1alpha1.VSphereDatacenterConfig,
 	if oldVmc.Spec.Template != newVmc.Spec.Template {
 		return true
 	}
+	if oldVmc.Spec.IPPool != newVmc.Spec.IPPool {
+		return true
+	}
+	if !reflect.DeepEqual(oldVmc.Spec.Networks, newVmc.Spec.Networks) {
+		return true
+	}
+	if !reflect.DeepEqual(oldVmc.Spec.AdditionalDisks, newVmc.Spec.AdditionalDisks) {
+		return true
+	}
+	if !slices.Equal(oldVmc.Spec.PCIDevices, newVmc.Spec.PCIDevices) {
+		return true
+	}
+	for _, pool := range machineIPPools(newVmc) {
+		oldPool, newPool := oldVdc.IPPool(pool), newVdc.IPPool(pool)
+		if oldPool == nil || newPool == nil || !slices.Equal(oldPool.Nameservers, newPool.Nameservers) {
+			return true
+		}
+	}
 	return false
 }
 
@@ -181,6 +181,14 @@ func (pc *DummyProviderGovcClient) GetHardDiskSize(ctx context.Context, vm, data
 	return map[string]float64{"Hard disk 1": 23068672}, nil
 }
 
+func (pc *DummyProviderGovcClient) GetHardDisksCapacityInKB(ctx context.Context, vm, datacenter string) ([]float64, error) {
+	return []float64{23068672}, nil
+}
+
+func (pc *DummyProviderGovcClient) GetHostsPCIPassthroughDevices(ctx context.Context, computeCluster string) (map[string][]executables.PCIDevice, error) {
+	return map[string][]executables.PCIDevice{}, nil
+}
+
 func (pc *DummyProviderGovcClient) GetResourcePoolInfo(ctx context.Context, datacenter, resourcePool string, args ...string) (map[string]int, error) {
 	return map[string]int{"Memory_Available": -1}, nil
 }
@@ -4200,3 +4208,15 @@ func TestProviderGenerateCAPISpecForUpgradeEtcdEncryption(t *testing.T) {
 		})
 	}
 }
+
+func TestNeedsNewKubeadmConfigTemplateAdditionalDisksChanged(t *testing.T) {
+	g := NewWithT(t)
+	wng := &v1alpha1.WorkerNodeGroupConfiguration{Name: "md-0"}
+	oldVmc := &v1alpha1.VSphereMachineConfig{}
+	newVmc := oldVmc.DeepCopy()
+
+	g.Expect(NeedsNewKubeadmConfigTemplate(wng, wng, oldVmc, newVmc)).To(BeFalse())
+
+	newVmc.Spec.AdditionalDisks = []v1alpha1.VSphereAdditionalDisk{{Name: "data", SizeGiB: 100, MountPath: "/data"}}
+	g.Expect(NeedsNewKubeadmConfigTemplate(wng, wng, oldVmc, newVmc)).To(BeTrue())
+}
@@ -0,0 +1,57 @@
+package containerd
+
+import (
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+)
+
+// HostConfig is the containerd configuration for an additional registry mirror endpoint.
+type HostConfig struct {
+	// Host is the address of the endpoint, used to key its configuration and its CA file.
+	Host                 string
+	CACert               string
+	InsecureSkipVerify   bool
+	CredentialsSecretRef string
+	Username             string
+	Password             string
+}
+
+// HostConfigs returns the containerd configuration for the additional endpoints of a registry mirror,
+// in order. Credentials are read from the env variables for the credentials secret of each endpoint.
+func HostConfigs(r *registrymirror.RegistryMirror) ([]HostConfig, error) {
+	hosts := make([]HostConfig, 0, len(r.Endpoints))
+	for _, e := range r.Endpoints {
+		host := HostConfig{
+			Host:                 e.BaseRegistry,
+			CACert:               e.CACertContent,
+			InsecureSkipVerify:   e.InsecureSkipVerify,
+			CredentialsSecretRef: e.CredentialsSecretRef,
+		}
+		if e.CredentialsSecretRef != "" {
+			username, password, err := config.ReadEndpointCredentials(e.CredentialsSecretRef)
+			if err != nil {
+				return nil, err
+			}
+			host.Username = username
+			host.Password = password
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+// CACertBundle returns the CA certificates of the primary and the additional endpoints of a registry
+// mirror in a single bundle, for OSes that only take one trust bundle for the registry mirror.
+func CACertBundle(r *registrymirror.RegistryMirror) string {
+	bundle := r.CACertContent
+	for _, e := range r.Endpoints {
+		if e.CACertContent == "" {
+			continue
+		}
+		if bundle != "" && bundle[len(bundle)-1] != '\n' {
+			bundle += "\n"
+		}
+		bundle += e.CACertContent
+	}
+	return bundle
+}
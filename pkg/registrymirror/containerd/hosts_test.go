@@ -0,0 +1,79 @@
+package containerd_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+)
+
+func TestHostConfigs(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME_HARBOR_CENTRAL", "username")
+	t.Setenv("REGISTRY_PASSWORD_HARBOR_CENTRAL", "password")
+	mirror := &registrymirror.RegistryMirror{
+		BaseRegistry: "harbor-site:443",
+		Endpoints: []registrymirror.Endpoint{
+			{BaseRegistry: "harbor-central:443", CACertContent: "ca", CredentialsSecretRef: "harbor-central"},
+			{BaseRegistry: "harbor-backup:443", InsecureSkipVerify: true},
+		},
+	}
+
+	g.Expect(containerd.HostConfigs(mirror)).To(Equal([]containerd.HostConfig{
+		{Host: "harbor-central:443", CACert: "ca", CredentialsSecretRef: "harbor-central", Username: "username", Password: "password"},
+		{Host: "harbor-backup:443", InsecureSkipVerify: true},
+	}))
+}
+
+func TestHostConfigsMissingCredentials(t *testing.T) {
+	g := NewWithT(t)
+	mirror := &registrymirror.RegistryMirror{
+		Endpoints: []registrymirror.Endpoint{
+			{BaseRegistry: "harbor-central:443", CredentialsSecretRef: "harbor-missing"},
+		},
+	}
+
+	_, err := containerd.HostConfigs(mirror)
+	g.Expect(err).To(MatchError("please set REGISTRY_USERNAME_HARBOR_MISSING env var"))
+}
+
+func TestCACertBundle(t *testing.T) {
+	tests := []struct {
+		name   string
+		mirror *registrymirror.RegistryMirror
+		want   string
+	}{
+		{
+			name:   "no certs",
+			mirror: &registrymirror.RegistryMirror{},
+			want:   "",
+		},
+		{
+			name: "only additional endpoint",
+			mirror: &registrymirror.RegistryMirror{
+				Endpoints: []registrymirror.Endpoint{{CACertContent: "central"}},
+			},
+			want: "central",
+		},
+		{
+			name: "primary and additional endpoints",
+			mirror: &registrymirror.RegistryMirror{
+				CACertContent: "site",
+				Endpoints: []registrymirror.Endpoint{
+					{CACertContent: "central\n"},
+					{},
+					{CACertContent: "backup"},
+				},
+			},
+			want: "site\ncentral\nbackup",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(containerd.CACertBundle(tt.mirror)).To(Equal(tt.want))
+		})
+	}
+}
@@ -37,3 +37,15 @@ func ToAPIEndpoints(URLs map[string]string) map[string]string {
 	}
 	return endpoints
 }
+
+// ToAPIEndpointsList utilizes ToAPIEndpoint to turn all lists of URLs from a
+// map to valid API endpoints for a local registry.
+func ToAPIEndpointsList(URLs map[string][]string) map[string][]string {
+	endpoints := make(map[string][]string)
+	for key, urls := range URLs {
+		for _, url := range urls {
+			endpoints[key] = append(endpoints[key], ToAPIEndpoint(url))
+		}
+	}
+	return endpoints
+}
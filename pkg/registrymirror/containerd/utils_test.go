@@ -73,3 +73,15 @@ func TestToAPIEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestToAPIEndpointsList(t *testing.T) {
+	g := NewWithT(t)
+	result := containerd.ToAPIEndpointsList(map[string][]string{
+		constants.DefaultCoreEKSARegistry:        {"1.2.3.4:443/eks-anywhere", constants.DefaultCoreEKSARegistry},
+		constants.DefaultCuratedPackagesRegistry: {"1.2.3.4:443/curated-packages"},
+	})
+	g.Expect(result).To(Equal(map[string][]string{
+		constants.DefaultCoreEKSARegistry:        {"1.2.3.4:443/v2/eks-anywhere", constants.DefaultCoreEKSARegistry},
+		constants.DefaultCuratedPackagesRegistry: {"1.2.3.4:443/v2/curated-packages"},
+	}))
+}
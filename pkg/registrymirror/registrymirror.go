@@ -24,6 +24,25 @@ type RegistryMirror struct {
 	// InsecureSkipVerify skips the registry certificate verification.
 	// Only use this solution for isolated testing or in a tightly controlled, air-gapped environment.
 	InsecureSkipVerify bool
+	// Endpoints are the additional registry mirror endpoints, tried in order after BaseRegistry.
+	Endpoints []Endpoint
+	// FallbackToUpstream should be marked as true if images can be pulled from the upstream registries
+	// when none of the registry mirror endpoints can serve them.
+	FallbackToUpstream bool
+}
+
+// Endpoint is an additional registry mirror endpoint.
+type Endpoint struct {
+	// BaseRegistry is the address of the registry mirror endpoint without namespace. Just the host and the port.
+	BaseRegistry string
+	// NamespacedRegistryMap stores mirror mappings for artifact registries in this endpoint.
+	NamespacedRegistryMap map[string]string
+	// CACertContent defines the contents of the registry mirror endpoint CA certificate
+	CACertContent string
+	// CredentialsSecretRef is the name of the secret holding the credentials for the endpoint.
+	CredentialsSecretRef string
+	// InsecureSkipVerify skips the registry certificate verification.
+	InsecureSkipVerify bool
 }
 
 var re = regexp.MustCompile(constants.DefaultCuratedPackagesRegistryRegex)
@@ -38,11 +57,39 @@ func FromClusterRegistryMirrorConfiguration(config *v1alpha1.RegistryMirrorConfi
 	if config == nil {
 		return nil
 	}
-	registryMap := make(map[string]string)
 	base := net.JoinHostPort(config.Endpoint, config.Port)
+	var endpoints []Endpoint
+	for _, e := range config.Endpoints {
+		ociNamespaces := e.OCINamespaces
+		if len(ociNamespaces) == 0 {
+			ociNamespaces = config.OCINamespaces
+		}
+		endpointBase := net.JoinHostPort(e.Endpoint, e.Port)
+		endpoints = append(endpoints, Endpoint{
+			BaseRegistry:          endpointBase,
+			NamespacedRegistryMap: namespacedRegistryMap(endpointBase, ociNamespaces),
+			CACertContent:         e.CACertContent,
+			CredentialsSecretRef:  e.CredentialsSecretRef,
+			InsecureSkipVerify:    e.InsecureSkipVerify,
+		})
+	}
+
+	return &RegistryMirror{
+		BaseRegistry:          base,
+		NamespacedRegistryMap: namespacedRegistryMap(base, config.OCINamespaces),
+		Auth:                  config.Authenticate,
+		CACertContent:         config.CACertContent,
+		InsecureSkipVerify:    config.InsecureSkipVerify,
+		Endpoints:             endpoints,
+		FallbackToUpstream:    config.FallbackToUpstream,
+	}
+}
+
+func namespacedRegistryMap(base string, ociNamespaces []v1alpha1.OCINamespace) map[string]string {
+	registryMap := make(map[string]string)
 	// add registry mirror base address
 	// for each namespace, add corresponding endpoint
-	for _, ociNamespace := range config.OCINamespaces {
+	for _, ociNamespace := range ociNamespaces {
 		mirror := filepath.Join(base, ociNamespace.Namespace)
 		if re.MatchString(ociNamespace.Registry) {
 			// handle curated packages in all regions
@@ -57,13 +104,31 @@ func FromClusterRegistryMirrorConfiguration(config *v1alpha1.RegistryMirrorConfi
 		// when no namespace mapping is specified
 		registryMap[constants.DefaultCoreEKSARegistry] = base
 	}
-	return &RegistryMirror{
-		BaseRegistry:          base,
-		NamespacedRegistryMap: registryMap,
-		Auth:                  config.Authenticate,
-		CACertContent:         config.CACertContent,
-		InsecureSkipVerify:    config.InsecureSkipVerify,
+	return registryMap
+}
+
+// FallbackMirrors returns, for each registry mirrored by the primary endpoint, the ordered mirrors
+// of the additional endpoints followed by the upstream registry itself when FallbackToUpstream is set.
+// Registries without fallbacks are omitted.
+func (r *RegistryMirror) FallbackMirrors() map[string][]string {
+	fallbacks := make(map[string][]string)
+	for registry := range r.NamespacedRegistryMap {
+		for _, e := range r.Endpoints {
+			if mirror, ok := e.NamespacedRegistryMap[registry]; ok {
+				fallbacks[registry] = append(fallbacks[registry], mirror)
+			}
+		}
+		// the curated packages key matches the registries of all regions, it can't be pulled from
+		if r.FallbackToUpstream && registry != constants.DefaultCuratedPackagesRegistry {
+			fallbacks[registry] = append(fallbacks[registry], registry)
+		}
 	}
+	return fallbacks
+}
+
+// HasFallbacks returns true if images can be pulled from other places than the primary endpoint.
+func (r *RegistryMirror) HasFallbacks() bool {
+	return len(r.Endpoints) > 0 || r.FallbackToUpstream
 }
 
 // CoreEKSAMirror returns the configured mirror for public.ecr.aws.
@@ -385,3 +385,74 @@ func TestReplaceRegistry(t *testing.T) {
 		})
 	}
 }
+
+func TestFromClusterRegistryMirrorConfigurationEndpoints(t *testing.T) {
+	g := NewWithT(t)
+	config := &v1alpha1.RegistryMirrorConfiguration{
+		Endpoint: "harbor-site.eksa.demo",
+		Port:     "443",
+		OCINamespaces: []v1alpha1.OCINamespace{
+			{Registry: "public.ecr.aws", Namespace: "eks-anywhere"},
+			{Registry: "783794618700.dkr.ecr.us-west-2.amazonaws.com", Namespace: "curated-packages"},
+		},
+		Endpoints: []v1alpha1.RegistryMirrorEndpoint{
+			{
+				Endpoint:             "harbor-central.eksa.demo",
+				Port:                 "8443",
+				CACertContent:        "central-ca",
+				CredentialsSecretRef: "harbor-central",
+			},
+			{
+				Endpoint: "harbor-backup.eksa.demo",
+				Port:     "443",
+				OCINamespaces: []v1alpha1.OCINamespace{
+					{Registry: "public.ecr.aws", Namespace: "backup"},
+				},
+				InsecureSkipVerify: true,
+			},
+		},
+		FallbackToUpstream: true,
+	}
+
+	mirror := registrymirror.FromClusterRegistryMirrorConfiguration(config)
+	g.Expect(mirror.Endpoints).To(Equal([]registrymirror.Endpoint{
+		{
+			BaseRegistry: "harbor-central.eksa.demo:8443",
+			NamespacedRegistryMap: map[string]string{
+				constants.DefaultCoreEKSARegistry:        "harbor-central.eksa.demo:8443/eks-anywhere",
+				constants.DefaultCuratedPackagesRegistry: "harbor-central.eksa.demo:8443/curated-packages",
+			},
+			CACertContent:        "central-ca",
+			CredentialsSecretRef: "harbor-central",
+		},
+		{
+			BaseRegistry: "harbor-backup.eksa.demo:443",
+			NamespacedRegistryMap: map[string]string{
+				constants.DefaultCoreEKSARegistry: "harbor-backup.eksa.demo:443/backup",
+			},
+			InsecureSkipVerify: true,
+		},
+	}))
+	g.Expect(mirror.HasFallbacks()).To(BeTrue())
+	g.Expect(mirror.FallbackMirrors()).To(Equal(map[string][]string{
+		constants.DefaultCoreEKSARegistry: {
+			"harbor-central.eksa.demo:8443/eks-anywhere",
+			"harbor-backup.eksa.demo:443/backup",
+			constants.DefaultCoreEKSARegistry,
+		},
+		constants.DefaultCuratedPackagesRegistry: {
+			"harbor-central.eksa.demo:8443/curated-packages",
+		},
+	}))
+}
+
+func TestFallbackMirrorsNoFallbacks(t *testing.T) {
+	g := NewWithT(t)
+	mirror := registrymirror.FromClusterRegistryMirrorConfiguration(&v1alpha1.RegistryMirrorConfiguration{
+		Endpoint: "harbor.eksa.demo",
+		Port:     "443",
+	})
+
+	g.Expect(mirror.HasFallbacks()).To(BeFalse())
+	g.Expect(mirror.FallbackMirrors()).To(BeEmpty())
+}
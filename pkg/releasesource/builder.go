@@ -0,0 +1,99 @@
+package releasesource
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Builder creates a release source in a directory.
+type Builder struct {
+	dir     string
+	index   Index
+	indexed map[string]bool
+}
+
+// NewBuilder constructs a Builder that stores the release source in dir.
+func NewBuilder(dir, releaseManifestURL string) *Builder {
+	return &Builder{
+		dir:     dir,
+		index:   Index{ReleaseManifestURL: releaseManifestURL},
+		indexed: map[string]bool{},
+	}
+}
+
+// AddFile writes content to path, relative to the release source directory, and indexes
+// it as the artifact for the remote location source.
+func (b *Builder) AddFile(source, path string, content []byte) error {
+	return b.AddFromReader(source, path, bytes.NewReader(content))
+}
+
+// AddFromReader streams the content of r to path, relative to the release source directory, and
+// indexes it as the artifact for the remote location source. It avoids loading large artifacts in memory.
+func (b *Builder) AddFromReader(source, path string, r io.Reader) error {
+	if !filepath.IsLocal(path) {
+		return fmt.Errorf("release source artifact %s has invalid path %s", source, path)
+	}
+
+	fullPath := filepath.Join(b.dir, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(fullPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(f, h), r); err != nil {
+		return fmt.Errorf("writing release source artifact %s: %v", source, err)
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	b.add(source, path, hex.EncodeToString(h.Sum(nil)))
+	return nil
+}
+
+// Contains returns true if an artifact has already been added for the remote location source.
+func (b *Builder) Contains(source string) bool {
+	return b.indexed[source]
+}
+
+// Write writes the release source index.
+func (b *Builder) Write() error {
+	content, err := yaml.Marshal(b.index)
+	if err != nil {
+		return fmt.Errorf("marshalling release source index: %v", err)
+	}
+
+	if err = os.MkdirAll(b.dir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(b.dir, IndexFileName), content, 0o644)
+}
+
+// add indexes an artifact. Artifacts shared by several versions bundles are indexed the first time they are added.
+func (b *Builder) add(source, path, sum string) {
+	if b.indexed[source] {
+		return
+	}
+
+	b.indexed[source] = true
+	b.index.Artifacts = append(b.index.Artifacts, Artifact{
+		Source: source,
+		Path:   path,
+		SHA256: sum,
+	})
+}
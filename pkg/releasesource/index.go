@@ -0,0 +1,23 @@
+package releasesource
+
+// IndexFileName is the name of the file at the root of a release source that indexes its content.
+const IndexFileName = "index.yaml"
+
+// Index maps the remote locations EKS Anywhere reads release artifacts from
+// to the files that hold them in a release source.
+type Index struct {
+	// ReleaseManifestURL is the URL of the EKS Anywhere release manifest the source was built from.
+	ReleaseManifestURL string `json:"releaseManifestURL"`
+	// Artifacts are the files stored in the release source.
+	Artifacts []Artifact `json:"artifacts"`
+}
+
+// Artifact is a file stored in a release source.
+type Artifact struct {
+	// Source is the remote location of the artifact: an URL or an OCI reference.
+	Source string `json:"source"`
+	// Path is the location of the file relative to the root of the release source.
+	Path string `json:"path"`
+	// SHA256 is the hex encoded sha256 checksum of the file.
+	SHA256 string `json:"sha256"`
+}
@@ -0,0 +1,100 @@
+package releasesource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const ociScheme = "oci://"
+
+// Open returns the release source at location, which can be a local directory or
+// a reference to an OCI artifact, optionally prefixed with oci://. OCI artifacts are
+// pulled into cacheDir before being loaded.
+func Open(ctx context.Context, location, cacheDir string, credential auth.CredentialFunc) (*Source, error) {
+	if !strings.HasPrefix(location, ociScheme) {
+		if info, err := os.Stat(location); err == nil && info.IsDir() {
+			return Load(location)
+		}
+	}
+
+	dir, err := pull(ctx, strings.TrimPrefix(location, ociScheme), cacheDir, credential)
+	if err != nil {
+		return nil, fmt.Errorf("pulling release source %s: %v", location, err)
+	}
+
+	return Load(dir)
+}
+
+// pull copies the OCI artifact ref into a clean directory under cacheDir and returns the directory
+// containing the release source index.
+func pull(ctx context.Context, ref, cacheDir string, credential auth.CredentialFunc) (string, error) {
+	repo, err := remote.NewRepository(ref)
+	if err != nil {
+		return "", err
+	}
+	repo.Client = &auth.Client{
+		Cache:      auth.NewCache(),
+		Credential: credential,
+	}
+
+	dir := filepath.Join(cacheDir, strings.NewReplacer("/", "_", ":", "_", "@", "_").Replace(ref))
+	if err = os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	store, err := file.New(dir)
+	if err != nil {
+		return "", err
+	}
+	defer store.Close()
+
+	logger.V(2).Info("Pulling release source", "reference", ref, "dir", dir)
+	if _, err = oras.Copy(ctx, repo, repo.Reference.Reference, store, repo.Reference.Reference, oras.DefaultCopyOptions); err != nil {
+		return "", err
+	}
+
+	return findIndexDir(dir)
+}
+
+// findIndexDir returns dir if it contains the release source index or its only subdirectory
+// containing it. The latter is the layout obtained when the release source directory is pushed
+// as a single layer.
+func findIndexDir(dir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(dir, IndexFileName)); err == nil {
+		return dir, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	var found []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, e.Name(), IndexFileName)); err == nil {
+			found = append(found, filepath.Join(dir, e.Name()))
+		}
+	}
+
+	if len(found) != 1 {
+		return "", fmt.Errorf("expected one %s in release source artifact, found %d", IndexFileName, len(found))
+	}
+
+	return found[0], nil
+}
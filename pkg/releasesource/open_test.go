@@ -0,0 +1,27 @@
+package releasesource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFindIndexDir(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	sourceDir := filepath.Join(dir, "eks-anywhere-downloads")
+	g.Expect(os.MkdirAll(sourceDir, 0o755)).To(Succeed())
+	g.Expect(os.MkdirAll(filepath.Join(dir, "other"), 0o755)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(sourceDir, IndexFileName), []byte{}, 0o644)).To(Succeed())
+
+	g.Expect(findIndexDir(dir)).To(Equal(sourceDir))
+	g.Expect(findIndexDir(sourceDir)).To(Equal(sourceDir))
+}
+
+func TestFindIndexDirMissing(t *testing.T) {
+	g := NewWithT(t)
+	_, err := findIndexDir(t.TempDir())
+	g.Expect(err).To(MatchError("expected one index.yaml in release source artifact, found 0"))
+}
@@ -0,0 +1,91 @@
+package releasesource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Source is a local copy of the release manifest, bundles and artifacts of an EKS Anywhere release.
+// It allows to run the CLI without access to the internet.
+type Source struct {
+	dir       string
+	index     Index
+	artifacts map[string]Artifact
+}
+
+// Load reads the release source stored in dir.
+func Load(dir string) (*Source, error) {
+	content, err := os.ReadFile(filepath.Join(dir, IndexFileName))
+	if err != nil {
+		return nil, fmt.Errorf("reading release source index: %v", err)
+	}
+
+	s := &Source{
+		dir:       dir,
+		artifacts: map[string]Artifact{},
+	}
+	if err = yaml.UnmarshalStrict(content, &s.index); err != nil {
+		return nil, fmt.Errorf("parsing release source index: %v", err)
+	}
+
+	for _, a := range s.index.Artifacts {
+		if !filepath.IsLocal(a.Path) {
+			return nil, fmt.Errorf("release source artifact %s has invalid path %s", a.Source, a.Path)
+		}
+		s.artifacts[a.Source] = a
+	}
+
+	return s, nil
+}
+
+// Dir returns the directory the release source is stored in.
+func (s *Source) Dir() string {
+	return s.dir
+}
+
+// ReleaseManifestURL returns the URL of the release manifest the source was built from.
+func (s *Source) ReleaseManifestURL() string {
+	return s.index.ReleaseManifestURL
+}
+
+// Contains returns true if the release source holds the artifact for a remote location.
+func (s *Source) Contains(source string) bool {
+	_, ok := s.artifacts[source]
+	return ok
+}
+
+// Path returns the path to the local file holding the artifact for a remote location.
+// It doesn't read the file, which makes it the preferred method for large artifacts like OS images.
+func (s *Source) Path(source string) (string, error) {
+	a, ok := s.artifacts[source]
+	if !ok {
+		return "", fmt.Errorf("artifact %s not found in release source %s", source, s.dir)
+	}
+
+	return filepath.Join(s.dir, a.Path), nil
+}
+
+// ReadFile returns the content of the artifact for a remote location, validating its checksum.
+func (s *Source) ReadFile(source string) ([]byte, error) {
+	path, err := s.Path(source)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading artifact %s from release source: %v", source, err)
+	}
+
+	sum := sha256.Sum256(content)
+	if expected := s.artifacts[source].SHA256; hex.EncodeToString(sum[:]) != expected {
+		return nil, fmt.Errorf("artifact %s in release source doesn't match checksum %s", source, expected)
+	}
+
+	return content, nil
+}
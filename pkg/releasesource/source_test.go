@@ -0,0 +1,103 @@
+package releasesource_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/releasesource"
+)
+
+const (
+	releaseURL = "https://anywhere-assets.eks.amazonaws.com/releases/eks-a/manifest.yaml"
+	bundleURL  = "https://anywhere-assets.eks.amazonaws.com/releases/bundles/1/manifest.yaml"
+)
+
+func buildSource(t *testing.T, dir string) {
+	t.Helper()
+	g := NewWithT(t)
+	b := releasesource.NewBuilder(dir, releaseURL)
+	g.Expect(b.AddFile(releaseURL, "eks-a-release.yaml", []byte("release"))).To(Succeed())
+	g.Expect(b.AddFile(bundleURL, "bundles/manifest.yaml", []byte("bundle"))).To(Succeed())
+	g.Expect(b.AddFile(bundleURL, "copy/manifest.yaml", []byte("bundle"))).To(Succeed())
+	g.Expect(b.AddFromReader("https://example.com/image.ova", "os/image.ova", strings.NewReader("ova"))).To(Succeed())
+	g.Expect(b.Contains(bundleURL)).To(BeTrue())
+	g.Expect(b.Write()).To(Succeed())
+}
+
+func TestSourceReadFile(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	buildSource(t, dir)
+
+	s, err := releasesource.Load(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s.ReleaseManifestURL()).To(Equal(releaseURL))
+	g.Expect(s.ReadFile(releaseURL)).To(BeEquivalentTo("release"))
+	g.Expect(s.ReadFile(bundleURL)).To(BeEquivalentTo("bundle"))
+	g.Expect(s.ReadFile("https://example.com/image.ova")).To(BeEquivalentTo("ova"))
+	g.Expect(s.Path(bundleURL)).To(Equal(filepath.Join(dir, "bundles/manifest.yaml")))
+	g.Expect(s.Contains(bundleURL)).To(BeTrue())
+}
+
+func TestSourceReadFileNotFound(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	buildSource(t, dir)
+
+	s, err := releasesource.Load(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = s.ReadFile("https://example.com/missing.yaml")
+	g.Expect(err).To(MatchError(ContainSubstring("artifact https://example.com/missing.yaml not found in release source")))
+	g.Expect(s.Contains("https://example.com/missing.yaml")).To(BeFalse())
+}
+
+func TestSourceReadFileChecksumMismatch(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	buildSource(t, dir)
+	g.Expect(os.WriteFile(filepath.Join(dir, "eks-a-release.yaml"), []byte("tampered"), 0o644)).To(Succeed())
+
+	s, err := releasesource.Load(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = s.ReadFile(releaseURL)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't match checksum")))
+}
+
+func TestLoadInvalidPath(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	index := "releaseManifestURL: " + releaseURL + "\nartifacts:\n- source: " + bundleURL + "\n  path: ../manifest.yaml\n  sha256: abc\n"
+	g.Expect(os.WriteFile(filepath.Join(dir, releasesource.IndexFileName), []byte(index), 0o644)).To(Succeed())
+
+	_, err := releasesource.Load(dir)
+	g.Expect(err).To(MatchError(ContainSubstring("has invalid path ../manifest.yaml")))
+}
+
+func TestLoadMissingIndex(t *testing.T) {
+	g := NewWithT(t)
+	_, err := releasesource.Load(t.TempDir())
+	g.Expect(err).To(MatchError(ContainSubstring("reading release source index")))
+}
+
+func TestBuilderInvalidPath(t *testing.T) {
+	g := NewWithT(t)
+	b := releasesource.NewBuilder(t.TempDir(), releaseURL)
+	g.Expect(b.AddFile(bundleURL, "/etc/manifest.yaml", []byte("bundle"))).To(
+		MatchError(ContainSubstring("has invalid path /etc/manifest.yaml")),
+	)
+}
+
+func TestOpenDirectory(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	buildSource(t, dir)
+
+	s, err := releasesource.Open(context.Background(), dir, t.TempDir(), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s.Dir()).To(Equal(dir))
+}
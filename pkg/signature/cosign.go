@@ -0,0 +1,131 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+)
+
+const (
+	cosignSignatureType       = "cosign"
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	cosignSignatureTagSuffix  = ".sig"
+)
+
+// cosignPayload is the simple signing payload signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// cosignSignatureTag returns the tag cosign stores the signatures of a manifest under, sha256-<hex>.sig.
+func cosignSignatureTag(desc ocispec.Descriptor) string {
+	return strings.Replace(desc.Digest.String(), ":", "-", 1) + cosignSignatureTagSuffix
+}
+
+func (v *ImageVerifier) verifyCosignSignatures(ctx context.Context, repo Repository, image ocispec.Descriptor) ([]SignatureVerification, error) {
+	sigDesc, err := repo.Resolve(ctx, cosignSignatureTag(image))
+	if errors.Is(err, errdef.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifestContent, err := content.FetchAll(ctx, repo, sigDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &ocispec.Manifest{}
+	if err := json.Unmarshal(manifestContent, manifest); err != nil {
+		return nil, fmt.Errorf("unmarshalling cosign signature manifest: %v", err)
+	}
+
+	var results []SignatureVerification
+	for _, layer := range manifest.Layers {
+		sig, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		result := SignatureVerification{
+			Type:   cosignSignatureType,
+			Digest: layer.Digest.String(),
+		}
+
+		if len(v.cosignKeys) == 0 {
+			result.Status = SignatureSkipped
+			results = append(results, result)
+			continue
+		}
+
+		if err := v.verifyCosignLayer(ctx, repo, image, layer, sig); err != nil {
+			result.Status = SignatureFailed
+			result.Error = err.Error()
+		} else {
+			result.Status = SignatureVerified
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (v *ImageVerifier) verifyCosignLayer(ctx context.Context, repo Repository, image, layer ocispec.Descriptor, encodedSig string) error {
+	payload, err := content.FetchAll(ctx, repo, layer)
+	if err != nil {
+		return fmt.Errorf("fetching signature payload: %v", err)
+	}
+
+	p := &cosignPayload{}
+	if err := json.Unmarshal(payload, p); err != nil {
+		return fmt.Errorf("unmarshalling signature payload: %v", err)
+	}
+
+	if p.Critical.Image.DockerManifestDigest != image.Digest.String() {
+		return fmt.Errorf("signature payload is for digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(encodedSig)
+	if err != nil {
+		return fmt.Errorf("signature isn't base64 encoded: %v", err)
+	}
+
+	for _, key := range v.cosignKeys {
+		if verifyCosignSignature(key, payload, sig) {
+			return nil
+		}
+	}
+
+	return errors.New("signature doesn't match any of the trusted public keys")
+}
+
+func verifyCosignSignature(key crypto.PublicKey, payload, sig []byte) bool {
+	digest := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, sig)
+	default:
+		return false
+	}
+}
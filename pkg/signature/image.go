@@ -0,0 +1,194 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	orasregistry "oras.land/oras-go/v2/registry"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// Repository is the read only view of an OCI repository needed to verify images.
+type Repository interface {
+	content.ReadOnlyGraphStorage
+	content.Resolver
+}
+
+// Repositories provides access to the OCI repositories storing the images to verify.
+type Repositories interface {
+	// Repository returns the repository for a name in the form registry/repository.
+	Repository(ctx context.Context, name string) (Repository, error)
+}
+
+// ImageReference identifies an image to verify along with the digest recorded for it in a signed bundle.
+type ImageReference struct {
+	// Image is the image URI as recorded in the bundle.
+	Image string
+	// Reference is the location of the image to verify, in the form
+	// registry/repository:tag or registry/repository@digest.
+	Reference string
+	// Digest is the manifest digest recorded in the bundle.
+	Digest string
+}
+
+// ImageVerifier checks that images stored in a registry match the manifest digests
+// recorded in a signed bundle and verifies their cosign and Notation signatures when present.
+// Images with signatures that can't be verified because no trust material is configured fail verification.
+type ImageVerifier struct {
+	repositories  Repositories
+	cosignKeys    []crypto.PublicKey
+	notationRoots *x509.CertPool
+}
+
+// ImageVerifierOpt allows to customize an ImageVerifier.
+type ImageVerifierOpt func(*ImageVerifier)
+
+// WithCosignPublicKey configures a public key trusted to sign cosign signatures.
+func WithCosignPublicKey(key crypto.PublicKey) ImageVerifierOpt {
+	return func(v *ImageVerifier) {
+		v.cosignKeys = append(v.cosignKeys, key)
+	}
+}
+
+// WithNotationTrustRoots configures the root certificates trusted to issue Notation signing certificates.
+func WithNotationTrustRoots(roots *x509.CertPool) ImageVerifierOpt {
+	return func(v *ImageVerifier) {
+		v.notationRoots = roots
+	}
+}
+
+// NewImageVerifier constructs a new ImageVerifier.
+func NewImageVerifier(repositories Repositories, opts ...ImageVerifierOpt) *ImageVerifier {
+	v := &ImageVerifier{
+		repositories: repositories,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Verify verifies all images and returns a report with the result for each of them.
+// Images with the same reference are only verified once.
+func (v *ImageVerifier) Verify(ctx context.Context, images []ImageReference) *VerificationReport {
+	report := &VerificationReport{}
+	seen := make(map[string]struct{}, len(images))
+	for _, image := range images {
+		if _, ok := seen[image.Reference]; ok {
+			continue
+		}
+		seen[image.Reference] = struct{}{}
+
+		result := v.verifyImage(ctx, image)
+		if !result.Verified {
+			logger.V(3).Info("Image verification failed", "image", result.Reference, "error", result.Error)
+		}
+		report.Images = append(report.Images, result)
+	}
+
+	return report
+}
+
+func (v *ImageVerifier) verifyImage(ctx context.Context, image ImageReference) ImageVerification {
+	result := ImageVerification{
+		Image:          image.Image,
+		Reference:      image.Reference,
+		ExpectedDigest: image.Digest,
+	}
+
+	desc, repo, err := v.resolve(ctx, image)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Digest = desc.Digest.String()
+
+	if image.Digest == "" {
+		result.Error = "bundle doesn't record a digest for image"
+		return result
+	}
+
+	if result.Digest != image.Digest {
+		result.Error = fmt.Sprintf("manifest digest %s doesn't match digest %s recorded in bundle", result.Digest, image.Digest)
+		return result
+	}
+
+	cosignSignatures, err := v.verifyCosignSignatures(ctx, repo, desc)
+	if err != nil {
+		result.Error = fmt.Sprintf("reading cosign signatures: %v", err)
+		return result
+	}
+
+	notationSignatures, err := v.verifyNotationSignatures(ctx, repo, desc)
+	if err != nil {
+		result.Error = fmt.Sprintf("reading notation signatures: %v", err)
+		return result
+	}
+
+	result.Signatures = append(cosignSignatures, notationSignatures...)
+	for _, s := range result.Signatures {
+		switch s.Status {
+		case SignatureFailed:
+			result.Error = fmt.Sprintf("%s signature %s is not valid: %s", s.Type, s.Digest, s.Error)
+			return result
+		case SignatureSkipped:
+			// A signature that can't be checked doesn't prove anything, fail instead of trusting it.
+			result.Error = fmt.Sprintf("%s signature %s can't be verified, no %s trust material configured", s.Type, s.Digest, s.Type)
+			return result
+		}
+	}
+
+	result.Verified = true
+	return result
+}
+
+func (v *ImageVerifier) resolve(ctx context.Context, image ImageReference) (ocispec.Descriptor, Repository, error) {
+	ref, err := orasregistry.ParseReference(image.Reference)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("parsing image reference: %v", err)
+	}
+
+	repo, err := v.repositories.Repository(ctx, ref.Registry+"/"+ref.Repository)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("getting repository: %v", err)
+	}
+
+	desc, err := repo.Resolve(ctx, ref.Reference)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("resolving image manifest: %v", err)
+	}
+
+	return desc, repo, nil
+}
+
+// ParseCosignPublicKey parses a PEM encoded PKIX public key used to verify cosign signatures.
+func ParseCosignPublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("cosign public key is not PEM encoded")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cosign public key: %v", err)
+	}
+
+	return key, nil
+}
+
+// ParseCertPool parses PEM encoded certificates into a certificate pool.
+func ParseCertPool(data []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("no valid PEM encoded certificates found")
+	}
+
+	return pool, nil
+}
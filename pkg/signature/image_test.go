@@ -0,0 +1,546 @@
+package signature_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+
+	"github.com/aws/eks-anywhere/pkg/signature"
+)
+
+const (
+	testRepository = "registry.test/eks-anywhere/image"
+	otherDigest    = "sha256:0000000000000000000000000000000000000000000000000000000000000001"
+)
+
+type fakeRepositories map[string]*memory.Store
+
+func (f fakeRepositories) Repository(_ context.Context, name string) (signature.Repository, error) {
+	repo, ok := f[name]
+	if !ok {
+		return nil, errors.New("repository not found")
+	}
+	return repo, nil
+}
+
+type imageVerifierTest struct {
+	*WithT
+	ctx          context.Context
+	store        *memory.Store
+	repositories fakeRepositories
+	image        ocispec.Descriptor
+}
+
+func newImageVerifierTest(t *testing.T) *imageVerifierTest {
+	ctx := context.Background()
+	store := memory.New()
+	tt := &imageVerifierTest{
+		WithT:        NewWithT(t),
+		ctx:          ctx,
+		store:        store,
+		repositories: fakeRepositories{testRepository: store},
+	}
+
+	config := tt.push(ocispec.MediaTypeImageConfig, []byte("{}"), nil)
+	tt.image = tt.pushManifest(ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    config,
+		Layers:    []ocispec.Descriptor{},
+	})
+	tt.Expect(store.Tag(ctx, tt.image, "v1.0.0")).To(Succeed())
+
+	return tt
+}
+
+func (tt *imageVerifierTest) push(mediaType string, blob []byte, annotations map[string]string) ocispec.Descriptor {
+	desc := content.NewDescriptorFromBytes(mediaType, blob)
+	desc.Annotations = annotations
+	tt.Expect(tt.store.Push(tt.ctx, desc, bytes.NewReader(blob))).To(Succeed())
+	return desc
+}
+
+func (tt *imageVerifierTest) pushManifest(manifest ocispec.Manifest) ocispec.Descriptor {
+	manifest.Versioned = specs.Versioned{SchemaVersion: 2}
+	manifest.MediaType = ocispec.MediaTypeImageManifest
+	blob, err := json.Marshal(manifest)
+	tt.Expect(err).NotTo(HaveOccurred())
+	desc := tt.push(ocispec.MediaTypeImageManifest, blob, nil)
+	desc.ArtifactType = manifest.ArtifactType
+	return desc
+}
+
+func (tt *imageVerifierTest) reference() signature.ImageReference {
+	return signature.ImageReference{
+		Image:     "public.ecr.aws/eks-anywhere/image:v1.0.0",
+		Reference: testRepository + ":v1.0.0",
+		Digest:    tt.image.Digest.String(),
+	}
+}
+
+func (tt *imageVerifierTest) addCosignSignature(key *ecdsa.PrivateKey, signedDigest string) {
+	payload := []byte(`{"critical":{"identity":{"docker-reference":"registry.test/eks-anywhere/image"},"image":{"docker-manifest-digest":"` + signedDigest + `"},"type":"cosign container image signature"},"optional":null}`)
+	hash := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	layer := tt.push("application/vnd.dev.cosign.simplesigning.v1+json", payload, map[string]string{
+		"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(sig),
+	})
+	config := tt.push(ocispec.MediaTypeImageConfig, []byte(`{"cosign":true}`), nil)
+	manifest := tt.pushManifest(ocispec.Manifest{
+		Config: config,
+		Layers: []ocispec.Descriptor{layer},
+	})
+	tt.Expect(tt.store.Tag(tt.ctx, manifest, "sha256-"+tt.image.Digest.Encoded()+".sig")).To(Succeed())
+}
+
+func (tt *imageVerifierTest) addNotationSignature(leaf *x509.Certificate, key *ecdsa.PrivateKey, target ocispec.Descriptor) {
+	tt.addNotationSignatureWithHeader(leaf, key, target, nil)
+}
+
+// addNotationSignatureWithHeader signs target with extra protected header fields, overriding the defaults.
+func (tt *imageVerifierTest) addNotationSignatureWithHeader(leaf *x509.Certificate, key *ecdsa.PrivateKey, target ocispec.Descriptor, extraHeader map[string]interface{}) {
+	header := map[string]interface{}{
+		"alg":                          "ES256",
+		"cty":                          "application/vnd.cncf.notary.payload.v1+json",
+		"crit":                         []string{"io.cncf.notary.signingScheme"},
+		"io.cncf.notary.signingScheme": "notary.x509",
+		"io.cncf.notary.signingTime":   time.Now().Format(time.RFC3339),
+	}
+	for k, v := range extraHeader {
+		header[k] = v
+	}
+	headerContent, err := json.Marshal(header)
+	tt.Expect(err).NotTo(HaveOccurred())
+	payloadContent, err := json.Marshal(map[string]interface{}{
+		"targetArtifact": ocispec.Descriptor{MediaType: target.MediaType, Digest: target.Digest, Size: target.Size},
+	})
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	protected := base64.RawURLEncoding.EncodeToString(headerContent)
+	payload := base64.RawURLEncoding.EncodeToString(payloadContent)
+	hash := sha256.Sum256([]byte(protected + "." + payload))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	tt.Expect(err).NotTo(HaveOccurred())
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	envelope, err := json.Marshal(map[string]interface{}{
+		"payload":   payload,
+		"protected": protected,
+		"header": map[string]interface{}{
+			"x5c": [][]byte{leaf.Raw},
+		},
+		"signature": base64.RawURLEncoding.EncodeToString(sig),
+	})
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	layer := tt.push("application/jose+json", envelope, nil)
+	config := tt.push("application/vnd.cncf.notary.signature", []byte("{}"), nil)
+	subject := tt.image
+	tt.pushManifest(ocispec.Manifest{
+		ArtifactType: "application/vnd.cncf.notary.signature",
+		Config:       config,
+		Layers:       []ocispec.Descriptor{layer},
+		Subject:      &subject,
+	})
+}
+
+func newSigningCertificate(t *testing.T, usage x509.ExtKeyUsage) (*x509.CertPool, *x509.Certificate, *ecdsa.PrivateKey) {
+	return newSigningCertificateValidUntil(t, usage, time.Now().Add(time.Hour))
+}
+
+func newSigningCertificateValidUntil(t *testing.T, usage x509.ExtKeyUsage, notAfter time.Time) (*x509.CertPool, *x509.Certificate, *ecdsa.PrivateKey) {
+	g := NewWithT(t)
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	g.Expect(err).NotTo(HaveOccurred())
+	ca, err := x509.ParseCertificate(caDER)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test signer"},
+		NotBefore:    time.Now().Add(-2 * time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, ca, leafKey.Public(), caKey)
+	g.Expect(err).NotTo(HaveOccurred())
+	leaf, err := x509.ParseCertificate(leafDER)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	return roots, leaf, leafKey
+}
+
+func newECDSAKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestImageVerifierVerifyDigestMatches(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	v := signature.NewImageVerifier(tt.repositories)
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference(), tt.reference()})
+	report.BundleSignatureVerified = true
+
+	tt.Expect(report.Images).To(HaveLen(1))
+	tt.Expect(report.Images[0].Verified).To(BeTrue())
+	tt.Expect(report.Images[0].Digest).To(Equal(tt.image.Digest.String()))
+	tt.Expect(report.Images[0].Signatures).To(BeEmpty())
+	tt.Expect(report.Err()).To(Succeed())
+}
+
+func TestImageVerifierVerifyDigestMismatch(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	v := signature.NewImageVerifier(tt.repositories)
+	ref := tt.reference()
+	ref.Digest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{ref})
+	report.BundleSignatureVerified = true
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("doesn't match digest"))
+	tt.Expect(report.Err()).To(MatchError(ContainSubstring("1 of 1 images failed verification")))
+}
+
+func TestImageVerifierVerifyMissingRecordedDigest(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	v := signature.NewImageVerifier(tt.repositories)
+	ref := tt.reference()
+	ref.Digest = ""
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{ref})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(Equal("bundle doesn't record a digest for image"))
+}
+
+func TestImageVerifierVerifyImageNotFound(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	v := signature.NewImageVerifier(tt.repositories)
+	ref := tt.reference()
+	ref.Reference = testRepository + ":v2.0.0"
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{ref})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("resolving image manifest"))
+}
+
+func TestImageVerifierVerifyCosignSignature(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	key := newECDSAKey(t)
+	tt.addCosignSignature(key, tt.image.Digest.String())
+	v := signature.NewImageVerifier(tt.repositories, signature.WithCosignPublicKey(key.Public()))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeTrue(), report.Images[0].Error)
+	tt.Expect(report.Images[0].Signatures).To(HaveLen(1))
+	tt.Expect(report.Images[0].Signatures[0].Type).To(Equal("cosign"))
+	tt.Expect(report.Images[0].Signatures[0].Status).To(Equal(signature.SignatureVerified))
+}
+
+func TestImageVerifierVerifyCosignSignatureUntrustedKey(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	tt.addCosignSignature(newECDSAKey(t), tt.image.Digest.String())
+	v := signature.NewImageVerifier(tt.repositories, signature.WithCosignPublicKey(newECDSAKey(t).Public()))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Signatures[0].Status).To(Equal(signature.SignatureFailed))
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("doesn't match any of the trusted public keys"))
+}
+
+func TestImageVerifierVerifyCosignSignatureForOtherDigest(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	key := newECDSAKey(t)
+	tt.addCosignSignature(key, otherDigest)
+	v := signature.NewImageVerifier(tt.repositories, signature.WithCosignPublicKey(key.Public()))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signature payload is for digest"))
+}
+
+func TestImageVerifierVerifyCosignSignatureWithoutKey(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	tt.addCosignSignature(newECDSAKey(t), tt.image.Digest.String())
+	v := signature.NewImageVerifier(tt.repositories)
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Signatures[0].Status).To(Equal(signature.SignatureSkipped))
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("no cosign trust material configured"))
+}
+
+func TestImageVerifierVerifyNotationSignature(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	roots, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	tt.addNotationSignature(leaf, key, tt.image)
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeTrue(), report.Images[0].Error)
+	tt.Expect(report.Images[0].Signatures).To(HaveLen(1))
+	tt.Expect(report.Images[0].Signatures[0].Type).To(Equal("notation"))
+	tt.Expect(report.Images[0].Signatures[0].Status).To(Equal(signature.SignatureVerified))
+}
+
+func TestImageVerifierVerifyNotationSignatureUntrustedRoot(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	_, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	otherRoots, _, _ := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	tt.addNotationSignature(leaf, key, tt.image)
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(otherRoots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signing certificate is not trusted"))
+}
+
+func TestImageVerifierVerifyNotationSignatureWrongKeyUsage(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	roots, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageServerAuth)
+	tt.addNotationSignature(leaf, key, tt.image)
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signing certificate is not trusted"))
+}
+
+func TestImageVerifierVerifyNotationSignatureForOtherDigest(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	roots, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	target := tt.image
+	target.Digest = otherDigest
+	tt.addNotationSignature(leaf, key, target)
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signature is for digest"))
+}
+
+func TestImageVerifierVerifyNotationSignatureWithoutTrustRoots(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	_, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	tt.addNotationSignature(leaf, key, tt.image)
+	v := signature.NewImageVerifier(tt.repositories)
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Signatures[0].Status).To(Equal(signature.SignatureSkipped))
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("no notation trust material configured"))
+}
+
+func TestVerificationReportErrBundleSignature(t *testing.T) {
+	g := NewWithT(t)
+	report := &signature.VerificationReport{Bundle: "bundles-1"}
+
+	g.Expect(report.Err()).To(MatchError("bundle bundles-1 signature is not valid"))
+}
+
+func TestVerificationReportWrite(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "reports", "verification.json")
+	report := &signature.VerificationReport{
+		Bundle:                  "bundles-1",
+		BundleSignatureVerified: true,
+		Images: []signature.ImageVerification{
+			{
+				Image:          "public.ecr.aws/eks-anywhere/image:v1.0.0",
+				Reference:      "registry.test/eks-anywhere/image:v1.0.0",
+				ExpectedDigest: "sha256:abc",
+				Digest:         "sha256:abc",
+				Verified:       true,
+			},
+		},
+	}
+
+	g.Expect(report.Write(path)).To(Succeed())
+
+	content, err := os.ReadFile(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	got := &signature.VerificationReport{}
+	g.Expect(json.Unmarshal(content, got)).To(Succeed())
+	g.Expect(got).To(Equal(report))
+}
+
+func TestParseCosignPublicKey(t *testing.T) {
+	g := NewWithT(t)
+	key := newECDSAKey(t)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	got, err := signature.ParseCosignPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.(crypto.PublicKey)).To(Equal(key.Public()))
+
+	_, err = signature.ParseCosignPublicKey([]byte("not a key"))
+	g.Expect(err).To(MatchError("cosign public key is not PEM encoded"))
+}
+
+func TestParseCertPool(t *testing.T) {
+	g := NewWithT(t)
+	_, leaf, _ := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+
+	_, err := signature.ParseCertPool(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw}))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = signature.ParseCertPool([]byte("not a certificate"))
+	g.Expect(err).To(MatchError("no valid PEM encoded certificates found"))
+}
+
+func TestImageVerifierVerifyNotationSignatureForOtherSize(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	roots, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	target := tt.image
+	target.Size++
+	tt.addNotationSignature(leaf, key, target)
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signature is for size"))
+}
+
+func TestImageVerifierVerifyNotationSignatureForOtherMediaType(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	roots, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	target := tt.image
+	target.MediaType = ocispec.MediaTypeImageIndex
+	tt.addNotationSignature(leaf, key, target)
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signature is for media type"))
+}
+
+func TestImageVerifierVerifyNotationSignatureExpiredCertificate(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	roots, leaf, key := newSigningCertificateValidUntil(t, x509.ExtKeyUsageCodeSigning, time.Now().Add(-time.Hour))
+	// The signing time claimed by the signer is within the certificate validity, but it isn't
+	// backed by a timestamp so the certificate is checked at the current time.
+	tt.addNotationSignatureWithHeader(leaf, key, tt.image, map[string]interface{}{
+		"io.cncf.notary.signingTime": time.Now().Add(-90 * time.Minute).Format(time.RFC3339),
+	})
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signing certificate is not trusted"))
+}
+
+func TestImageVerifierVerifyNotationSignatureExpired(t *testing.T) {
+	tt := newImageVerifierTest(t)
+	roots, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+	tt.addNotationSignatureWithHeader(leaf, key, tt.image, map[string]interface{}{
+		"crit":                  []string{"io.cncf.notary.signingScheme", "io.cncf.notary.expiry"},
+		"io.cncf.notary.expiry": time.Now().Add(-time.Minute).Format(time.RFC3339),
+	})
+	v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+	report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+	tt.Expect(report.Images[0].Verified).To(BeFalse())
+	tt.Expect(report.Images[0].Error).To(ContainSubstring("signature expired"))
+}
+
+func TestImageVerifierVerifyNotationSignatureCriticalHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:    "unknown critical header",
+			header:  map[string]interface{}{"crit": []string{"io.cncf.notary.signingScheme", "io.cncf.notary.verificationPlugin"}, "io.cncf.notary.verificationPlugin": "plugin"},
+			wantErr: "unsupported critical header io.cncf.notary.verificationPlugin",
+		},
+		{
+			name:    "missing critical header",
+			header:  map[string]interface{}{"crit": []string{"io.cncf.notary.signingScheme", "io.cncf.notary.expiry"}},
+			wantErr: "critical header io.cncf.notary.expiry is missing",
+		},
+		{
+			name:    "empty crit",
+			header:  map[string]interface{}{"crit": []string{}},
+			wantErr: "protected header crit is empty",
+		},
+		{
+			name:    "unknown signing scheme",
+			header:  map[string]interface{}{"io.cncf.notary.signingScheme": "notary.other"},
+			wantErr: "unsupported signing scheme",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newImageVerifierTest(t)
+			roots, leaf, key := newSigningCertificate(t, x509.ExtKeyUsageCodeSigning)
+			tt.addNotationSignatureWithHeader(leaf, key, tt.image, tc.header)
+			v := signature.NewImageVerifier(tt.repositories, signature.WithNotationTrustRoots(roots))
+
+			report := v.Verify(tt.ctx, []signature.ImageReference{tt.reference()})
+
+			tt.Expect(report.Images[0].Verified).To(BeFalse())
+			tt.Expect(report.Images[0].Error).To(ContainSubstring(tc.wantErr))
+		})
+	}
+}
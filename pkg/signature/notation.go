@@ -0,0 +1,306 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha512" // registers SHA384 and SHA512 used by notation signatures
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	orasregistry "oras.land/oras-go/v2/registry"
+)
+
+const (
+	notationSignatureType        = "notation"
+	notationArtifactType         = "application/vnd.cncf.notary.signature"
+	notationJWSEnvelopeMediaType = "application/jose+json"
+	notationPayloadContentType   = "application/vnd.cncf.notary.payload.v1+json"
+
+	notationSigningSchemeHeader        = "io.cncf.notary.signingScheme"
+	notationExpiryHeader               = "io.cncf.notary.expiry"
+	notationAuthenticSigningTimeHeader = "io.cncf.notary.authenticSigningTime"
+)
+
+// notationSigningSchemes are the signing schemes defined by the Notation signature specification.
+var notationSigningSchemes = map[string]struct{}{
+	"notary.x509":                  {},
+	"notary.x509.signingAuthority": {},
+}
+
+// understoodCriticalHeaders are the protected header extensions that can be marked critical.
+// Any other extension listed in crit makes the signature invalid, as required by RFC 7515.
+var understoodCriticalHeaders = map[string]struct{}{
+	notationSigningSchemeHeader:        {},
+	notationExpiryHeader:               {},
+	notationAuthenticSigningTimeHeader: {},
+}
+
+// jwsEnvelope is the JWS JSON serialization used by Notation signature envelopes.
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		CertChain [][]byte `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type jwsProtectedHeader struct {
+	Algorithm     string   `json:"alg"`
+	ContentType   string   `json:"cty"`
+	Critical      []string `json:"crit"`
+	SigningScheme string   `json:"io.cncf.notary.signingScheme"`
+	Expiry        string   `json:"io.cncf.notary.expiry"`
+}
+
+type notationPayload struct {
+	TargetArtifact ocispec.Descriptor `json:"targetArtifact"`
+}
+
+func (v *ImageVerifier) verifyNotationSignatures(ctx context.Context, repo Repository, image ocispec.Descriptor) ([]SignatureVerification, error) {
+	referrers, err := orasregistry.Referrers(ctx, repo, image, notationArtifactType)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SignatureVerification, 0, len(referrers))
+	for _, referrer := range referrers {
+		result := SignatureVerification{
+			Type:   notationSignatureType,
+			Digest: referrer.Digest.String(),
+		}
+
+		if v.notationRoots == nil {
+			result.Status = SignatureSkipped
+			results = append(results, result)
+			continue
+		}
+
+		if err := v.verifyNotationSignature(ctx, repo, image, referrer); err != nil {
+			result.Status = SignatureFailed
+			result.Error = err.Error()
+		} else {
+			result.Status = SignatureVerified
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (v *ImageVerifier) verifyNotationSignature(ctx context.Context, repo Repository, image, sigManifest ocispec.Descriptor) error {
+	manifestContent, err := content.FetchAll(ctx, repo, sigManifest)
+	if err != nil {
+		return fmt.Errorf("fetching signature manifest: %v", err)
+	}
+
+	manifest := &ocispec.Manifest{}
+	if err := json.Unmarshal(manifestContent, manifest); err != nil {
+		return fmt.Errorf("unmarshalling signature manifest: %v", err)
+	}
+
+	if len(manifest.Layers) != 1 {
+		return fmt.Errorf("signature manifest has %d layers, expected 1", len(manifest.Layers))
+	}
+
+	envelope := manifest.Layers[0]
+	if envelope.MediaType != notationJWSEnvelopeMediaType {
+		return fmt.Errorf("unsupported signature envelope media type %s", envelope.MediaType)
+	}
+
+	envelopeContent, err := content.FetchAll(ctx, repo, envelope)
+	if err != nil {
+		return fmt.Errorf("fetching signature envelope: %v", err)
+	}
+
+	payload, err := verifyJWSEnvelope(envelopeContent, v.notationRoots)
+	if err != nil {
+		return err
+	}
+
+	target := payload.TargetArtifact
+	if target.Digest != image.Digest {
+		return fmt.Errorf("signature is for digest %s", target.Digest)
+	}
+	if target.MediaType != image.MediaType {
+		return fmt.Errorf("signature is for media type %s, image is %s", target.MediaType, image.MediaType)
+	}
+	if target.Size != image.Size {
+		return fmt.Errorf("signature is for size %d, image is %d", target.Size, image.Size)
+	}
+
+	return nil
+}
+
+// verifyJWSEnvelope verifies the signature of a Notation JWS envelope with the certificate chain
+// it embeds and checks the chain is issued by one of the trusted roots.
+// The chain is validated at the current time: RFC 3161 timestamp countersignatures aren't verified,
+// so the signing time claimed by the signer can't extend a signature past its certificates validity.
+func verifyJWSEnvelope(envelopeContent []byte, roots *x509.CertPool) (*notationPayload, error) {
+	envelope := &jwsEnvelope{}
+	if err := json.Unmarshal(envelopeContent, envelope); err != nil {
+		return nil, fmt.Errorf("unmarshalling signature envelope: %v", err)
+	}
+
+	protectedContent, err := base64.RawURLEncoding.DecodeString(envelope.Protected)
+	if err != nil {
+		return nil, fmt.Errorf("decoding protected header: %v", err)
+	}
+
+	header := &jwsProtectedHeader{}
+	if err := json.Unmarshal(protectedContent, header); err != nil {
+		return nil, fmt.Errorf("unmarshalling protected header: %v", err)
+	}
+
+	now := time.Now()
+	if err := header.validate(protectedContent, now); err != nil {
+		return nil, err
+	}
+
+	if len(envelope.Header.CertChain) == 0 {
+		return nil, errors.New("signature envelope doesn't contain a certificate chain")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(envelope.Header.CertChain))
+	for _, raw := range envelope.Header.CertChain {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing signing certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	leaf := certs[0]
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		CurrentTime:   now,
+	}); err != nil {
+		return nil, fmt.Errorf("signing certificate is not trusted: %v", err)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(envelope.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("decoding signature: %v", err)
+	}
+
+	signingInput := []byte(envelope.Protected + "." + envelope.Payload)
+	if err := verifyJWSSignature(header.Algorithm, leaf.PublicKey, signingInput, sig); err != nil {
+		return nil, err
+	}
+
+	payloadContent, err := base64.RawURLEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("decoding payload: %v", err)
+	}
+
+	payload := &notationPayload{}
+	if err := json.Unmarshal(payloadContent, payload); err != nil {
+		return nil, fmt.Errorf("unmarshalling payload: %v", err)
+	}
+
+	return payload, nil
+}
+
+// validate checks the protected header of a Notation signature, including that all the critical
+// extensions are understood and present, and that the signature hasn't expired at now.
+func (h *jwsProtectedHeader) validate(protectedContent []byte, now time.Time) error {
+	if h.ContentType != notationPayloadContentType {
+		return fmt.Errorf("unsupported payload content type %s", h.ContentType)
+	}
+
+	if _, ok := notationSigningSchemes[h.SigningScheme]; !ok {
+		return fmt.Errorf("unsupported signing scheme %q", h.SigningScheme)
+	}
+
+	if h.Critical != nil {
+		if len(h.Critical) == 0 {
+			return errors.New("protected header crit is empty")
+		}
+
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(protectedContent, &fields); err != nil {
+			return fmt.Errorf("unmarshalling protected header: %v", err)
+		}
+		for _, name := range h.Critical {
+			if _, ok := understoodCriticalHeaders[name]; !ok {
+				return fmt.Errorf("unsupported critical header %s", name)
+			}
+			if _, ok := fields[name]; !ok {
+				return fmt.Errorf("critical header %s is missing from protected header", name)
+			}
+		}
+	}
+
+	if h.Expiry != "" {
+		expiry, err := time.Parse(time.RFC3339, h.Expiry)
+		if err != nil {
+			return fmt.Errorf("parsing signature expiry: %v", err)
+		}
+		if now.After(expiry) {
+			return fmt.Errorf("signature expired at %s", h.Expiry)
+		}
+	}
+
+	return nil
+}
+
+func verifyJWSSignature(alg string, key crypto.PublicKey, signingInput, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "PS256", "ES256":
+		hash = crypto.SHA256
+	case "PS384", "ES384":
+		hash = crypto.SHA384
+	case "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signature algorithm %s", alg)
+	}
+
+	h := hash.New()
+	h.Write(signingInput)
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if alg[0] != 'P' {
+			return fmt.Errorf("signature algorithm %s doesn't match RSA signing key", alg)
+		}
+		if err := rsa.VerifyPSS(k, hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}); err != nil {
+			return errors.New("signature doesn't match signing certificate")
+		}
+	case *ecdsa.PublicKey:
+		if alg[0] != 'E' {
+			return fmt.Errorf("signature algorithm %s doesn't match ECDSA signing key", alg)
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("signature doesn't match signing certificate")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return errors.New("signature doesn't match signing certificate")
+		}
+	default:
+		return fmt.Errorf("unsupported signing key type %T", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package signature
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SignatureStatus is the outcome of verifying a single image signature.
+type SignatureStatus string
+
+const (
+	// SignatureVerified means the signature was verified with the configured trust material.
+	SignatureVerified SignatureStatus = "Verified"
+	// SignatureFailed means the signature couldn't be verified with the configured trust material.
+	SignatureFailed SignatureStatus = "Failed"
+	// SignatureSkipped means the signature was found but no trust material was configured to verify it.
+	// The image fails verification.
+	SignatureSkipped SignatureStatus = "Skipped"
+)
+
+// SignatureVerification is the result of verifying a single image signature.
+type SignatureVerification struct {
+	// Type is the signature format, cosign or notation.
+	Type   string          `json:"type"`
+	Digest string          `json:"digest"`
+	Status SignatureStatus `json:"status"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// ImageVerification is the verification result for an image.
+type ImageVerification struct {
+	Image          string                  `json:"image"`
+	Reference      string                  `json:"reference"`
+	ExpectedDigest string                  `json:"expectedDigest"`
+	Digest         string                  `json:"digest,omitempty"`
+	Signatures     []SignatureVerification `json:"signatures,omitempty"`
+	Verified       bool                    `json:"verified"`
+	Error          string                  `json:"error,omitempty"`
+}
+
+// VerificationReport contains the verification results for a bundle and all its images.
+type VerificationReport struct {
+	Bundle                  string              `json:"bundle,omitempty"`
+	BundleSignatureVerified bool                `json:"bundleSignatureVerified"`
+	Images                  []ImageVerification `json:"images"`
+}
+
+// Failed returns the images that didn't pass verification.
+func (r *VerificationReport) Failed() []ImageVerification {
+	var failed []ImageVerification
+	for _, i := range r.Images {
+		if !i.Verified {
+			failed = append(failed, i)
+		}
+	}
+	return failed
+}
+
+// Err returns an error if the bundle signature or any of the images failed verification.
+func (r *VerificationReport) Err() error {
+	if !r.BundleSignatureVerified {
+		return fmt.Errorf("bundle %s signature is not valid", r.Bundle)
+	}
+
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d of %d images failed verification, first failure %s: %s", len(failed), len(r.Images), failed[0].Reference, failed[0].Error)
+}
+
+// Write writes the report as JSON to a file.
+func (r *VerificationReport) Write(path string) error {
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling verification report: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating verification report directory: %v", err)
+	}
+
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("writing verification report: %v", err)
+	}
+
+	return nil
+}
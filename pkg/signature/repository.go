@@ -0,0 +1,66 @@
+package signature
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// RemoteRepositories provides access to repositories in remote OCI registries.
+type RemoteRepositories struct {
+	client    *auth.Client
+	plainHTTP bool
+}
+
+// RemoteRepositoriesOpt allows to customize RemoteRepositories.
+type RemoteRepositoriesOpt func(*RemoteRepositories)
+
+// WithPlainHTTP configures the repositories to be accessed over plain http.
+func WithPlainHTTP(plainHTTP bool) RemoteRepositoriesOpt {
+	return func(r *RemoteRepositories) {
+		r.plainHTTP = plainHTTP
+	}
+}
+
+// NewRemoteRepositories constructs a new RemoteRepositories that authenticates with
+// the credentials returned by credential.
+func NewRemoteRepositories(credential auth.CredentialFunc, certificates *x509.CertPool, insecure bool, opts ...RemoteRepositoriesOpt) *RemoteRepositories {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	{ // #nosec G402
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:            certificates,
+			InsecureSkipVerify: insecure,
+		}
+	}
+	client := &auth.Client{
+		Client: &http.Client{
+			Transport: transport,
+		},
+		Cache:      auth.NewCache(),
+		Credential: credential,
+	}
+	client.SetUserAgent("eksa")
+
+	r := &RemoteRepositories{
+		client: client,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Repository returns the remote repository for a name in the form registry/repository.
+func (r *RemoteRepositories) Repository(_ context.Context, name string) (Repository, error) {
+	repo, err := remote.NewRepository(name)
+	if err != nil {
+		return nil, err
+	}
+	repo.Client = r.client
+	repo.PlainHTTP = r.plainHTTP
+	return repo, nil
+}
@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/events"
 	"github.com/aws/eks-anywhere/pkg/filewriter"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers"
@@ -100,6 +101,25 @@ func (pp *Profiler) Metrics() map[string]map[string]time.Duration {
 	return pp.metrics
 }
 
+// durations sets the duration of the event's task and its subtasks.
+func (pp *Profiler) durations(e events.Event) events.Event {
+	durationMap, ok := pp.metrics[e.Task]
+	if !ok {
+		return e
+	}
+	for k, v := range durationMap {
+		if k == e.Task {
+			e.DurationSeconds = v.Seconds()
+			continue
+		}
+		if e.SubtaskDurationsSeconds == nil {
+			e.SubtaskDurationsSeconds = map[string]float64{}
+		}
+		e.SubtaskDurationsSeconds[k] = v.Seconds()
+	}
+	return e
+}
+
 // debug logs for task metric.
 func (pp *Profiler) logProfileSummary(taskName string) {
 	if durationMap, ok := pp.metrics[taskName]; ok {
@@ -117,13 +137,18 @@ func (pp *Profiler) logProfileSummary(taskName string) {
 
 // Manages Task execution.
 type taskRunner struct {
-	task           Task
-	writer         filewriter.FileWriter
-	withCheckpoint bool
+	task              Task
+	writer            filewriter.FileWriter
+	withCheckpoint    bool
+	resume            bool
+	requireCheckpoint bool
 }
 
 type TaskRunnerOpt func(*taskRunner)
 
+// WithCheckpointFile makes the task runner save a checkpoint after every completed task so a failed
+// or killed run can be resumed later. The checkpoint is only read when resuming and it's removed
+// once all the tasks succeed.
 func WithCheckpointFile() TaskRunnerOpt {
 	return func(t *taskRunner) {
 		logger.V(4).Info("Checkpoint feature enabled")
@@ -131,6 +156,27 @@ func WithCheckpointFile() TaskRunnerOpt {
 	}
 }
 
+// WithResume makes the task runner resume from the checkpoint saved by a previous run,
+// skipping the tasks that already completed. It fails if there is no checkpoint to resume from.
+func WithResume() TaskRunnerOpt {
+	return func(t *taskRunner) {
+		logger.V(4).Info("Resuming from checkpoint")
+		t.withCheckpoint = true
+		t.resume = true
+		t.requireCheckpoint = true
+	}
+}
+
+// WithResumeIfCheckpointExists is like WithResume but it starts from the first task when there is
+// no checkpoint instead of failing.
+func WithResumeIfCheckpointExists() TaskRunnerOpt {
+	return func(t *taskRunner) {
+		logger.V(4).Info("Resuming from checkpoint if present")
+		t.withCheckpoint = true
+		t.resume = true
+	}
+}
+
 func (tr *taskRunner) RunTask(ctx context.Context, commandContext *CommandContext) error {
 	checkpointFileName := fmt.Sprintf("%s-checkpoint.yaml", commandContext.ClusterSpec.Cluster.Name)
 	var checkpointInfo CheckpointInfo
@@ -151,7 +197,8 @@ func (tr *taskRunner) RunTask(ctx context.Context, commandContext *CommandContex
 	}
 
 	for task != nil {
-		if completedTask, ok := checkpointInfo.CompletedTasks[task.Name()]; ok {
+		name := task.Name()
+		if completedTask, ok := checkpointInfo.CompletedTasks[name]; ok {
 			logger.V(4).Info("Restoring task", "task_name", task.Name())
 			nextTask, err := task.Restore(ctx, commandContext, completedTask)
 			if err != nil {
@@ -162,20 +209,46 @@ func (tr *taskRunner) RunTask(ctx context.Context, commandContext *CommandContex
 		}
 		logger.V(4).Info("Task start", "task_name", task.Name())
 		commandContext.Profiler.SetStartTask(task.Name())
+		event := events.Event{Type: events.TaskStarted, Cluster: commandContext.ClusterSpec.Cluster.Name, Task: name}
+		events.Emit(event)
+		previousError := commandContext.OriginalError
 		nextTask := task.Run(ctx, commandContext)
 		commandContext.Profiler.MarkDoneTask(task.Name())
 		commandContext.Profiler.logProfileSummary(task.Name())
+		event = commandContext.Profiler.durations(event)
+		if previousError == nil && commandContext.OriginalError != nil {
+			event.Type = events.TaskFailed
+			event = event.WithError(commandContext.OriginalError)
+		} else {
+			event.Type = events.TaskFinished
+		}
+		events.Emit(event)
 		if commandContext.OriginalError == nil {
 			checkpointInfo.taskCompleted(task.Name(), task.Checkpoint())
+			// Save the checkpoint as soon as the task completes so it survives a run that is
+			// killed before reaching the end.
+			if tr.withCheckpoint {
+				checkpointInfo.State = newCommandState(commandContext)
+				if err := tr.saveCheckpoint(checkpointInfo, checkpointFileName); err != nil {
+					return err
+				}
+			}
 		}
 		task = nextTask
 	}
 	if commandContext.OriginalError != nil {
+		checkpointInfo.State = newCommandState(commandContext)
 		if err := tr.saveCheckpoint(checkpointInfo, checkpointFileName); err != nil {
 			return err
 		}
+		return commandContext.OriginalError
+	}
+	if tr.withCheckpoint {
+		if err := tr.removeCheckpoint(commandContext, checkpointFileName); err != nil {
+			return err
+		}
 	}
-	return commandContext.OriginalError
+	return nil
 }
 
 func taskRunnerFinalBlock(startTime time.Time) {
@@ -209,23 +282,80 @@ func (tr *taskRunner) saveCheckpoint(checkpointInfo CheckpointInfo, filename str
 
 func (tr *taskRunner) setupCheckpointInfo(commandContext *CommandContext, checkpointFileName string) (CheckpointInfo, error) {
 	checkpointInfo := newCheckpointInfo()
-	if tr.withCheckpoint {
-		checkpointFilePath := filepath.Join(commandContext.Writer.TempDir(), checkpointFileName)
-		if _, err := os.Stat(checkpointFilePath); err == nil {
-			checkpointFile, err := readCheckpointFile(checkpointFilePath)
-			if err != nil {
-				return checkpointInfo, err
-			}
-			checkpointInfo.CompletedTasks = checkpointFile.CompletedTasks
+	if !tr.resume {
+		return checkpointInfo, nil
+	}
+	checkpointFilePath := filepath.Join(commandContext.Writer.TempDir(), checkpointFileName)
+	if _, err := os.Stat(checkpointFilePath); err != nil {
+		if tr.requireCheckpoint {
+			return checkpointInfo, fmt.Errorf("no checkpoint to resume from: %s doesn't exist", checkpointFilePath)
 		}
+		return checkpointInfo, nil
+	}
+	checkpointFile, err := readCheckpointFile(checkpointFilePath)
+	if err != nil {
+		return checkpointInfo, err
 	}
+	checkpointInfo.CompletedTasks = checkpointFile.CompletedTasks
+	checkpointFile.State.restore(commandContext)
 	return checkpointInfo, nil
 }
 
+// removeCheckpoint deletes the checkpoint of a run whose tasks all succeeded, so a later
+// resume doesn't skip tasks of an operation that already finished.
+func (tr *taskRunner) removeCheckpoint(commandContext *CommandContext, checkpointFileName string) error {
+	checkpointFilePath := filepath.Join(commandContext.Writer.TempDir(), checkpointFileName)
+	logger.V(4).Info("Removing checkpoint", "file", checkpointFilePath)
+	if err := os.Remove(checkpointFilePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing task runner checkpoint: %v", err)
+	}
+	return nil
+}
+
 type TaskCheckpoint interface{}
 
 type CheckpointInfo struct {
 	CompletedTasks map[string]*CompletedTask `json:"completedTasks"`
+	// State is the part of the CommandContext built by the completed tasks that is needed
+	// to run the remaining ones.
+	State *CommandState `json:"state,omitempty"`
+}
+
+// CommandState holds the CommandContext fields that are persisted in a checkpoint.
+type CommandState struct {
+	BootstrapCluster      *types.Cluster `json:"bootstrapCluster,omitempty"`
+	ManagementCluster     *types.Cluster `json:"managementCluster,omitempty"`
+	WorkloadCluster       *types.Cluster `json:"workloadCluster,omitempty"`
+	BackupClusterStateDir string         `json:"backupClusterStateDir,omitempty"`
+}
+
+func newCommandState(commandContext *CommandContext) *CommandState {
+	return &CommandState{
+		BootstrapCluster:      commandContext.BootstrapCluster,
+		ManagementCluster:     commandContext.ManagementCluster,
+		WorkloadCluster:       commandContext.WorkloadCluster,
+		BackupClusterStateDir: commandContext.BackupClusterStateDir,
+	}
+}
+
+// restore sets the persisted state in the command context, keeping the values already
+// set by the workflow for the fields that weren't persisted.
+func (s *CommandState) restore(commandContext *CommandContext) {
+	if s == nil {
+		return
+	}
+	if s.BootstrapCluster != nil {
+		commandContext.BootstrapCluster = s.BootstrapCluster
+	}
+	if s.ManagementCluster != nil {
+		commandContext.ManagementCluster = s.ManagementCluster
+	}
+	if s.WorkloadCluster != nil {
+		commandContext.WorkloadCluster = s.WorkloadCluster
+	}
+	if s.BackupClusterStateDir != "" {
+		commandContext.BackupClusterStateDir = s.BackupClusterStateDir
+	}
 }
 
 type CompletedTask struct {
@@ -1,16 +1,23 @@
 package task_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/events"
 	"github.com/aws/eks-anywhere/pkg/features"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
 	writermocks "github.com/aws/eks-anywhere/pkg/filewriter/mocks"
 	"github.com/aws/eks-anywhere/pkg/task"
 	mocktasks "github.com/aws/eks-anywhere/pkg/task/mocks"
@@ -70,15 +77,20 @@ func TestTaskRunnerRunTaskWithCheckpointSecondRunSuccess(t *testing.T) {
 	tt.taskC.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil).Times(1)
 	tt.taskC.EXPECT().Name().Return("taskC").Times(6)
 	tt.taskC.EXPECT().Checkpoint()
-	tt.writer.EXPECT().TempDir().Return("testdata")
+	dir := copyCheckpoint(t, "test-cluster-checkpoint.yaml")
+	tt.writer.EXPECT().TempDir().Return(dir).Times(2)
+	tt.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", tt.cmdContext.ClusterSpec.Cluster.Name), gomock.Any()).Times(2)
 
 	tasks := []task.Task{tt.taskA, tt.taskB, tt.taskC}
 
 	t.Setenv(features.CheckpointEnabledEnvVar, "true")
-	runner := task.NewTaskRunner(tasks[0], tt.cmdContext.Writer, task.WithCheckpointFile())
+	runner := task.NewTaskRunner(tasks[0], tt.cmdContext.Writer, task.WithCheckpointFile(), task.WithResumeIfCheckpointExists())
 	if err := runner.RunTask(tt.ctx, tt.cmdContext); err != nil {
 		t.Fatal(err)
 	}
+	if _, err := os.Stat(filepath.Join(dir, "test-cluster-checkpoint.yaml")); !os.IsNotExist(err) {
+		t.Errorf("checkpoint should be removed after a successful run, stat err = %v", err)
+	}
 
 	if err := os.Unsetenv(features.CheckpointEnabledEnvVar); err != nil {
 		t.Fatal(err)
@@ -91,7 +103,6 @@ func TestTaskRunnerRunTaskWithCheckpointFirstRunFailed(t *testing.T) {
 
 	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
 	tt.taskA.EXPECT().Name().Return("taskA").Times(5)
-	tt.writer.EXPECT().TempDir()
 	tt.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", tt.cmdContext.ClusterSpec.Cluster.Name), gomock.Any())
 
 	tasks := []task.Task{tt.taskA, tt.taskB}
@@ -106,6 +117,36 @@ func TestTaskRunnerRunTaskWithCheckpointFirstRunFailed(t *testing.T) {
 	}
 }
 
+func TestTaskRunnerRunTaskWithCheckpointSavesCompletedTasks(t *testing.T) {
+	tt := newTaskRunnerTest(t)
+	var saved string
+
+	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(tt.taskB)
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.taskB.EXPECT().Run(tt.ctx, tt.cmdContext).DoAndReturn(func(_ context.Context, c *task.CommandContext) task.Task {
+		if !strings.Contains(saved, "taskA") {
+			t.Errorf("checkpoint wasn't saved after taskA completed:\n%s", saved)
+		}
+		return nil
+	})
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskB.EXPECT().Checkpoint()
+	tt.writer.EXPECT().TempDir().Return(t.TempDir())
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any()).DoAndReturn(func(_ string, content []byte, _ ...filewriter.FileOptionsFunc) (string, error) {
+		saved = string(content)
+		return "", nil
+	}).Times(2)
+
+	runner := task.NewTaskRunner(tt.taskA, tt.cmdContext.Writer, task.WithCheckpointFile())
+	if err := runner.RunTask(tt.ctx, tt.cmdContext); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(saved, "taskB") {
+		t.Errorf("checkpoint wasn't saved after taskB completed:\n%s", saved)
+	}
+}
+
 func TestTaskRunnerRunTaskWithCheckpointSecondRunRestoreFailure(t *testing.T) {
 	tt := newTaskRunnerTest(t)
 
@@ -116,7 +157,7 @@ func TestTaskRunnerRunTaskWithCheckpointSecondRunRestoreFailure(t *testing.T) {
 	tasks := []task.Task{tt.taskA, tt.taskB, tt.taskC}
 
 	t.Setenv(features.CheckpointEnabledEnvVar, "true")
-	runner := task.NewTaskRunner(tasks[0], tt.cmdContext.Writer, task.WithCheckpointFile())
+	runner := task.NewTaskRunner(tasks[0], tt.cmdContext.Writer, task.WithCheckpointFile(), task.WithResumeIfCheckpointExists())
 	if err := runner.RunTask(tt.ctx, tt.cmdContext); err == nil {
 		t.Fatalf("Task.Restore want err, got nil")
 	}
@@ -132,7 +173,6 @@ func TestTaskRunnerRunTaskWithCheckpointSaveFailed(t *testing.T) {
 
 	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
 	tt.taskA.EXPECT().Name().Return("taskA").Times(5)
-	tt.writer.EXPECT().TempDir()
 	tt.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", tt.cmdContext.ClusterSpec.Cluster.Name), gomock.Any()).Return("", fmt.Errorf("error"))
 
 	tasks := []task.Task{tt.taskA, tt.taskB}
@@ -157,7 +197,7 @@ func TestTaskRunnerRunTaskWithCheckpointReadFailure(t *testing.T) {
 	tasks := []task.Task{tt.taskA, tt.taskB, tt.taskC}
 
 	t.Setenv(features.CheckpointEnabledEnvVar, "true")
-	runner := task.NewTaskRunner(tasks[0], tt.cmdContext.Writer, task.WithCheckpointFile())
+	runner := task.NewTaskRunner(tasks[0], tt.cmdContext.Writer, task.WithCheckpointFile(), task.WithResumeIfCheckpointExists())
 	if err := runner.RunTask(tt.ctx, tt.cmdContext); err == nil {
 		t.Fatalf("Task.ReadCheckpointFile want err, got nil")
 	}
@@ -167,6 +207,189 @@ func TestTaskRunnerRunTaskWithCheckpointReadFailure(t *testing.T) {
 	}
 }
 
+func TestTaskRunnerRunTaskWithCheckpointFirstRunFailedSavesState(t *testing.T) {
+	tt := newTaskRunnerTest(t)
+	tt.cmdContext.BootstrapCluster = &types.Cluster{Name: "bootstrap", KubeconfigFile: "bootstrap.kubeconfig"}
+
+	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).DoAndReturn(func(_ context.Context, c *task.CommandContext) task.Task {
+		c.SetError(fmt.Errorf("error"))
+		return nil
+	})
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any()).DoAndReturn(func(_ string, content []byte, _ ...filewriter.FileOptionsFunc) (string, error) {
+		if !strings.Contains(string(content), "KubeconfigFile: bootstrap.kubeconfig") {
+			t.Errorf("checkpoint doesn't contain the bootstrap cluster:\n%s", content)
+		}
+		if !strings.Contains(string(content), "backupClusterStateDir: "+tt.cmdContext.BackupClusterStateDir) {
+			t.Errorf("checkpoint doesn't contain the backup dir:\n%s", content)
+		}
+		return "", nil
+	})
+
+	runner := task.NewTaskRunner(tt.taskA, tt.cmdContext.Writer)
+	if err := runner.RunTask(tt.ctx, tt.cmdContext); err == nil {
+		t.Fatalf("Task.RunTask want err, got nil")
+	}
+}
+
+func TestTaskRunnerRunTaskWithResumeRestoresState(t *testing.T) {
+	tt := newTaskRunnerTest(t)
+	tt.cmdContext.ClusterSpec.Cluster.Name = "resumed"
+
+	tt.taskA.EXPECT().Restore(tt.ctx, tt.cmdContext, gomock.Any()).Return(nil, nil)
+	tt.taskA.EXPECT().Name().Return("taskA").Times(2)
+	tt.writer.EXPECT().TempDir().Return(copyCheckpoint(t, "resumed-checkpoint.yaml")).Times(2)
+
+	runner := task.NewTaskRunner(tt.taskA, tt.cmdContext.Writer, task.WithResume())
+	if err := runner.RunTask(tt.ctx, tt.cmdContext); err != nil {
+		t.Fatal(err)
+	}
+
+	wantBootstrap := &types.Cluster{Name: "resumed-eks-a-cluster", KubeconfigFile: "resumed/generated/resumed.kind.kubeconfig"}
+	if !reflect.DeepEqual(tt.cmdContext.BootstrapCluster, wantBootstrap) {
+		t.Errorf("BootstrapCluster = %v, want %v", tt.cmdContext.BootstrapCluster, wantBootstrap)
+	}
+	if tt.cmdContext.BackupClusterStateDir != "resumed-backup-2024-01-02T15_04_05" {
+		t.Errorf("BackupClusterStateDir = %s, want resumed-backup-2024-01-02T15_04_05", tt.cmdContext.BackupClusterStateDir)
+	}
+}
+
+func TestTaskRunnerRunTaskWithResumeNoCheckpoint(t *testing.T) {
+	tt := newTaskRunnerTest(t)
+	tt.cmdContext.ClusterSpec.Cluster.Name = "missing"
+
+	tt.writer.EXPECT().TempDir().Return("testdata")
+
+	runner := task.NewTaskRunner(tt.taskA, tt.cmdContext.Writer, task.WithResume())
+	if err := runner.RunTask(tt.ctx, tt.cmdContext); err == nil {
+		t.Fatalf("Task.RunTask want err, got nil")
+	}
+}
+
+func TestTaskRunnerRunTaskWithCheckpointIgnoredWithoutResume(t *testing.T) {
+	tt := newTaskRunnerTest(t)
+
+	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any())
+	tt.writer.EXPECT().TempDir().Return(copyCheckpoint(t, "test-cluster-checkpoint.yaml"))
+
+	runner := task.NewTaskRunner(tt.taskA, tt.cmdContext.Writer, task.WithCheckpointFile())
+	if err := runner.RunTask(tt.ctx, tt.cmdContext); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTaskRunnerRunTaskResumeAfterKilledRun(t *testing.T) {
+	tt := newTaskRunnerTest(t)
+	writer, err := filewriter.NewWriter(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt.cmdContext.Writer = writer
+	tt.cmdContext.BootstrapCluster = &types.Cluster{Name: "bootstrap", KubeconfigFile: "bootstrap.kubeconfig"}
+
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+
+	// First run: taskA completes and the process is killed while taskB runs, so
+	// the runner never gets to save a checkpoint for a failed run.
+	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(tt.taskB)
+	tt.taskA.EXPECT().Checkpoint().Return(&task.CompletedTask{Checkpoint: &types.Cluster{Name: "from-taskA"}})
+	tt.taskB.EXPECT().Run(tt.ctx, tt.cmdContext).Do(func(context.Context, *task.CommandContext) {
+		panic("killed")
+	})
+	func() {
+		defer func() {
+			if r := recover(); r != "killed" {
+				t.Fatalf("first run recover() = %v, want killed", r)
+			}
+		}()
+		_ = task.NewTaskRunner(tt.taskA, writer, task.WithCheckpointFile()).RunTask(tt.ctx, tt.cmdContext)
+	}()
+
+	// Second run: taskA is restored from the checkpoint and only taskB runs.
+	tt.cmdContext.BootstrapCluster = nil
+	tt.taskA.EXPECT().Restore(tt.ctx, tt.cmdContext, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *task.CommandContext, completed *task.CompletedTask) (task.Task, error) {
+			c := &types.Cluster{}
+			if err := task.UnmarshalTaskCheckpoint(completed.Checkpoint, c); err != nil {
+				return nil, err
+			}
+			if c.Name != "from-taskA" {
+				t.Errorf("restored checkpoint = %v, want from-taskA", c)
+			}
+			return tt.taskB, nil
+		})
+	tt.taskB.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
+	tt.taskB.EXPECT().Checkpoint()
+
+	if err := task.NewTaskRunner(tt.taskA, writer, task.WithCheckpointFile(), task.WithResume()).RunTask(tt.ctx, tt.cmdContext); err != nil {
+		t.Fatal(err)
+	}
+	if tt.cmdContext.BootstrapCluster == nil || tt.cmdContext.BootstrapCluster.KubeconfigFile != "bootstrap.kubeconfig" {
+		t.Errorf("BootstrapCluster = %v, want the one saved by the killed run", tt.cmdContext.BootstrapCluster)
+	}
+	if _, err := os.Stat(filepath.Join(writer.TempDir(), "test-cluster-checkpoint.yaml")); !os.IsNotExist(err) {
+		t.Errorf("checkpoint should be removed after a successful run, stat err = %v", err)
+	}
+}
+
+func TestTaskRunnerRunTaskEmitsEvents(t *testing.T) {
+	tt := newTaskRunnerTest(t)
+	out := &bytes.Buffer{}
+	if err := events.Init(events.JSONFormat, out); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(events.Reset)
+
+	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(tt.taskB)
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.taskB.EXPECT().Run(tt.ctx, tt.cmdContext).DoAndReturn(func(_ context.Context, c *task.CommandContext) task.Task {
+		c.SetError(fmt.Errorf("error"))
+		return nil
+	})
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any())
+
+	runner := task.NewTaskRunner(tt.taskA, tt.cmdContext.Writer)
+	if err := runner.RunTask(tt.ctx, tt.cmdContext); err == nil {
+		t.Fatalf("Task.RunTask want err, got nil")
+	}
+
+	var got []events.Event
+	for _, line := range bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n")) {
+		e := events.Event{}
+		if err := json.Unmarshal(line, &e); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, e)
+	}
+
+	want := []struct {
+		eventType events.Type
+		task      string
+	}{
+		{events.TaskStarted, "taskA"},
+		{events.TaskFinished, "taskA"},
+		{events.TaskStarted, "taskB"},
+		{events.TaskFailed, "taskB"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d: %s", len(got), len(want), out.String())
+	}
+	for i, w := range want {
+		if got[i].Type != w.eventType || got[i].Task != w.task || got[i].Cluster != "test-cluster" {
+			t.Errorf("event %d = %+v, want type %s for task %s", i, got[i], w.eventType, w.task)
+		}
+	}
+	if got[3].Error != "error" || got[3].ErrorCategory != events.UnknownCategory {
+		t.Errorf("failed event = %+v, want error with category %s", got[3], events.UnknownCategory)
+	}
+}
+
 func TestUnmarshalTaskCheckpointSuccess(t *testing.T) {
 	testConfigType := types.Cluster{}
 	testTaskCheckpoint := types.Cluster{
@@ -179,6 +402,20 @@ func TestUnmarshalTaskCheckpointSuccess(t *testing.T) {
 	}
 }
 
+// copyCheckpoint copies a checkpoint from testdata to a temp dir, since a successful run removes it.
+func copyCheckpoint(t *testing.T, name string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, name), content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
 type taskRunnerTest struct {
 	ctx        context.Context
 	cmdContext *task.CommandContext
@@ -40,9 +40,20 @@ func ValidateOSForRegistryMirror(clusterSpec *cluster.Spec, provider providers.P
 	}
 
 	for _, mc := range machineConfigs {
-		if mc.OSFamily() == v1alpha1.Bottlerocket && cluster.Spec.RegistryMirrorConfiguration.InsecureSkipVerify {
+		if mc.OSFamily() != v1alpha1.Bottlerocket {
+			continue
+		}
+		if cluster.Spec.RegistryMirrorConfiguration.InsecureSkipVerify {
 			return errors.New("InsecureSkipVerify is not supported for bottlerocket")
 		}
+		for _, endpoint := range cluster.Spec.RegistryMirrorConfiguration.Endpoints {
+			if endpoint.InsecureSkipVerify {
+				return fmt.Errorf("InsecureSkipVerify is not supported for bottlerocket in registry mirror endpoint %s", endpoint.Endpoint)
+			}
+			if endpoint.CredentialsSecretRef != "" {
+				return fmt.Errorf("credentialsSecretRef is not supported for bottlerocket in registry mirror endpoint %s", endpoint.Endpoint)
+			}
+		}
 	}
 
 	ociNamespaces := cluster.Spec.RegistryMirrorConfiguration.OCINamespaces
@@ -59,23 +70,36 @@ func ValidateCertForRegistryMirror(clusterSpec *cluster.Spec, tlsValidator TlsVa
 		return nil
 	}
 
-	if cluster.Spec.RegistryMirrorConfiguration.InsecureSkipVerify {
-		logger.V(1).Info("Warning: skip registry certificate verification is enabled", "registryMirrorConfiguration.insecureSkipVerify", true)
+	mirrorConfig := cluster.Spec.RegistryMirrorConfiguration
+	if err := validateCertForRegistryMirrorEndpoint(mirrorConfig.Endpoint, mirrorConfig.Port, mirrorConfig.CACertContent, mirrorConfig.InsecureSkipVerify, tlsValidator); err != nil {
+		return err
+	}
+
+	for _, endpoint := range mirrorConfig.Endpoints {
+		if err := validateCertForRegistryMirrorEndpoint(endpoint.Endpoint, endpoint.Port, endpoint.CACertContent, endpoint.InsecureSkipVerify, tlsValidator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCertForRegistryMirrorEndpoint(host, port, certContent string, insecureSkipVerify bool, tlsValidator TlsValidator) error {
+	if insecureSkipVerify {
+		logger.V(1).Info("Warning: skip registry certificate verification is enabled", "endpoint", host, "insecureSkipVerify", true)
 		return nil
 	}
 
-	host, port := cluster.Spec.RegistryMirrorConfiguration.Endpoint, cluster.Spec.RegistryMirrorConfiguration.Port
 	authorityUnknown, err := tlsValidator.IsSignedByUnknownAuthority(host, port)
 	if err != nil {
 		return fmt.Errorf("validating registry mirror endpoint: %v", err)
 	}
 	if authorityUnknown {
-		logger.V(1).Info(fmt.Sprintf("Warning: registry mirror endpoint %s is using self-signed certs", cluster.Spec.RegistryMirrorConfiguration.Endpoint))
+		logger.V(1).Info(fmt.Sprintf("Warning: registry mirror endpoint %s is using self-signed certs", host))
 	}
 
-	certContent := cluster.Spec.RegistryMirrorConfiguration.CACertContent
 	if certContent == "" && authorityUnknown {
-		return fmt.Errorf("registry %s is using self-signed certs, please provide the certificate using caCertContent field. Or use insecureSkipVerify field to skip registry certificate verification", cluster.Spec.RegistryMirrorConfiguration.Endpoint)
+		return fmt.Errorf("registry %s is using self-signed certs, please provide the certificate using caCertContent field. Or use insecureSkipVerify field to skip registry certificate verification", host)
 	}
 
 	if certContent != "" {
@@ -87,7 +111,8 @@ func ValidateCertForRegistryMirror(clusterSpec *cluster.Spec, tlsValidator TlsVa
 	return nil
 }
 
-// ValidateAuthenticationForRegistryMirror checks if REGISTRY_USERNAME and REGISTRY_PASSWORD is set if authenticated registry mirrors are used.
+// ValidateAuthenticationForRegistryMirror checks if REGISTRY_USERNAME and REGISTRY_PASSWORD is set if authenticated registry mirrors are used,
+// as well as the credentials env variables of the registry mirror endpoints with a credentials secret.
 func ValidateAuthenticationForRegistryMirror(clusterSpec *cluster.Spec) error {
 	cluster := clusterSpec.Cluster
 	if cluster.Spec.RegistryMirrorConfiguration != nil && cluster.Spec.RegistryMirrorConfiguration.Authenticate {
@@ -96,6 +121,12 @@ func ValidateAuthenticationForRegistryMirror(clusterSpec *cluster.Spec) error {
 			return err
 		}
 	}
+
+	for _, secretRef := range cluster.RegistryMirrorCredentialsSecretRefs() {
+		if _, _, err := config.ReadEndpointCredentials(secretRef); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -156,6 +187,24 @@ func ValidateManagementClusterEksaVersion(ctx context.Context, k KubectlClient,
 	return ValidateManagementEksaVersion(mgmt, workload.Cluster)
 }
 
+// ValidateIPAMProviderInstalled ensures the management cluster runs the in-cluster IPAM provider
+// when the cluster machines get their addresses from vSphere ipPools.
+func ValidateIPAMProviderInstalled(ctx context.Context, k KubectlClient, mgmtCluster *types.Cluster, spec *cluster.Spec) error {
+	if spec.VSphereDatacenter == nil || len(spec.VSphereDatacenter.Spec.IPPools) == 0 {
+		return nil
+	}
+
+	installed, err := k.CheckProviderExists(ctx, mgmtCluster.KubeconfigFile, constants.InClusterIPAMProviderName, constants.CapiIPAMInClusterSystemNamespace)
+	if err != nil {
+		return fmt.Errorf("checking the in-cluster IPAM provider: %v", err)
+	}
+	if !installed {
+		return fmt.Errorf("management cluster %s doesn't have the in-cluster IPAM provider required by the vSphere ipPools", mgmtCluster.Name)
+	}
+
+	return nil
+}
+
 // ValidateManagementEksaVersion ensures a workload cluster's EksaVersion is not greater than a management cluster's version.
 func ValidateManagementEksaVersion(mgmtCluster, cluster *v1alpha1.Cluster) error {
 	if !clustersHaveEksaVersion(mgmtCluster, cluster) {
@@ -293,6 +342,21 @@ func ValidateBottlerocketKubeletConfig(spec *cluster.Spec) error {
 	return nil
 }
 
+// ValidateLoadBalancerBundle ensures the bundle includes the kube-vip images when the cluster
+// configures an in-cluster loadBalancer.
+func ValidateLoadBalancerBundle(spec *cluster.Spec) error {
+	if spec.Cluster.Spec.LoadBalancer == nil {
+		return nil
+	}
+
+	versionsBundle := spec.RootVersionsBundle()
+	if versionsBundle == nil || versionsBundle.LoadBalancer == nil {
+		return fmt.Errorf("loadBalancer is not supported by the bundle for kubernetes version %s, it doesn't include the kube-vip images", spec.Cluster.Spec.KubernetesVersion)
+	}
+
+	return nil
+}
+
 // ValidateExtendedKubernetesSupport validates the extended kubernetes version support for create and upgrade operations.
 func ValidateExtendedKubernetesSupport(ctx context.Context, clusterSpec v1alpha1.Cluster, reader *manifests.Reader, k kubernetes.Client, bundlesOverride string) error {
 	if clusterSpec.Spec.DatacenterRef.Kind == "SnowDatacenterConfig" {
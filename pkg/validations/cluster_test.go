@@ -18,6 +18,7 @@ import (
 	internalmocks "github.com/aws/eks-anywhere/internal/test/mocks"
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/features"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/releases"
@@ -26,6 +27,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validations"
 	"github.com/aws/eks-anywhere/pkg/validations/mocks"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 type fakeFileReader struct {
@@ -143,6 +145,32 @@ func TestValidateCertForRegistryMirrorInsecureSkip(t *testing.T) {
 	tt.Expect(validations.ValidateCertForRegistryMirror(tt.clusterSpec, tt.tlsValidator)).To(Succeed())
 }
 
+func TestValidateCertForRegistryMirrorEndpointIsSignedByUnknownAuthority(t *testing.T) {
+	tt := newTest(t, withTLS())
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.Endpoints = []anywherev1.RegistryMirrorEndpoint{
+		{Endpoint: "central.h", Port: "443"},
+	}
+	tt.tlsValidator.EXPECT().IsSignedByUnknownAuthority(tt.host, tt.port).Return(false, nil)
+	tt.tlsValidator.EXPECT().IsSignedByUnknownAuthority("central.h", "443").Return(true, nil)
+
+	tt.Expect(validations.ValidateCertForRegistryMirror(tt.clusterSpec, tt.tlsValidator)).To(
+		MatchError(ContainSubstring("registry central.h is using self-signed certs")),
+	)
+}
+
+func TestValidateCertForRegistryMirrorEndpointCertValid(t *testing.T) {
+	tt := newTest(t, withTLS())
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.Endpoints = []anywherev1.RegistryMirrorEndpoint{
+		{Endpoint: "central.h", Port: "443", CACertContent: tt.certContent},
+		{Endpoint: "insecure.h", Port: "443", InsecureSkipVerify: true},
+	}
+	tt.tlsValidator.EXPECT().IsSignedByUnknownAuthority(tt.host, tt.port).Return(false, nil)
+	tt.tlsValidator.EXPECT().IsSignedByUnknownAuthority("central.h", "443").Return(true, nil)
+	tt.tlsValidator.EXPECT().ValidateCert("central.h", "443", tt.certContent).Return(nil)
+
+	tt.Expect(validations.ValidateCertForRegistryMirror(tt.clusterSpec, tt.tlsValidator)).To(Succeed())
+}
+
 func TestValidateAuthenticationForRegistryMirrorNoRegistryMirror(t *testing.T) {
 	tt := newTest(t, withTLS())
 	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration = nil
@@ -180,6 +208,20 @@ func TestValidateAuthenticationForRegistryMirrorAuthValid(t *testing.T) {
 	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(Succeed())
 }
 
+func TestValidateAuthenticationForRegistryMirrorEndpointCredentials(t *testing.T) {
+	tt := newTest(t, withTLS())
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.Endpoints = []anywherev1.RegistryMirrorEndpoint{
+		{Endpoint: "central.h", CredentialsSecretRef: "harbor-central"},
+	}
+	t.Setenv("REGISTRY_USERNAME_HARBOR_CENTRAL", "username")
+
+	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(
+		MatchError(ContainSubstring("please set REGISTRY_PASSWORD_HARBOR_CENTRAL env var")))
+
+	t.Setenv("REGISTRY_PASSWORD_HARBOR_CENTRAL", "password")
+	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(Succeed())
+}
+
 func TestValidateOSForRegistryMirrorNoRegistryMirror(t *testing.T) {
 	tt := newTest(t, withTLS())
 	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration = nil
@@ -268,6 +310,49 @@ func TestValidateOSForRegistryMirrorInsecureSkipVerifyEnabled(t *testing.T) {
 	}
 }
 
+func TestValidateOSForRegistryMirrorEndpointsBottlerocket(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint anywherev1.RegistryMirrorEndpoint
+		wantErr  string
+	}{
+		{
+			name:     "endpoint with ca",
+			endpoint: anywherev1.RegistryMirrorEndpoint{Endpoint: "central.h", CACertContent: "content"},
+		},
+		{
+			name:     "endpoint with insecureSkipVerify",
+			endpoint: anywherev1.RegistryMirrorEndpoint{Endpoint: "central.h", InsecureSkipVerify: true},
+			wantErr:  "InsecureSkipVerify is not supported for bottlerocket in registry mirror endpoint central.h",
+		},
+		{
+			name:     "endpoint with credentials",
+			endpoint: anywherev1.RegistryMirrorEndpoint{Endpoint: "central.h", CredentialsSecretRef: "harbor-central"},
+			wantErr:  "credentialsSecretRef is not supported for bottlerocket in registry mirror endpoint central.h",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tt := newTest(t, withTLS())
+			tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.Endpoints = []anywherev1.RegistryMirrorEndpoint{test.endpoint}
+			tt.provider.EXPECT().MachineConfigs(tt.clusterSpec).Return([]providers.MachineConfig{
+				&anywherev1.VSphereMachineConfig{
+					Spec: anywherev1.VSphereMachineConfigSpec{
+						OSFamily: anywherev1.Bottlerocket,
+					},
+				},
+			})
+			err := validations.ValidateOSForRegistryMirror(tt.clusterSpec, tt.provider)
+			if test.wantErr != "" {
+				tt.Expect(err).To(MatchError(test.wantErr))
+			} else {
+				tt.Expect(err).To(Succeed())
+			}
+		})
+	}
+}
+
 func TestValidateOSForRegistryMirrorNoPublicEcrRegistry(t *testing.T) {
 	tt := newTest(t, withTLS())
 	tests := []struct {
@@ -626,6 +711,57 @@ func TestValidateEksaReleaseExistOnManagement(t *testing.T) {
 	}
 }
 
+func TestValidateIPAMProviderInstalled(t *testing.T) {
+	tests := []struct {
+		name      string
+		ipPools   bool
+		installed bool
+		checkErr  error
+		wantErr   string
+	}{
+		{
+			name:    "no ipPools",
+			ipPools: false,
+		},
+		{
+			name:      "installed",
+			ipPools:   true,
+			installed: true,
+		},
+		{
+			name:      "not installed",
+			ipPools:   true,
+			installed: false,
+			wantErr:   "management cluster mgmt doesn't have the in-cluster IPAM provider",
+		},
+		{
+			name:     "check error",
+			ipPools:  true,
+			checkErr: errors.New("connection refused"),
+			wantErr:  "checking the in-cluster IPAM provider: connection refused",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newTest(t, withKubectl())
+			ctx := context.Background()
+			mgmtCluster := &types.Cluster{Name: "mgmt", KubeconfigFile: "mgmt.kubeconfig"}
+			tt.clusterSpec.VSphereDatacenter = &anywherev1.VSphereDatacenterConfig{}
+			if tc.ipPools {
+				tt.clusterSpec.VSphereDatacenter.Spec.IPPools = []anywherev1.VSphereIPPool{{Name: "nodes"}}
+				tt.kubectl.EXPECT().CheckProviderExists(ctx, mgmtCluster.KubeconfigFile, constants.InClusterIPAMProviderName, constants.CapiIPAMInClusterSystemNamespace).Return(tc.installed, tc.checkErr)
+			}
+
+			err := validations.ValidateIPAMProviderInstalled(ctx, tt.kubectl, mgmtCluster, tt.clusterSpec)
+			if tc.wantErr == "" {
+				tt.Expect(err).To(Succeed())
+			} else {
+				tt.Expect(err).To(MatchError(ContainSubstring(tc.wantErr)))
+			}
+		})
+	}
+}
+
 func TestValidatePauseAnnotation(t *testing.T) {
 	mgmtName := "test"
 	tests := []struct {
@@ -1173,3 +1309,23 @@ func TestValidateK8s133SupportActive(t *testing.T) {
 	os.Setenv(features.K8s133SupportEnvVar, "true")
 	tt.Expect(validations.ValidateK8s133Support(tt.clusterSpec)).To(Succeed())
 }
+
+func TestValidateLoadBalancerBundleNoLoadBalancer(t *testing.T) {
+	tt := newTest(t)
+	tt.Expect(validations.ValidateLoadBalancerBundle(tt.clusterSpec)).To(Succeed())
+}
+
+func TestValidateLoadBalancerBundleMissingImages(t *testing.T) {
+	tt := newTest(t)
+	tt.clusterSpec.Cluster.Spec.LoadBalancer = &anywherev1.LoadBalancerConfiguration{}
+	tt.clusterSpec.VersionsBundles[tt.clusterSpec.Cluster.Spec.KubernetesVersion].LoadBalancer = nil
+	tt.Expect(validations.ValidateLoadBalancerBundle(tt.clusterSpec)).To(
+		MatchError(ContainSubstring("doesn't include the kube-vip images")))
+}
+
+func TestValidateLoadBalancerBundleSuccess(t *testing.T) {
+	tt := newTest(t)
+	tt.clusterSpec.Cluster.Spec.LoadBalancer = &anywherev1.LoadBalancerConfiguration{}
+	tt.clusterSpec.VersionsBundles[tt.clusterSpec.Cluster.Spec.KubernetesVersion].LoadBalancer = &releasev1alpha1.LoadBalancerBundle{}
+	tt.Expect(validations.ValidateLoadBalancerBundle(tt.clusterSpec)).To(Succeed())
+}
@@ -35,7 +35,7 @@ func NewValidations(clusterSpec *cluster.Spec, provider providers.Provider, gitO
 }
 
 func (v *ValidationManager) Validate(ctx context.Context) error {
-	runner := validations.NewRunner()
+	runner := validations.NewRunner(validations.RunnerOptsFor(v.createValidations)...)
 	runner.Register(v.generateCreateValidations(ctx)...)
 	runner.Register(v.gitOpsFlux.Validations(ctx, v.clusterSpec)...)
 	err := runner.Run()
@@ -68,8 +68,10 @@ func (v *ValidationManager) generateCreateValidations(ctx context.Context) []val
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
-				Name: fmt.Sprintf("validate %s Provider", v.provider.Name()),
-				Err:  v.provider.SetupAndValidateCreateCluster(ctx, v.clusterSpec),
+				ID:       validations.ProviderSetup,
+				Name:     fmt.Sprintf("validate %s Provider", v.provider.Name()),
+				Category: validations.ProviderCategory,
+				Err:      v.provider.SetupAndValidateCreateCluster(ctx, v.clusterSpec),
 			}
 		},
 	}
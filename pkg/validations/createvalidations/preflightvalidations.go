@@ -24,47 +24,68 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 	createValidations := []validations.Validation{
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.RegistryMirrorOS,
 				Name:        "validate OS is compatible with registry mirror configuration",
+				Category:    validations.RegistryCategory,
 				Remediation: "please use a valid OS for your registry mirror configuration",
 				Err:         validations.ValidateOSForRegistryMirror(v.Opts.Spec, v.Opts.Provider),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.RegistryMirrorCertificate,
 				Name:        "validate certificate for registry mirror",
+				Category:    validations.RegistryCategory,
 				Remediation: fmt.Sprintf("provide a valid certificate for you registry endpoint using %s env var", anywherev1.RegistryMirrorCAKey),
 				Err:         validations.ValidateCertForRegistryMirror(v.Opts.Spec, v.Opts.TLSValidator),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.GitProviderAuthentication,
 				Name:        "validate authentication for git provider",
+				Category:    validations.GitOpsCategory,
 				Remediation: fmt.Sprintf("ensure %s, %s env variable are set and valid", config.EksaGitPrivateKeyTokenEnv, config.EksaGitKnownHostsFileEnv),
 				Err:         validations.ValidateAuthenticationForGitProvider(v.Opts.Spec, v.Opts.CliConfig),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.EksaVersion,
 				Name:        "validate cluster's eksaVersion matches EKS-A version",
+				Category:    validations.VersionCategory,
 				Remediation: "ensure EksaVersion matches the EKS-A release or omit the value from the cluster config",
 				Err:         validations.ValidateEksaVersion(ctx, v.Opts.CliVersion, v.Opts.Spec),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.ExtendedKubernetesSupport,
 				Name:        "validate extended kubernetes version support is supported",
+				Category:    validations.VersionCategory,
 				Remediation: "ensure you have a valid license for extended Kubernetes version support",
 				Err:         validations.ValidateExtendedKubernetesSupport(ctx, *v.Opts.Spec.Cluster, v.Opts.ManifestReader, v.Opts.KubeClient, v.Opts.BundlesOverride),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.Kubernetes133Support,
 				Name:        "validate kubernetes version 1.33 support",
+				Category:    validations.VersionCategory,
 				Remediation: fmt.Sprintf("ensure %v env variable is set", features.K8s133SupportEnvVar),
 				Err:         validations.ValidateK8s133Support(v.Opts.Spec),
 				Silent:      true,
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				ID:          validations.LoadBalancerBundle,
+				Name:        "validate bundle includes the load balancer images",
+				Category:    validations.ClusterCategory,
+				Remediation: "remove loadBalancer from the cluster spec or use a bundle that includes the kube-vip images",
+				Err:         validations.ValidateLoadBalancerBundle(v.Opts.Spec),
+			}
+		},
 	}
 
 	if len(v.Opts.Spec.VSphereMachineConfigs) != 0 {
@@ -73,7 +94,9 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 			createValidations = append(createValidations,
 				func() *validations.ValidationResult {
 					return &validations.ValidationResult{
+						ID:          validations.BottlerocketControlPlaneKubeletConfig,
 						Name:        "validate cluster's kubelet configuration for Bottlerocket OS",
+						Category:    validations.ClusterCategory,
 						Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
 						Err:         validations.ValidateBottlerocketKubeletConfig(v.Opts.Spec),
 					}
@@ -88,7 +111,9 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 				createValidations = append(createValidations,
 					func() *validations.ValidationResult {
 						return &validations.ValidationResult{
+							ID:          validations.BottlerocketWorkerKubeletConfig,
 							Name:        "validate cluster's worker node kubelet configuration for Bottlerocket OS",
+							Category:    validations.ClusterCategory,
 							Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
 							Err:         validations.ValidateBottlerocketKubeletConfig(v.Opts.Spec),
 						}
@@ -102,35 +127,45 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 			createValidations,
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.ClusterNameUnique,
 					Name:        "validate cluster name",
+					Category:    validations.ClusterCategory,
 					Remediation: "",
 					Err:         ValidateClusterNameIsUnique(ctx, k, targetCluster, v.Opts.Spec.Cluster.Name),
 				}
 			},
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.GitOpsConfig,
 					Name:        "validate gitops",
+					Category:    validations.GitOpsCategory,
 					Remediation: "",
 					Err:         ValidateGitOps(ctx, k, v.Opts.ManagementCluster, v.Opts.Spec),
 				}
 			},
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.IdentityProviderNameUnique,
 					Name:        "validate identity providers' name",
+					Category:    validations.ClusterCategory,
 					Remediation: "",
 					Err:         ValidateIdentityProviderNameIsUnique(ctx, k, targetCluster, v.Opts.Spec),
 				}
 			},
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.ManagementClusterCRDs,
 					Name:        "validate management cluster has eksa crds",
+					Category:    validations.ManagementClusterCategory,
 					Remediation: "",
 					Err:         ValidateManagementCluster(ctx, k, targetCluster),
 				}
 			},
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
-					Name: "validate management cluster name is valid",
+					ID:       validations.ManagementClusterName,
+					Name:     "validate management cluster name is valid",
+					Category: validations.ManagementClusterCategory,
 					Remediation: "Specify a valid management cluster in the cluster spec. This cannot be a workload cluster that is managed by a different " +
 						"management cluster.",
 					Err: validations.ValidateManagementClusterName(ctx, k, v.Opts.ManagementCluster, v.Opts.Spec.Cluster.Spec.ManagementCluster.Name),
@@ -138,18 +173,31 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 			},
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.ManagementClusterEksaVersion,
 					Name:        "validate management cluster eksaVersion compatibility",
+					Category:    validations.VersionCategory,
 					Remediation: fmt.Sprintf("upgrade management cluster %s before creating workload cluster %s", v.Opts.Spec.Cluster.ManagedBy(), v.Opts.WorkloadCluster.Name),
 					Err:         validations.ValidateManagementClusterEksaVersion(ctx, k, v.Opts.ManagementCluster, v.Opts.Spec),
 				}
 			},
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.ManagementClusterEksaRelease,
 					Name:        "validate eksa release components exist on management cluster",
+					Category:    validations.ManagementClusterCategory,
 					Remediation: fmt.Sprintf("ensure eksaVersion is in the correct format (vMajor.Minor.Patch) and matches one of the available releases on the management cluster: kubectl get eksareleases -n %s --kubeconfig %s", constants.EksaSystemNamespace, v.Opts.ManagementCluster.KubeconfigFile),
 					Err:         validations.ValidateEksaReleaseExistOnManagement(ctx, v.Opts.KubeClient, v.Opts.Spec.Cluster),
 				}
 			},
+			func() *validations.ValidationResult {
+				return &validations.ValidationResult{
+					ID:          validations.IPAMProviderInstalled,
+					Name:        "validate in-cluster IPAM provider is installed on management cluster",
+					Category:    validations.ManagementClusterCategory,
+					Remediation: fmt.Sprintf("upgrade management cluster %s to install the in-cluster IPAM provider before creating workload cluster %s", v.Opts.Spec.Cluster.ManagedBy(), v.Opts.WorkloadCluster.Name),
+					Err:         validations.ValidateIPAMProviderInstalled(ctx, k, v.Opts.ManagementCluster, v.Opts.Spec),
+				}
+			},
 		)
 	}
 
@@ -0,0 +1,113 @@
+package deletecluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+// ValidationManager checks that a cluster can be deleted without deleting anything.
+type ValidationManager struct {
+	clusterSpec       *cluster.Spec
+	workloadCluster   *types.Cluster
+	managementCluster *types.Cluster
+	provider          providers.Provider
+	kubectl           KubectlClient
+}
+
+// KubectlClient reads the EKS-A objects from the management cluster.
+type KubectlClient interface {
+	ValidateClustersCRD(ctx context.Context, cluster *types.Cluster) error
+	GetEksaCluster(ctx context.Context, cluster *types.Cluster, clusterName string) (*v1alpha1.Cluster, error)
+}
+
+// NewValidations builds a ValidationManager for deleting workloadCluster from managementCluster.
+func NewValidations(clusterSpec *cluster.Spec, workloadCluster, managementCluster *types.Cluster, provider providers.Provider, kubectl KubectlClient) *ValidationManager {
+	return &ValidationManager{
+		clusterSpec:       clusterSpec,
+		workloadCluster:   workloadCluster,
+		managementCluster: managementCluster,
+		provider:          provider,
+		kubectl:           kubectl,
+	}
+}
+
+// Validate checks that the management cluster is reachable, that it contains the cluster
+// and that the provider is ready to delete it. All the checks run even if some fail.
+func (v *ValidationManager) Validate(ctx context.Context) error {
+	runner := validations.NewRunner()
+	runner.Register(
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				ID:          validations.ManagementClusterReachable,
+				Name:        "management cluster reachable",
+				Category:    validations.ManagementClusterCategory,
+				Remediation: fmt.Sprintf("ensure the kubeconfig %s points to a reachable management cluster", v.managementCluster.KubeconfigFile),
+				Err:         v.kubectl.ValidateClustersCRD(ctx, v.managementCluster),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				ID:          validations.ClusterPresent,
+				Name:        "cluster present in management cluster",
+				Category:    validations.ManagementClusterCategory,
+				Remediation: fmt.Sprintf("ensure cluster %s is managed by the management cluster in %s", v.clusterSpec.Cluster.Name, v.managementCluster.KubeconfigFile),
+				Err:         v.validateClusterPresent(ctx),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				ID:       validations.ProviderSetup,
+				Name:     fmt.Sprintf("validate %s Provider", v.provider.Name()),
+				Category: validations.ProviderCategory,
+				Err:      v.provider.SetupAndValidateDeleteCluster(ctx, v.workloadCluster, v.clusterSpec),
+			}
+		},
+	)
+
+	return runner.Run()
+}
+
+func (v *ValidationManager) validateClusterPresent(ctx context.Context) error {
+	_, err := v.kubectl.GetEksaCluster(ctx, v.managementCluster, v.clusterSpec.Cluster.Name)
+	return err
+}
+
+// Resource identifies an object in the management cluster.
+type Resource struct {
+	Type      string
+	Name      string
+	Namespace string
+}
+
+func (r Resource) String() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s/%s", r.Type, r.Name)
+	}
+	return fmt.Sprintf("%s/%s (namespace %s)", r.Type, r.Name, r.Namespace)
+}
+
+// ProviderResources returns the objects provider removes from the management cluster
+// in DeleteResources: the machine configs and the datacenter config of the cluster.
+func ProviderResources(provider providers.Provider, clusterSpec *cluster.Spec) []Resource {
+	machineConfigs := provider.MachineConfigs(clusterSpec)
+	resources := make([]Resource, 0, len(machineConfigs)+1)
+	for _, mc := range machineConfigs {
+		resources = append(resources, Resource{
+			Type:      provider.MachineResourceType(),
+			Name:      mc.GetName(),
+			Namespace: mc.GetNamespace(),
+		})
+	}
+
+	return append(resources, Resource{
+		Type:      provider.DatacenterResourceType(),
+		Name:      clusterSpec.Cluster.Spec.DatacenterRef.Name,
+		Namespace: clusterSpec.Cluster.Namespace,
+	})
+}
@@ -0,0 +1,99 @@
+package deletecluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	providermocks "github.com/aws/eks-anywhere/pkg/providers/mocks"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations/deletecluster"
+	"github.com/aws/eks-anywhere/pkg/validations/deletecluster/mocks"
+)
+
+type deleteClusterValidationTest struct {
+	ctx               context.Context
+	clusterSpec       *cluster.Spec
+	workloadCluster   *types.Cluster
+	managementCluster *types.Cluster
+	provider          *providermocks.MockProvider
+	kubectl           *mocks.MockKubectlClient
+	validations       *deletecluster.ValidationManager
+}
+
+func newValidateTest(t *testing.T) *deleteClusterValidationTest {
+	ctrl := gomock.NewController(t)
+	tt := &deleteClusterValidationTest{
+		ctx: context.Background(),
+		clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+			s.Cluster.Name = "workload"
+		}),
+		workloadCluster:   &types.Cluster{Name: "workload", KubeconfigFile: "mgmt.kubeconfig"},
+		managementCluster: &types.Cluster{Name: "mgmt", KubeconfigFile: "mgmt.kubeconfig"},
+		provider:          providermocks.NewMockProvider(ctrl),
+		kubectl:           mocks.NewMockKubectlClient(ctrl),
+	}
+	tt.provider.EXPECT().Name().Return("vsphere").AnyTimes()
+	tt.validations = deletecluster.NewValidations(tt.clusterSpec, tt.workloadCluster, tt.managementCluster, tt.provider, tt.kubectl)
+
+	return tt
+}
+
+func TestDeleteClusterValidationsSuccess(t *testing.T) {
+	g := NewWithT(t)
+	tt := newValidateTest(t)
+	tt.kubectl.EXPECT().ValidateClustersCRD(tt.ctx, tt.managementCluster)
+	tt.kubectl.EXPECT().GetEksaCluster(tt.ctx, tt.managementCluster, "workload").Return(tt.clusterSpec.Cluster, nil)
+	tt.provider.EXPECT().SetupAndValidateDeleteCluster(tt.ctx, tt.workloadCluster, tt.clusterSpec)
+
+	g.Expect(tt.validations.Validate(tt.ctx)).To(Succeed())
+}
+
+func TestDeleteClusterValidationsReportsAllFailures(t *testing.T) {
+	g := NewWithT(t)
+	tt := newValidateTest(t)
+	tt.kubectl.EXPECT().ValidateClustersCRD(tt.ctx, tt.managementCluster).Return(errors.New("connection refused"))
+	tt.kubectl.EXPECT().GetEksaCluster(tt.ctx, tt.managementCluster, "workload").Return(nil, errors.New("cluster not found"))
+	tt.provider.EXPECT().SetupAndValidateDeleteCluster(tt.ctx, tt.workloadCluster, tt.clusterSpec).Return(errors.New("invalid credentials"))
+
+	err := tt.validations.Validate(tt.ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("connection refused")))
+	g.Expect(err).To(MatchError(ContainSubstring("cluster not found")))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid credentials")))
+}
+
+func TestProviderResources(t *testing.T) {
+	g := NewWithT(t)
+	tt := newValidateTest(t)
+	tt.clusterSpec.Cluster.Namespace = "eksa"
+	tt.clusterSpec.Cluster.Spec.DatacenterRef.Name = "dc"
+	cpMachine := &v1alpha1.VSphereMachineConfig{}
+	cpMachine.Name = "cp"
+	cpMachine.Namespace = "eksa"
+	workerMachine := &v1alpha1.VSphereMachineConfig{}
+	workerMachine.Name = "worker"
+	workerMachine.Namespace = "eksa"
+	tt.provider.EXPECT().MachineConfigs(tt.clusterSpec).Return([]providers.MachineConfig{cpMachine, workerMachine})
+	tt.provider.EXPECT().MachineResourceType().Return("vspheremachineconfigs.anywhere.eks.amazonaws.com").Times(2)
+	tt.provider.EXPECT().DatacenterResourceType().Return("vspheredatacenterconfigs.anywhere.eks.amazonaws.com")
+
+	g.Expect(deletecluster.ProviderResources(tt.provider, tt.clusterSpec)).To(Equal([]deletecluster.Resource{
+		{Type: "vspheremachineconfigs.anywhere.eks.amazonaws.com", Name: "cp", Namespace: "eksa"},
+		{Type: "vspheremachineconfigs.anywhere.eks.amazonaws.com", Name: "worker", Namespace: "eksa"},
+		{Type: "vspheredatacenterconfigs.anywhere.eks.amazonaws.com", Name: "dc", Namespace: "eksa"},
+	}))
+}
+
+func TestResourceString(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(deletecluster.Resource{Type: "t", Name: "n"}.String()).To(Equal("t/n"))
+	g.Expect(deletecluster.Resource{Type: "t", Name: "n", Namespace: "ns"}.String()).To(Equal("t/n (namespace ns)"))
+}
@@ -0,0 +1,70 @@
+package validations
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Status is the outcome of a validation in a Report.
+type Status string
+
+const (
+	PassedStatus  Status = "passed"
+	FailedStatus  Status = "failed"
+	SkippedStatus Status = "skipped"
+)
+
+// Report is the audit record of a validations run.
+type Report struct {
+	Time    time.Time      `json:"time"`
+	Results []ReportResult `json:"results"`
+}
+
+// ReportResult is the outcome of a single validation.
+type ReportResult struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Category    Category `json:"category"`
+	Status      Status   `json:"status"`
+	Error       string   `json:"error,omitempty"`
+	Remediation string   `json:"remediation,omitempty"`
+}
+
+func newReport() *Report {
+	return &Report{Time: time.Now(), Results: []ReportResult{}}
+}
+
+func (r *Report) add(result *ValidationResult, status Status) {
+	entry := ReportResult{
+		ID:       result.ID,
+		Name:     result.Name,
+		Category: result.ValidationCategory(),
+		Status:   status,
+	}
+	if result.Err != nil {
+		entry.Error = result.Err.Error()
+		entry.Remediation = result.Remediation
+	}
+	r.Results = append(r.Results, entry)
+}
+
+func (r *Report) write(path string) error {
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling validation report: %v", err)
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
+
+// ReportFilePath returns the path of the validation report for the cluster config in
+// clusterConfigFile, which sits next to it: cluster.yaml is reported in
+// cluster-validation-report.json.
+func ReportFilePath(clusterConfigFile string) string {
+	base := strings.TrimSuffix(filepath.Base(clusterConfigFile), filepath.Ext(clusterConfigFile))
+	return filepath.Join(filepath.Dir(clusterConfigFile), base+"-validation-report.json")
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	eksae "github.com/aws/eks-anywhere/pkg/errors"
+	"github.com/aws/eks-anywhere/pkg/events"
+	"github.com/aws/eks-anywhere/pkg/logger"
 )
 
 var errRunnerValidation = errors.New("validations failed")
@@ -13,28 +15,73 @@ type Validation func() *ValidationResult
 
 type Runner struct {
 	validations []Validation
+	skipped     map[string]bool
+	reportFile  string
 }
 
-func NewRunner() *Runner {
-	return &Runner{validations: make([]Validation, 0)}
+// RunnerOpt allows to customize a Runner on construction.
+type RunnerOpt func(*Runner)
+
+// WithSkippedValidations makes the runner ignore the failures of the validations whose
+// ID is set to true in skipped. Those validations still run and are reported as skipped.
+func WithSkippedValidations(skipped map[string]bool) RunnerOpt {
+	return func(r *Runner) {
+		r.skipped = skipped
+	}
+}
+
+// WithReportFile makes the runner write a JSON report with the result of every validation
+// to path once they have all run.
+func WithReportFile(path string) RunnerOpt {
+	return func(r *Runner) {
+		r.reportFile = path
+	}
+}
+
+func NewRunner(opts ...RunnerOpt) *Runner {
+	r := &Runner{validations: make([]Validation, 0)}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 func (r *Runner) Register(validations ...Validation) {
 	r.validations = append(r.validations, validations...)
 }
 
+// Run runs all the registered validations, even if some of them fail, and returns an
+// error aggregating all the failures.
 func (r *Runner) Run() error {
 	var errs []error
+	report := newReport()
 	for _, v := range r.validations {
 		result := v()
+		if result.Err != nil && result.ID != "" && r.skipped[result.ID] {
+			logger.Info("Validation skipped", "validation", result.Name, "id", result.ID, "error", result.Err.Error())
+			report.add(result, SkippedStatus)
+			continue
+		}
+
 		result.Report()
 		if result.Err != nil {
 			errs = append(errs, result.Err)
+			report.add(result, FailedStatus)
+		} else {
+			report.add(result, PassedStatus)
+		}
+	}
+
+	if r.reportFile != "" {
+		if err := report.write(r.reportFile); err != nil {
+			logger.Info("Warning: failed to write validation report", "file", r.reportFile, "error", err)
+		} else {
+			logger.V(3).Info("Validation report written", "file", r.reportFile)
 		}
 	}
 
 	if len(errs) > 0 {
-		return fmt.Errorf("validations failed: %w", eksae.NewAggregate(errs))
+		return events.WithCategory(fmt.Errorf("validations failed: %w", eksae.NewAggregate(errs)), events.ValidationCategory)
 	}
 
 	return nil
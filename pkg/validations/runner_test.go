@@ -1,7 +1,10 @@
 package validations_test
 
 import (
+	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -43,3 +46,87 @@ func TestRunnerRunSuccess(t *testing.T) {
 
 	g.Expect(r.Run()).To(Succeed())
 }
+
+func TestRunnerRunSkippedValidation(t *testing.T) {
+	g := NewWithT(t)
+	r := validations.NewRunner(validations.WithSkippedValidations(map[string]bool{"flaky-check": true}))
+	r.Register(func() *validations.ValidationResult {
+		return &validations.ValidationResult{
+			ID:  "flaky-check",
+			Err: errors.New("one error"),
+		}
+	})
+	r.Register(func() *validations.ValidationResult {
+		return &validations.ValidationResult{
+			Name: "other check",
+			Err:  errors.New("other error"),
+		}
+	})
+
+	err := r.Run()
+	g.Expect(err).To(MatchError(ContainSubstring("other error")))
+	g.Expect(err).NotTo(MatchError(ContainSubstring("one error")))
+}
+
+func TestRunnerRunValidationWithoutIDNotSkipped(t *testing.T) {
+	g := NewWithT(t)
+	r := validations.NewRunner(validations.WithSkippedValidations(map[string]bool{"": true}))
+	r.Register(func() *validations.ValidationResult {
+		return &validations.ValidationResult{
+			Name: "check without id",
+			Err:  errors.New("one error"),
+		}
+	})
+
+	g.Expect(r.Run()).To(MatchError(ContainSubstring("one error")))
+}
+
+func TestRunnerRunWritesReport(t *testing.T) {
+	g := NewWithT(t)
+	reportFile := filepath.Join(t.TempDir(), "cluster-validation-report.json")
+	r := validations.NewRunner(
+		validations.WithSkippedValidations(map[string]bool{"skipped-check": true}),
+		validations.WithReportFile(reportFile),
+	)
+	r.Register(
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				ID:       "passed-check",
+				Name:     "passed check",
+				Category: validations.ClusterCategory,
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate cluster's failed check",
+				Remediation: "fix it",
+				Err:         errors.New("failed"),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				ID:  "skipped-check",
+				Err: errors.New("ignored"),
+			}
+		},
+	)
+
+	g.Expect(r.Run()).NotTo(Succeed())
+
+	content, err := os.ReadFile(reportFile)
+	g.Expect(err).NotTo(HaveOccurred())
+	report := &validations.Report{}
+	g.Expect(json.Unmarshal(content, report)).To(Succeed())
+	g.Expect(report.Results).To(Equal([]validations.ReportResult{
+		{ID: "passed-check", Name: "passed check", Category: validations.ClusterCategory, Status: validations.PassedStatus},
+		{Name: "validate cluster's failed check", Category: validations.GeneralCategory, Status: validations.FailedStatus, Error: "failed", Remediation: "fix it"},
+		{ID: "skipped-check", Category: validations.GeneralCategory, Status: validations.SkippedStatus, Error: "ignored"},
+	}))
+}
+
+func TestReportFilePath(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(validations.ReportFilePath("clusters/dev.yaml")).To(Equal("clusters/dev-validation-report.json"))
+	g.Expect(validations.ReportFilePath("dev.yaml")).To(Equal("dev-validation-report.json"))
+}
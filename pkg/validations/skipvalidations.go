@@ -2,6 +2,7 @@ package validations
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -12,7 +13,81 @@ const (
 	EksaVersionSkew = "eksa-version-skew"
 )
 
-// ValidSkippableValidationsMap returns a map for all valid skippable validations as keys, defaulting values to false.
+// IDs of the validations whose failures can be ignored with --skip-validations.
+const (
+	ProviderSetup                         = "provider-setup"
+	SSHKeysPresent                        = "ssh-keys-present"
+	RegistryMirrorOS                      = "registry-mirror-os"
+	RegistryMirrorCertificate             = "registry-mirror-certificate"
+	ControlPlaneReady                     = "control-plane-ready"
+	WorkerNodesReady                      = "worker-nodes-ready"
+	NodesReady                            = "nodes-ready"
+	ClusterCRDsReady                      = "cluster-crds-ready"
+	CAPIClusterObject                     = "capi-cluster-object"
+	KubernetesVersionSkew                 = "kubernetes-version-skew"
+	WorkerKubernetesVersionSkew           = "worker-kubernetes-version-skew"
+	GitProviderAuthentication             = "git-provider-authentication"
+	GitOpsConfig                          = "gitops-config"
+	FluxPath                              = "flux-path"
+	ImmutableFields                       = "immutable-fields"
+	EksaVersion                           = "eksa-version"
+	EksaControllerNotPaused               = "eksa-controller-not-paused"
+	ExtendedKubernetesSupport             = "extended-kubernetes-support"
+	Kubernetes133Support                  = "kubernetes-1-33-support"
+	BottlerocketControlPlaneKubeletConfig = "bottlerocket-control-plane-kubelet-config"
+	BottlerocketWorkerKubeletConfig       = "bottlerocket-worker-kubelet-config"
+	ClusterNameUnique                     = "cluster-name-unique"
+	ClusterPresent                        = "cluster-present"
+	IdentityProviderNameUnique            = "identity-provider-name-unique"
+	ManagementClusterReachable            = "management-cluster-reachable"
+	ManagementClusterCRDs                 = "management-cluster-crds"
+	ManagementClusterName                 = "management-cluster-name"
+	ManagementClusterEksaVersion          = "management-cluster-eksa-version"
+	ManagementClusterEksaRelease          = "management-cluster-eksa-release"
+	IPAMProviderInstalled                 = "ipam-provider-installed"
+	LoadBalancerBundle                    = "load-balancer-bundle"
+)
+
+// registeredValidationIDs are all the IDs accepted by --skip-validations. A validation
+// can only be skipped if its ID is registered here.
+var registeredValidationIDs = []string{
+	PDB,
+	VSphereUserPriv,
+	EksaVersionSkew,
+	ProviderSetup,
+	SSHKeysPresent,
+	RegistryMirrorOS,
+	RegistryMirrorCertificate,
+	ControlPlaneReady,
+	WorkerNodesReady,
+	NodesReady,
+	ClusterCRDsReady,
+	CAPIClusterObject,
+	KubernetesVersionSkew,
+	WorkerKubernetesVersionSkew,
+	GitProviderAuthentication,
+	GitOpsConfig,
+	FluxPath,
+	ImmutableFields,
+	EksaVersion,
+	EksaControllerNotPaused,
+	ExtendedKubernetesSupport,
+	Kubernetes133Support,
+	BottlerocketControlPlaneKubeletConfig,
+	BottlerocketWorkerKubeletConfig,
+	ClusterNameUnique,
+	ClusterPresent,
+	IdentityProviderNameUnique,
+	ManagementClusterReachable,
+	ManagementClusterCRDs,
+	ManagementClusterName,
+	ManagementClusterEksaVersion,
+	ManagementClusterEksaRelease,
+	IPAMProviderInstalled,
+	LoadBalancerBundle,
+}
+
+// validSkippableValidationsMap returns a map for all valid skippable validations as keys, defaulting values to false.
 // Defaulting to False means these validations won't be skipped unless set to True.
 func validSkippableValidationsMap(skippableValidations []string) map[string]bool {
 	validationsMap := make(map[string]bool, len(skippableValidations))
@@ -24,17 +99,29 @@ func validSkippableValidationsMap(skippableValidations []string) map[string]bool
 	return validationsMap
 }
 
-// ValidateSkippableValidation validates if provided validations are supported by EKSA to skip for upgrades.
-func ValidateSkippableValidation(skippedValidations []string, skippableValidations []string) (map[string]bool, error) {
+// RegisteredValidationIDs returns the sorted IDs of all the validations that can be skipped
+// with --skip-validations.
+func RegisteredValidationIDs() []string {
+	ids := append([]string{}, registeredValidationIDs...)
+	sort.Strings(ids)
+	return ids
+}
+
+// ParseSkippedValidations builds the map of validations to skip from the IDs passed to
+// --skip-validations. It fails if any of the IDs isn't registered. The IDs in
+// skippableValidations are always present in the map so the validations that check them
+// before running can look them up.
+func ParseSkippedValidations(skippedValidations []string, skippableValidations []string) (map[string]bool, error) {
 	svMap := validSkippableValidationsMap(skippableValidations)
+	registered := validSkippableValidationsMap(registeredValidationIDs)
 
-	for i := range skippedValidations {
-		validationName := skippedValidations[i]
-		_, ok := svMap[validationName]
-		if !ok {
-			return nil, fmt.Errorf("invalid validation name to be skipped. The supported validations that can be skipped using --skip-validations are %s", strings.Join(skippableValidations[:], ","))
+	for _, id := range skippedValidations {
+		_, isSkippable := svMap[id]
+		_, isRegistered := registered[id]
+		if !isSkippable && !isRegistered {
+			return nil, fmt.Errorf("invalid validation ID %q to be skipped. The validations that can be skipped using --skip-validations are %s", id, strings.Join(RegisteredValidationIDs(), ","))
 		}
-		svMap[validationName] = true
+		svMap[id] = true
 	}
 
 	return svMap, nil
@@ -1,61 +1,71 @@
 package validations_test
 
 import (
-	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/aws/eks-anywhere/pkg/validations"
-	"github.com/aws/eks-anywhere/pkg/validations/createvalidations"
 	"github.com/aws/eks-anywhere/pkg/validations/upgradevalidations"
 )
 
-func TestValidateSkippableValidation(t *testing.T) {
+func TestRegisteredValidationIDs(t *testing.T) {
+	ids := validations.RegisteredValidationIDs()
+	if !sort.StringsAreSorted(ids) {
+		t.Errorf("RegisteredValidationIDs() = %v, want sorted", ids)
+	}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			t.Errorf("RegisteredValidationIDs() has duplicated ID %s", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestParseSkippedValidations(t *testing.T) {
 	tests := []struct {
-		name                 string
-		want                 map[string]bool
-		wantErr              error
-		skippedValidations   []string
-		skippableValidations []string
+		name               string
+		want               map[string]bool
+		wantErr            string
+		skippedValidations []string
 	}{
 		{
-			name:                 "invalid upgrade validation param",
-			want:                 nil,
-			wantErr:              fmt.Errorf("invalid validation name to be skipped. The supported validations that can be skipped using --skip-validations are %s", strings.Join(upgradevalidations.SkippableValidations[:], ",")),
-			skippedValidations:   []string{"test"},
-			skippableValidations: upgradevalidations.SkippableValidations,
-		},
-		{
-			name: "valid upgrade validation param",
+			name: "skippable and registered validation IDs",
 			want: map[string]bool{
-				validations.PDB:             true,
-				validations.VSphereUserPriv: false,
-				validations.EksaVersionSkew: false,
+				validations.PDB:               true,
+				validations.VSphereUserPriv:   false,
+				validations.EksaVersionSkew:   false,
+				validations.ControlPlaneReady: true,
 			},
-			wantErr:              nil,
-			skippedValidations:   []string{validations.PDB},
-			skippableValidations: upgradevalidations.SkippableValidations,
+			skippedValidations: []string{validations.PDB, validations.ControlPlaneReady},
 		},
 		{
-			name: "valid create validation param",
-			want: map[string]bool{
-				validations.VSphereUserPriv: true,
-			},
-			wantErr:              nil,
-			skippedValidations:   []string{validations.VSphereUserPriv},
-			skippableValidations: createvalidations.SkippableValidations,
+			name:               "unregistered validation ID",
+			wantErr:            `invalid validation ID "control-plane-readyy" to be skipped`,
+			skippedValidations: []string{"control-plane-readyy"},
+		},
+		{
+			name:               "validation name instead of ID",
+			wantErr:            `invalid validation ID "Control Plane Ready" to be skipped`,
+			skippedValidations: []string{"Control Plane Ready"},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := validations.ValidateSkippableValidation(tt.skippedValidations, tt.skippableValidations)
-			if !reflect.DeepEqual(err, tt.wantErr) {
-				t.Errorf("ValidateSkippableValidation() error = %v, wantErr %v", err, tt.wantErr)
+			got, err := validations.ParseSkippedValidations(tt.skippedValidations, upgradevalidations.SkippableValidations)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ParseSkippedValidations() error = %v, wantErr %v", err, tt.wantErr)
+				}
 				return
 			}
+			if err != nil {
+				t.Fatalf("ParseSkippedValidations() error = %v", err)
+			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("ValidateSkippableValidation() = %v, want %v", got, tt.want)
+				t.Errorf("ParseSkippedValidations() = %v, want %v", got, tt.want)
 			}
 		})
 	}
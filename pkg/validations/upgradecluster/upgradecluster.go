@@ -0,0 +1,76 @@
+package upgradecluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+// ValidationManager runs the same validations the upgrade workflow runs before
+// making any change to the cluster.
+type ValidationManager struct {
+	clusterSpec        *cluster.Spec
+	managementCluster  *types.Cluster
+	provider           providers.Provider
+	gitOpsFlux         *flux.Flux
+	specGetter         CurrentSpecGetter
+	upgradeValidations Validator
+}
+
+// Validator returns the preflight validations for an upgrade.
+type Validator interface {
+	PreflightValidations(ctx context.Context) []validations.Validation
+}
+
+// CurrentSpecGetter reads the spec of a cluster as it exists in its management cluster.
+type CurrentSpecGetter interface {
+	GetCurrentClusterSpec(ctx context.Context, managementCluster *types.Cluster, clusterName string) (*cluster.Spec, error)
+}
+
+// NewValidations builds a ValidationManager for upgrading the cluster in clusterSpec,
+// which is managed by managementCluster.
+func NewValidations(clusterSpec *cluster.Spec, managementCluster *types.Cluster, provider providers.Provider, gitOpsFlux *flux.Flux, specGetter CurrentSpecGetter, upgradeValidations Validator) *ValidationManager {
+	return &ValidationManager{
+		clusterSpec:        clusterSpec,
+		managementCluster:  managementCluster,
+		provider:           provider,
+		gitOpsFlux:         gitOpsFlux,
+		specGetter:         specGetter,
+		upgradeValidations: upgradeValidations,
+	}
+}
+
+// Validate runs all the upgrade validations without modifying the cluster. All of them
+// run even if some fail and the returned error aggregates every failure.
+func (v *ValidationManager) Validate(ctx context.Context) error {
+	runner := validations.NewRunner(validations.RunnerOptsFor(v.upgradeValidations)...)
+	runner.Register(v.providerValidation(ctx))
+	runner.Register(v.gitOpsFlux.Validations(ctx, v.clusterSpec)...)
+	runner.Register(v.upgradeValidations.PreflightValidations(ctx)...)
+
+	return runner.Run()
+}
+
+func (v *ValidationManager) providerValidation(ctx context.Context) validations.Validation {
+	return func() *validations.ValidationResult {
+		result := &validations.ValidationResult{
+			ID:       validations.ProviderSetup,
+			Name:     fmt.Sprintf("validate %s Provider", v.provider.Name()),
+			Category: validations.ProviderCategory,
+		}
+
+		currentSpec, err := v.specGetter.GetCurrentClusterSpec(ctx, v.managementCluster, v.clusterSpec.Cluster.Name)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Err = v.provider.SetupAndValidateUpgradeCluster(ctx, v.managementCluster, v.clusterSpec, currentSpec)
+		return result
+	}
+}
@@ -0,0 +1,99 @@
+package upgradecluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux"
+	providermocks "github.com/aws/eks-anywhere/pkg/providers/mocks"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+	"github.com/aws/eks-anywhere/pkg/validations/upgradecluster"
+	"github.com/aws/eks-anywhere/pkg/validations/upgradecluster/mocks"
+)
+
+type upgradeClusterValidationTest struct {
+	ctx                context.Context
+	clusterSpec        *cluster.Spec
+	currentSpec        *cluster.Spec
+	managementCluster  *types.Cluster
+	provider           *providermocks.MockProvider
+	specGetter         *mocks.MockCurrentSpecGetter
+	upgradeValidations *mocks.MockValidator
+	validations        *upgradecluster.ValidationManager
+}
+
+func newValidateTest(t *testing.T) *upgradeClusterValidationTest {
+	ctrl := gomock.NewController(t)
+	tt := &upgradeClusterValidationTest{
+		ctx: context.Background(),
+		clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+			s.Cluster.Name = "my-cluster"
+		}),
+		currentSpec:        test.NewClusterSpec(),
+		managementCluster:  &types.Cluster{Name: "my-cluster", KubeconfigFile: "my-cluster.kubeconfig"},
+		provider:           providermocks.NewMockProvider(ctrl),
+		specGetter:         mocks.NewMockCurrentSpecGetter(ctrl),
+		upgradeValidations: mocks.NewMockValidator(ctrl),
+	}
+	tt.provider.EXPECT().Name().Return("vsphere").AnyTimes()
+	tt.validations = upgradecluster.NewValidations(
+		tt.clusterSpec,
+		tt.managementCluster,
+		tt.provider,
+		flux.NewFluxFromGitOpsFluxClient(nil, nil, nil, nil),
+		tt.specGetter,
+		tt.upgradeValidations,
+	)
+
+	return tt
+}
+
+func (tt *upgradeClusterValidationTest) expectPreflightValidations(errs ...error) {
+	vs := make([]validations.Validation, 0, len(errs))
+	for _, err := range errs {
+		err := err
+		vs = append(vs, func() *validations.ValidationResult {
+			return &validations.ValidationResult{Name: "preflight", Err: err}
+		})
+	}
+	tt.upgradeValidations.EXPECT().PreflightValidations(tt.ctx).Return(vs)
+}
+
+func TestUpgradeClusterValidationsSuccess(t *testing.T) {
+	g := NewWithT(t)
+	tt := newValidateTest(t)
+	tt.specGetter.EXPECT().GetCurrentClusterSpec(tt.ctx, tt.managementCluster, "my-cluster").Return(tt.currentSpec, nil)
+	tt.provider.EXPECT().SetupAndValidateUpgradeCluster(tt.ctx, tt.managementCluster, tt.clusterSpec, tt.currentSpec)
+	tt.expectPreflightValidations(nil, nil)
+
+	g.Expect(tt.validations.Validate(tt.ctx)).To(Succeed())
+}
+
+func TestUpgradeClusterValidationsReportsAllFailures(t *testing.T) {
+	g := NewWithT(t)
+	tt := newValidateTest(t)
+	tt.specGetter.EXPECT().GetCurrentClusterSpec(tt.ctx, tt.managementCluster, "my-cluster").Return(nil, errors.New("cluster not found"))
+	tt.expectPreflightValidations(errors.New("nodes not ready"), nil, errors.New("version skew"))
+
+	err := tt.validations.Validate(tt.ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("cluster not found")))
+	g.Expect(err).To(MatchError(ContainSubstring("nodes not ready")))
+	g.Expect(err).To(MatchError(ContainSubstring("version skew")))
+}
+
+func TestUpgradeClusterValidationsProviderFailure(t *testing.T) {
+	g := NewWithT(t)
+	tt := newValidateTest(t)
+	tt.specGetter.EXPECT().GetCurrentClusterSpec(tt.ctx, tt.managementCluster, "my-cluster").Return(tt.currentSpec, nil)
+	tt.provider.EXPECT().SetupAndValidateUpgradeCluster(tt.ctx, tt.managementCluster, tt.clusterSpec, tt.currentSpec).Return(errors.New("invalid datacenter"))
+	tt.expectPreflightValidations(nil)
+
+	g.Expect(tt.validations.Validate(tt.ctx)).To(MatchError(ContainSubstring("invalid datacenter")))
+}
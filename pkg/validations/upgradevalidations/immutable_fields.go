@@ -66,6 +66,10 @@ func ValidateImmutableFields(ctx context.Context, k validations.KubectlClient, c
 		return fmt.Errorf("spec.clusterNetwork.cniConfig.cilium.skipUpgrade cannot be toggled off")
 	}
 
+	if oCNI != nil && oCNI.Cilium != nil && oCNI.Cilium.KubeProxyReplacement && (nCNI == nil || nCNI.Cilium == nil || !nCNI.Cilium.KubeProxyReplacement) {
+		return fmt.Errorf("spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement cannot be toggled off")
+	}
+
 	if !nSpec.ProxyConfiguration.Equal(oSpec.ProxyConfiguration) {
 		return fmt.Errorf("spec.proxyConfiguration is immutable")
 	}
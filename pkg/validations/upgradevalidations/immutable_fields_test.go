@@ -301,6 +301,22 @@ func TestValidateImmutableFields(t *testing.T) {
 			},
 			ExpectedError: "spec.clusterNetwork.cniConfig.cilium.skipUpgrade cannot be toggled off",
 		},
+		{
+			Name: "Toggle Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement off",
+			ConfigureCurrent: func(current *v1alpha1.Cluster) {
+				current.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{
+						KubeProxyReplacement: true,
+					},
+				}
+			},
+			ConfigureDesired: func(desired *v1alpha1.Cluster) {
+				desired.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{},
+				}
+			},
+			ExpectedError: "spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement cannot be toggled off",
+		},
 	}
 
 	clstr := &types.Cluster{}
@@ -28,116 +28,156 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 	upgradeValidations := []validations.Validation{
 		func() *validations.ValidationResult {
 			return resultForRemediableValidation(
+				validations.SSHKeysPresent,
 				"SSH Keys present",
 				providers.ValidateSSHKeyPresentForUpgrade(ctx, u.Opts.Spec),
 			)
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.RegistryMirrorOS,
 				Name:        "validate OS is compatible with registry mirror configuration",
+				Category:    validations.RegistryCategory,
 				Remediation: "please use a valid OS for your registry mirror configuration",
 				Err:         validations.ValidateOSForRegistryMirror(u.Opts.Spec, u.Opts.Provider),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.RegistryMirrorCertificate,
 				Name:        "validate certificate for registry mirror",
+				Category:    validations.RegistryCategory,
 				Remediation: fmt.Sprintf("provide a valid certificate for you registry endpoint using %s env var", anywherev1.RegistryMirrorCAKey),
 				Err:         validations.ValidateCertForRegistryMirror(u.Opts.Spec, u.Opts.TLSValidator),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.ControlPlaneReady,
 				Name:        "control plane ready",
+				Category:    validations.ClusterCategory,
 				Remediation: fmt.Sprintf("ensure control plane nodes and pods for cluster %s are ready", u.Opts.WorkloadCluster.Name),
 				Err:         k.ValidateControlPlaneNodes(ctx, targetCluster, targetCluster.Name),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.WorkerNodesReady,
 				Name:        "worker nodes ready",
+				Category:    validations.ClusterCategory,
 				Remediation: fmt.Sprintf("ensure machine deployments for cluster %s are ready", u.Opts.WorkloadCluster.Name),
 				Err:         k.ValidateWorkerNodes(ctx, u.Opts.Spec.Cluster.Name, targetCluster.KubeconfigFile),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.NodesReady,
 				Name:        "nodes ready",
+				Category:    validations.ClusterCategory,
 				Remediation: fmt.Sprintf("check the Status of the control plane and worker nodes in cluster %s and verify they are Ready", u.Opts.WorkloadCluster.Name),
 				Err:         k.ValidateNodes(ctx, u.Opts.WorkloadCluster.KubeconfigFile),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.ClusterCRDsReady,
 				Name:        "cluster CRDs ready",
+				Category:    validations.ManagementClusterCategory,
 				Remediation: "",
 				Err:         k.ValidateClustersCRD(ctx, u.Opts.ManagementCluster),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.CAPIClusterObject,
 				Name:        "cluster object present on workload cluster",
+				Category:    validations.ManagementClusterCategory,
 				Remediation: fmt.Sprintf("ensure that the CAPI cluster object %s representing cluster %s is present", clusterv1.GroupVersion, u.Opts.WorkloadCluster.Name),
 				Err:         ValidateClusterObjectExists(ctx, k, u.Opts.ManagementCluster),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.KubernetesVersionSkew,
 				Name:        "upgrade cluster kubernetes version increment",
+				Category:    validations.VersionCategory,
 				Remediation: "ensure that the cluster kubernetes version is incremented by one minor version exactly (e.g. 1.18 -> 1.19)",
 				Err:         ValidateServerVersionSkew(ctx, u.Opts.Spec.Cluster, u.Opts.WorkloadCluster, u.Opts.ManagementCluster, k),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.WorkerKubernetesVersionSkew,
 				Name:        "upgrade cluster worker node group kubernetes version increment",
+				Category:    validations.VersionCategory,
 				Remediation: "ensure that the cluster worker node group kubernetes version is incremented by one minor version exactly (e.g. 1.18 -> 1.19) and cluster level kubernetes version does not exceed worker node group version by two minor versions",
 				Err:         ValidateWorkerServerVersionSkew(ctx, u.Opts.Spec.Cluster, u.Opts.WorkloadCluster, u.Opts.ManagementCluster, k),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.GitProviderAuthentication,
 				Name:        "validate authentication for git provider",
+				Category:    validations.GitOpsCategory,
 				Remediation: fmt.Sprintf("ensure %s, %s env variable are set and valid", config.EksaGitPrivateKeyTokenEnv, config.EksaGitKnownHostsFileEnv),
 				Err:         validations.ValidateAuthenticationForGitProvider(u.Opts.Spec, u.Opts.CliConfig),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.ImmutableFields,
 				Name:        "validate immutable fields",
+				Category:    validations.ClusterCategory,
 				Remediation: "",
 				Err:         ValidateImmutableFields(ctx, k, targetCluster, u.Opts.Spec, u.Opts.Provider),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.EksaVersion,
 				Name:        "validate cluster's eksaVersion matches EKS-Anywhere Version",
+				Category:    validations.VersionCategory,
 				Remediation: "ensure eksaVersion matches the EKS-Anywhere release or omit the value from the cluster config",
 				Err:         validations.ValidateEksaVersion(ctx, u.Opts.CliVersion, u.Opts.Spec),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.EksaControllerNotPaused,
 				Name:        "validate eksa controller is not paused",
+				Category:    validations.ClusterCategory,
 				Remediation: fmt.Sprintf("remove cluster controller reconciler pause annotation %s before upgrading the cluster %s", u.Opts.Spec.Cluster.PausedAnnotation(), targetCluster.Name),
 				Err:         validations.ValidatePauseAnnotation(ctx, k, targetCluster, targetCluster.Name),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.ExtendedKubernetesSupport,
 				Name:        "validate extended kubernetes version support is supported",
+				Category:    validations.VersionCategory,
 				Remediation: "ensure you have a valid license for extended Kubernetes version support",
 				Err:         validations.ValidateExtendedKubernetesSupport(ctx, *u.Opts.Spec.Cluster, u.Opts.ManifestReader, u.Opts.KubeClient, u.Opts.BundlesOverride),
 			}
 		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
+				ID:          validations.Kubernetes133Support,
 				Name:        "validate kubernetes version 1.33 support",
+				Category:    validations.VersionCategory,
 				Remediation: fmt.Sprintf("ensure %v env variable is set", features.K8s133SupportEnvVar),
 				Err:         validations.ValidateK8s133Support(u.Opts.Spec),
 				Silent:      true,
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				ID:          validations.LoadBalancerBundle,
+				Name:        "validate bundle includes the load balancer images",
+				Category:    validations.ClusterCategory,
+				Remediation: "remove loadBalancer from the cluster spec or use a bundle that includes the kube-vip images",
+				Err:         validations.ValidateLoadBalancerBundle(u.Opts.Spec),
+			}
+		},
 	}
 
 	if len(u.Opts.Spec.VSphereMachineConfigs) != 0 {
@@ -146,7 +186,9 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 			upgradeValidations = append(upgradeValidations,
 				func() *validations.ValidationResult {
 					return &validations.ValidationResult{
+						ID:          validations.BottlerocketControlPlaneKubeletConfig,
 						Name:        "validate cluster's control plane kubelet configuration for Bottlerocket OS",
+						Category:    validations.ClusterCategory,
 						Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
 						Err:         validations.ValidateBottlerocketKubeletConfig(u.Opts.Spec),
 					}
@@ -161,7 +203,9 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 				upgradeValidations = append(upgradeValidations,
 					func() *validations.ValidationResult {
 						return &validations.ValidationResult{
+							ID:          validations.BottlerocketWorkerKubeletConfig,
 							Name:        "validate cluster's worker node kubelet configuration for Bottlerocket OS",
+							Category:    validations.ClusterCategory,
 							Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
 							Err:         validations.ValidateBottlerocketKubeletConfig(u.Opts.Spec),
 						}
@@ -175,18 +219,31 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 			upgradeValidations,
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.ManagementClusterEksaVersion,
 					Name:        "validate management cluster eksaVersion compatibility",
+					Category:    validations.VersionCategory,
 					Remediation: fmt.Sprintf("upgrade management cluster %s before upgrading workload cluster %s", u.Opts.Spec.Cluster.ManagedBy(), u.Opts.WorkloadCluster.Name),
 					Err:         validations.ValidateManagementClusterEksaVersion(ctx, k, u.Opts.ManagementCluster, u.Opts.Spec),
 				}
 			},
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.ManagementClusterEksaRelease,
 					Name:        "validate eksa release components exist on management cluster",
+					Category:    validations.ManagementClusterCategory,
 					Remediation: fmt.Sprintf("ensure eksaVersion is in the correct format (vMajor.Minor.Patch) and matches one of the available releases on the management cluster: kubectl get eksareleases -n %s --kubeconfig %s", constants.EksaSystemNamespace, u.Opts.ManagementCluster.KubeconfigFile),
 					Err:         validations.ValidateEksaReleaseExistOnManagement(ctx, u.Opts.KubeClient, u.Opts.Spec.Cluster),
 				}
 			},
+			func() *validations.ValidationResult {
+				return &validations.ValidationResult{
+					ID:          validations.IPAMProviderInstalled,
+					Name:        "validate in-cluster IPAM provider is installed on management cluster",
+					Category:    validations.ManagementClusterCategory,
+					Remediation: fmt.Sprintf("upgrade management cluster %s to install the in-cluster IPAM provider before upgrading workload cluster %s", u.Opts.Spec.Cluster.ManagedBy(), u.Opts.WorkloadCluster.Name),
+					Err:         validations.ValidateIPAMProviderInstalled(ctx, k, u.Opts.ManagementCluster, u.Opts.Spec),
+				}
+			},
 		)
 	}
 
@@ -195,7 +252,9 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 			upgradeValidations,
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.PDB,
 					Name:        "validate pod disruption budgets",
+					Category:    validations.ClusterCategory,
 					Remediation: "",
 					Err:         ValidatePodDisruptionBudgets(ctx, k, u.Opts.WorkloadCluster),
 				}
@@ -206,7 +265,9 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 			upgradeValidations,
 			func() *validations.ValidationResult {
 				return &validations.ValidationResult{
+					ID:          validations.EksaVersionSkew,
 					Name:        "validate eksaVersion skew is one minor version",
+					Category:    validations.VersionCategory,
 					Remediation: "ensure eksaVersion upgrades are sequential by minor version",
 					Err:         validations.ValidateEksaVersionSkew(ctx, k, u.Opts.ManagementCluster, u.Opts.Spec),
 				}
@@ -217,10 +278,12 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 	return upgradeValidations
 }
 
-func resultForRemediableValidation(name string, err error) *validations.ValidationResult {
+func resultForRemediableValidation(id, name string, err error) *validations.ValidationResult {
 	r := &validations.ValidationResult{
-		Name: name,
-		Err:  err,
+		ID:       id,
+		Name:     name,
+		Category: validations.ClusterCategory,
+		Err:      err,
 	}
 
 	if r.Err == nil {
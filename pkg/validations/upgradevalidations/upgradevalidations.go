@@ -4,7 +4,8 @@ import (
 	"github.com/aws/eks-anywhere/pkg/validations"
 )
 
-// SkippableValidations represents all the validations we offer for users to skip.
+// SkippableValidations are the validations that don't run at all when skipped. Any other
+// validation can be skipped by ID, which ignores its failure.
 var SkippableValidations = []string{
 	validations.PDB,
 	validations.VSphereUserPriv,
@@ -19,3 +20,8 @@ func New(opts *validations.Opts) *UpgradeValidations {
 type UpgradeValidations struct {
 	Opts *validations.Opts
 }
+
+// RunnerOpts satisfies the validations.RunnerConfigurer interface.
+func (u *UpgradeValidations) RunnerOpts() []validations.RunnerOpt {
+	return u.Opts.RunnerOpts()
+}
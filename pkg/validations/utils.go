@@ -6,16 +6,42 @@ import (
 	"github.com/aws/eks-anywhere/pkg/logger"
 )
 
+// Category groups validations by what they check.
+type Category string
+
+const (
+	GeneralCategory           Category = "general"
+	ClusterCategory           Category = "cluster"
+	ManagementClusterCategory Category = "management-cluster"
+	ProviderCategory          Category = "provider"
+	RegistryCategory          Category = "registry"
+	GitOpsCategory            Category = "gitops"
+	VersionCategory           Category = "version"
+)
+
 type ValidationResult struct {
-	Name        string
-	Err         error
+	// ID identifies the validation in reports and in --skip-validations. It must be one of
+	// the registered validation IDs. Validations without an ID can't be skipped.
+	ID       string
+	Name     string
+	Category Category
+	Err      error
+	// Remediation tells the user how to fix the failure.
 	Remediation string
 	Silent      bool
 }
 
+// ValidationCategory returns the category of the validation, GeneralCategory if not set.
+func (v *ValidationResult) ValidationCategory() Category {
+	if v.Category == "" {
+		return GeneralCategory
+	}
+	return v.Category
+}
+
 func (v *ValidationResult) Report() {
 	if v.Err != nil {
-		logger.MarkFail("Validation failed", "validation", v.Name, "error", v.Err.Error(), "remediation", v.Remediation)
+		logger.MarkFail("Validation failed", "validation", v.Name, "id", v.ID, "error", v.Err.Error(), "remediation", v.Remediation)
 		return
 	}
 	if !v.Silent {
@@ -24,6 +24,8 @@ type Opts struct {
 	KubeClient         kubernetes.Client
 	ManifestReader     *manifests.Reader
 	BundlesOverride    string
+	// ReportFile is where the runner writes the validation report. No report is written if empty.
+	ReportFile string
 }
 
 func (o *Opts) SetDefaults() {
@@ -34,3 +36,26 @@ func (o *Opts) SetDefaults() {
 		o.CliVersion = version.Get().GitVersion
 	}
 }
+
+// RunnerOpts returns the options for the runner the validations built from o are run in.
+func (o *Opts) RunnerOpts() []RunnerOpt {
+	opts := []RunnerOpt{WithSkippedValidations(o.SkippedValidations)}
+	if o.ReportFile != "" {
+		opts = append(opts, WithReportFile(o.ReportFile))
+	}
+	return opts
+}
+
+// RunnerConfigurer is implemented by the validation builders that customize the runner
+// their validations are run in.
+type RunnerConfigurer interface {
+	RunnerOpts() []RunnerOpt
+}
+
+// RunnerOptsFor returns the runner options configured by v, if it's a RunnerConfigurer.
+func RunnerOptsFor(v interface{}) []RunnerOpt {
+	if c, ok := v.(RunnerConfigurer); ok {
+		return c.RunnerOpts()
+	}
+	return nil
+}
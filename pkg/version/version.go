@@ -1,8 +1,11 @@
 package version
 
 import (
+	"fmt"
+
 	"github.com/aws/eks-anywhere/pkg/files"
 	"github.com/aws/eks-anywhere/pkg/manifests/releases"
+	"github.com/aws/eks-anywhere/pkg/releasesource"
 )
 
 var gitVersion string
@@ -21,10 +24,16 @@ func Get() Info {
 
 // GetFullVersionInfo returns the complete version information for the
 // EKS Anywhere, including Git version and bundle manifest URL
-// associated with this release.
-func GetFullVersionInfo() (Info, error) {
-	reader := files.NewReader(files.WithEKSAUserAgent("cli", gitVersion))
-	bundleManifestURL, err := releases.GetBundleManifestURL(reader, gitVersion)
+// associated with this release. The release manifest is read from source when it's not nil.
+func GetFullVersionInfo(source *releasesource.Source) (Info, error) {
+	reader := files.NewReader(files.WithEKSAUserAgent("cli", gitVersion), files.WithReleaseSource(source))
+	releaseManifestURL := releases.ManifestURLForSource(source)
+	rls, err := releases.ReadReleasesFromURL(reader, releaseManifestURL)
+	if err != nil {
+		return Info{GitVersion: gitVersion}, fmt.Errorf("failed to read releases: %v", err)
+	}
+
+	bundleManifestURL, err := releases.BundleManifestURL(rls, gitVersion)
 	if err != nil {
 		return Info{GitVersion: gitVersion}, err
 	}
@@ -32,6 +41,6 @@ func GetFullVersionInfo() (Info, error) {
 	return Info{
 		GitVersion:         gitVersion,
 		BundleManifestURL:  bundleManifestURL,
-		ReleaseManifestURL: releases.ManifestURL(),
+		ReleaseManifestURL: releaseManifestURL,
 	}, nil
 }
@@ -0,0 +1,113 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/workflow/workflowcontext"
+)
+
+// Checkpoint records the progress of a workflow execution so it can be resumed after a failure.
+type Checkpoint struct {
+	// CompletedTasks are the tasks that, together with their hooks, ran successfully.
+	CompletedTasks []TaskName `json:"completedTasks"`
+
+	// The clusters found in the workflow context after the last completed task.
+	BootstrapCluster  *types.Cluster `json:"bootstrapCluster,omitempty"`
+	ManagementCluster *types.Cluster `json:"managementCluster,omitempty"`
+	WorkloadCluster   *types.Cluster `json:"workloadCluster,omitempty"`
+}
+
+// CheckpointStore persists workflow checkpoints.
+type CheckpointStore interface {
+	// Load returns the last saved checkpoint or nil if there is none.
+	Load() (*Checkpoint, error)
+
+	// Save persists c, replacing any previously saved checkpoint.
+	Save(c *Checkpoint) error
+}
+
+func (c *Checkpoint) completed(name TaskName) bool {
+	for _, n := range c.CompletedTasks {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// restoreContext populates ctx with the clusters saved in the checkpoint.
+func (c *Checkpoint) restoreContext(ctx context.Context) context.Context {
+	if c.BootstrapCluster != nil {
+		ctx = workflowcontext.WithBootstrapCluster(ctx, c.BootstrapCluster)
+	}
+	if c.ManagementCluster != nil {
+		ctx = workflowcontext.WithManagementCluster(ctx, c.ManagementCluster)
+	}
+	if c.WorkloadCluster != nil {
+		ctx = workflowcontext.WithWorkloadCluster(ctx, c.WorkloadCluster)
+	}
+	return ctx
+}
+
+// taskCompleted records name as completed along with the clusters in ctx.
+func (c *Checkpoint) taskCompleted(ctx context.Context, name TaskName) {
+	c.CompletedTasks = append(c.CompletedTasks, name)
+	c.BootstrapCluster = workflowcontext.BootstrapCluster(ctx)
+	c.ManagementCluster = workflowcontext.ManagementCluster(ctx)
+	c.WorkloadCluster = workflowcontext.WorkloadCluster(ctx)
+}
+
+// FileCheckpointStore is a CheckpointStore that keeps the checkpoint as a yaml file in the
+// writer's temporary folder, so it's removed once the command using the writer succeeds.
+type FileCheckpointStore struct {
+	writer   filewriter.FileWriter
+	fileName string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore for the workflows run against clusterName.
+func NewFileCheckpointStore(writer filewriter.FileWriter, clusterName string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		writer:   writer,
+		fileName: fmt.Sprintf("%s-workflow-checkpoint.yaml", clusterName),
+	}
+}
+
+// Load satisfies the CheckpointStore interface.
+func (s *FileCheckpointStore) Load() (*Checkpoint, error) {
+	content, err := os.ReadFile(filepath.Join(s.writer.TempDir(), s.fileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading workflow checkpoint: %v", err)
+	}
+
+	c := &Checkpoint{}
+	if err = yaml.Unmarshal(content, c); err != nil {
+		return nil, fmt.Errorf("unmarshalling workflow checkpoint: %v", err)
+	}
+
+	return c, nil
+}
+
+// Save satisfies the CheckpointStore interface.
+func (s *FileCheckpointStore) Save(c *Checkpoint) error {
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("marshalling workflow checkpoint: %v", err)
+	}
+
+	if _, err = s.writer.Write(s.fileName, content); err != nil {
+		return fmt.Errorf("saving workflow checkpoint: %v", err)
+	}
+
+	return nil
+}
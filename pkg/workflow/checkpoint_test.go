@@ -0,0 +1,112 @@
+package workflow_test
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/workflow"
+	"github.com/aws/eks-anywhere/pkg/workflow/workflowcontext"
+)
+
+type memoryCheckpointStore struct {
+	checkpoint *workflow.Checkpoint
+	saves      int
+}
+
+func (s *memoryCheckpointStore) Load() (*workflow.Checkpoint, error) {
+	return s.checkpoint, nil
+}
+
+func (s *memoryCheckpointStore) Save(c *workflow.Checkpoint) error {
+	s.checkpoint = c
+	s.saves++
+	return nil
+}
+
+func TestWorkflowExecuteSavesCheckpoints(t *testing.T) {
+	g := gomega.NewWithT(t)
+	bootstrap := &types.Cluster{Name: "bootstrap", KubeconfigFile: "bootstrap.kubeconfig"}
+	store := &memoryCheckpointStore{}
+
+	wflw := workflow.New(workflow.Config{CheckpointStore: store})
+	g.Expect(wflw.AppendTask("task1", workflow.TaskFunc(func(ctx context.Context) (context.Context, error) {
+		return workflowcontext.WithBootstrapCluster(ctx, bootstrap), nil
+	}))).To(gomega.Succeed())
+	g.Expect(wflw.AppendTask("task2", workflow.TaskFunc(func(ctx context.Context) (context.Context, error) {
+		return ctx, nil
+	}))).To(gomega.Succeed())
+
+	g.Expect(wflw.Execute(context.Background())).To(gomega.Succeed())
+	g.Expect(store.saves).To(gomega.Equal(2))
+	g.Expect(store.checkpoint.CompletedTasks).To(gomega.Equal([]workflow.TaskName{"task1", "task2"}))
+	g.Expect(store.checkpoint.BootstrapCluster).To(gomega.Equal(bootstrap))
+	g.Expect(store.checkpoint.WorkloadCluster).To(gomega.BeNil())
+}
+
+func TestWorkflowExecuteResume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	g := gomega.NewWithT(t)
+	bootstrap := &types.Cluster{Name: "bootstrap", KubeconfigFile: "bootstrap.kubeconfig"}
+	store := &memoryCheckpointStore{
+		checkpoint: &workflow.Checkpoint{
+			CompletedTasks:   []workflow.TaskName{"task1"},
+			BootstrapCluster: bootstrap,
+		},
+	}
+
+	// task1 has no expectations so running it fails the test.
+	task1 := NewMockTask(ctrl)
+	task2 := NewMockTask(ctrl)
+	task2.EXPECT().
+		RunTask(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (context.Context, error) {
+			g.Expect(workflowcontext.BootstrapCluster(ctx)).To(gomega.Equal(bootstrap))
+			return ctx, nil
+		})
+
+	wflw := workflow.New(workflow.Config{CheckpointStore: store, Resume: true})
+	g.Expect(wflw.AppendTask("task1", task1)).To(gomega.Succeed())
+	g.Expect(wflw.AppendTask("task2", task2)).To(gomega.Succeed())
+
+	g.Expect(wflw.Execute(context.Background())).To(gomega.Succeed())
+	g.Expect(store.checkpoint.CompletedTasks).To(gomega.Equal([]workflow.TaskName{"task1", "task2"}))
+}
+
+func TestWorkflowExecuteResumeNoCheckpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	g := gomega.NewWithT(t)
+
+	wflw := workflow.New(workflow.Config{CheckpointStore: &memoryCheckpointStore{}, Resume: true})
+	g.Expect(wflw.AppendTask("task1", NewMockTask(ctrl))).To(gomega.Succeed())
+
+	g.Expect(wflw.Execute(context.Background())).To(gomega.MatchError(gomega.ContainSubstring("no checkpoint")))
+}
+
+func TestWorkflowExecuteResumeNoCheckpointStore(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	wflw := workflow.New(workflow.Config{Resume: true})
+
+	g.Expect(wflw.Execute(context.Background())).To(gomega.MatchError(gomega.ContainSubstring("requires a checkpoint store")))
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	g := gomega.NewWithT(t)
+	writer, err := filewriter.NewWriter(t.TempDir())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	store := workflow.NewFileCheckpointStore(writer, "test-cluster")
+
+	g.Expect(store.Load()).To(gomega.BeNil())
+
+	checkpoint := &workflow.Checkpoint{
+		CompletedTasks:    []workflow.TaskName{"task1"},
+		ManagementCluster: &types.Cluster{Name: "mgmt", KubeconfigFile: "mgmt.kubeconfig"},
+	}
+	g.Expect(store.Save(checkpoint)).To(gomega.Succeed())
+	g.Expect(store.Load()).To(gomega.Equal(checkpoint))
+}
@@ -44,6 +44,9 @@ type CreateCluster struct {
 	// FS is a file system abstraction used to write files.
 	FS filewriter.FileWriter
 
+	// Resume continues a previous failed execution from the checkpoint saved in FS.
+	Resume bool
+
 	// hookRegistrars are data structures that wish to bind runtime hooks to the workflow.
 	// They should be added via the WithHookRegistrar method.
 	hookRegistrars []CreateClusterHookRegistrar
@@ -66,7 +69,10 @@ func (c CreateCluster) Run(ctx context.Context) error {
 }
 
 func (c CreateCluster) build() (*workflow.Workflow, error) {
-	wflw := workflow.New(workflow.Config{})
+	wflw := workflow.New(workflow.Config{
+		CheckpointStore: workflow.NewFileCheckpointStore(c.FS, c.Spec.Cluster.Name),
+		Resume:          c.Resume,
+	})
 
 	for _, r := range c.hookRegistrars {
 		r.RegisterCreateManagementClusterHooks(wflw)
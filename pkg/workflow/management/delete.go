@@ -16,6 +16,12 @@ type DeleteClusterHookRegistrar interface {
 // DeleteClusterBuilder defines the configuration for a management cluster deletion workflow.
 type DeleteClusterBuilder struct {
 	HookRegistrars []DeleteClusterHookRegistrar
+
+	// CheckpointStore persists the workflow progress. Optional.
+	CheckpointStore workflow.CheckpointStore
+
+	// Resume continues a previous failed execution from the checkpoint in CheckpointStore.
+	Resume bool
 }
 
 // WithHookRegistrar adds a hook registrar to the delete cluster workflow builder.
@@ -26,7 +32,10 @@ func (b *DeleteClusterBuilder) WithHookRegistrar(registrar DeleteClusterHookRegi
 
 // Build builds the delete cluster workflow.
 func (cfg *DeleteClusterBuilder) Build() (*workflow.Workflow, error) {
-	wflw := workflow.New(workflow.Config{})
+	wflw := workflow.New(workflow.Config{
+		CheckpointStore: cfg.CheckpointStore,
+		Resume:          cfg.Resume,
+	})
 
 	for _, r := range cfg.HookRegistrars {
 		r.RegisterDeleteManagementClusterHooks(wflw)
@@ -16,6 +16,12 @@ type UpgradeClusterHookRegistrar interface {
 // UpgradeClusterBuilder defines the configuration for a management cluster upgrade workflow.
 type UpgradeClusterBuilder struct {
 	HookRegistrars []UpgradeClusterHookRegistrar
+
+	// CheckpointStore persists the workflow progress. Optional.
+	CheckpointStore workflow.CheckpointStore
+
+	// Resume continues a previous failed execution from the checkpoint in CheckpointStore.
+	Resume bool
 }
 
 // WithHookRegistrar adds a hook registrar to the upgrade cluster workflow builder.
@@ -26,7 +32,10 @@ func (b *UpgradeClusterBuilder) WithHookRegistrar(registrar UpgradeClusterHookRe
 
 // Build builds the upgrade cluster workflow.
 func (cfg *UpgradeClusterBuilder) Build() (*workflow.Workflow, error) {
-	wflw := workflow.New(workflow.Config{})
+	wflw := workflow.New(workflow.Config{
+		CheckpointStore: cfg.CheckpointStore,
+		Resume:          cfg.Resume,
+	})
 
 	for _, r := range cfg.HookRegistrars {
 		r.RegisterUpgradeManagementClusterHooks(wflw)
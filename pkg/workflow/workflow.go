@@ -2,6 +2,10 @@ package workflow
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/events"
 )
 
 // Config is the configuration for constructing a Workflow instance.
@@ -10,6 +14,14 @@ type Config struct {
 	// from hook or from a task. The original error is alwasy returned from the workflow's Execute.
 	// Optional. Defaults to a no-op handler.
 	ErrorHandler ErrorHandler
+
+	// CheckpointStore persists the workflow progress after every task so a failed execution can
+	// be resumed. Optional. Defaults to no checkpoints.
+	CheckpointStore CheckpointStore
+
+	// Resume skips the tasks recorded as completed in the checkpoint loaded from CheckpointStore
+	// and restores the clusters it holds in the context. Requires a CheckpointStore.
+	Resume bool
 }
 
 // Workflow defines an abstract workflow that can execute a serialized set of tasks.
@@ -58,22 +70,26 @@ func (w *Workflow) AppendTask(name TaskName, t Task) error {
 
 // Execute executes the workflow running any pre and post hooks registered for each task.
 func (w *Workflow) Execute(ctx context.Context) error {
-	var err error
+	checkpoint, err := w.loadCheckpoint()
+	if err != nil {
+		return w.handleError(ctx, err)
+	}
+	ctx = checkpoint.restoreContext(ctx)
 
 	if ctx, err = runHooks(ctx, w.preWorkflowHooks); err != nil {
 		return w.handleError(ctx, err)
 	}
 
 	for _, task := range w.tasks {
-		if ctx, err = w.runPreTaskHooks(ctx, task.Name); err != nil {
-			return w.handleError(ctx, err)
+		if checkpoint.completed(task.Name) {
+			continue
 		}
 
-		if ctx, err = task.RunTask(ctx); err != nil {
+		if ctx, err = w.runTask(ctx, task); err != nil {
 			return w.handleError(ctx, err)
 		}
 
-		if ctx, err = w.runPostTaskHooks(ctx, task.Name); err != nil {
+		if err = w.saveCheckpoint(ctx, checkpoint, task.Name); err != nil {
 			return w.handleError(ctx, err)
 		}
 	}
@@ -85,6 +101,67 @@ func (w *Workflow) Execute(ctx context.Context) error {
 	return nil
 }
 
+// runTask runs task with its pre and post hooks, emitting its progress events.
+func (w *Workflow) runTask(ctx context.Context, task namedTask) (context.Context, error) {
+	start := time.Now()
+	events.Emit(events.Event{Type: events.TaskStarted, Task: string(task.Name)})
+
+	ctx, err := w.runTaskWithHooks(ctx, task)
+
+	event := events.Event{Type: events.TaskFinished, Task: string(task.Name), DurationSeconds: time.Since(start).Seconds()}
+	if err != nil {
+		event.Type = events.TaskFailed
+		event = event.WithError(err)
+	}
+	events.Emit(event)
+
+	return ctx, err
+}
+
+func (w *Workflow) runTaskWithHooks(ctx context.Context, task namedTask) (context.Context, error) {
+	var err error
+	if ctx, err = w.runPreTaskHooks(ctx, task.Name); err != nil {
+		return ctx, err
+	}
+
+	if ctx, err = task.RunTask(ctx); err != nil {
+		return ctx, err
+	}
+
+	return w.runPostTaskHooks(ctx, task.Name)
+}
+
+// loadCheckpoint returns the checkpoint to resume from when Resume is set or an empty one otherwise.
+func (w *Workflow) loadCheckpoint() (*Checkpoint, error) {
+	if !w.Resume {
+		return &Checkpoint{}, nil
+	}
+
+	if w.CheckpointStore == nil {
+		return nil, errors.New("resuming a workflow requires a checkpoint store")
+	}
+
+	checkpoint, err := w.CheckpointStore.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if checkpoint == nil {
+		return nil, errors.New("no checkpoint to resume the workflow from")
+	}
+
+	return checkpoint, nil
+}
+
+func (w *Workflow) saveCheckpoint(ctx context.Context, checkpoint *Checkpoint, name TaskName) error {
+	if w.CheckpointStore == nil {
+		return nil
+	}
+
+	checkpoint.taskCompleted(ctx, name)
+	return w.CheckpointStore.Save(checkpoint)
+}
+
 // BindPreWorkflowHook implements the HookBinder interface.
 func (w *Workflow) BindPreWorkflowHook(t Task) {
 	w.preWorkflowHooks = append(w.preWorkflowHooks, t)
@@ -16,7 +16,8 @@ func WithBootstrapCluster(ctx context.Context, cluster *types.Cluster) context.C
 
 // BootstrapCluster retrieves the bootstrap cluster configured in ctx or returns a nil pointer.
 func BootstrapCluster(ctx context.Context) *types.Cluster {
-	return ctx.Value(bootstrapCluster).(*types.Cluster)
+	cluster, _ := ctx.Value(bootstrapCluster).(*types.Cluster)
+	return cluster
 }
 
 const managementCluster contextKey = "management-cluster"
@@ -28,7 +29,8 @@ func WithManagementCluster(ctx context.Context, cluster *types.Cluster) context.
 
 // ManagementCluster retrieves the management cluster configured in ctx or returns a nil pointer.
 func ManagementCluster(ctx context.Context) *types.Cluster {
-	return ctx.Value(managementCluster).(*types.Cluster)
+	cluster, _ := ctx.Value(managementCluster).(*types.Cluster)
+	return cluster
 }
 
 // workloadCluster is used to store and retrieve a target cluster kubeconfig.
@@ -41,7 +43,8 @@ func WithWorkloadCluster(ctx context.Context, cluster *types.Cluster) context.Co
 
 // WorkloadCluster retrieves the workload cluster configured in ctx or returns a nil pointer.
 func WorkloadCluster(ctx context.Context) *types.Cluster {
-	return ctx.Value(workloadCluster).(*types.Cluster)
+	cluster, _ := ctx.Value(workloadCluster).(*types.Cluster)
+	return cluster
 }
 
 // WithBootstrapAsManagementCluster is shorthand for WithBootstrapCluster followed by
@@ -24,6 +24,7 @@ type Create struct {
 	eksaInstaller  interfaces.EksaInstaller
 	clusterMover   interfaces.ClusterMover
 	iamAuth        interfaces.AwsIamAuth
+	runnerOpts     []task.TaskRunnerOpt
 }
 
 // NewCreate builds a new create construct.
@@ -55,6 +56,12 @@ func NewCreate(bootstrapper interfaces.Bootstrapper,
 	return createWorkflow
 }
 
+// WithResume makes Run continue a previous failed create from its checkpoint instead of starting over.
+func (c *Create) WithResume() *Create {
+	c.runnerOpts = append(c.runnerOpts, task.WithResume())
+	return c
+}
+
 // Run runs all the create management cluster tasks.
 func (c *Create) Run(ctx context.Context, clusterSpec *cluster.Spec, validator interfaces.Validator) error {
 	commandContext := &task.CommandContext{
@@ -74,5 +81,7 @@ func (c *Create) Run(ctx context.Context, clusterSpec *cluster.Spec, validator i
 		IamAuth:        c.iamAuth,
 	}
 
-	return task.NewTaskRunner(&setupAndValidateCreate{}, c.writer).RunTask(ctx, commandContext)
+	// Always save a checkpoint so a failed or killed run can be resumed with --resume.
+	opts := append([]task.TaskRunnerOpt{task.WithCheckpointFile()}, c.runnerOpts...)
+	return task.NewTaskRunner(&setupAndValidateCreate{}, c.writer, opts...).RunTask(ctx, commandContext)
 }
@@ -33,7 +33,7 @@ func (s *createBootStrapClusterTask) Name() string {
 }
 
 func (s *createBootStrapClusterTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &updateSecretsCreate{}, nil
 }
 
 func (s *createBootStrapClusterTask) Checkpoint() *task.CompletedTask {
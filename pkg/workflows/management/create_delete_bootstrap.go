@@ -27,7 +27,7 @@ func (s *deleteBootstrapClusterTask) Name() string {
 }
 
 func (s *deleteBootstrapClusterTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installCuratedPackagesTask{}, nil
 }
 
 func (s *deleteBootstrapClusterTask) Checkpoint() *task.CompletedTask {
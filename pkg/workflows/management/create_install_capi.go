@@ -39,7 +39,7 @@ func (s *installCAPIComponentsTask) Name() string {
 }
 
 func (s *installCAPIComponentsTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installEksaComponentsOnBootstrapTask{}, nil
 }
 
 func (s *installCAPIComponentsTask) Checkpoint() *task.CompletedTask {
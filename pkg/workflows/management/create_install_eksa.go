@@ -29,7 +29,7 @@ func (s *installEksaComponentsOnBootstrapTask) Name() string {
 }
 
 func (s *installEksaComponentsOnBootstrapTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &createWorkloadClusterTask{}, nil
 }
 
 func (s *installEksaComponentsOnBootstrapTask) Checkpoint() *task.CompletedTask {
@@ -97,7 +97,7 @@ func (s *installEksaComponentsOnWorkloadTask) Name() string {
 }
 
 func (s *installEksaComponentsOnWorkloadTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installGitOpsManagerTask{}, nil
 }
 
 func (s *installEksaComponentsOnWorkloadTask) Checkpoint() *task.CompletedTask {
@@ -22,7 +22,7 @@ func (s *installProviderSpecificResources) Name() string {
 }
 
 func (s *installProviderSpecificResources) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &moveClusterManagementTask{}, nil
 }
 
 func (s *installProviderSpecificResources) Checkpoint() *task.CompletedTask {
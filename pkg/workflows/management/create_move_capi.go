@@ -33,7 +33,7 @@ func (s *moveClusterManagementTask) Name() string {
 }
 
 func (s *moveClusterManagementTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installEksaComponentsOnWorkloadTask{}, nil
 }
 
 func (s *moveClusterManagementTask) Checkpoint() *task.CompletedTask {
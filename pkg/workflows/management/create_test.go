@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -60,6 +62,8 @@ func newCreateTest(t *testing.T) *createTestSetup {
 	gitOpsManager := mocks.NewMockGitOpsManager(mockCtrl)
 	provider := providermocks.NewMockProvider(mockCtrl)
 	writer := writermocks.NewMockFileWriter(mockCtrl)
+	// The workflow saves a checkpoint after every completed task.
+	writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any()).AnyTimes()
 	eksdInstaller := mocks.NewMockEksdInstaller(mockCtrl)
 	eksaInstaller := mocks.NewMockEksaInstaller(mockCtrl)
 
@@ -309,6 +313,8 @@ func TestCreateRunSuccess(t *testing.T) {
 	test.expectDatacenterConfig()
 	test.expectMachineConfigs()
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
@@ -328,7 +334,6 @@ func TestCreateBootstrapOptsFailure(t *testing.T) {
 		c.provider.EXPECT().BootstrapClusterOpts(
 			c.clusterSpec).Return(opts, err),
 	)
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
 
 	err = c.run()
 	if err == nil {
@@ -352,7 +357,6 @@ func TestCreateBootstrapFailure(t *testing.T) {
 			c.ctx, c.clusterSpec, gomock.Not(gomock.Nil()),
 		).Return(nil, err),
 	)
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
 
 	err = c.run()
 	if err == nil {
@@ -370,7 +374,6 @@ func TestCreateRegistrySecretFailure(t *testing.T) {
 	c.expectCreateRegistrySecret(fmt.Errorf(""))
 
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
 
 	err := c.run()
 	if err == nil {
@@ -388,7 +391,6 @@ func TestCreatePreCAPIFailure(t *testing.T) {
 		c.ctx, c.bootstrapCluster, c.clusterSpec).Return(errors.New("test"))
 
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
 
 	err := c.run()
 	if err == nil {
@@ -411,7 +413,6 @@ func TestCreateInstallCAPIFailure(t *testing.T) {
 	)
 
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
 
 	err := c.run()
 	if err == nil {
@@ -428,7 +429,6 @@ func TestCreatePostCAPIFailure(t *testing.T) {
 	c.expectCAPIInstall(nil, nil, err)
 
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
 
 	err = c.run()
 	if err == nil {
@@ -450,8 +450,6 @@ func TestCreateInstallCRDFailure(t *testing.T) {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, nil)
 
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := c.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -475,8 +473,6 @@ func TestCreateInstallCustomComponentsFailure(t *testing.T) {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, nil)
 
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
-
 	err = c.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -503,8 +499,6 @@ func TestCreateInstallCustomProviderComponentsFailure(t *testing.T) {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, nil)
 
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
-
 	err = c.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -534,8 +528,6 @@ func TestCreateInstallEksdManifestFailure(t *testing.T) {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, nil)
 
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
-
 	err = c.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -554,7 +546,6 @@ func TestCreateSyncFailure(t *testing.T) {
 	test.expectCreateNamespace()
 	test.clusterCreator.EXPECT().CreateSync(test.ctx, test.clusterSpec, test.bootstrapCluster).Return(nil, errors.New("test"))
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
 
 	err := test.run()
 	if err == nil {
@@ -576,7 +567,6 @@ func TestCreateEKSANamespaceFailure(t *testing.T) {
 	test.clusterManager.EXPECT().CreateEKSANamespace(test.ctx, test.workloadCluster).Return(errors.New("test"))
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 	test.clusterManager.EXPECT().SaveLogsWorkloadCluster(test.ctx, test.provider, test.clusterSpec, test.workloadCluster)
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
 
 	err := test.run()
 	if err == nil {
@@ -606,8 +596,6 @@ func TestCreateInstallCAPIWorkloadFailure(t *testing.T) {
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 	test.clusterManager.EXPECT().SaveLogsWorkloadCluster(test.ctx, test.provider, test.clusterSpec, test.workloadCluster)
 
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("expected error from task")
@@ -628,8 +616,6 @@ func TestCreateUpdateSecretsFailure(t *testing.T) {
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 	test.clusterManager.EXPECT().SaveLogsWorkloadCluster(test.ctx, test.provider, test.clusterSpec, test.workloadCluster)
 
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("expected error from task")
@@ -651,8 +637,6 @@ func TestCreatePostWorkloadInitFailure(t *testing.T) {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, c.workloadCluster)
 
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := c.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -675,8 +659,6 @@ func TestCreateMoveCAPIFailure(t *testing.T) {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, c.workloadCluster)
 
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := c.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -698,8 +680,6 @@ func TestPauseReconcilerFailure(t *testing.T) {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, c.workloadCluster)
 
-	c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := c.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -724,8 +704,6 @@ func TestCreateEKSAWorkloadComponentsFailure(t *testing.T) {
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 	test.clusterManager.EXPECT().SaveLogsWorkloadCluster(test.ctx, test.provider, test.clusterSpec, test.workloadCluster)
 
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -748,8 +726,6 @@ func TestCreateEKSAWorkloadFailure(t *testing.T) {
 
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -772,8 +748,6 @@ func TestCreateSrcClientFailure(t *testing.T) {
 
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -796,8 +770,6 @@ func TestCreateDstClientFailure(t *testing.T) {
 
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -820,8 +792,6 @@ func TestCreateEKSAResumeWorkloadFailure(t *testing.T) {
 
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
 
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() expected to return an error %v", err)
@@ -851,6 +821,8 @@ func TestCreateGitOPsFailure(t *testing.T) {
 	test.expectDeleteBootstrap(nil)
 	test.expectCuratedPackagesInstallation()
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
@@ -884,7 +856,6 @@ func TestCreateWriteConfigFailure(t *testing.T) {
 	test.clusterManager.EXPECT().SaveLogsWorkloadCluster(
 		test.ctx, test.provider, test.clusterSpec, test.workloadCluster,
 	)
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
 
 	err := test.run()
 	if err == nil {
@@ -920,7 +891,6 @@ func TestCreateWriteConfigAWSIAMFailure(t *testing.T) {
 	test.clusterManager.EXPECT().SaveLogsWorkloadCluster(
 		test.ctx, test.provider, test.clusterSpec, test.workloadCluster,
 	)
-	test.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", test.clusterSpec.Cluster.Name), gomock.Any())
 
 	err := test.run()
 	if err == nil {
@@ -948,8 +918,6 @@ func TestCreateRunDeleteBootstrapFailure(t *testing.T) {
 	test.expectDatacenterConfig()
 	test.expectMachineConfigs()
 
-	test.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any(), gomock.Any())
-
 	err := test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
@@ -965,10 +933,57 @@ func TestCreateNamespaceClientFailure(t *testing.T) {
 	test.expectInstallEksaComponentsBootstrap(nil, nil, nil, nil)
 	test.clientFactory.EXPECT().BuildClientFromKubeconfig(test.bootstrapCluster.KubeconfigFile).Return(test.client, fmt.Errorf(""))
 	test.clusterManager.EXPECT().SaveLogsManagementCluster(test.ctx, test.clusterSpec, test.bootstrapCluster)
-	test.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any(), gomock.Any())
 
 	err := test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
 	}
 }
+
+func TestCreateRunResume(t *testing.T) {
+	test := newCreateTest(t)
+	test.bootstrapCluster.KubeconfigFile = "test-cluster/generated/test-cluster.kind.kubeconfig"
+	dir := t.TempDir()
+	checkpoint := []byte(`completedTasks:
+  setup-validate:
+  bootstrap-cluster-init:
+  update-secrets-create:
+  install-capi-components-bootstrap:
+  eksa-components-bootstrap-install:
+  workload-cluster-init:
+  install-resources-on-management-cluster:
+  capi-management-move:
+  eksa-components-workload-install:
+  gitops-manager-install:
+  write-cluster-config:
+state:
+  bootstrapCluster:
+    Name: test-cluster
+    KubeconfigFile: test-cluster/generated/test-cluster.kind.kubeconfig
+`)
+	if err := os.WriteFile(filepath.Join(dir, "test-cluster-checkpoint.yaml"), checkpoint, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	test.writer.EXPECT().TempDir().Return(dir).Times(2)
+	test.provider.EXPECT().SetupAndValidateCreateCluster(test.ctx, test.clusterSpec)
+	test.provider.EXPECT().Name()
+	test.expectDeleteBootstrap(nil)
+	test.expectCuratedPackagesInstallation()
+
+	if err := test.workflow.WithResume().Run(test.ctx, test.clusterSpec, test.validator); err != nil {
+		t.Fatalf("Create.Run() err = %v, want err = nil", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "test-cluster-checkpoint.yaml")); !os.IsNotExist(err) {
+		t.Fatalf("checkpoint should be removed after a successful run, stat err = %v", err)
+	}
+}
+
+func TestCreateRunResumeNoCheckpoint(t *testing.T) {
+	test := newCreateTest(t)
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
+	if err := test.workflow.WithResume().Run(test.ctx, test.clusterSpec, test.validator); err == nil {
+		t.Fatal("Create.Run() err = nil, want err")
+	}
+}
@@ -74,7 +74,7 @@ func (s *createWorkloadClusterTask) Name() string {
 }
 
 func (s *createWorkloadClusterTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installProviderSpecificResources{}, nil
 }
 
 func (s *createWorkloadClusterTask) Checkpoint() *task.CompletedTask {
@@ -23,6 +23,7 @@ type Delete struct {
 	eksaInstaller  interfaces.EksaInstaller
 	clientFactory  interfaces.ClientFactory
 	clusterMover   interfaces.ClusterMover
+	runnerOpts     []task.TaskRunnerOpt
 }
 
 // NewDelete builds a new delete construct.
@@ -51,6 +52,12 @@ func NewDelete(bootstrapper interfaces.Bootstrapper,
 	}
 }
 
+// WithResume makes Run continue a previous failed delete from its checkpoint, reusing its bootstrap cluster.
+func (c *Delete) WithResume() *Delete {
+	c.runnerOpts = append(c.runnerOpts, task.WithResume())
+	return c
+}
+
 // Run executes the tasks to delete a management cluster.
 func (c *Delete) Run(ctx context.Context, workload *types.Cluster, clusterSpec *cluster.Spec) error {
 	commandContext := &task.CommandContext{
@@ -68,5 +75,7 @@ func (c *Delete) Run(ctx context.Context, workload *types.Cluster, clusterSpec *
 		ClusterMover:    c.clusterMover,
 	}
 
-	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer).RunTask(ctx, commandContext)
+	// Always save a checkpoint so a failed or killed run can be resumed with --resume.
+	opts := append([]task.TaskRunnerOpt{task.WithCheckpointFile()}, c.runnerOpts...)
+	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer, opts...).RunTask(ctx, commandContext)
 }
@@ -33,7 +33,7 @@ func (s *deleteManagementCluster) Name() string {
 }
 
 func (s *deleteManagementCluster) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &cleanupGitRepo{}, nil
 }
 
 func (s *deleteManagementCluster) Checkpoint() *task.CompletedTask {
@@ -58,7 +58,7 @@ func (s *cleanupGitRepo) Name() string {
 }
 
 func (s *cleanupGitRepo) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &deleteBootstrapClusterForDeleteTask{}, nil
 }
 
 func (s *cleanupGitRepo) Checkpoint() *task.CompletedTask {
@@ -33,7 +33,7 @@ func (s *createBootStrapClusterForDeleteTask) Name() string {
 }
 
 func (s *createBootStrapClusterForDeleteTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installCAPIComponentsForDeleteTask{}, nil
 }
 
 func (s *createBootStrapClusterForDeleteTask) Checkpoint() *task.CompletedTask {
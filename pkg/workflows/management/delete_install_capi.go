@@ -33,7 +33,7 @@ func (s *installCAPIComponentsForDeleteTask) Name() string {
 }
 
 func (s *installCAPIComponentsForDeleteTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &moveClusterManagementForDeleteTask{}, nil
 }
 
 func (s *installCAPIComponentsForDeleteTask) Checkpoint() *task.CompletedTask {
@@ -53,6 +53,8 @@ func newDeleteTest(t *testing.T) *deleteTestSetup {
 	mockCtrl := gomock.NewController(t)
 	provider := providermocks.NewMockProvider(mockCtrl)
 	writer := writermocks.NewMockFileWriter(mockCtrl)
+	// The workflow saves a checkpoint after every completed task.
+	writer.EXPECT().Write("workload-checkpoint.yaml", gomock.Any()).AnyTimes()
 	manager := mocks.NewMockClusterManager(mockCtrl)
 	client := clientmocks.NewMockClient(mockCtrl)
 
@@ -134,17 +136,12 @@ func (c *deleteTestSetup) run() error {
 	return c.workload.Run(c.ctx, c.workloadCluster, c.clusterSpec)
 }
 
-func (c *deleteTestSetup) expectWrite() {
-	c.writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
-}
-
 func (c *deleteTestSetup) expectSaveLogsWorkload() {
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, c.workloadCluster)
 }
 
 func (c *deleteTestSetup) expectSaveLogsManagement() {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.bootstrapCluster)
-	c.expectWrite()
 }
 
 func (c *deleteTestSetup) expectDeleteBootstrap(err error) {
@@ -220,6 +217,8 @@ func TestDeleteRunSuccess(t *testing.T) {
 	test.expectCleanupGitRepo(nil)
 	test.expectDeleteBootstrap(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
@@ -231,7 +230,6 @@ func TestDeleteRunFailSetup(t *testing.T) {
 	os.Setenv(features.UseControllerForCli, "true")
 	test := newDeleteTest(t)
 	test.expectSetup(fmt.Errorf("Failure"))
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
@@ -245,7 +243,6 @@ func TestDeleteRunFailBootstrapOpts(t *testing.T) {
 	test := newDeleteTest(t)
 	test.expectSetup(nil)
 	test.expectBootstrapOpts(fmt.Errorf(""))
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
@@ -260,7 +257,6 @@ func TestDeleteRunFailCreateBootstrap(t *testing.T) {
 	test.expectSetup(nil)
 	test.expectBootstrapOpts(nil)
 	test.expectCreateBootstrap(fmt.Errorf(""))
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
@@ -61,5 +61,5 @@ func (s *updateSecretsCreate) Checkpoint() *task.CompletedTask {
 }
 
 func (s *updateSecretsCreate) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installCAPIComponentsTask{}, nil
 }
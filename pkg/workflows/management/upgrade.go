@@ -26,6 +26,7 @@ type Upgrade struct {
 	clusterUpgrader   interfaces.ClusterUpgrader
 	packageManager    interfaces.PackageManager
 	iamAuth           interfaces.AwsIamAuth
+	runnerOpts        []task.TaskRunnerOpt
 }
 
 // NewUpgrade builds a new upgrade construct.
@@ -59,6 +60,12 @@ func NewUpgrade(clientFactory interfaces.ClientFactory, provider providers.Provi
 	return upgradeWorkflow
 }
 
+// WithResume makes Run skip the upgrade tasks recorded as completed in the checkpoint of a previous failed run.
+func (c *Upgrade) WithResume() *Upgrade {
+	c.runnerOpts = append(c.runnerOpts, task.WithResume())
+	return c
+}
+
 // Run Upgrade implements upgrade functionality for management cluster's upgrade operation.
 func (c *Upgrade) Run(ctx context.Context, clusterSpec *cluster.Spec, managementCluster *types.Cluster, validator interfaces.Validator) error {
 	commandContext := &task.CommandContext{
@@ -78,9 +85,11 @@ func (c *Upgrade) Run(ctx context.Context, clusterSpec *cluster.Spec, management
 		PackageManager:    c.packageManager,
 		IamAuth:           c.iamAuth,
 	}
+	// Always save a checkpoint so a failed or killed run can be resumed with --resume.
+	opts := append([]task.TaskRunnerOpt{task.WithCheckpointFile()}, c.runnerOpts...)
 	if features.IsActive(features.CheckpointEnabled()) {
-		return task.NewTaskRunner(&setupAndValidateUpgrade{}, c.writer, task.WithCheckpointFile()).RunTask(ctx, commandContext)
+		opts = append(opts, task.WithResumeIfCheckpointExists())
 	}
 
-	return task.NewTaskRunner(&setupAndValidateUpgrade{}, c.writer).RunTask(ctx, commandContext)
+	return task.NewTaskRunner(&setupAndValidateUpgrade{}, c.writer, opts...).RunTask(ctx, commandContext)
 }
@@ -149,12 +149,14 @@ func (s *setupAndValidateMC) Run(ctx context.Context, commandContext *task.Comma
 		return nil
 	}
 	commandContext.CurrentClusterSpec = currentSpec
-	runner := validations.NewRunner()
+	runner := validations.NewRunner(validations.RunnerOptsFor(commandContext.Validations)...)
 	runner.Register(
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
-				Name: fmt.Sprintf("%s provider setup and validation", commandContext.Provider.Name()),
-				Err:  commandContext.Provider.SetupAndValidateUpgradeManagementComponents(ctx, commandContext.ClusterSpec),
+				ID:       validations.ProviderSetup,
+				Name:     fmt.Sprintf("%s provider setup and validation", commandContext.Provider.Name()),
+				Category: validations.ProviderCategory,
+				Err:      commandContext.Provider.SetupAndValidateUpgradeManagementComponents(ctx, commandContext.ClusterSpec),
 			}
 		},
 	)
@@ -175,8 +177,18 @@ func (s *setupAndValidateMC) Name() string {
 	return "validate"
 }
 
-func (s *setupAndValidateMC) Restore(_ context.Context, _ *task.CommandContext, _ *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+func (s *setupAndValidateMC) Restore(ctx context.Context, commandContext *task.CommandContext, _ *task.CompletedTask) (task.Task, error) {
+	currentSpec, err := commandContext.ClusterManager.GetCurrentClusterSpec(ctx, commandContext.ManagementCluster, commandContext.ClusterSpec.Cluster.Name)
+	if err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	commandContext.CurrentClusterSpec = currentSpec
+	if err = commandContext.Provider.SetupAndValidateUpgradeManagementComponents(ctx, commandContext.ClusterSpec); err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	return &upgradeCoreComponentsMC{}, nil
 }
 
 func (s *setupAndValidateMC) Checkpoint() *task.CompletedTask {
@@ -62,6 +62,8 @@ func newUpgradeManagementTest(t *testing.T) *upgradeManagementTestSetup {
 	gitOpsManager := mocks.NewMockGitOpsManager(mockCtrl)
 	provider := providermocks.NewMockProvider(mockCtrl)
 	writer := writermocks.NewMockFileWriter(mockCtrl)
+	// The workflow saves a checkpoint after every completed task.
+	writer.EXPECT().Write("management-checkpoint.yaml", gomock.Any()).AnyTimes()
 	validator := mocks.NewMockValidator(mockCtrl)
 	eksdInstaller := mocks.NewMockEksdInstaller(mockCtrl)
 	eksdUpgrader := mocks.NewMockEksdUpgrader(mockCtrl)
@@ -304,12 +306,6 @@ func (c *upgradeManagementTestSetup) expectSaveLogs() {
 	)
 }
 
-func (c *upgradeManagementTestSetup) expectWriteCheckpointFile() {
-	gomock.InOrder(
-		c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.newClusterSpec.Cluster.Name), gomock.Any()),
-	)
-}
-
 func (c *upgradeManagementTestSetup) run() error {
 	return c.management.Run(c.ctx, c.newClusterSpec, c.managementCluster, c.validator)
 }
@@ -327,7 +323,6 @@ func TestUpgradeManagementRunUpdateSetupFailed(t *testing.T) {
 	features.ClearCache()
 	test := newUpgradeManagementClusterTest(t)
 	test.expectSetupToFail()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -343,7 +338,6 @@ func TestUpgradeManagementRunUpdateSecretFailed(t *testing.T) {
 	test.expectPreflightValidationsToPass()
 	test.expectUpdateSecrets(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -360,7 +354,6 @@ func TestUpgradeManagementRunEnsureETCDFailed(t *testing.T) {
 	test.expectUpdateSecrets(nil)
 	test.expectEnsureManagementEtcdCAPIComponentsExist(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -378,7 +371,6 @@ func TestUpgradeManagementRunPauseGitOpsReconcileUpgradeFailed(t *testing.T) {
 	test.expectEnsureManagementEtcdCAPIComponentsExist(nil)
 	test.expectPauseGitOpsReconcile(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -399,7 +391,6 @@ func TestUpgradeManagementRunFailedBackup(t *testing.T) {
 	test.expectBackupManagementFromCluster(errors.New(""))
 	test.expectBackupManagementInfrastructureFromCluster(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -420,7 +411,6 @@ func TestUpgradeManagementRunPauseWorkloadCAPIFailed(t *testing.T) {
 	test.expectBackupManagementFromCluster(nil)
 	test.expectPauseCAPIWorkloadClusters(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -442,7 +432,6 @@ func TestUpgradeManagementRunFailedUpgradeBuildClientFromKubeconfig(t *testing.T
 	test.expectDatacenterConfig()
 	test.expectMachineConfigs()
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -466,7 +455,6 @@ func TestUpgradeManagementRunFailedUpgradeGetManagementComponents(t *testing.T)
 	u.expectDatacenterConfig()
 	u.expectMachineConfigs()
 	u.expectSaveLogs()
-	u.expectWriteCheckpointFile()
 
 	err := u.run()
 	g := NewWithT(t)
@@ -488,7 +476,6 @@ func TestUpgradeManagementRunFailedUpgradeClientGet(t *testing.T) {
 	c.expectDatacenterConfig()
 	c.expectMachineConfigs()
 	c.expectSaveLogs()
-	c.expectWriteCheckpointFile()
 
 	err := c.run()
 	g := NewWithT(t)
@@ -513,7 +500,6 @@ func TestUpgradeManagementRunFailedUpgradeInstallEksd(t *testing.T) {
 	test.expectApplyReleases(nil)
 	test.expectInstallEksdManifest(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -537,7 +523,6 @@ func TestUpgradeManagementRunFailedUpgradeApplyBundles(t *testing.T) {
 	test.expectMachineConfigs()
 	test.expectApplyBundles(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -562,7 +547,6 @@ func TestUpgradeManagementRunFailedUpgradeApplyReleases(t *testing.T) {
 	test.expectApplyBundles(nil)
 	test.expectApplyReleases(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -589,7 +573,6 @@ func TestUpgradeManagementRunFailedUpgrade(t *testing.T) {
 	test.expectApplyReleases(nil)
 	test.clusterUpgrader.EXPECT().Run(test.ctx, test.newClusterSpec, *test.managementCluster).Return(errors.New("failed upgrading"))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -616,7 +599,6 @@ func TestUpgradeManagementRunFailedUpgradeClusterBuildClientFromKubeconfig(t *te
 	test.expectApplyReleases(nil)
 	test.clusterUpgrader.EXPECT().Run(test.ctx, test.newClusterSpec, *test.managementCluster).Return(errors.New("failed upgrading"))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -648,7 +630,6 @@ func TestUpgradeManagementRunResumeCAPIWorkloadFailed(t *testing.T) {
 	test.expectWriteManagementClusterConfig(nil)
 	test.expectResumeCAPIWorkloadClustersAPI(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -676,7 +657,6 @@ func TestUpgradeManagementRunUpdateGitEksaSpecFailed(t *testing.T) {
 	test.expectUpgradeManagementCluster()
 	test.expectUpdateGitEksaSpec(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -705,7 +685,6 @@ func TestUpgradeManagementRunForceReconcileGitRepoFailed(t *testing.T) {
 	test.expectUpdateGitEksaSpec(nil)
 	test.expectForceReconcileGitRepo(errors.New(""))
 	test.expectSaveLogs()
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -736,7 +715,6 @@ func TestUpgradeManagementRunResumeClusterResourcesReconcileFailed(t *testing.T)
 	test.expectResumeGitOpsReconcile(errors.New(""))
 	test.expectWriteManagementClusterConfig(nil)
 	test.expectResumeCAPIWorkloadClustersAPI(nil)
-	test.expectWriteCheckpointFile()
 
 	err := test.run()
 	if err == nil {
@@ -777,6 +755,8 @@ func TestUpgradeManagementRunUpgradeCuratedPackagesSuccess(t *testing.T) {
 	tt.expectWriteManagementClusterConfig(nil)
 	tt.expectPackagesUpgrade()
 
+	tt.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := tt.run()
 	if err != nil {
 		t.Fatalf("UpgradeManagement.Run() err = %v, want err = nil", err)
@@ -807,6 +787,8 @@ func TestUpgradeManagementRunSuccess(t *testing.T) {
 	test.expectResumeGitOpsReconcile(nil)
 	test.expectWriteManagementClusterConfig(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("UpgradeManagement.Run() err = %v, want err = nil", err)
@@ -844,6 +826,8 @@ func TestTinkerbellUpgradeManagementRunSuccess(t *testing.T) {
 	test.expectResumeGitOpsReconcile(nil)
 	test.expectWriteManagementClusterConfig(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("UpgradeManagement.Run() err = %v, want err = nil", err)
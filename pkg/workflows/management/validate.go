@@ -15,7 +15,7 @@ type setupAndValidateCreate struct{}
 
 func (s *setupAndValidateCreate) Run(ctx context.Context, commandContext *task.CommandContext) task.Task {
 	logger.Info("Performing setup and validations")
-	runner := validations.NewRunner()
+	runner := validations.NewRunner(validations.RunnerOptsFor(commandContext.Validations)...)
 	runner.Register(s.providerValidation(ctx, commandContext)...)
 	runner.Register(commandContext.GitOpsManager.Validations(ctx, commandContext.ClusterSpec)...)
 	runner.Register(commandContext.Validations.PreflightValidations(ctx)...)
@@ -33,8 +33,10 @@ func (s *setupAndValidateCreate) providerValidation(ctx context.Context, command
 	return []validations.Validation{
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
-				Name: fmt.Sprintf("%s Provider setup is valid", commandContext.Provider.Name()),
-				Err:  commandContext.Provider.SetupAndValidateCreateCluster(ctx, commandContext.ClusterSpec),
+				ID:       validations.ProviderSetup,
+				Name:     fmt.Sprintf("%s Provider setup is valid", commandContext.Provider.Name()),
+				Category: validations.ProviderCategory,
+				Err:      commandContext.Provider.SetupAndValidateCreateCluster(ctx, commandContext.ClusterSpec),
 			}
 		},
 	}
@@ -45,7 +47,12 @@ func (s *setupAndValidateCreate) Name() string {
 }
 
 func (s *setupAndValidateCreate) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	if err := commandContext.Provider.SetupAndValidateCreateCluster(ctx, commandContext.ClusterSpec); err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	logger.Info(fmt.Sprintf("%s Provider setup is valid", commandContext.Provider.Name()))
+	return &createBootStrapClusterTask{}, nil
 }
 
 func (s *setupAndValidateCreate) Checkpoint() *task.CompletedTask {
@@ -63,7 +70,7 @@ func (s *setupAndValidateUpgrade) Run(ctx context.Context, commandContext *task.
 		return nil
 	}
 	commandContext.CurrentClusterSpec = currentSpec
-	runner := validations.NewRunner()
+	runner := validations.NewRunner(validations.RunnerOptsFor(commandContext.Validations)...)
 	runner.Register(s.providerValidation(ctx, commandContext)...)
 	runner.Register(commandContext.Validations.PreflightValidations(ctx)...)
 
@@ -80,8 +87,10 @@ func (s *setupAndValidateUpgrade) providerValidation(ctx context.Context, comman
 	return []validations.Validation{
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
-				Name: fmt.Sprintf("%s provider validation", commandContext.Provider.Name()),
-				Err:  commandContext.Provider.SetupAndValidateUpgradeCluster(ctx, commandContext.ManagementCluster, commandContext.ClusterSpec, commandContext.CurrentClusterSpec),
+				ID:       validations.ProviderSetup,
+				Name:     fmt.Sprintf("%s provider validation", commandContext.Provider.Name()),
+				Category: validations.ProviderCategory,
+				Err:      commandContext.Provider.SetupAndValidateUpgradeCluster(ctx, commandContext.ManagementCluster, commandContext.ClusterSpec, commandContext.CurrentClusterSpec),
 			}
 		},
 	}
@@ -130,7 +139,11 @@ func (s *setupAndValidateDelete) Name() string {
 }
 
 func (s *setupAndValidateDelete) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	if err := commandContext.Provider.SetupAndValidateDeleteCluster(ctx, commandContext.WorkloadCluster, commandContext.ClusterSpec); err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	return &createBootStrapClusterForDeleteTask{}, nil
 }
 
 func (s *setupAndValidateDelete) Checkpoint() *task.CompletedTask {
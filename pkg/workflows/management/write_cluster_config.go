@@ -67,7 +67,7 @@ func (s *writeCreateClusterConfig) Name() string {
 }
 
 func (s *writeCreateClusterConfig) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &deleteBootstrapClusterTask{}, nil
 }
 
 func (s *writeCreateClusterConfig) Checkpoint() *task.CompletedTask {
@@ -21,6 +21,7 @@ type Create struct {
 	clusterCreator   interfaces.ClusterCreator
 	packageInstaller interfaces.PackageManager
 	iamAuth          interfaces.AwsIamAuth
+	runnerOpts       []task.TaskRunnerOpt
 }
 
 // NewCreate builds a new create construct.
@@ -48,6 +49,12 @@ func NewCreate(provider providers.Provider,
 	return createWorkflow
 }
 
+// WithResume makes Run continue a previous failed workload cluster create from its checkpoint.
+func (c *Create) WithResume() *Create {
+	c.runnerOpts = append(c.runnerOpts, task.WithResume())
+	return c
+}
+
 // Run executes the tasks to create a workload cluster.
 func (c *Create) Run(ctx context.Context, clusterSpec *cluster.Spec, validator interfaces.Validator) error {
 	commandContext := &task.CommandContext{
@@ -63,5 +70,7 @@ func (c *Create) Run(ctx context.Context, clusterSpec *cluster.Spec, validator i
 		IamAuth:           c.iamAuth,
 	}
 
-	return task.NewTaskRunner(&setAndValidateCreateWorkloadTask{}, c.writer).RunTask(ctx, commandContext)
+	// Always save a checkpoint so a failed or killed run can be resumed with --resume.
+	opts := append([]task.TaskRunnerOpt{task.WithCheckpointFile()}, c.runnerOpts...)
+	return task.NewTaskRunner(&setAndValidateCreateWorkloadTask{}, c.writer, opts...).RunTask(ctx, commandContext)
 }
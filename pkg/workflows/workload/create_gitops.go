@@ -26,7 +26,7 @@ func (s *installGitOpsManagerTask) Name() string {
 }
 
 func (s *installGitOpsManagerTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &writeClusterConfig{}, nil
 }
 
 func (s *installGitOpsManagerTask) Checkpoint() *task.CompletedTask {
@@ -56,6 +56,8 @@ func newCreateTest(t *testing.T) *createTestSetup {
 	gitOpsManager := mocks.NewMockGitOpsManager(mockCtrl)
 	provider := providermocks.NewMockProvider(mockCtrl)
 	writer := writermocks.NewMockFileWriter(mockCtrl)
+	// The workflow saves a checkpoint after every completed task.
+	writer.EXPECT().Write("workload-checkpoint.yaml", gomock.Any()).AnyTimes()
 	eksd := mocks.NewMockEksdInstaller(mockCtrl)
 	packageInstaller := mocks.NewMockPackageManager(mockCtrl)
 	eksdInstaller := mocks.NewMockEksdInstaller(mockCtrl)
@@ -171,7 +173,6 @@ func (c *createTestSetup) expectPreflightValidationsToPass() {
 
 func (c *createTestSetup) expectSaveLogsManagement() {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.clusterSpec.ManagementCluster)
-	c.expectWrite()
 }
 
 func (c *createTestSetup) expectInstallGitOpsManager(err error) {
@@ -184,10 +185,6 @@ func (c *createTestSetup) expectAWSIAMAuthKubeconfig(err error) {
 		c.ctx, c.clusterSpec.ManagementCluster, c.workloadCluster, c.clusterSpec).Return(err)
 }
 
-func (c *createTestSetup) expectWrite() {
-	c.writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
-}
-
 func TestCreateRunSuccess(t *testing.T) {
 	features.ClearCache()
 	os.Setenv(features.UseControllerForCli, "true")
@@ -200,6 +197,8 @@ func TestCreateRunSuccess(t *testing.T) {
 	test.expectInstallGitOpsManager(nil)
 	test.expectWriteWorkloadClusterConfig(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
@@ -246,7 +245,6 @@ func TestCreateRunValidateFail(t *testing.T) {
 	test.gitOpsManager.EXPECT().Validations(test.ctx, test.clusterSpec)
 	test.provider.EXPECT().SetupAndValidateCreateCluster(test.ctx, test.clusterSpec).Return(fmt.Errorf("Failure"))
 	test.expectPreflightValidationsToPass()
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil || !strings.Contains(err.Error(), "validations failed") {
@@ -265,6 +263,8 @@ func TestCreateRunGitOpsConfigFail(t *testing.T) {
 	test.expectInstallGitOpsManager(fmt.Errorf("Failure"))
 	test.expectWriteWorkloadClusterConfig(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
@@ -281,7 +281,6 @@ func TestCreateRunWriteClusterConfigFail(t *testing.T) {
 	test.expectCreateWorkloadCluster(nil, nil)
 	test.expectInstallGitOpsManager(nil)
 	test.expectWriteWorkloadClusterConfig(fmt.Errorf("Failure"))
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
@@ -302,6 +301,8 @@ func TestCreateAWSIAMSuccess(t *testing.T) {
 	test.expectWriteWorkloadClusterConfig(nil)
 	test.expectAWSIAMAuthKubeconfig(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
@@ -322,8 +323,6 @@ func TestCreateAWSIAMFailure(t *testing.T) {
 	err := errors.New("test")
 	test.expectAWSIAMAuthKubeconfig(err)
 
-	test.writer.EXPECT().Write("workload-checkpoint.yaml", gomock.Any(), gomock.Any()).Return("workload-checkpoint.yaml.yaml", err)
-
 	err = test.run()
 	if err == nil {
 		t.Fatalf("Create.Run() err = %v, want err = nil", err)
@@ -18,6 +18,7 @@ type Delete struct {
 	clusterManager interfaces.ClusterManager
 	clusterDeleter interfaces.ClusterDeleter
 	gitopsManager  interfaces.GitOpsManager
+	runnerOpts     []task.TaskRunnerOpt
 }
 
 // NewDelete builds a new delete construct.
@@ -36,6 +37,12 @@ func NewDelete(provider providers.Provider,
 	}
 }
 
+// WithResume makes Run continue a previous failed workload cluster delete from its checkpoint.
+func (c *Delete) WithResume() *Delete {
+	c.runnerOpts = append(c.runnerOpts, task.WithResume())
+	return c
+}
+
 // Run executes the tasks to delete a workload cluster.
 func (c *Delete) Run(ctx context.Context, workload *types.Cluster, clusterSpec *cluster.Spec) error {
 	commandContext := &task.CommandContext{
@@ -49,5 +56,7 @@ func (c *Delete) Run(ctx context.Context, workload *types.Cluster, clusterSpec *
 		GitOpsManager:     c.gitopsManager,
 	}
 
-	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer).RunTask(ctx, commandContext)
+	// Always save a checkpoint so a failed or killed run can be resumed with --resume.
+	opts := append([]task.TaskRunnerOpt{task.WithCheckpointFile()}, c.runnerOpts...)
+	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer, opts...).RunTask(ctx, commandContext)
 }
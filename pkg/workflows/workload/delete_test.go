@@ -40,6 +40,8 @@ func newDeleteTest(t *testing.T) *deleteTestSetup {
 	mockCtrl := gomock.NewController(t)
 	provider := providermocks.NewMockProvider(mockCtrl)
 	writer := writermocks.NewMockFileWriter(mockCtrl)
+	// The workflow saves a checkpoint after every completed task.
+	writer.EXPECT().Write("workload-checkpoint.yaml", gomock.Any()).AnyTimes()
 	manager := mocks.NewMockClusterManager(mockCtrl)
 
 	datacenterConfig := &v1alpha1.VSphereDatacenterConfig{}
@@ -91,13 +93,8 @@ func (c *deleteTestSetup) run() error {
 	return c.workload.Run(c.ctx, c.workloadCluster, c.clusterSpec)
 }
 
-func (c *deleteTestSetup) expectWrite() {
-	c.writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
-}
-
 func (c *deleteTestSetup) expectSaveLogsWorkload() {
 	c.clusterManager.EXPECT().SaveLogsWorkloadCluster(c.ctx, c.provider, c.clusterSpec, c.workloadCluster)
-	c.expectWrite()
 }
 
 func (c *deleteTestSetup) expectCleanup(err error) {
@@ -112,6 +109,8 @@ func TestDeleteRunSuccess(t *testing.T) {
 	test.expectDeleteWorkloadCluster(nil)
 	test.expectCleanup(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
@@ -137,7 +136,6 @@ func TestDeleteRunFailSetup(t *testing.T) {
 	os.Setenv(features.UseControllerForCli, "true")
 	test := newDeleteTest(t)
 	test.expectSetup(fmt.Errorf("Failure"))
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
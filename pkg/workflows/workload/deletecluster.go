@@ -26,7 +26,7 @@ func (s *deleteWorkloadCluster) Name() string {
 }
 
 func (s *deleteWorkloadCluster) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &postDeleteWorkload{}, nil
 }
 
 func (s *deleteWorkloadCluster) Checkpoint() *task.CompletedTask {
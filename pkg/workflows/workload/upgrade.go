@@ -22,6 +22,7 @@ type Upgrade struct {
 	clusterUpgrader  interfaces.ClusterUpgrader
 	packageInstaller interfaces.PackageManager
 	iamAuth          interfaces.AwsIamAuth
+	runnerOpts       []task.TaskRunnerOpt
 }
 
 // NewUpgrade builds a new upgrade construct.
@@ -49,6 +50,12 @@ func NewUpgrade(clientFactory interfaces.ClientFactory,
 	return upgradeWorkflow
 }
 
+// WithResume makes Run continue a previous failed workload cluster upgrade from its checkpoint.
+func (c *Upgrade) WithResume() *Upgrade {
+	c.runnerOpts = append(c.runnerOpts, task.WithResume())
+	return c
+}
+
 // Run Upgrade implements upgrade functionality for workload cluster's upgrade operation.
 func (c *Upgrade) Run(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, validator interfaces.Validator) error {
 	commandContext := &task.CommandContext{
@@ -65,5 +72,7 @@ func (c *Upgrade) Run(ctx context.Context, cluster *types.Cluster, clusterSpec *
 		IamAuth:           c.iamAuth,
 	}
 
-	return task.NewTaskRunner(&setAndValidateUpgradeWorkloadTask{}, c.writer).RunTask(ctx, commandContext)
+	// Always save a checkpoint so a failed or killed run can be resumed with --resume.
+	opts := append([]task.TaskRunnerOpt{task.WithCheckpointFile()}, c.runnerOpts...)
+	return task.NewTaskRunner(&setAndValidateUpgradeWorkloadTask{}, c.writer, opts...).RunTask(ctx, commandContext)
 }
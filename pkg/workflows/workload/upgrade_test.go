@@ -55,6 +55,8 @@ func newUpgradeTest(t *testing.T) *upgradeTestSetup {
 	gitOpsManager := mocks.NewMockGitOpsManager(mockCtrl)
 	provider := providermocks.NewMockProvider(mockCtrl)
 	writer := writermocks.NewMockFileWriter(mockCtrl)
+	// The workflow saves a checkpoint after every completed task.
+	writer.EXPECT().Write("workload-checkpoint.yaml", gomock.Any()).AnyTimes()
 	eksd := mocks.NewMockEksdInstaller(mockCtrl)
 	packageInstaller := mocks.NewMockPackageManager(mockCtrl)
 	eksdInstaller := mocks.NewMockEksdInstaller(mockCtrl)
@@ -172,11 +174,6 @@ func (c *upgradeTestSetup) expectBackupWorkloadFromCluster(err error) {
 
 func (c *upgradeTestSetup) expectSaveLogsManagement() {
 	c.clusterManager.EXPECT().SaveLogsManagementCluster(c.ctx, c.clusterSpec, c.clusterSpec.ManagementCluster)
-	c.expectWrite()
-}
-
-func (c *upgradeTestSetup) expectWrite() {
-	c.writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 }
 
 func TestUpgradeRunSuccess(t *testing.T) {
@@ -192,6 +189,8 @@ func TestUpgradeRunSuccess(t *testing.T) {
 	test.expectBuildClientFromKubeconfig(nil)
 	test.expectWriteWorkloadClusterConfig(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Upgrade.Run() err = %v, want err = nil", err)
@@ -212,6 +211,8 @@ func TestUpgradeRunWithAWSIAMSuccess(t *testing.T) {
 	test.expectBuildClientFromKubeconfig(nil)
 	test.expectWriteWorkloadClusterConfig(nil)
 	test.expectWithoutAWSIAMAuthKubeconfig(nil)
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Upgrade.Run() err = %v, want err = nil", err)
@@ -281,6 +282,8 @@ func TestTinkerbellUpgradeRunUpgradeSuccess(t *testing.T) {
 	test.expectBuildClientFromKubeconfig(nil)
 	test.expectWriteWorkloadClusterConfig(nil)
 
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
 	err := test.run()
 	if err != nil {
 		t.Fatalf("Upgrade.Run() err = %v, want err = nil", err)
@@ -324,7 +327,6 @@ func TestUpgradeRunGetCurrentClusterSpecFail(t *testing.T) {
 	os.Setenv(features.UseControllerForCli, "true")
 	test := newUpgradeTest(t)
 	test.clusterManager.EXPECT().GetCurrentClusterSpec(test.ctx, test.clusterSpec.ManagementCluster, test.clusterSpec.Cluster.Name).Return(nil, fmt.Errorf("boom"))
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
@@ -341,7 +343,6 @@ func TestUpgradeRunValidateFail(t *testing.T) {
 	test.gitOpsManager.EXPECT().Validations(test.ctx, test.clusterSpec).AnyTimes()
 	test.provider.EXPECT().SetupAndValidateUpgradeCluster(test.ctx, test.clusterSpec.ManagementCluster, test.clusterSpec, test.currentClusterSpec).Return(fmt.Errorf("boom"))
 	test.expectPreflightValidationsToPass()
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
@@ -378,7 +379,6 @@ func TestUpgradeRunWriteClusterConfigFail(t *testing.T) {
 	test.expectUpgradeWorkloadCluster(nil)
 	test.expectBuildClientFromKubeconfig(nil)
 	test.expectWriteWorkloadClusterConfig(fmt.Errorf("boom"))
-	test.expectWrite()
 
 	err := test.run()
 	if err == nil {
@@ -16,7 +16,7 @@ type (
 
 // Run setAndValidateCreateWorkloadTask performs actions needed to validate creating the workload cluster.
 func (s *setAndValidateCreateWorkloadTask) Run(ctx context.Context, commandContext *task.CommandContext) task.Task {
-	runner := validations.NewRunner()
+	runner := validations.NewRunner(validations.RunnerOptsFor(commandContext.Validations)...)
 	runner.Register(s.providerValidation(ctx, commandContext)...)
 	runner.Register(commandContext.GitOpsManager.Validations(ctx, commandContext.ClusterSpec)...)
 	runner.Register(commandContext.Validations.PreflightValidations(ctx)...)
@@ -33,8 +33,10 @@ func (s *setAndValidateCreateWorkloadTask) providerValidation(ctx context.Contex
 	return []validations.Validation{
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
-				Name: fmt.Sprintf("workload cluster's %s Provider setup is valid", commandContext.Provider.Name()),
-				Err:  commandContext.Provider.SetupAndValidateCreateCluster(ctx, commandContext.ClusterSpec),
+				ID:       validations.ProviderSetup,
+				Name:     fmt.Sprintf("workload cluster's %s Provider setup is valid", commandContext.Provider.Name()),
+				Category: validations.ProviderCategory,
+				Err:      commandContext.Provider.SetupAndValidateCreateCluster(ctx, commandContext.ClusterSpec),
 			}
 		},
 	}
@@ -45,7 +47,11 @@ func (s *setAndValidateCreateWorkloadTask) Name() string {
 }
 
 func (s *setAndValidateCreateWorkloadTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	if err := commandContext.Provider.SetupAndValidateCreateCluster(ctx, commandContext.ClusterSpec); err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	return &createCluster{}, nil
 }
 
 func (s *setAndValidateCreateWorkloadTask) Checkpoint() *task.CompletedTask {
@@ -60,7 +66,7 @@ func (s *setAndValidateUpgradeWorkloadTask) Run(ctx context.Context, commandCont
 		return nil
 	}
 	commandContext.CurrentClusterSpec = currentSpec
-	runner := validations.NewRunner()
+	runner := validations.NewRunner(validations.RunnerOptsFor(commandContext.Validations)...)
 	runner.Register(s.providerValidation(ctx, commandContext)...)
 	runner.Register(commandContext.GitOpsManager.Validations(ctx, commandContext.ClusterSpec)...)
 	runner.Register(commandContext.Validations.PreflightValidations(ctx)...)
@@ -77,8 +83,10 @@ func (s *setAndValidateUpgradeWorkloadTask) providerValidation(ctx context.Conte
 	return []validations.Validation{
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
-				Name: fmt.Sprintf("workload cluster's %s Provider setup is valid", commandContext.Provider.Name()),
-				Err:  commandContext.Provider.SetupAndValidateUpgradeCluster(ctx, commandContext.ManagementCluster, commandContext.ClusterSpec, commandContext.CurrentClusterSpec),
+				ID:       validations.ProviderSetup,
+				Name:     fmt.Sprintf("workload cluster's %s Provider setup is valid", commandContext.Provider.Name()),
+				Category: validations.ProviderCategory,
+				Err:      commandContext.Provider.SetupAndValidateUpgradeCluster(ctx, commandContext.ManagementCluster, commandContext.ClusterSpec, commandContext.CurrentClusterSpec),
 			}
 		},
 	}
@@ -89,7 +97,17 @@ func (s *setAndValidateUpgradeWorkloadTask) Name() string {
 }
 
 func (s *setAndValidateUpgradeWorkloadTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	currentSpec, err := commandContext.ClusterManager.GetCurrentClusterSpec(ctx, commandContext.ClusterSpec.ManagementCluster, commandContext.ClusterSpec.Cluster.Name)
+	if err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	commandContext.CurrentClusterSpec = currentSpec
+	if err = commandContext.Provider.SetupAndValidateUpgradeCluster(ctx, commandContext.ManagementCluster, commandContext.ClusterSpec, commandContext.CurrentClusterSpec); err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	return &preClusterUpgrade{}, nil
 }
 
 func (s *setAndValidateUpgradeWorkloadTask) Checkpoint() *task.CompletedTask {
@@ -113,7 +131,11 @@ func (s *setupAndValidateDelete) Name() string {
 }
 
 func (s *setupAndValidateDelete) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	if err := commandContext.Provider.SetupAndValidateDeleteCluster(ctx, commandContext.WorkloadCluster, commandContext.ClusterSpec); err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+	return &deleteWorkloadCluster{}, nil
 }
 
 func (s *setupAndValidateDelete) Checkpoint() *task.CompletedTask {
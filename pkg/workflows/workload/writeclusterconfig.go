@@ -57,7 +57,7 @@ func (s *writeClusterConfig) Checkpoint() *task.CompletedTask {
 }
 
 func (s *writeClusterConfig) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	if commandContext.CurrentClusterSpec == nil {
+	if commandContext.CurrentClusterSpec != nil {
 		return &postClusterUpgrade{}, nil
 	}
 	return nil, nil
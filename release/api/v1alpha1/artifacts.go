@@ -177,7 +177,7 @@ func (vb *VersionsBundle) NutanixImages() []Image {
 
 // SharedImages returns images that are shared across different providers in a VersionsBundle.
 func (vb *VersionsBundle) SharedImages() []Image {
-	return []Image{
+	i := []Image{
 		vb.Bootstrap.Controller,
 		vb.Bootstrap.KubeProxy,
 		vb.BottleRocketHostContainers.Admin,
@@ -211,6 +211,12 @@ func (vb *VersionsBundle) SharedImages() []Image {
 		vb.PackageController.TokenRefresher,
 		vb.Upgrader.Upgrader,
 	}
+
+	if vb.LoadBalancer != nil {
+		i = append(i, vb.LoadBalancer.KubeVip, vb.LoadBalancer.CloudProvider)
+	}
+
+	return i
 }
 
 // Images returns all images from the VersionsBundle by aggregating those from different providers.
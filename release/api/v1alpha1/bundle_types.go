@@ -85,6 +85,9 @@ type VersionsBundle struct {
 	Snow                       SnowBundle                       `json:"snow,omitempty"`
 	Nutanix                    NutanixBundle                    `json:"nutanix,omitempty"`
 	Upgrader                   UpgraderBundle                   `json:"upgrader,omitempty"`
+	// LoadBalancer is the in-cluster load balancer for Services of type LoadBalancer, only installed
+	// for clusters that configure it.
+	LoadBalancer *LoadBalancerBundle `json:"loadBalancer,omitempty"`
 	// This field has been deprecated
 	Aws *AwsBundle `json:"aws,omitempty"`
 }
@@ -220,6 +223,23 @@ type VSphereBundle struct {
 	Driver *Image `json:"driver,omitempty"`
 	// This field has been deprecated
 	Syncer *Image `json:"syncer,omitempty"`
+	// IPAM is the CAPI in-cluster IPAM provider, only installed for clusters that use static IP pools.
+	IPAM *IPAMBundle `json:"ipam,omitempty"`
+}
+
+// IPAMBundle defines the CAPI in-cluster IPAM provider image, manifests and version for this bundle.
+type IPAMBundle struct {
+	Version    string   `json:"version"`
+	Controller Image    `json:"controller"`
+	Components Manifest `json:"components"`
+	Metadata   Manifest `json:"metadata"`
+}
+
+// LoadBalancerBundle defines the kube-vip images used as in-cluster load balancer for this bundle.
+type LoadBalancerBundle struct {
+	Version       string `json:"version"`
+	KubeVip       Image  `json:"kubeVip"`
+	CloudProvider Image  `json:"cloudProvider"`
 }
 
 // DockerBundle defines the Docker provider images and version for this bundle.
@@ -609,6 +609,24 @@ func (in *HookBundle) DeepCopy() *HookBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMBundle) DeepCopyInto(out *IPAMBundle) {
+	*out = *in
+	in.Controller.DeepCopyInto(&out.Controller)
+	out.Components = in.Components
+	out.Metadata = in.Metadata
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMBundle.
+func (in *IPAMBundle) DeepCopy() *IPAMBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -683,6 +701,23 @@ func (in *KubeadmControlPlaneBundle) DeepCopy() *KubeadmControlPlaneBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerBundle) DeepCopyInto(out *LoadBalancerBundle) {
+	*out = *in
+	in.KubeVip.DeepCopyInto(&out.KubeVip)
+	in.CloudProvider.DeepCopyInto(&out.CloudProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerBundle.
+func (in *LoadBalancerBundle) DeepCopy() *LoadBalancerBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Manifest) DeepCopyInto(out *Manifest) {
 	*out = *in
@@ -990,6 +1025,11 @@ func (in *VSphereBundle) DeepCopyInto(out *VSphereBundle) {
 		*out = new(Image)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPAM != nil {
+		in, out := &in.IPAM, &out.IPAM
+		*out = new(IPAMBundle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereBundle.
@@ -1026,6 +1066,11 @@ func (in *VersionsBundle) DeepCopyInto(out *VersionsBundle) {
 	in.Snow.DeepCopyInto(&out.Snow)
 	in.Nutanix.DeepCopyInto(&out.Nutanix)
 	in.Upgrader.DeepCopyInto(&out.Upgrader)
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerBundle)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Aws != nil {
 		in, out := &in.Aws, &out.Aws
 		*out = new(AwsBundle)
@@ -184,6 +184,27 @@ var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
 			},
 		},
 	},
+	// Cluster-api-ipam-provider-in-cluster artifacts
+	{
+		ProjectName: "cluster-api-ipam-provider-in-cluster",
+		ProjectPath: "projects/kubernetes-sigs/cluster-api-ipam-provider-in-cluster",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "cluster-api-ipam-in-cluster-controller",
+			},
+		},
+		ImageRepoPrefix: "kubernetes-sigs/cluster-api-ipam-provider-in-cluster",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				Name:          "ipam-in-cluster",
+				ManifestFiles: []string{"ipam-components.yaml", "metadata.yaml"},
+			},
+		},
+	},
 	// Cluster-api-provider-aws-snow artifacts
 	{
 		ProjectName: "cluster-api-provider-aws-snow",
@@ -718,6 +739,21 @@ var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
 			"projectPath",
 		},
 	},
+	// Kube-vip-cloud-provider artifacts
+	{
+		ProjectName: "kube-vip-cloud-provider",
+		ProjectPath: "projects/kube-vip/kube-vip-cloud-provider",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "kube-vip-cloud-provider",
+			},
+		},
+		ImageRepoPrefix: "kube-vip",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+	},
 	// Envoy artifacts
 	{
 		ProjectName: "envoy",
@@ -136,6 +136,11 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests releasetypes
 		return nil, errors.Wrapf(err, "Error getting bundle for Nutanix infrastructure provider")
 	}
 
+	loadBalancerBundle, err := GetLoadBalancerBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for kube-vip load balancer")
+	}
+
 	eksDReleaseMap, err := filereader.ReadEksDReleases(r)
 	if err != nil {
 		return nil, err
@@ -206,6 +211,7 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests releasetypes
 			Snow:                       snowBundle,
 			Nutanix:                    nutanixBundle,
 			Upgrader:                   upgraderBundle,
+			LoadBalancer:               &loadBalancerBundle,
 		}
 		if endOfStandardSupport != "" {
 			versionsBundle.EndOfStandardSupport = endOfStandardSupport
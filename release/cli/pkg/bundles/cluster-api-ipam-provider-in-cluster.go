@@ -0,0 +1,101 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundles
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	anywherev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	"github.com/aws/eks-anywhere/release/cli/pkg/constants"
+	releasetypes "github.com/aws/eks-anywhere/release/cli/pkg/types"
+	"github.com/aws/eks-anywhere/release/cli/pkg/version"
+)
+
+// GetIPAMBundle returns the bundle for the CAPI in-cluster IPAM provider, installed with the vSphere
+// provider by the clusters that use static IP pools.
+func GetIPAMBundle(r *releasetypes.ReleaseConfig, imageDigests releasetypes.ImageDigestsTable) (anywherev1alpha1.IPAMBundle, error) {
+	project := "cluster-api-ipam-provider-in-cluster"
+	artifacts, err := r.BundleArtifactsTable.Load(project)
+	if err != nil {
+		return anywherev1alpha1.IPAMBundle{}, fmt.Errorf("artifacts for project %s not found in bundle artifacts table", project)
+	}
+
+	var sourceBranch string
+	var componentChecksum string
+	bundleImageArtifacts := map[string]anywherev1alpha1.Image{}
+	bundleManifestArtifacts := map[string]anywherev1alpha1.Manifest{}
+	artifactHashes := []string{}
+
+	for _, artifact := range artifacts {
+		if artifact.Image != nil {
+			imageArtifact := artifact.Image
+			sourceBranch = imageArtifact.SourcedFromBranch
+			imageDigest, err := imageDigests.Load(imageArtifact.ReleaseImageURI)
+			if err != nil {
+				return anywherev1alpha1.IPAMBundle{}, fmt.Errorf("loading digest from image digests table: %v", err)
+			}
+			bundleImageArtifact := anywherev1alpha1.Image{
+				Name:        imageArtifact.AssetName,
+				Description: fmt.Sprintf("Container image for %s image", imageArtifact.AssetName),
+				OS:          imageArtifact.OS,
+				Arch:        imageArtifact.Arch,
+				URI:         imageArtifact.ReleaseImageURI,
+				ImageDigest: imageDigest,
+			}
+			bundleImageArtifacts[imageArtifact.AssetName] = bundleImageArtifact
+			artifactHashes = append(artifactHashes, bundleImageArtifact.ImageDigest)
+		}
+
+		if artifact.Manifest != nil {
+			manifestArtifact := artifact.Manifest
+			bundleManifestArtifact := anywherev1alpha1.Manifest{
+				URI: manifestArtifact.ReleaseCdnURI,
+			}
+
+			bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
+
+			manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
+			if err != nil {
+				return anywherev1alpha1.IPAMBundle{}, err
+			}
+
+			artifactHashes = append(artifactHashes, manifestHash)
+		}
+	}
+
+	if r.DryRun {
+		componentChecksum = version.FakeComponentChecksum
+	} else {
+		componentChecksum = version.GenerateComponentHash(artifactHashes, r.DryRun)
+	}
+	version, err := version.BuildComponentVersion(
+		version.NewVersionerWithGITTAG(r.BuildRepoSource, constants.CapiIPAMInClusterProjectPath, sourceBranch, r),
+		componentChecksum,
+	)
+	if err != nil {
+		return anywherev1alpha1.IPAMBundle{}, errors.Wrapf(err, "Error getting version for cluster-api-ipam-provider-in-cluster")
+	}
+
+	bundle := anywherev1alpha1.IPAMBundle{
+		Version:    version,
+		Controller: bundleImageArtifacts["cluster-api-ipam-in-cluster-controller"],
+		Components: bundleManifestArtifacts["ipam-components.yaml"],
+		Metadata:   bundleManifestArtifacts["metadata.yaml"],
+	}
+
+	return bundle, nil
+}
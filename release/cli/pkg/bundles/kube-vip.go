@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundles
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	anywherev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	"github.com/aws/eks-anywhere/release/cli/pkg/constants"
+	releasetypes "github.com/aws/eks-anywhere/release/cli/pkg/types"
+	"github.com/aws/eks-anywhere/release/cli/pkg/version"
+)
+
+// GetLoadBalancerBundle returns the bundle for the kube-vip DaemonSet and cloud provider that
+// serve the Services of type LoadBalancer of the clusters that configure a loadBalancer.
+func GetLoadBalancerBundle(r *releasetypes.ReleaseConfig, imageDigests releasetypes.ImageDigestsTable) (anywherev1alpha1.LoadBalancerBundle, error) {
+	projectsInBundle := []string{"kube-vip", "kube-vip-cloud-provider"}
+
+	var sourceBranch string
+	var componentChecksum string
+	bundleImageArtifacts := map[string]anywherev1alpha1.Image{}
+	artifactHashes := []string{}
+
+	for _, project := range projectsInBundle {
+		projectArtifacts, err := r.BundleArtifactsTable.Load(project)
+		if err != nil {
+			return anywherev1alpha1.LoadBalancerBundle{}, fmt.Errorf("artifacts for project %s not found in bundle artifacts table", project)
+		}
+
+		for _, artifact := range projectArtifacts {
+			imageArtifact := artifact.Image
+			if project == "kube-vip" {
+				sourceBranch = imageArtifact.SourcedFromBranch
+			}
+			imageDigest, err := imageDigests.Load(imageArtifact.ReleaseImageURI)
+			if err != nil {
+				return anywherev1alpha1.LoadBalancerBundle{}, fmt.Errorf("loading digest from image digests table: %v", err)
+			}
+			bundleImageArtifact := anywherev1alpha1.Image{
+				Name:        imageArtifact.AssetName,
+				Description: fmt.Sprintf("Container image for %s image", imageArtifact.AssetName),
+				OS:          imageArtifact.OS,
+				Arch:        imageArtifact.Arch,
+				URI:         imageArtifact.ReleaseImageURI,
+				ImageDigest: imageDigest,
+			}
+			bundleImageArtifacts[imageArtifact.AssetName] = bundleImageArtifact
+			artifactHashes = append(artifactHashes, bundleImageArtifact.ImageDigest)
+		}
+	}
+
+	if r.DryRun {
+		componentChecksum = version.FakeComponentChecksum
+	} else {
+		componentChecksum = version.GenerateComponentHash(artifactHashes, r.DryRun)
+	}
+	version, err := version.BuildComponentVersion(
+		version.NewVersionerWithGITTAG(r.BuildRepoSource, constants.KubeVipProjectPath, sourceBranch, r),
+		componentChecksum,
+	)
+	if err != nil {
+		return anywherev1alpha1.LoadBalancerBundle{}, errors.Wrapf(err, "Error getting version for kube-vip")
+	}
+
+	bundle := anywherev1alpha1.LoadBalancerBundle{
+		Version:       version,
+		KubeVip:       bundleImageArtifacts["kube-vip"],
+		CloudProvider: bundleImageArtifacts["kube-vip-cloud-provider"],
+	}
+
+	return bundle, nil
+}
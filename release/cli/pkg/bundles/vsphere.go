@@ -103,6 +103,11 @@ func GetVsphereBundle(r *releasetypes.ReleaseConfig, eksDReleaseChannel string,
 		return anywherev1alpha1.VSphereBundle{}, errors.Wrapf(err, "Error getting version for cluster-api-provider-vsphere")
 	}
 
+	ipamBundle, err := GetIPAMBundle(r, imageDigests)
+	if err != nil {
+		return anywherev1alpha1.VSphereBundle{}, errors.Wrapf(err, "Error getting bundle for in-cluster IPAM provider")
+	}
+
 	bundle := anywherev1alpha1.VSphereBundle{
 		Version:              version,
 		ClusterAPIController: bundleImageArtifacts["cluster-api-provider-vsphere"],
@@ -112,6 +117,7 @@ func GetVsphereBundle(r *releasetypes.ReleaseConfig, eksDReleaseChannel string,
 		Components:           bundleManifestArtifacts["infrastructure-components.yaml"],
 		ClusterTemplate:      bundleManifestArtifacts["cluster-template.yaml"],
 		Metadata:             bundleManifestArtifacts["metadata.yaml"],
+		IPAM:                 &ipamBundle,
 	}
 
 	return bundle, nil
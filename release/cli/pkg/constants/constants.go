@@ -30,6 +30,7 @@ const (
 	CapasProjectPath                    = "projects/aws/cluster-api-provider-aws-snow"
 	CapcProjectPath                     = "projects/kubernetes-sigs/cluster-api-provider-cloudstack"
 	CapiProjectPath                     = "projects/kubernetes-sigs/cluster-api"
+	CapiIPAMInClusterProjectPath        = "projects/kubernetes-sigs/cluster-api-ipam-provider-in-cluster"
 	CaptProjectPath                     = "projects/tinkerbell/cluster-api-provider-tinkerbell"
 	CapvProjectPath                     = "projects/kubernetes-sigs/cluster-api-provider-vsphere"
 	CapxProjectPath                     = "projects/nutanix-cloud-native/cluster-api-provider-nutanix"
@@ -43,6 +44,7 @@ const (
 	ImageBuilderProjectPath             = "projects/kubernetes-sigs/image-builder"
 	KindProjectPath                     = "projects/kubernetes-sigs/kind"
 	KubeRbacProxyProjectPath            = "projects/brancz/kube-rbac-proxy"
+	KubeVipProjectPath                  = "projects/kube-vip/kube-vip"
 	PackagesProjectPath                 = "projects/aws/eks-anywhere-packages"
 	UpgraderProjectPath                 = "projects/aws/upgrader"
 